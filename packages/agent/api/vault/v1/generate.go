@@ -0,0 +1,6 @@
+package vaultv1
+
+// Code is generated from vault.proto with protoc, protoc-gen-go and
+// protoc-gen-go-grpc on the PATH: `go generate ./api/...`
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative vault.proto
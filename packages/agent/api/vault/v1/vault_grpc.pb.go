@@ -0,0 +1,458 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.27.1
+// source: vault.proto
+
+package vaultv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	VaultService_Upload_FullMethodName          = "/nebularvault.vault.v1.VaultService/Upload"
+	VaultService_Download_FullMethodName        = "/nebularvault.vault.v1.VaultService/Download"
+	VaultService_GetFileMetadata_FullMethodName = "/nebularvault.vault.v1.VaultService/GetFileMetadata"
+	VaultService_ListFiles_FullMethodName       = "/nebularvault.vault.v1.VaultService/ListFiles"
+	VaultService_GetProof_FullMethodName        = "/nebularvault.vault.v1.VaultService/GetProof"
+	VaultService_GetJob_FullMethodName          = "/nebularvault.vault.v1.VaultService/GetJob"
+	VaultService_ChunkFile_FullMethodName       = "/nebularvault.vault.v1.VaultService/ChunkFile"
+	VaultService_ReconstructFile_FullMethodName = "/nebularvault.vault.v1.VaultService/ReconstructFile"
+)
+
+// VaultServiceClient is the client API for VaultService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type VaultServiceClient interface {
+	// Upload streams a file to the agent and queues it for chunking, upload to
+	// 0G Storage and anchoring. The first message carries the file's info,
+	// the rest its content.
+	Upload(ctx context.Context, opts ...grpc.CallOption) (VaultService_UploadClient, error)
+	// Download streams a stored file, chunk by chunk, from 0G Storage. A hash
+	// that is not a stored file is fetched as a single 0G root.
+	Download(ctx context.Context, in *DownloadRequest, opts ...grpc.CallOption) (VaultService_DownloadClient, error)
+	// GetFileMetadata returns a stored file's record
+	GetFileMetadata(ctx context.Context, in *GetFileMetadataRequest, opts ...grpc.CallOption) (*File, error)
+	// ListFiles returns the files the caller owns, or every file for API keys
+	ListFiles(ctx context.Context, in *ListFilesRequest, opts ...grpc.CallOption) (*ListFilesResponse, error)
+	// GetProof returns the 0G Storage proof for a hash
+	GetProof(ctx context.Context, in *GetProofRequest, opts ...grpc.CallOption) (*Proof, error)
+	// GetJob returns an upload, anchor, verify or repair job, optionally
+	// waiting for it to finish
+	GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*Job, error)
+	// ChunkFile splits a file on the agent's disk into chunks. Needs the admin
+	// scope, as it reads server-side paths.
+	ChunkFile(ctx context.Context, in *ChunkFileRequest, opts ...grpc.CallOption) (*FileManifest, error)
+	// ReconstructFile reassembles chunked content to a path on the agent's
+	// disk. Needs the admin scope, as it writes server-side paths.
+	ReconstructFile(ctx context.Context, in *ReconstructFileRequest, opts ...grpc.CallOption) (*ReconstructFileResponse, error)
+}
+
+type vaultServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewVaultServiceClient(cc grpc.ClientConnInterface) VaultServiceClient {
+	return &vaultServiceClient{cc}
+}
+
+func (c *vaultServiceClient) Upload(ctx context.Context, opts ...grpc.CallOption) (VaultService_UploadClient, error) {
+	stream, err := c.cc.NewStream(ctx, &VaultService_ServiceDesc.Streams[0], VaultService_Upload_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vaultServiceUploadClient{stream}
+	return x, nil
+}
+
+type VaultService_UploadClient interface {
+	Send(*UploadRequest) error
+	CloseAndRecv() (*Job, error)
+	grpc.ClientStream
+}
+
+type vaultServiceUploadClient struct {
+	grpc.ClientStream
+}
+
+func (x *vaultServiceUploadClient) Send(m *UploadRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *vaultServiceUploadClient) CloseAndRecv() (*Job, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(Job)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vaultServiceClient) Download(ctx context.Context, in *DownloadRequest, opts ...grpc.CallOption) (VaultService_DownloadClient, error) {
+	stream, err := c.cc.NewStream(ctx, &VaultService_ServiceDesc.Streams[1], VaultService_Download_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vaultServiceDownloadClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type VaultService_DownloadClient interface {
+	Recv() (*DownloadResponse, error)
+	grpc.ClientStream
+}
+
+type vaultServiceDownloadClient struct {
+	grpc.ClientStream
+}
+
+func (x *vaultServiceDownloadClient) Recv() (*DownloadResponse, error) {
+	m := new(DownloadResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vaultServiceClient) GetFileMetadata(ctx context.Context, in *GetFileMetadataRequest, opts ...grpc.CallOption) (*File, error) {
+	out := new(File)
+	err := c.cc.Invoke(ctx, VaultService_GetFileMetadata_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) ListFiles(ctx context.Context, in *ListFilesRequest, opts ...grpc.CallOption) (*ListFilesResponse, error) {
+	out := new(ListFilesResponse)
+	err := c.cc.Invoke(ctx, VaultService_ListFiles_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) GetProof(ctx context.Context, in *GetProofRequest, opts ...grpc.CallOption) (*Proof, error) {
+	out := new(Proof)
+	err := c.cc.Invoke(ctx, VaultService_GetProof_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*Job, error) {
+	out := new(Job)
+	err := c.cc.Invoke(ctx, VaultService_GetJob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) ChunkFile(ctx context.Context, in *ChunkFileRequest, opts ...grpc.CallOption) (*FileManifest, error) {
+	out := new(FileManifest)
+	err := c.cc.Invoke(ctx, VaultService_ChunkFile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) ReconstructFile(ctx context.Context, in *ReconstructFileRequest, opts ...grpc.CallOption) (*ReconstructFileResponse, error) {
+	out := new(ReconstructFileResponse)
+	err := c.cc.Invoke(ctx, VaultService_ReconstructFile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// VaultServiceServer is the server API for VaultService service.
+// All implementations must embed UnimplementedVaultServiceServer
+// for forward compatibility
+type VaultServiceServer interface {
+	// Upload streams a file to the agent and queues it for chunking, upload to
+	// 0G Storage and anchoring. The first message carries the file's info,
+	// the rest its content.
+	Upload(VaultService_UploadServer) error
+	// Download streams a stored file, chunk by chunk, from 0G Storage. A hash
+	// that is not a stored file is fetched as a single 0G root.
+	Download(*DownloadRequest, VaultService_DownloadServer) error
+	// GetFileMetadata returns a stored file's record
+	GetFileMetadata(context.Context, *GetFileMetadataRequest) (*File, error)
+	// ListFiles returns the files the caller owns, or every file for API keys
+	ListFiles(context.Context, *ListFilesRequest) (*ListFilesResponse, error)
+	// GetProof returns the 0G Storage proof for a hash
+	GetProof(context.Context, *GetProofRequest) (*Proof, error)
+	// GetJob returns an upload, anchor, verify or repair job, optionally
+	// waiting for it to finish
+	GetJob(context.Context, *GetJobRequest) (*Job, error)
+	// ChunkFile splits a file on the agent's disk into chunks. Needs the admin
+	// scope, as it reads server-side paths.
+	ChunkFile(context.Context, *ChunkFileRequest) (*FileManifest, error)
+	// ReconstructFile reassembles chunked content to a path on the agent's
+	// disk. Needs the admin scope, as it writes server-side paths.
+	ReconstructFile(context.Context, *ReconstructFileRequest) (*ReconstructFileResponse, error)
+	mustEmbedUnimplementedVaultServiceServer()
+}
+
+// UnimplementedVaultServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedVaultServiceServer struct {
+}
+
+func (UnimplementedVaultServiceServer) Upload(VaultService_UploadServer) error {
+	return status.Errorf(codes.Unimplemented, "method Upload not implemented")
+}
+func (UnimplementedVaultServiceServer) Download(*DownloadRequest, VaultService_DownloadServer) error {
+	return status.Errorf(codes.Unimplemented, "method Download not implemented")
+}
+func (UnimplementedVaultServiceServer) GetFileMetadata(context.Context, *GetFileMetadataRequest) (*File, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFileMetadata not implemented")
+}
+func (UnimplementedVaultServiceServer) ListFiles(context.Context, *ListFilesRequest) (*ListFilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFiles not implemented")
+}
+func (UnimplementedVaultServiceServer) GetProof(context.Context, *GetProofRequest) (*Proof, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProof not implemented")
+}
+func (UnimplementedVaultServiceServer) GetJob(context.Context, *GetJobRequest) (*Job, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJob not implemented")
+}
+func (UnimplementedVaultServiceServer) ChunkFile(context.Context, *ChunkFileRequest) (*FileManifest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChunkFile not implemented")
+}
+func (UnimplementedVaultServiceServer) ReconstructFile(context.Context, *ReconstructFileRequest) (*ReconstructFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconstructFile not implemented")
+}
+func (UnimplementedVaultServiceServer) mustEmbedUnimplementedVaultServiceServer() {}
+
+// UnsafeVaultServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to VaultServiceServer will
+// result in compilation errors.
+type UnsafeVaultServiceServer interface {
+	mustEmbedUnimplementedVaultServiceServer()
+}
+
+func RegisterVaultServiceServer(s grpc.ServiceRegistrar, srv VaultServiceServer) {
+	s.RegisterService(&VaultService_ServiceDesc, srv)
+}
+
+func _VaultService_Upload_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(VaultServiceServer).Upload(&vaultServiceUploadServer{stream})
+}
+
+type VaultService_UploadServer interface {
+	SendAndClose(*Job) error
+	Recv() (*UploadRequest, error)
+	grpc.ServerStream
+}
+
+type vaultServiceUploadServer struct {
+	grpc.ServerStream
+}
+
+func (x *vaultServiceUploadServer) SendAndClose(m *Job) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *vaultServiceUploadServer) Recv() (*UploadRequest, error) {
+	m := new(UploadRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _VaultService_Download_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VaultServiceServer).Download(m, &vaultServiceDownloadServer{stream})
+}
+
+type VaultService_DownloadServer interface {
+	Send(*DownloadResponse) error
+	grpc.ServerStream
+}
+
+type vaultServiceDownloadServer struct {
+	grpc.ServerStream
+}
+
+func (x *vaultServiceDownloadServer) Send(m *DownloadResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _VaultService_GetFileMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFileMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).GetFileMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_GetFileMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).GetFileMetadata(ctx, req.(*GetFileMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_ListFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).ListFiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_ListFiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).ListFiles(ctx, req.(*ListFilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_GetProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).GetProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_GetProof_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).GetProof(ctx, req.(*GetProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_GetJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).GetJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_GetJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).GetJob(ctx, req.(*GetJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_ChunkFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChunkFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).ChunkFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_ChunkFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).ChunkFile(ctx, req.(*ChunkFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_ReconstructFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconstructFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).ReconstructFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_ReconstructFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).ReconstructFile(ctx, req.(*ReconstructFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// VaultService_ServiceDesc is the grpc.ServiceDesc for VaultService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var VaultService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "nebularvault.vault.v1.VaultService",
+	HandlerType: (*VaultServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetFileMetadata",
+			Handler:    _VaultService_GetFileMetadata_Handler,
+		},
+		{
+			MethodName: "ListFiles",
+			Handler:    _VaultService_ListFiles_Handler,
+		},
+		{
+			MethodName: "GetProof",
+			Handler:    _VaultService_GetProof_Handler,
+		},
+		{
+			MethodName: "GetJob",
+			Handler:    _VaultService_GetJob_Handler,
+		},
+		{
+			MethodName: "ChunkFile",
+			Handler:    _VaultService_ChunkFile_Handler,
+		},
+		{
+			MethodName: "ReconstructFile",
+			Handler:    _VaultService_ReconstructFile_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Upload",
+			Handler:       _VaultService_Upload_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Download",
+			Handler:       _VaultService_Download_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "vault.proto",
+}
@@ -0,0 +1,285 @@
+// Package client is a Go client for the NebularVault agent's REST API. It
+// uploads files resumably, downloads and verifies them, and reads their
+// metadata, proofs and jobs, retrying requests that fail transiently.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultMaxRetries      = 3
+	defaultBackoff         = 500 * time.Millisecond
+	defaultMaxBackoff      = 10 * time.Second
+	defaultUploadChunkSize = 4 << 20
+
+	// maxJobWait is the longest the agent waits for a job in one request
+	maxJobWait = 25 * time.Second
+
+	// maxErrorBody caps how much of an error response is read
+	maxErrorBody = 64 << 10
+)
+
+// Config controls how a Client authenticates and retries
+type Config struct {
+	// APIKey is sent as X-API-Key
+	APIKey string
+	// Token is a wallet session token, sent as a bearer token
+	Token string
+	// HTTPClient defaults to http.DefaultClient. Leave its Timeout unset
+	// for large transfers; contexts bound each call.
+	HTTPClient *http.Client
+	// MaxRetries is how many times a failed request is retried, 3 by
+	// default; negative disables retries
+	MaxRetries int
+	// Backoff is the delay before the first retry, doubling after each up
+	// to MaxBackoff; a Retry-After from the agent takes precedence
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+	// UploadChunkSize is how much content each upload request carries,
+	// 4 MiB by default
+	UploadChunkSize int64
+	UserAgent       string
+}
+
+// Client calls one agent's REST API. It is safe for concurrent use.
+type Client struct {
+	baseURL *url.URL
+	config  Config
+	http    *http.Client
+}
+
+// New creates a client for the agent at baseURL, such as
+// "http://localhost:8080"
+func New(baseURL string, config Config) (*Client, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("invalid agent URL %q", baseURL)
+	}
+	u.Path = strings.TrimSuffix(u.Path, "/")
+
+	if config.MaxRetries == 0 {
+		config.MaxRetries = defaultMaxRetries
+	}
+	if config.Backoff <= 0 {
+		config.Backoff = defaultBackoff
+	}
+	if config.MaxBackoff <= 0 {
+		config.MaxBackoff = defaultMaxBackoff
+	}
+	if config.UploadChunkSize <= 0 {
+		config.UploadChunkSize = defaultUploadChunkSize
+	}
+	httpClient := config.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &Client{baseURL: u, config: config, http: httpClient}, nil
+}
+
+// envelope is the agent's response wrapper
+type envelope struct {
+	Success bool            `json:"success"`
+	Data    json.RawMessage `json:"data"`
+	Error   string          `json:"error"`
+	Message string          `json:"message"`
+}
+
+// problem is the agent's RFC 7807 error document. Error is read too, for
+// agents that still report errors in the envelope.
+type problem struct {
+	Title     string          `json:"title"`
+	Detail    string          `json:"detail"`
+	Code      string          `json:"code"`
+	RequestID string          `json:"request_id"`
+	Debug     string          `json:"debug"`
+	Data      json.RawMessage `json:"data"`
+	Error     string          `json:"error"`
+}
+
+// request is one API call. Its body is kept so the call can be retried.
+type request struct {
+	method string
+	path   string
+	query  url.Values
+	header http.Header
+	body   []byte
+	// noRetry sends the request once; the caller recovers from failures
+	noRetry bool
+}
+
+func jsonRequest(method, path string, body interface{}) (*request, error) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode request: %w", err)
+	}
+	return &request{
+		method: method,
+		path:   path,
+		header: http.Header{"Content-Type": {"application/json"}},
+		body:   data,
+	}, nil
+}
+
+// do sends req, retrying transport failures and responses that may succeed
+// later, and returns the first successful response for the caller to close.
+// Unsuccessful responses are returned as *APIError.
+func (c *Client) do(ctx context.Context, req *request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		resp, err := c.send(ctx, req)
+		if err == nil && resp.StatusCode < 300 {
+			return resp, nil
+		}
+
+		var retryAfter time.Duration
+		if err == nil {
+			apiErr := decodeError(resp)
+			resp.Body.Close()
+			if !retryable(apiErr.StatusCode) {
+				return nil, apiErr
+			}
+			err, retryAfter = apiErr, apiErr.RetryAfter
+		} else if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		if req.noRetry || c.config.MaxRetries < 0 || attempt >= c.config.MaxRetries {
+			return nil, err
+		}
+		if err := c.sleep(ctx, attempt, retryAfter); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// call sends req and decodes the data of the agent's response into out,
+// which may be nil
+func (c *Client) call(ctx context.Context, req *request, out interface{}) error {
+	resp, err := c.do(ctx, req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return decodeData(resp, out)
+}
+
+func (c *Client) send(ctx context.Context, req *request) (*http.Response, error) {
+	u := *c.baseURL
+	u.Path += req.path
+	u.RawQuery = req.query.Encode()
+
+	var body io.Reader
+	if req.body != nil {
+		body = bytes.NewReader(req.body)
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, req.method, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+	for name, values := range req.header {
+		httpReq.Header[name] = values
+	}
+	c.authorize(httpReq)
+	return c.http.Do(httpReq)
+}
+
+func (c *Client) authorize(req *http.Request) {
+	if c.config.APIKey != "" {
+		req.Header.Set("X-API-Key", c.config.APIKey)
+	}
+	if c.config.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.config.Token)
+	}
+	if c.config.UserAgent != "" {
+		req.Header.Set("User-Agent", c.config.UserAgent)
+	}
+}
+
+// sleep waits before retry attempt+1: retryAfter when the agent named a
+// delay, otherwise exponential backoff with jitter up to MaxBackoff
+func (c *Client) sleep(ctx context.Context, attempt int, retryAfter time.Duration) error {
+	delay := retryAfter
+	if delay <= 0 {
+		delay = min(c.config.Backoff<<min(attempt, 16), c.config.MaxBackoff)
+		delay = delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// retryable reports whether a request answered with status may succeed if
+// sent again
+func retryable(status int) bool {
+	switch status {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+func decodeData(resp *http.Response, out interface{}) error {
+	var env envelope
+	if err := json.NewDecoder(resp.Body).Decode(&env); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	if out == nil || len(env.Data) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(env.Data, out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+// decodeError reads an unsuccessful response into an *APIError
+func decodeError(resp *http.Response) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		RequestID:  resp.Header.Get("X-Request-ID"),
+	}
+	if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds > 0 {
+		apiErr.RetryAfter = time.Duration(seconds) * time.Second
+	}
+
+	var p problem
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+	if json.Unmarshal(body, &p) == nil {
+		apiErr.Code = p.Code
+		apiErr.Debug = p.Debug
+		apiErr.Data = p.Data
+		for _, message := range []string{p.Detail, p.Title, p.Error} {
+			if message != "" {
+				apiErr.Message = message
+				break
+			}
+		}
+		if apiErr.RequestID == "" {
+			apiErr.RequestID = p.RequestID
+		}
+	}
+	if apiErr.Message == "" {
+		apiErr.Message = http.StatusText(resp.StatusCode)
+	}
+	return apiErr
+}
+
+func escape(segment string) string {
+	return url.PathEscape(segment)
+}
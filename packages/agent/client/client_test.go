@@ -0,0 +1,439 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	"nebularvault-agent/internal/apikey"
+	"nebularvault-agent/internal/auth"
+	"nebularvault-agent/internal/handlers"
+	"nebularvault-agent/internal/jobs"
+	"nebularvault-agent/internal/metadata"
+	"nebularvault-agent/internal/middleware"
+	"nebularvault-agent/internal/pipeline"
+	"nebularvault-agent/internal/storage"
+	"nebularvault-agent/internal/zerog"
+)
+
+// content spans three 16 byte chunks, the last one short
+var content = []byte("NebularVault keeps every chunk in 0G Storage.")
+
+// testAgent is an agent serving the file and job routes in process, with
+// 16 byte chunks and local chunk copies
+type testAgent struct {
+	url     string
+	dataDir string
+	key     string
+	faults  *faultyTransport
+}
+
+func newTestAgent(t *testing.T) *testAgent {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	dir := t.TempDir()
+
+	store, err := metadata.Open(filepath.Join(dir, "metadata"))
+	if err != nil {
+		t.Fatalf("Failed to open metadata store: %v", err)
+	}
+	zeroGClient, err := zerog.NewZeroGClient(&zerog.ZeroGConfig{}, logrus.New())
+	if err != nil {
+		t.Fatalf("Failed to create 0G client: %v", err)
+	}
+	storageManager := storage.NewStorageManager(dir, filepath.Join(dir, "temp"), 16)
+	queue := jobs.NewQueue(store, jobs.Config{}, logrus.New())
+	p := pipeline.New(storageManager, zeroGClient, store, nil, queue, pipeline.Config{
+		SpoolDir:   filepath.Join(dir, "spool"),
+		KeepChunks: true,
+	}, logrus.New())
+
+	authenticator, err := auth.NewAuthenticator(auth.Config{Domain: "vault.example", ChainID: 1})
+	if err != nil {
+		t.Fatalf("Failed to create authenticator: %v", err)
+	}
+	keys, err := apikey.Open(filepath.Join(dir, "api-keys.json"))
+	if err != nil {
+		t.Fatalf("Failed to open key store: %v", err)
+	}
+	_, key, err := keys.Create("client", []string{apikey.ScopeFilesRead, apikey.ScopeFilesWrite}, 0)
+	if err != nil {
+		t.Fatalf("Failed to create key: %v", err)
+	}
+
+	router := gin.New()
+	router.Use(middleware.RequestID())
+	api := router.Group("/api/v1", middleware.Authenticate(authenticator, keys))
+	files := api.Group("/files", middleware.RequireScope(apikey.ScopeFilesRead, apikey.ScopeFilesWrite))
+	{
+		files.GET("", handlers.ListFiles(store))
+		files.POST("/uploads", handlers.CreateUpload(p, nil, 1024))
+		files.GET("/uploads/:id", handlers.GetUpload(p))
+		files.PATCH("/uploads/:id", handlers.AppendUpload(p, queue, nil))
+		files.DELETE("/uploads/:id", handlers.CancelUpload(p))
+		files.GET("/metadata/:hash", handlers.GetFileMetadata(store, nil))
+		files.GET("/proof/:hash", handlers.GetProof(zeroGClient, nil))
+		files.GET("/:hash/content", handlers.DownloadContent(store, p, nil))
+	}
+	jobRoutes := api.Group("/jobs", middleware.RequireScope(apikey.ScopeFilesRead, apikey.ScopeFilesWrite))
+	{
+		jobRoutes.GET("", handlers.ListJobs(queue))
+		jobRoutes.POST("", handlers.CreateJob(p, queue, nil))
+		jobRoutes.GET("/:id", handlers.GetJob(queue))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	if err := queue.Start(ctx); err != nil {
+		t.Fatalf("Failed to start job queue: %v", err)
+	}
+	server := httptest.NewServer(router)
+	t.Cleanup(func() {
+		server.Close()
+		cancel()
+		queue.Wait()
+		store.Close()
+	})
+
+	return &testAgent{
+		url:     server.URL,
+		dataDir: dir,
+		key:     key,
+		faults:  &faultyTransport{next: http.DefaultTransport},
+	}
+}
+
+// client returns a client for the agent that retries quickly through its
+// faulty transport
+func (a *testAgent) client(t *testing.T, key string) *Client {
+	t.Helper()
+	c, err := New(a.url, Config{
+		APIKey:          key,
+		HTTPClient:      &http.Client{Transport: a.faults},
+		Backoff:         time.Millisecond,
+		UploadChunkSize: 20,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	return c
+}
+
+// faultyTransport fails requests the way a flaky network or overloaded
+// agent would
+type faultyTransport struct {
+	next http.RoundTripper
+	// unavailable answers the next requests with 503
+	unavailable atomic.Int32
+	// cutUploads delivers part of the next upload requests' content, then
+	// loses the response
+	cutUploads atomic.Int32
+	// cutDownloads breaks the next content responses after their first
+	// chunk
+	cutDownloads atomic.Int32
+	// ranges records the Range of each content request
+	ranges []string
+}
+
+func (f *faultyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if f.unavailable.Add(-1) >= 0 {
+		return &http.Response{
+			StatusCode: http.StatusServiceUnavailable,
+			Header:     http.Header{"Content-Type": {"application/problem+json"}},
+			Body:       io.NopCloser(strings.NewReader(`{"title":"Service unavailable","status":503,"code":"unavailable"}`)),
+			Request:    req,
+		}, nil
+	}
+
+	if req.Method == http.MethodPatch && req.ContentLength > 5 && f.cutUploads.Add(-1) >= 0 {
+		partial := req.Clone(req.Context())
+		partial.Body = io.NopCloser(io.LimitReader(req.Body, 5))
+		partial.ContentLength = 5
+		resp, err := f.next.RoundTrip(partial)
+		if err == nil {
+			resp.Body.Close()
+		}
+		return nil, errors.New("connection reset by peer")
+	}
+
+	resp, err := f.next.RoundTrip(req)
+	if err != nil || !strings.HasSuffix(req.URL.Path, "/content") {
+		return resp, err
+	}
+	f.ranges = append(f.ranges, req.Header.Get("Range"))
+	if f.cutDownloads.Add(-1) >= 0 {
+		resp.Body = &brokenBody{ReadCloser: resp.Body, left: 20}
+	}
+	return resp, nil
+}
+
+// brokenBody fails once left bytes have been read
+type brokenBody struct {
+	io.ReadCloser
+	left int
+}
+
+func (b *brokenBody) Read(p []byte) (int, error) {
+	if b.left <= 0 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	n, err := b.ReadCloser.Read(p[:min(len(p), b.left)])
+	b.left -= n
+	return n, err
+}
+
+// failingReader fails after n bytes
+type failingReader struct {
+	r io.Reader
+	n int
+}
+
+func (f *failingReader) Read(p []byte) (int, error) {
+	if f.n <= 0 {
+		return 0, errors.New("disk unplugged")
+	}
+	n, err := f.r.Read(p[:min(len(p), f.n)])
+	f.n -= n
+	return n, err
+}
+
+// upload stores content and returns its upload result
+func upload(t *testing.T, c *Client) *UploadResult {
+	t.Helper()
+	job, err := c.Upload(context.Background(), "notes.txt", bytes.NewReader(content), int64(len(content)), &UploadOptions{Wait: 10 * time.Second})
+	if err != nil {
+		t.Fatalf("Failed to upload: %v", err)
+	}
+	result, err := job.UploadResult()
+	if err != nil {
+		t.Fatalf("Expected a finished upload, got %+v: %v", job, err)
+	}
+	return result
+}
+
+func TestClient_UploadDownload(t *testing.T) {
+	agent := newTestAgent(t)
+	c := agent.client(t, agent.key)
+	ctx := context.Background()
+
+	var progress []int64
+	job, err := c.Upload(ctx, "notes.txt", bytes.NewReader(content), int64(len(content)), &UploadOptions{
+		Progress: func(sent, total int64) { progress = append(progress, sent) },
+	})
+	if err != nil {
+		t.Fatalf("Failed to upload: %v", err)
+	}
+	if fmt.Sprint(progress) != "[20 40 45]" {
+		t.Errorf("Expected progress after each piece, got %v", progress)
+	}
+	job, err = c.WaitJob(ctx, job.ID)
+	if err != nil || job.Type != JobUpload {
+		t.Fatalf("Expected the upload job to succeed, got %+v, %v", job, err)
+	}
+	result, err := job.UploadResult()
+	if err != nil {
+		t.Fatalf("Failed to decode upload result: %v", err)
+	}
+
+	file, err := c.GetFile(ctx, "0x"+result.Hash)
+	if err != nil {
+		t.Fatalf("Failed to get file: %v", err)
+	}
+	if file.Filename != "notes.txt" || file.Size != int64(len(content)) || file.ChunkSize != 16 || len(file.ChunkHashes) != 3 {
+		t.Errorf("Unexpected file %+v", file)
+	}
+	files, err := c.ListFiles(ctx)
+	if err != nil || len(files) != 1 || files[0].Hash != result.Hash {
+		t.Errorf("Expected the upload to be listed, got %v, %v", files, err)
+	}
+	if proof, err := c.GetProof(ctx, file.StorageRefs[0]); err != nil || proof.Proof == "" {
+		t.Errorf("Failed to get proof: %v", err)
+	}
+
+	var downloaded bytes.Buffer
+	if _, err := c.Download(ctx, result.Hash, &downloaded, nil); err != nil {
+		t.Fatalf("Failed to download: %v", err)
+	}
+	if !bytes.Equal(downloaded.Bytes(), content) {
+		t.Errorf("Expected %q, downloaded %q", content, downloaded.Bytes())
+	}
+
+	downloaded.Reset()
+	if _, err := c.Download(ctx, result.Hash, &downloaded, &DownloadOptions{Offset: 10, Length: 25}); err != nil {
+		t.Fatalf("Failed to download range: %v", err)
+	}
+	if want := content[10:35]; !bytes.Equal(downloaded.Bytes(), want) {
+		t.Errorf("Expected %q, downloaded %q", want, downloaded.Bytes())
+	}
+	// The range is fetched in whole chunks so each can be verified
+	if last := agent.faults.ranges[len(agent.faults.ranges)-1]; last != "bytes=0-44" {
+		t.Errorf("Expected the covering chunks to be requested, got %s", last)
+	}
+
+	verify, err := c.Verify(ctx, result.Hash)
+	if err == nil {
+		verify, err = c.WaitJob(ctx, verify.ID)
+	}
+	if err != nil || verify.Type != JobVerify {
+		t.Fatalf("Expected the verify job to succeed, got %+v, %v", verify, err)
+	}
+	uploads, err := c.ListJobs(ctx, JobFilter{Type: JobUpload, Status: JobSucceeded})
+	if err != nil || len(uploads) != 1 || uploads[0].ID != job.ID {
+		t.Errorf("Expected the upload job to be listed, got %v, %v", uploads, err)
+	}
+}
+
+func TestClient_RecoversFromFailures(t *testing.T) {
+	agent := newTestAgent(t)
+	c := agent.client(t, agent.key)
+	ctx := context.Background()
+
+	agent.faults.unavailable.Store(2)
+	agent.faults.cutUploads.Store(1)
+	result := upload(t, c)
+
+	agent.faults.cutDownloads.Store(1)
+	var downloaded bytes.Buffer
+	if _, err := c.Download(ctx, result.Hash, &downloaded, nil); err != nil {
+		t.Fatalf("Failed to download: %v", err)
+	}
+	if !bytes.Equal(downloaded.Bytes(), content) {
+		t.Errorf("Expected %q, downloaded %q", content, downloaded.Bytes())
+	}
+	// The broken response delivered one whole chunk; the rest was resumed
+	if got := strings.Join(agent.faults.ranges, " "); got != "bytes=0-44 bytes=16-44" {
+		t.Errorf("Expected the download to resume after the first chunk, got %s", got)
+	}
+
+	agent.faults.unavailable.Store(10)
+	_, err := c.GetFile(ctx, result.Hash)
+	var apiErr *APIError
+	if !errors.Is(err, ErrUnavailable) || !errors.As(err, &apiErr) || apiErr.Message != "Service unavailable" || apiErr.Code != "unavailable" {
+		t.Errorf("Expected retries to give up with the agent's error, got %v", err)
+	}
+}
+
+func TestClient_ResumeUpload(t *testing.T) {
+	agent := newTestAgent(t)
+	c := agent.client(t, agent.key)
+	ctx := context.Background()
+
+	_, err := c.Upload(ctx, "notes.txt", &failingReader{r: bytes.NewReader(content), n: 30}, int64(len(content)), nil)
+	var uploadErr *UploadError
+	if !errors.As(err, &uploadErr) || uploadErr.Offset != 20 {
+		t.Fatalf("Expected an upload error after the first piece, got %v", err)
+	}
+	session, err := c.GetUpload(ctx, uploadErr.SessionID)
+	if err != nil || session.Offset != 20 || session.Size != int64(len(content)) {
+		t.Fatalf("Expected the session to hold the first piece, got %+v, %v", session, err)
+	}
+
+	job, err := c.ResumeUpload(ctx, session.ID, bytes.NewReader(content), &UploadOptions{Wait: 10 * time.Second})
+	if err != nil {
+		t.Fatalf("Failed to resume upload: %v", err)
+	}
+	result, err := job.UploadResult()
+	if err != nil {
+		t.Fatalf("Expected a finished upload, got %+v: %v", job, err)
+	}
+	var downloaded bytes.Buffer
+	if _, err := c.Download(ctx, result.Hash, &downloaded, nil); err != nil || !bytes.Equal(downloaded.Bytes(), content) {
+		t.Errorf("Expected the resumed upload to round-trip, got %q, %v", downloaded.Bytes(), err)
+	}
+	if _, err := c.GetUpload(ctx, session.ID); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected a completed session to end, got %v", err)
+	}
+
+	other, err := c.CreateUpload(ctx, "other.txt", 10)
+	if err != nil {
+		t.Fatalf("Failed to create upload: %v", err)
+	}
+	if err := c.CancelUpload(ctx, other.ID); err != nil {
+		t.Fatalf("Failed to cancel upload: %v", err)
+	}
+	if _, err := c.GetUpload(ctx, other.ID); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected a cancelled session to be gone, got %v", err)
+	}
+}
+
+func TestClient_Errors(t *testing.T) {
+	agent := newTestAgent(t)
+	c := agent.client(t, agent.key)
+	ctx := context.Background()
+
+	_, err := agent.client(t, agent.key+"x").ListFiles(ctx)
+	var apiErr *APIError
+	if !errors.Is(err, ErrUnauthorized) || !errors.As(err, &apiErr) || apiErr.RequestID == "" {
+		t.Errorf("Expected a bad key to be unauthorized with a request ID, got %v", err)
+	}
+	if _, err := c.GetFile(ctx, strings.Repeat("ab", 32)); !errors.Is(err, ErrNotFound) || !errors.As(err, &apiErr) || apiErr.Code != "file_not_found" {
+		t.Errorf("Expected an unknown file to be not found, got %v", err)
+	}
+	if _, err := c.CreateUpload(ctx, "big.bin", 2048); !errors.Is(err, ErrTooLarge) {
+		t.Errorf("Expected an upload over the size limit to be refused, got %v", err)
+	}
+	if _, err := New("localhost:8080", Config{}); err == nil {
+		t.Error("Expected a URL without a scheme to be refused")
+	}
+
+	// Corrupt the agent's copy of the second chunk; it falls back to 0G,
+	// whose stand-in answers with a placeholder
+	result := upload(t, c)
+	file, err := c.GetFile(ctx, result.Hash)
+	if err != nil {
+		t.Fatalf("Failed to get file: %v", err)
+	}
+	chunk := filepath.Join(agent.dataDir, "chunks", storage.ChunkID(file.ID, 1))
+	if err := os.WriteFile(chunk, bytes.Repeat([]byte("x"), 16), 0644); err != nil {
+		t.Fatalf("Failed to corrupt chunk: %v", err)
+	}
+
+	var downloaded bytes.Buffer
+	_, err = c.Download(ctx, result.Hash, &downloaded, nil)
+	if !errors.Is(err, ErrIntegrity) {
+		t.Fatalf("Expected the corrupted chunk to fail verification, got %v", err)
+	}
+	if !bytes.Equal(downloaded.Bytes(), content[:16]) {
+		t.Errorf("Expected only the verified chunk to be written, got %q", downloaded.Bytes())
+	}
+	if _, err := c.Download(ctx, result.Hash, io.Discard, &DownloadOptions{Offset: 16, SkipVerify: true}); err != nil {
+		t.Errorf("Expected an unverified download to succeed, got %v", err)
+	}
+}
+
+func TestClient_SleepHonoursRetryAfter(t *testing.T) {
+	c, err := New("http://localhost:8080", Config{Backoff: time.Millisecond, MaxBackoff: time.Millisecond})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	ctx := context.Background()
+
+	// MaxBackoff caps computed backoff but not a delay the agent asked for
+	start := time.Now()
+	if err := c.sleep(ctx, 0, 50*time.Millisecond); err != nil {
+		t.Fatalf("Failed to sleep: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("Expected to wait out Retry-After, waited %v", elapsed)
+	}
+
+	start = time.Now()
+	if err := c.sleep(ctx, 20, 0); err != nil {
+		t.Fatalf("Failed to sleep: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Expected backoff capped at MaxBackoff, waited %v", elapsed)
+	}
+}
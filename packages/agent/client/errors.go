@@ -0,0 +1,94 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Errors an *APIError matches with errors.Is, by HTTP status
+var (
+	ErrBadRequest   = errors.New("bad request")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrForbidden    = errors.New("forbidden")
+	ErrNotFound     = errors.New("not found")
+	ErrConflict     = errors.New("conflict")
+	ErrTooLarge     = errors.New("too large")
+	ErrRateLimited  = errors.New("rate limited")
+	ErrUnavailable  = errors.New("agent or its backends unavailable")
+)
+
+var statusErrors = map[int]error{
+	http.StatusBadRequest:            ErrBadRequest,
+	http.StatusUnauthorized:          ErrUnauthorized,
+	http.StatusForbidden:             ErrForbidden,
+	http.StatusNotFound:              ErrNotFound,
+	http.StatusConflict:              ErrConflict,
+	http.StatusRequestEntityTooLarge: ErrTooLarge,
+	http.StatusTooManyRequests:       ErrRateLimited,
+	http.StatusBadGateway:            ErrUnavailable,
+	http.StatusServiceUnavailable:    ErrUnavailable,
+	http.StatusGatewayTimeout:        ErrUnavailable,
+}
+
+var (
+	// ErrIntegrity means downloaded content does not match the hashes the
+	// agent recorded for it
+	ErrIntegrity = errors.New("content does not match its recorded hash")
+	// ErrJobFailed means a job the client waited for failed
+	ErrJobFailed = errors.New("job failed")
+)
+
+// APIError is an unsuccessful response from the agent, read from its RFC
+// 7807 problem document
+type APIError struct {
+	StatusCode int
+	// Code is the agent's machine-readable error code, such as
+	// file_not_found or zerog_timeout
+	Code string
+	// Message is the agent's error message
+	Message   string
+	RequestID string
+	// Debug is the underlying cause, sent only by agents with debug errors
+	// enabled
+	Debug string
+	// RetryAfter is how long the agent asked callers to wait, if it did
+	RetryAfter time.Duration
+	// Data is the data the agent sent with the error, if any
+	Data json.RawMessage
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("nebularvault: %s (HTTP %d", e.Message, e.StatusCode)
+	if e.Code != "" {
+		msg += " " + e.Code
+	}
+	if e.RequestID != "" {
+		msg += ", request " + e.RequestID
+	}
+	return msg + ")"
+}
+
+// Is matches the error for e's HTTP status, such as ErrNotFound
+func (e *APIError) Is(target error) bool {
+	return statusErrors[e.StatusCode] == target
+}
+
+// UploadError is a failed upload. The content received so far is kept in
+// the session until it expires; pass SessionID to ResumeUpload to finish it.
+type UploadError struct {
+	SessionID string
+	// Offset is how much content the agent is known to have
+	Offset int64
+	Err    error
+}
+
+func (e *UploadError) Error() string {
+	return fmt.Sprintf("upload %s failed at byte %d: %v", e.SessionID, e.Offset, e.Err)
+}
+
+func (e *UploadError) Unwrap() error {
+	return e.Err
+}
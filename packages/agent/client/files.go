@@ -0,0 +1,210 @@
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// GetFile returns a stored file's metadata by content hash
+func (c *Client) GetFile(ctx context.Context, hash string) (*File, error) {
+	var file File
+	if err := c.call(ctx, &request{method: http.MethodGet, path: "/api/v1/files/metadata/" + escape(hash)}, &file); err != nil {
+		return nil, err
+	}
+	return &file, nil
+}
+
+// ListFiles returns the caller's files: a signed-in wallet's own, or every
+// file for API keys
+func (c *Client) ListFiles(ctx context.Context) ([]File, error) {
+	var files []File
+	if err := c.call(ctx, &request{method: http.MethodGet, path: "/api/v1/files"}, &files); err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// GetProof returns the 0G Storage proof for a hash, such as one of a file's
+// storage refs
+func (c *Client) GetProof(ctx context.Context, hash string) (*Proof, error) {
+	var proof Proof
+	if err := c.call(ctx, &request{method: http.MethodGet, path: "/api/v1/files/proof/" + escape(hash)}, &proof); err != nil {
+		return nil, err
+	}
+	return &proof, nil
+}
+
+// DownloadOptions selects part of a file and whether to verify it
+type DownloadOptions struct {
+	// Offset and Length select a byte range; Length 0 reads to the end
+	Offset int64
+	Length int64
+	// SkipVerify writes content without checking it against the file's
+	// recorded hashes
+	SkipVerify bool
+}
+
+// Download writes a stored file's content, or the range opts selects, to w
+// and returns the file's metadata. Content is fetched in whole chunks and
+// each is checked against its recorded hash before it is written; an
+// interrupted transfer resumes from the last chunk written. Files recorded
+// without a chunk size are checked against the file hash once complete,
+// after their content is written.
+func (c *Client) Download(ctx context.Context, hash string, w io.Writer, opts *DownloadOptions) (*File, error) {
+	if opts == nil {
+		opts = &DownloadOptions{}
+	}
+	file, err := c.GetFile(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	start, end := opts.Offset, file.Size
+	if opts.Length > 0 {
+		end = min(start+opts.Length, file.Size)
+	}
+	if start < 0 || start > file.Size || opts.Length < 0 {
+		return file, fmt.Errorf("range %d+%d is outside the %d byte file", opts.Offset, opts.Length, file.Size)
+	}
+	if start == end {
+		return file, nil
+	}
+
+	verify := !opts.SkipVerify
+	if verify && merkleRoot(file.ChunkHashes) != file.MerkleRoot {
+		return file, fmt.Errorf("%w: chunk hashes do not produce the Merkle root", ErrIntegrity)
+	}
+	if file.ChunkSize <= 0 {
+		return file, c.downloadWhole(ctx, file, w, start, end, verify)
+	}
+	return file, c.downloadChunks(ctx, file, w, start, end, verify)
+}
+
+// downloadChunks fetches the chunks covering [start, end), verifying each
+// before writing its part of the range
+func (c *Client) downloadChunks(ctx context.Context, file *File, w io.Writer, start, end int64, verify bool) error {
+	size := file.ChunkSize
+	index := int(start / size)
+	pos := int64(index) * size
+	to := min((((end-1)/size)+1)*size, file.Size)
+	buf := make([]byte, size)
+
+	failures := 0
+	for pos < to {
+		resp, err := c.do(ctx, &request{
+			method: http.MethodGet,
+			path:   "/api/v1/files/" + escape(file.Hash) + "/content",
+			header: http.Header{"Range": {fmt.Sprintf("bytes=%d-%d", pos, to-1)}},
+		})
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode != http.StatusPartialContent && pos > 0 {
+			resp.Body.Close()
+			return fmt.Errorf("agent ignored the requested range")
+		}
+
+		resumed := pos
+		for pos < to {
+			chunk := buf[:min(size, file.Size-pos)]
+			if _, err = io.ReadFull(resp.Body, chunk); err != nil {
+				break
+			}
+			if index >= len(file.ChunkHashes) {
+				resp.Body.Close()
+				return fmt.Errorf("%w: file has no chunk %d", ErrIntegrity, index)
+			}
+			if verify && sha256Hex(chunk) != file.ChunkHashes[index] {
+				resp.Body.Close()
+				return fmt.Errorf("%w: chunk %d", ErrIntegrity, index)
+			}
+
+			lo, hi := max(start-pos, 0), min(end-pos, int64(len(chunk)))
+			if _, werr := w.Write(chunk[lo:hi]); werr != nil {
+				resp.Body.Close()
+				return werr
+			}
+			pos += int64(len(chunk))
+			index++
+		}
+		resp.Body.Close()
+		if err == nil {
+			break
+		}
+
+		// Resume from the next unwritten chunk, counting only failures that
+		// made no progress
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if pos > resumed {
+			failures = 0
+		}
+		if c.config.MaxRetries < 0 || failures >= c.config.MaxRetries {
+			return fmt.Errorf("failed to read content: %w", err)
+		}
+		if err := c.sleep(ctx, failures, 0); err != nil {
+			return err
+		}
+		failures++
+	}
+	return nil
+}
+
+// downloadWhole fetches a file recorded without a chunk size, writing
+// [start, end) and checking the file hash at the end
+func (c *Client) downloadWhole(ctx context.Context, file *File, w io.Writer, start, end int64, verify bool) error {
+	resp, err := c.do(ctx, &request{method: http.MethodGet, path: "/api/v1/files/" + escape(file.Hash) + "/content"})
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	hasher := sha256.New()
+	body := io.TeeReader(resp.Body, hasher)
+	if _, err := io.CopyN(io.Discard, body, start); err != nil {
+		return fmt.Errorf("failed to read content: %w", err)
+	}
+	if _, err := io.CopyN(w, body, end-start); err != nil {
+		return fmt.Errorf("failed to read content: %w", err)
+	}
+	if !verify {
+		return nil
+	}
+	if _, err := io.Copy(io.Discard, body); err != nil {
+		return fmt.Errorf("failed to read content: %w", err)
+	}
+	if hex.EncodeToString(hasher.Sum(nil)) != file.Hash {
+		return fmt.Errorf("%w: file hash", ErrIntegrity)
+	}
+	return nil
+}
+
+// merkleRoot combines chunk hashes as the agent does: pairs of hex hashes
+// are concatenated and hashed level by level, an odd one out carried up
+func merkleRoot(hashes []string) string {
+	if len(hashes) == 0 {
+		return ""
+	}
+	for len(hashes) > 1 {
+		var next []string
+		for i := 0; i < len(hashes); i += 2 {
+			if i+1 < len(hashes) {
+				next = append(next, sha256Hex([]byte(hashes[i]+hashes[i+1])))
+			} else {
+				next = append(next, hashes[i])
+			}
+		}
+		hashes = next
+	}
+	return hashes[0]
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,87 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// GetJob returns a job by ID
+func (c *Client) GetJob(ctx context.Context, id string) (*Job, error) {
+	var job Job
+	if err := c.call(ctx, &request{method: http.MethodGet, path: "/api/v1/jobs/" + escape(id)}, &job); err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// WaitJob long-polls a job until it finishes or ctx is done. A job that
+// failed is returned with an error matching ErrJobFailed.
+func (c *Client) WaitJob(ctx context.Context, id string) (*Job, error) {
+	for {
+		var job Job
+		err := c.call(ctx, &request{
+			method: http.MethodGet,
+			path:   "/api/v1/jobs/" + escape(id),
+			query:  url.Values{"wait": {maxJobWait.String()}},
+		}, &job)
+		if err != nil {
+			return nil, err
+		}
+
+		switch job.Status {
+		case JobSucceeded:
+			return &job, nil
+		case JobFailed:
+			return &job, fmt.Errorf("%w: %s", ErrJobFailed, job.Error)
+		}
+		if err := ctx.Err(); err != nil {
+			return &job, err
+		}
+	}
+}
+
+// ListJobs returns the caller's jobs matching filter, newest first
+func (c *Client) ListJobs(ctx context.Context, filter JobFilter) ([]Job, error) {
+	query := url.Values{}
+	if filter.Type != "" {
+		query.Set("type", filter.Type)
+	}
+	if filter.Status != "" {
+		query.Set("status", string(filter.Status))
+	}
+
+	var jobs []Job
+	if err := c.call(ctx, &request{method: http.MethodGet, path: "/api/v1/jobs", query: query}, &jobs); err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+// Verify queues a check that a stored file is intact and retrievable
+func (c *Client) Verify(ctx context.Context, hash string) (*Job, error) {
+	return c.createJob(ctx, JobVerify, hash)
+}
+
+// Repair queues a re-upload of a stored file's missing chunks
+func (c *Client) Repair(ctx context.Context, hash string) (*Job, error) {
+	return c.createJob(ctx, JobRepair, hash)
+}
+
+// createJob queues a job for a file. Unfinished jobs are shared, so a
+// retried request returns the job already queued.
+func (c *Client) createJob(ctx context.Context, jobType, hash string) (*Job, error) {
+	req, err := jsonRequest(http.MethodPost, "/api/v1/jobs", map[string]string{
+		"type": jobType,
+		"hash": hash,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var job Job
+	if err := c.call(ctx, req, &job); err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
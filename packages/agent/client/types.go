@@ -0,0 +1,131 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Anchor is the state of a file's registration on chain
+type Anchor struct {
+	Status      string    `json:"status"`
+	TxHash      string    `json:"tx_hash,omitempty"`
+	BlockNumber uint64    `json:"block_number,omitempty"`
+	Error       string    `json:"error,omitempty"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// Verification is the outcome of the agent's last check of a file
+type Verification struct {
+	Valid           bool      `json:"valid"`
+	MerkleRootValid bool      `json:"merkle_root_valid"`
+	MissingChunks   []int     `json:"missing_chunks,omitempty"`
+	CheckedAt       time.Time `json:"checked_at"`
+}
+
+// File is a stored file's metadata. Its content is split into chunks of
+// ChunkSize bytes, the last possibly shorter, hashed into ChunkHashes.
+type File struct {
+	ID           string        `json:"id"`
+	Filename     string        `json:"filename"`
+	Size         int64         `json:"size"`
+	MimeType     string        `json:"mime_type"`
+	Hash         string        `json:"hash"`
+	MerkleRoot   string        `json:"merkle_root"`
+	ChunkSize    int64         `json:"chunk_size,omitempty"`
+	ChunkHashes  []string      `json:"chunk_hashes"`
+	StorageRefs  []string      `json:"storage_refs"`
+	UploadedAt   string        `json:"uploaded_at"`
+	UserID       string        `json:"user_id"`
+	IsPublic     bool          `json:"is_public"`
+	Anchor       *Anchor       `json:"anchor,omitempty"`
+	Verification *Verification `json:"verification,omitempty"`
+}
+
+// Proof is a 0G Storage proof for a hash
+type Proof struct {
+	Hash  string `json:"hash"`
+	Proof string `json:"proof"`
+}
+
+// JobStatus is where a job is in its lifecycle
+type JobStatus string
+
+const (
+	JobQueued    JobStatus = "queued"
+	JobRunning   JobStatus = "running"
+	JobSucceeded JobStatus = "succeeded"
+	JobFailed    JobStatus = "failed"
+)
+
+// Job types
+const (
+	JobUpload = "upload"
+	JobVerify = "verify"
+	JobRepair = "repair"
+	JobAnchor = "anchor"
+)
+
+// Job is a background job on the agent
+type Job struct {
+	ID          string          `json:"id"`
+	Type        string          `json:"type"`
+	Subject     string          `json:"subject,omitempty"`
+	Status      JobStatus       `json:"status"`
+	Attempts    int             `json:"attempts"`
+	MaxAttempts int             `json:"max_attempts"`
+	Error       string          `json:"error,omitempty"`
+	Result      json.RawMessage `json:"result,omitempty"`
+	RetryAt     *time.Time      `json:"retry_at,omitempty"`
+	EventsURL   string          `json:"events_url"`
+	CreatedAt   time.Time       `json:"created_at"`
+	UpdatedAt   time.Time       `json:"updated_at"`
+}
+
+// Done reports whether the job has finished
+func (j *Job) Done() bool {
+	return j.Status == JobSucceeded || j.Status == JobFailed
+}
+
+// UploadResult decodes the result of a finished upload job
+func (j *Job) UploadResult() (*UploadResult, error) {
+	if j.Type != JobUpload || j.Status != JobSucceeded {
+		return nil, fmt.Errorf("job %s is not a finished upload", j.ID)
+	}
+	var result UploadResult
+	if err := json.Unmarshal(j.Result, &result); err != nil {
+		return nil, fmt.Errorf("failed to decode upload result: %w", err)
+	}
+	return &result, nil
+}
+
+// UploadResult describes a stored upload
+type UploadResult struct {
+	FileID      string   `json:"file_id"`
+	Hash        string   `json:"hash"`
+	Filename    string   `json:"filename"`
+	Size        int64    `json:"size"`
+	MerkleRoot  string   `json:"merkle_root"`
+	Chunks      []string `json:"chunks"`
+	UploadedAt  string   `json:"uploaded_at"`
+	Anchor      *Anchor  `json:"anchor,omitempty"`
+	AnchorJobID string   `json:"anchor_job_id,omitempty"`
+}
+
+// UploadSession is a resumable upload; the agent holds Offset of its Size
+// bytes
+type UploadSession struct {
+	ID        string    `json:"id"`
+	Filename  string    `json:"filename"`
+	Size      int64     `json:"size"`
+	Offset    int64     `json:"offset"`
+	URL       string    `json:"url"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// JobFilter narrows ListJobs; empty fields match every job
+type JobFilter struct {
+	Type   string
+	Status JobStatus
+}
@@ -0,0 +1,209 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+// UploadOptions controls an upload
+type UploadOptions struct {
+	// Wait is how long the agent waits for the upload job to finish before
+	// answering, at most 25s; WaitJob waits longer
+	Wait time.Duration
+	// Progress, if set, is called each time the agent accepts content
+	Progress func(sent, total int64)
+}
+
+// Upload streams size bytes from r to the agent as a resumable upload and
+// returns the upload job. Content is sent in UploadChunkSize pieces, and a
+// piece that fails is resent from where the agent stopped. If the upload
+// still fails, the error is an *UploadError naming the session to resume.
+func (c *Client) Upload(ctx context.Context, filename string, r io.Reader, size int64, opts *UploadOptions) (*Job, error) {
+	session, err := c.CreateUpload(ctx, filename, size)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendUpload(ctx, session, r, opts)
+}
+
+// UploadFile uploads the file at path under its base name
+func (c *Client) UploadFile(ctx context.Context, path string, opts *UploadOptions) (*Job, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+	return c.Upload(ctx, filepath.Base(path), file, info.Size(), opts)
+}
+
+// ResumeUpload finishes an interrupted upload, reading its content from r
+// starting where the agent stopped
+func (c *Client) ResumeUpload(ctx context.Context, id string, r io.ReadSeeker, opts *UploadOptions) (*Job, error) {
+	session, err := c.GetUpload(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := r.Seek(session.Offset, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("failed to seek to byte %d: %w", session.Offset, err)
+	}
+	return c.sendUpload(ctx, session, r, opts)
+}
+
+// CreateUpload starts a resumable upload of size bytes. Most callers use
+// Upload instead.
+func (c *Client) CreateUpload(ctx context.Context, filename string, size int64) (*UploadSession, error) {
+	req, err := jsonRequest(http.MethodPost, "/api/v1/files/uploads", map[string]interface{}{
+		"filename": filename,
+		"size":     size,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var session UploadSession
+	if err := c.call(ctx, req, &session); err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// GetUpload returns a resumable upload, reporting how much content the
+// agent holds
+func (c *Client) GetUpload(ctx context.Context, id string) (*UploadSession, error) {
+	var session UploadSession
+	if err := c.call(ctx, &request{method: http.MethodGet, path: "/api/v1/files/uploads/" + escape(id)}, &session); err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// CancelUpload ends a resumable upload and discards its content
+func (c *Client) CancelUpload(ctx context.Context, id string) error {
+	return c.call(ctx, &request{method: http.MethodDelete, path: "/api/v1/files/uploads/" + escape(id)}, nil)
+}
+
+// sendUpload sends the rest of a session's content from r, which is
+// positioned at the session's offset
+func (c *Client) sendUpload(ctx context.Context, session *UploadSession, r io.Reader, opts *UploadOptions) (*Job, error) {
+	if opts == nil {
+		opts = &UploadOptions{}
+	}
+	buf := make([]byte, min(c.config.UploadChunkSize, max(session.Size-session.Offset, 0)))
+
+	offset := session.Offset
+	for {
+		n, err := io.ReadFull(r, buf[:min(int64(len(buf)), session.Size-offset)])
+		if err != nil {
+			return nil, &UploadError{SessionID: session.ID, Offset: offset, Err: fmt.Errorf("failed to read content: %w", err)}
+		}
+
+		job, sent, err := c.sendPiece(ctx, session, offset, buf[:n], opts.Wait)
+		if err != nil {
+			return nil, &UploadError{SessionID: session.ID, Offset: sent, Err: err}
+		}
+		offset = sent
+		if opts.Progress != nil {
+			opts.Progress(offset, session.Size)
+		}
+		if job != nil {
+			return job, nil
+		}
+	}
+}
+
+// sendPiece sends piece, which starts at start, until the agent holds all of
+// it, asking the agent where to resume after each failure. It returns the
+// offset reached and, once the upload is complete, its job.
+func (c *Client) sendPiece(ctx context.Context, session *UploadSession, start int64, piece []byte, wait time.Duration) (*Job, int64, error) {
+	end := start + int64(len(piece))
+	offset := start
+	failures := 0
+	for {
+		job, sent, err := c.appendUpload(ctx, session, offset, piece[offset-start:], wait)
+		if err == nil {
+			if sent >= end || job != nil {
+				return job, sent, nil
+			}
+			offset = sent
+			continue
+		}
+
+		// Offset mismatches and busy sessions resolve once the agent has
+		// finished with an earlier attempt
+		var apiErr *APIError
+		var retryAfter time.Duration
+		if errors.As(err, &apiErr) {
+			if !retryable(apiErr.StatusCode) && apiErr.StatusCode != http.StatusConflict {
+				return nil, offset, err
+			}
+			retryAfter = apiErr.RetryAfter
+		} else if ctx.Err() != nil {
+			return nil, offset, ctx.Err()
+		}
+		if c.config.MaxRetries < 0 || failures >= c.config.MaxRetries {
+			return nil, offset, err
+		}
+		if err := c.sleep(ctx, failures, retryAfter); err != nil {
+			return nil, offset, err
+		}
+		failures++
+
+		current, err := c.GetUpload(ctx, session.ID)
+		if err != nil {
+			return nil, offset, err
+		}
+		if current.Offset < start || current.Offset > end {
+			return nil, current.Offset, fmt.Errorf("agent holds %d bytes, outside the piece at %d-%d", current.Offset, start, end)
+		}
+		offset = current.Offset
+	}
+}
+
+// appendUpload sends content at offset in one request, returning the
+// agent's new offset and, once the upload is complete, its job
+func (c *Client) appendUpload(ctx context.Context, session *UploadSession, offset int64, content []byte, wait time.Duration) (*Job, int64, error) {
+	req := &request{
+		method: http.MethodPatch,
+		path:   "/api/v1/files/uploads/" + escape(session.ID),
+		header: http.Header{
+			"Content-Type":  {"application/offset+octet-stream"},
+			"Upload-Offset": {strconv.FormatInt(offset, 10)},
+		},
+		body:    content,
+		noRetry: true,
+	}
+	if wait > 0 {
+		req.query = url.Values{"wait": {wait.String()}}
+	}
+
+	resp, err := c.do(ctx, req)
+	if err != nil {
+		return nil, offset, err
+	}
+	defer resp.Body.Close()
+
+	sent, err := strconv.ParseInt(resp.Header.Get("Upload-Offset"), 10, 64)
+	if err != nil {
+		return nil, offset, fmt.Errorf("agent did not report the upload offset")
+	}
+	if sent < session.Size {
+		return nil, sent, nil
+	}
+	var job Job
+	if err := decodeData(resp, &job); err != nil {
+		return nil, sent, err
+	}
+	return &job, sent, nil
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"nebularvault-agent/config"
+	"nebularvault-agent/internal/apikey"
+)
+
+var (
+	apiKeyName   string
+	apiKeyScopes []string
+	apiKeyTTL    time.Duration
+)
+
+var apiKeyCmd = &cobra.Command{
+	Use:   "apikey",
+	Short: "Manage API keys for service accounts",
+}
+
+var apiKeyCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create an API key and print its secret",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, err := openAPIKeys()
+		if err != nil {
+			return err
+		}
+
+		key, secret, err := store.Create(apiKeyName, apiKeyScopes, apiKeyTTL)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Created API key %s (%s)\n", key.ID, strings.Join(key.Scopes, ", "))
+		if key.ExpiresAt != nil {
+			fmt.Printf("Expires at %s\n", key.ExpiresAt.Format(time.RFC3339))
+		}
+		fmt.Printf("\n  %s\n\nStore it now; it cannot be shown again.\n", secret)
+		return nil
+	},
+}
+
+var apiKeyListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List API keys",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, err := openAPIKeys()
+		if err != nil {
+			return err
+		}
+
+		keys, err := store.List()
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "ID\tNAME\tSCOPES\tSTATUS\tEXPIRES\tLAST USED")
+		for _, key := range keys {
+			status := "active"
+			switch {
+			case key.RevokedAt != nil:
+				status = "revoked"
+			case !key.Active(now):
+				status = "expired"
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+				key.ID, key.Name, strings.Join(key.Scopes, ","), status,
+				formatTime(key.ExpiresAt), formatTime(key.LastUsedAt))
+		}
+		return w.Flush()
+	},
+}
+
+var apiKeyRevokeCmd = &cobra.Command{
+	Use:   "revoke <id>",
+	Short: "Revoke an API key",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, err := openAPIKeys()
+		if err != nil {
+			return err
+		}
+
+		key, err := store.Revoke(args[0])
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Revoked API key %s\n", key.ID)
+		return nil
+	},
+}
+
+func init() {
+	apiKeyCreateCmd.Flags().StringVar(&apiKeyName, "name", "", "Name of the service the key is for")
+	apiKeyCreateCmd.Flags().StringSliceVar(&apiKeyScopes, "scopes", []string{apikey.ScopeFilesRead}, "Scopes to grant (files:read, files:write, admin)")
+	apiKeyCreateCmd.Flags().DurationVar(&apiKeyTTL, "expires-in", 0, "Lifetime of the key, e.g. 720h; 0 never expires")
+	apiKeyCreateCmd.MarkFlagRequired("name")
+
+	apiKeyCmd.AddCommand(apiKeyCreateCmd, apiKeyListCmd, apiKeyRevokeCmd)
+	rootCmd.AddCommand(apiKeyCmd)
+}
+
+func openAPIKeys() (*apikey.Store, error) {
+	cfg, err := config.LoadConfig(configPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load configuration: %w", err)
+	}
+	return apikey.Open(apiKeyFile(cfg))
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return "-"
+	}
+	return t.Local().Format(time.RFC3339)
+}
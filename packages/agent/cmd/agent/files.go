@@ -0,0 +1,504 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strings"
+	"syscall"
+	"text/tabwriter"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"nebularvault-agent/config"
+	"nebularvault-agent/internal/anchor"
+	"nebularvault-agent/internal/apierror"
+	"nebularvault-agent/internal/contracts"
+	"nebularvault-agent/internal/jobs"
+	"nebularvault-agent/internal/metadata"
+	"nebularvault-agent/internal/pipeline"
+	"nebularvault-agent/internal/progress"
+	"nebularvault-agent/internal/storage"
+)
+
+var (
+	jsonOutput   bool
+	uploadName   string
+	uploadUser   string
+	downloadPath string
+	proofChunk   int
+	listUser     string
+)
+
+// errVerifyFailed fails verify after its report is printed
+var errVerifyFailed = errors.New("verification failed")
+
+var uploadCmd = &cobra.Command{
+	Use:   "upload <path>",
+	Short: "Chunk a file, upload it to 0G Storage and record it",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runFiles(cmd, func(ctx context.Context, b *fileBackends) error {
+			bar := newProgressBar(!jsonOutput)
+			result, err := b.pipeline.Upload(progress.WithReporter(ctx, bar.Report), pipeline.UploadRequest{
+				Path:     args[0],
+				Filename: uploadName,
+				UserID:   uploadUser,
+			})
+			bar.Done()
+			if err != nil {
+				return err
+			}
+
+			if jsonOutput {
+				return printJSON(result)
+			}
+			fmt.Printf("Uploaded %s (%s)\n", result.Filename, formatSize(result.Size))
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintf(w, "  Hash\t%s\n", result.Hash)
+			fmt.Fprintf(w, "  Merkle root\t%s\n", result.MerkleRoot)
+			fmt.Fprintf(w, "  File ID\t%s\n", result.FileID)
+			fmt.Fprintf(w, "  Chunks\t%d\n", len(result.Chunks))
+			if result.Anchor != nil {
+				fmt.Fprintf(w, "  Anchor\t%s\n", result.Anchor.Status)
+			}
+			if err := w.Flush(); err != nil {
+				return err
+			}
+			if result.AnchorJobID != "" {
+				fmt.Printf("Anchoring is queued as job %s and runs when the agent server next starts\n", result.AnchorJobID)
+			}
+			return nil
+		})
+	},
+}
+
+// downloadResult describes a file written by download
+type downloadResult struct {
+	Hash     string `json:"hash"`
+	Filename string `json:"filename"`
+	Size     int64  `json:"size"`
+	Chunks   int    `json:"chunks"`
+	Path     string `json:"path"`
+}
+
+var downloadCmd = &cobra.Command{
+	Use:   "download <hash|id|root>",
+	Short: "Reassemble a stored file, verifying every chunk against its hash",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runFiles(cmd, func(ctx context.Context, b *fileBackends) error {
+			record, err := findFile(b.store, args[0])
+			if err != nil {
+				return err
+			}
+
+			if downloadPath == "-" {
+				return readFile(ctx, b.pipeline, record, os.Stdout, newProgressBar(!jsonOutput))
+			}
+
+			// Content lands next to its destination and is only moved there
+			// once all of it is verified
+			out, err := os.CreateTemp(filepath.Dir(downloadPath), ".download-*")
+			if err != nil {
+				return fmt.Errorf("failed to create output file: %w", err)
+			}
+			defer os.Remove(out.Name())
+
+			bar := newProgressBar(!jsonOutput)
+			err = readFile(ctx, b.pipeline, record, out, bar)
+			if closeErr := out.Close(); err == nil {
+				err = closeErr
+			}
+			if err != nil {
+				return err
+			}
+			if err := os.Rename(out.Name(), downloadPath); err != nil {
+				return fmt.Errorf("failed to write %s: %w", downloadPath, err)
+			}
+
+			result := downloadResult{
+				Hash:     record.Hash,
+				Filename: record.Filename,
+				Size:     record.Size,
+				Chunks:   len(record.ChunkHashes),
+				Path:     downloadPath,
+			}
+			if jsonOutput {
+				return printJSON(result)
+			}
+			fmt.Printf("Downloaded %s (%s, %d chunks) to %s\n", result.Filename, formatSize(result.Size), result.Chunks, result.Path)
+			return nil
+		})
+	},
+}
+
+// verifyResult is the outcome of verify
+type verifyResult struct {
+	Path     string `json:"path,omitempty"`
+	Hash     string `json:"hash"`
+	Filename string `json:"filename"`
+	Chunks   int    `json:"chunks"`
+
+	Verification *metadata.Verification `json:"verification"`
+}
+
+var verifyCmd = &cobra.Command{
+	Use:   "verify <path|hash|id|root>",
+	Short: "Check that a stored file is intact and retrievable from 0G Storage",
+	Long: `Check that a stored file's chunk hashes still produce its Merkle root and
+that every chunk is retrievable from 0G Storage. Given the path of a local
+file, the file is hashed and the stored copy of its content is checked.
+Exits non-zero when the file fails the check.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runFiles(cmd, func(ctx context.Context, b *fileBackends) error {
+			bar := newProgressBar(!jsonOutput)
+			defer bar.Done()
+			ctx = progress.WithReporter(ctx, bar.Report)
+
+			result := verifyResult{}
+			var record *metadata.FileRecord
+			var err error
+			if info, statErr := os.Stat(args[0]); statErr == nil && !info.IsDir() {
+				// A local file is looked up by the hash of its content
+				var file *storage.FileMetadata
+				if file, err = b.storage.ChunkFile(ctx, args[0]); err != nil {
+					return err
+				}
+				record, err = b.store.GetFile(file.Hash)
+				if err == metadata.ErrNotFound {
+					return apierror.Newf(apierror.FileNotFound, "%s is not stored in the vault", args[0])
+				}
+				result.Path = args[0]
+			} else {
+				record, err = findFile(b.store, args[0])
+			}
+			if err != nil {
+				return err
+			}
+
+			verification, err := b.pipeline.Verify(ctx, record.Hash)
+			bar.Done()
+			if err != nil {
+				return err
+			}
+			result.Hash = record.Hash
+			result.Filename = record.Filename
+			result.Chunks = len(record.ChunkHashes)
+			result.Verification = verification
+
+			if jsonOutput {
+				if err := printJSON(result); err != nil {
+					return err
+				}
+			} else {
+				printVerification(result)
+			}
+			if !verification.Valid {
+				return errVerifyFailed
+			}
+			return nil
+		})
+	},
+}
+
+// proofResult is a chunk's inclusion proof against the keccak256 tree a
+// file's anchor is verified with
+type proofResult struct {
+	Hash       string   `json:"hash"`
+	Chunk      int      `json:"chunk"`
+	StorageRef string   `json:"storage_ref,omitempty"`
+	Leaf       string   `json:"leaf"`
+	Root       string   `json:"root"`
+	Proof      []string `json:"proof"`
+	Indices    []uint64 `json:"indices"`
+	Valid      bool     `json:"valid"`
+}
+
+var proofCmd = &cobra.Command{
+	Use:   "proof <hash|id|root>",
+	Short: "Print the Merkle proof of one of a stored file's chunks",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runFiles(cmd, func(ctx context.Context, b *fileBackends) error {
+			record, err := findFile(b.store, args[0])
+			if err != nil {
+				return err
+			}
+			if proofChunk < 0 || proofChunk >= max(len(record.ChunkHashes), 1) {
+				return apierror.Newf(apierror.ChunkNotFound, "File has %d chunks; there is no chunk %d", len(record.ChunkHashes), proofChunk)
+			}
+
+			proof, err := anchor.Proof(record, proofChunk)
+			if err != nil {
+				return err
+			}
+			result := proofResult{
+				Hash:    record.Hash,
+				Chunk:   proofChunk,
+				Leaf:    proof.Leaf.Hex(),
+				Root:    proof.Root.Hex(),
+				Proof:   make([]string, len(proof.Proof)),
+				Indices: proof.Indices,
+				Valid:   proof.Verify(),
+			}
+			for i, sibling := range proof.Proof {
+				result.Proof[i] = sibling.Hex()
+			}
+			if proofChunk < len(record.StorageRefs) {
+				result.StorageRef = record.StorageRefs[proofChunk]
+			}
+
+			if jsonOutput {
+				return printJSON(result)
+			}
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintf(w, "File\t%s\n", result.Hash)
+			fmt.Fprintf(w, "Chunk\t%d\n", result.Chunk)
+			if result.StorageRef != "" {
+				fmt.Fprintf(w, "0G root\t%s\n", result.StorageRef)
+			}
+			fmt.Fprintf(w, "Leaf\t%s\n", result.Leaf)
+			fmt.Fprintf(w, "Root\t%s\n", result.Root)
+			for i, sibling := range result.Proof {
+				side := "right"
+				if result.Indices[i] == 0 {
+					side = "left"
+				}
+				fmt.Fprintf(w, "Step %d\t%s (%s)\n", i, sibling, side)
+			}
+			fmt.Fprintf(w, "Valid\t%t\n", result.Valid)
+			return w.Flush()
+		})
+	},
+}
+
+var lsCmd = &cobra.Command{
+	Use:   "ls",
+	Short: "List stored files",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runFiles(cmd, func(ctx context.Context, b *fileBackends) error {
+			files, err := b.store.ListFiles(func(record *metadata.FileRecord) bool {
+				return listUser == "" || strings.EqualFold(record.UserID, listUser)
+			})
+			if err != nil {
+				return err
+			}
+			sort.SliceStable(files, func(i, j int) bool {
+				return files[i].UploadedAt < files[j].UploadedAt
+			})
+
+			if jsonOutput {
+				return printJSON(files)
+			}
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "HASH\tNAME\tSIZE\tCHUNKS\tANCHOR\tVERIFIED\tUPLOADED")
+			for _, record := range files {
+				anchorStatus := "-"
+				if record.Anchor != nil {
+					anchorStatus = string(record.Anchor.Status)
+				}
+				verified := "-"
+				if record.Verification != nil {
+					verified = "ok"
+					if !record.Verification.Valid {
+						verified = "failed"
+					}
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\t%s\t%s\n",
+					record.Hash, record.Filename, formatSize(record.Size), len(record.ChunkHashes),
+					anchorStatus, verified, record.UploadedAt)
+			}
+			return w.Flush()
+		})
+	},
+}
+
+func init() {
+	for _, cmd := range []*cobra.Command{uploadCmd, downloadCmd, verifyCmd, proofCmd, lsCmd} {
+		cmd.Flags().BoolVar(&jsonOutput, "json", false, "Print the result as JSON")
+		// Failures are operational, not usage mistakes, and main reports them
+		cmd.SilenceUsage = true
+		cmd.SilenceErrors = true
+	}
+	uploadCmd.Flags().StringVar(&uploadName, "name", "", "Filename to record instead of the file's own name")
+	uploadCmd.Flags().StringVar(&uploadUser, "user", "", "Owner to record for the file")
+	downloadCmd.Flags().StringVarP(&downloadPath, "output", "o", "", "Path to write the file to, or - for stdout")
+	downloadCmd.MarkFlagRequired("output")
+	proofCmd.Flags().IntVar(&proofChunk, "chunk", 0, "Index of the chunk to prove")
+	lsCmd.Flags().StringVar(&listUser, "user", "", "Only list files owned by this user")
+
+	rootCmd.AddCommand(uploadCmd, downloadCmd, verifyCmd, proofCmd, lsCmd)
+}
+
+// fileBackends are the server's storage components, run in-process by the
+// file commands
+type fileBackends struct {
+	storage  *storage.StorageManager
+	store    *metadata.Store
+	pipeline *pipeline.Pipeline
+}
+
+// runFiles opens the backends the configuration describes and runs fn with
+// them, cancelling its context on an interrupt. The metadata store can only
+// be open in one process, so the commands fail while the server runs.
+// Anchoring is queued for the server to run rather than run here.
+func runFiles(cmd *cobra.Command, fn func(context.Context, *fileBackends) error) error {
+	cfg, err := config.LoadConfig(configPath)
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+	if err := setupCommandLogging(cmd); err != nil {
+		return err
+	}
+
+	zeroGClient, err := setupZeroGClient(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to initialize 0G client: %w", err)
+	}
+	metadataStore, err := metadata.Open(filepath.Join(cfg.Storage.DataDir, "metadata"))
+	if errors.Is(err, syscall.EAGAIN) {
+		return fmt.Errorf("%w; stop the agent server to use its data directory", err)
+	}
+	if err != nil {
+		return err
+	}
+	defer metadataStore.Close()
+
+	jobQueue := jobs.NewQueue(metadataStore, jobs.Config{Retention: cfg.Jobs.Retention}, logrus.StandardLogger())
+	var anchorer *anchor.Anchorer
+	if cfg.Chain.Enabled {
+		var contractClient *contracts.ContractClient
+		contractClient, err = setupContractClient(cfg)
+		if err != nil {
+			return fmt.Errorf("failed to initialize contract client: %w", err)
+		}
+		defer contractClient.Close()
+		anchorer = setupAnchorer(cfg, contractClient, metadataStore, jobQueue)
+	}
+
+	storageManager := storage.NewStorageManager(cfg.Storage.DataDir, cfg.Storage.TempDir, cfg.Storage.ChunkSize)
+	backends := &fileBackends{
+		storage:  storageManager,
+		store:    metadataStore,
+		pipeline: setupPipeline(cfg, storageManager, zeroGClient, metadataStore, anchorer, jobQueue),
+	}
+
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	return fn(ctx, backends)
+}
+
+// setupCommandLogging keeps component logs on stderr, out of the way of
+// command output, and quiet but for warnings unless --log-level is given
+func setupCommandLogging(cmd *cobra.Command) error {
+	level := logrus.WarnLevel
+	if cmd.Flags().Changed("log-level") {
+		parsed, err := logrus.ParseLevel(logLevel)
+		if err != nil {
+			return err
+		}
+		level = parsed
+	}
+	logrus.SetOutput(os.Stderr)
+	logrus.SetLevel(level)
+	return nil
+}
+
+// findFile looks a stored file up by content hash, file ID or Merkle root
+func findFile(store *metadata.Store, ref string) (*metadata.FileRecord, error) {
+	hash := strings.TrimPrefix(strings.ToLower(ref), "0x")
+	record, err := store.GetFile(hash)
+	if err != metadata.ErrNotFound {
+		return record, err
+	}
+
+	matches, err := store.ListFiles(func(record *metadata.FileRecord) bool {
+		return record.ID == ref || strings.TrimPrefix(strings.ToLower(record.MerkleRoot), "0x") == hash
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(matches) == 0 {
+		return nil, apierror.Newf(apierror.FileNotFound, "No file has the hash, ID or root %s", ref)
+	}
+	return matches[0], nil
+}
+
+// readFile writes a stored file's content to w, checking each chunk against
+// its recorded hash and the whole against the file's hash
+func readFile(ctx context.Context, p *pipeline.Pipeline, record *metadata.FileRecord, w io.Writer, bar *progressBar) error {
+	defer bar.Done()
+
+	hasher := sha256.New()
+	out := io.MultiWriter(w, hasher)
+	var written int64
+	for index, chunkHash := range record.ChunkHashes {
+		data, err := p.ReadChunk(ctx, record, index)
+		if err != nil {
+			return err
+		}
+		if sum := sha256.Sum256(data); hex.EncodeToString(sum[:]) != chunkHash {
+			return fmt.Errorf("chunk %d does not match its recorded hash", index)
+		}
+		if _, err := out.Write(data); err != nil {
+			return fmt.Errorf("failed to write chunk %d: %w", index, err)
+		}
+		written += int64(len(data))
+		bar.Set("download", written, record.Size, fmt.Sprintf("%d/%d chunks", index+1, len(record.ChunkHashes)))
+	}
+
+	if hex.EncodeToString(hasher.Sum(nil)) != record.Hash {
+		return fmt.Errorf("downloaded content does not match file hash %s", record.Hash)
+	}
+	return nil
+}
+
+func printVerification(result verifyResult) {
+	verification := result.Verification
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	if result.Path != "" {
+		fmt.Fprintf(w, "Path\t%s\n", result.Path)
+	}
+	fmt.Fprintf(w, "File\t%s (%s)\n", result.Filename, result.Hash)
+
+	root := "valid"
+	if !verification.MerkleRootValid {
+		root = "does not match the chunk hashes"
+	}
+	fmt.Fprintf(w, "Merkle root\t%s\n", root)
+
+	chunks := fmt.Sprintf("%d/%d retrievable", result.Chunks-len(verification.MissingChunks), result.Chunks)
+	if len(verification.MissingChunks) > 0 {
+		missing := make([]string, len(verification.MissingChunks))
+		for i, index := range verification.MissingChunks {
+			missing[i] = fmt.Sprint(index)
+		}
+		chunks += ", missing " + strings.Join(missing, ", ")
+	}
+	fmt.Fprintf(w, "Chunks\t%s\n", chunks)
+
+	status := "OK"
+	if !verification.Valid {
+		status = "FAILED"
+	}
+	fmt.Fprintf(w, "Result\t%s\n", status)
+	w.Flush()
+}
+
+func printJSON(v interface{}) error {
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+
+	"nebularvault-agent/internal/apierror"
+	"nebularvault-agent/internal/jobs"
+	"nebularvault-agent/internal/metadata"
+	"nebularvault-agent/internal/pipeline"
+	"nebularvault-agent/internal/storage"
+	"nebularvault-agent/internal/zerog"
+)
+
+// TestFileCommands_FindAndRead uploads a file in-process, looks it up the
+// ways the commands accept and reads it back verified
+func TestFileCommands_FindAndRead(t *testing.T) {
+	dir := t.TempDir()
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	store, err := metadata.Open(filepath.Join(dir, "metadata"))
+	if err != nil {
+		t.Fatalf("Failed to open metadata store: %v", err)
+	}
+	defer store.Close()
+	zeroGClient, err := zerog.NewZeroGClient(&zerog.ZeroGConfig{}, logger)
+	if err != nil {
+		t.Fatalf("Failed to create 0G client: %v", err)
+	}
+	p := pipeline.New(storage.NewStorageManager(dir, dir, 16), zeroGClient, store, nil,
+		jobs.NewQueue(store, jobs.Config{}, logger), pipeline.Config{KeepChunks: true}, logger)
+
+	content := []byte("chunked across three chunks of sixteen bytes")
+	path := filepath.Join(dir, "notes.txt")
+	if err := os.WriteFile(path, content, 0600); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+	result, err := p.Upload(context.Background(), pipeline.UploadRequest{Path: path})
+	if err != nil {
+		t.Fatalf("Upload failed: %v", err)
+	}
+
+	for _, ref := range []string{result.Hash, "0x" + strings.ToUpper(result.Hash), result.FileID, result.MerkleRoot} {
+		record, err := findFile(store, ref)
+		if err != nil {
+			t.Fatalf("Failed to find file by %q: %v", ref, err)
+		}
+		if record.Hash != result.Hash {
+			t.Errorf("Expected %s for %q, got %s", result.Hash, ref, record.Hash)
+		}
+	}
+	if _, err := findFile(store, "missing"); apierror.CodeOf(err) != apierror.FileNotFound {
+		t.Errorf("Expected file_not_found for an unknown file, got %v", err)
+	}
+
+	record, err := store.GetFile(result.Hash)
+	if err != nil {
+		t.Fatalf("Failed to get record: %v", err)
+	}
+	var out bytes.Buffer
+	if err := readFile(context.Background(), p, record, &out, newProgressBar(false)); err != nil {
+		t.Fatalf("Read failed: %v", err)
+	}
+	if !bytes.Equal(out.Bytes(), content) {
+		t.Errorf("Expected %q, got %q", content, out.Bytes())
+	}
+
+	// A chunk that no longer matches its hash fails the read
+	record.ChunkHashes[1] = strings.Repeat("0", 64)
+	if err := readFile(context.Background(), p, record, io.Discard, newProgressBar(false)); err == nil || !strings.Contains(err.Error(), "chunk 1") {
+		t.Errorf("Expected chunk 1 to fail verification, got %v", err)
+	}
+}
@@ -2,21 +2,49 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
+	"io"
+	"math/big"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"syscall"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/gin-gonic/gin"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 
+	vaultv1 "nebularvault-agent/api/vault/v1"
 	"nebularvault-agent/config"
+	"nebularvault-agent/internal/anchor"
+	"nebularvault-agent/internal/apikey"
+	"nebularvault-agent/internal/auth"
+	"nebularvault-agent/internal/certs"
+	"nebularvault-agent/internal/contracts"
+	"nebularvault-agent/internal/grpcapi"
 	"nebularvault-agent/internal/handlers"
+	"nebularvault-agent/internal/health"
+	"nebularvault-agent/internal/indexer"
+	"nebularvault-agent/internal/jobs"
+	"nebularvault-agent/internal/logging"
+	"nebularvault-agent/internal/metadata"
+	"nebularvault-agent/internal/metrics"
 	"nebularvault-agent/internal/middleware"
+	"nebularvault-agent/internal/pipeline"
+	"nebularvault-agent/internal/policy"
+	"nebularvault-agent/internal/quota"
 	"nebularvault-agent/internal/storage"
+	"nebularvault-agent/internal/tracing"
+	"nebularvault-agent/internal/version"
+	"nebularvault-agent/internal/webhook"
 	"nebularvault-agent/internal/zerog"
 )
 
@@ -30,7 +58,8 @@ var rootCmd = &cobra.Command{
 	Short: "NebularVault Storage Agent for 0G Network integration",
 	Long: `NebularVault Agent is a storage service that handles file chunking,
 Merkle tree generation, and integration with the 0G Storage network.`,
-	Run: runAgent,
+	Version: version.Get().String(),
+	Run:     runAgent,
 }
 
 func init() {
@@ -53,11 +82,29 @@ func runAgent(cmd *cobra.Command, args []string) {
 	}
 
 	// Setup logging
-	setupLogging(cfg.Logging.Level)
+	logOutput := setupLogging(cmd, cfg)
+	defer logOutput.Close()
 
-	logrus.Info("🚀 Starting NebularVault Agent...")
+	logrus.WithField("version", version.Get()).Info("🚀 Starting NebularVault Agent...")
 	logrus.Infof("Configuration loaded from: %s", configPath)
 
+	// Tracing goes first so start-up calls are traced too
+	shutdownTracing := func(context.Context) error { return nil }
+	if cfg.Tracing.Enabled {
+		shutdownTracing, err = tracing.Setup(context.Background(), tracing.Config{
+			ServiceName: cfg.Tracing.ServiceName,
+			Exporter:    cfg.Tracing.Exporter,
+			Endpoint:    cfg.Tracing.Endpoint,
+			Headers:     cfg.Tracing.Headers,
+			FilePath:    cfg.Tracing.FilePath,
+			SampleRatio: cfg.Tracing.SampleRatio,
+		})
+		if err != nil {
+			logrus.Fatalf("Failed to set up tracing: %v", err)
+		}
+		logrus.Infof("Tracing enabled, exporting to %s", cfg.Tracing.Exporter)
+	}
+
 	// Initialize storage manager
 	storageManager := storage.NewStorageManager(
 		cfg.Storage.DataDir,
@@ -66,24 +113,13 @@ func runAgent(cmd *cobra.Command, args []string) {
 	)
 
 	// Initialize 0G client
-	zeroGConfig := &zerog.ZeroGConfig{
-		IndexerEndpoint:  cfg.Network.IndexerEndpoint,
-		TransferEndpoint: cfg.Network.TransferEndpoint,
-		CoreEndpoint:     cfg.Network.CoreEndpoint,
-		RPCURL:           cfg.Network.RPCURL,
-		ChainID:          cfg.Network.ChainID,
-		ContractAddress:  cfg.Network.ContractAddress,
-		PrivateKey:       cfg.Network.PrivateKey,
-		Timeout:          cfg.Network.Timeout,
-	}
-	
-	zeroGClient, err := zerog.NewZeroGClient(zeroGConfig)
+	zeroGClient, err := setupZeroGClient(cfg)
 	if err != nil {
 		logrus.Fatalf("Failed to initialize 0G client: %v", err)
 	}
 
 	// Test 0G connection
-	healthResp, err := zeroGClient.HealthCheck()
+	healthResp, err := zeroGClient.HealthCheck(context.Background())
 	if err != nil {
 		logrus.Warnf("0G Storage connection test failed: %v", err)
 		logrus.Warn("Agent will continue but 0G operations may fail")
@@ -92,57 +128,391 @@ func runAgent(cmd *cobra.Command, args []string) {
 		logrus.Info(healthResp.Message)
 	}
 
+	// Open the local metadata store
+	metadataStore, err := metadata.Open(filepath.Join(cfg.Storage.DataDir, "metadata"))
+	if err != nil {
+		logrus.Fatalf("Failed to open metadata store: %v", err)
+	}
+	defer metadataStore.Close()
+
+	ctx, stop := context.WithCancel(context.Background())
+	defer stop()
+
+	// Uploads, anchoring, verification and repair run as persistent jobs
+	jobQueue := jobs.NewQueue(metadataStore, jobs.Config{Retention: cfg.Jobs.Retention}, logrus.StandardLogger())
+
+	// Start the on-chain event indexer
+	var eventIndexer *indexer.Indexer
+	if cfg.Indexer.Enabled {
+		eventIndexer, err = setupIndexer(cfg, metadataStore)
+		if err != nil {
+			logrus.Fatalf("Failed to initialize event indexer: %v", err)
+		}
+		go eventIndexer.Run(ctx)
+		logrus.Infof("🔎 Indexing vault events from block %d", cfg.Indexer.StartBlock)
+	}
+
+	// Connect to the vault contracts and anchor uploads on chain
+	var contractClient *contracts.ContractClient
+	var anchorer *anchor.Anchorer
+	if cfg.Chain.Enabled {
+		contractClient, err = setupContractClient(cfg)
+		if err != nil {
+			logrus.Fatalf("Failed to initialize contract client: %v", err)
+		}
+		defer contractClient.Close()
+
+		if err := contractClient.HealthCheck(ctx); err != nil {
+			logrus.Warnf("Contract health check failed: %v", err)
+		}
+		contractClient.Start(ctx)
+
+		anchorer = setupAnchorer(cfg, contractClient, metadataStore, jobQueue)
+	}
+
+	filePipeline := setupPipeline(cfg, storageManager, zeroGClient, metadataStore, anchorer, jobQueue)
+
+	// Notify subscribed webhooks of file lifecycle events
+	webhooks := webhook.NewDispatcher(metadataStore, jobQueue, webhook.Config{
+		Workers:     cfg.Webhooks.Workers,
+		MaxAttempts: cfg.Webhooks.MaxAttempts,
+		Backoff:     cfg.Webhooks.Backoff,
+		MaxBackoff:  cfg.Webhooks.MaxBackoff,
+		Timeout:     cfg.Webhooks.Timeout,
+		Retention:   cfg.Webhooks.Retention,
+	}, logrus.StandardLogger())
+	if eventIndexer != nil {
+		eventIndexer.OnEvents(webhooks.OnChainEvents)
+	}
+
+	if err := jobQueue.Start(ctx); err != nil {
+		logrus.Fatalf("Failed to start job queue: %v", err)
+	}
+	if err := webhooks.Start(ctx); err != nil {
+		logrus.Fatalf("Failed to start webhooks: %v", err)
+	}
+	go filePipeline.ExpireSessions(ctx, 10*time.Minute)
+	if anchorer != nil {
+		if err := anchorer.Start(ctx); err != nil {
+			logrus.Fatalf("Failed to start anchoring: %v", err)
+		}
+		logrus.Info("⛓️  Anchoring uploads on chain")
+	}
+
+	// Wallet sign-in and API keys for API callers
+	var authenticator *auth.Authenticator
+	var apiKeys *apikey.Store
+	if cfg.Auth.Enabled {
+		authenticator, err = auth.NewAuthenticator(auth.Config{
+			Domain:     cfg.Auth.Domain,
+			ChainID:    cfg.Network.ChainID,
+			NonceTTL:   cfg.Auth.NonceTTL,
+			SessionTTL: cfg.Auth.SessionTTL,
+			Secret:     []byte(cfg.Auth.SessionSecret),
+		})
+		if err != nil {
+			logrus.Fatalf("Failed to initialize authentication: %v", err)
+		}
+		if cfg.Auth.SessionSecret == "" {
+			logrus.Warn("No auth.session_secret set; sessions will not survive a restart")
+		}
+
+		apiKeys, err = apikey.Open(apiKeyFile(cfg))
+		if err != nil {
+			logrus.Fatalf("Failed to open API keys: %v", err)
+		}
+		go apiKeys.Run(ctx, time.Minute, logrus.StandardLogger())
+	}
+
+	// Check file permissions against the chain for signed-in wallets
+	var policyEngine *policy.Engine
+	if cfg.Auth.Enabled {
+		var chain policy.Chain
+		if contractClient != nil {
+			chain = contractClient
+		}
+		policyEngine = policy.NewEngine(metadataStore, chain, policy.Config{
+			CacheTTL:       cfg.Auth.PolicyCacheTTL,
+			ReadPermission: cfg.Auth.ReadPermission,
+		}, logrus.StandardLogger())
+		if eventIndexer != nil {
+			eventIndexer.OnEvents(policyEngine.Invalidate)
+		}
+	}
+
+	// Enforce AccessControl storage quotas on wallet uploads
+	var quotaTracker *quota.Tracker
+	if cfg.Auth.Enabled && contractClient != nil {
+		quotaTracker = quota.NewTracker(metadataStore, contractClient, cfg.Storage.QuotaCacheTTL, logrus.StandardLogger())
+		if eventIndexer != nil {
+			eventIndexer.OnEvents(quotaTracker.Invalidate)
+		}
+	}
+
+	// Readiness checks every dependency requests need
+	prober := setupProber(cfg, zeroGClient, metadataStore, contractClient)
+
 	// Setup HTTP server
-	server := setupServer(cfg, storageManager, zeroGClient)
+	server := setupServer(cfg, storageManager, zeroGClient, metadataStore, eventIndexer, contractClient, anchorer, jobQueue, filePipeline, webhooks, authenticator, apiKeys, policyEngine, quotaTracker, prober)
+
+	if cfg.Security.EnableTLS {
+		server.TLSConfig, err = setupTLS(cfg)
+		if err != nil {
+			logrus.Fatalf("Failed to initialize TLS: %v", err)
+		}
+	}
 
 	// Start server in goroutine
 	go func() {
 		addr := fmt.Sprintf("%s:%d", cfg.Server.Host, cfg.Server.Port)
-		logrus.Infof("🌐 Server starting on %s", addr)
-		
-		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+
+		var err error
+		if server.TLSConfig != nil {
+			logrus.Infof("🔒 Server starting on %s with TLS (client certificates: %s)", addr, cfg.Security.ClientAuth)
+			// The certificate comes from TLSConfig.GetCertificate
+			err = server.ListenAndServeTLS("", "")
+		} else {
+			logrus.Infof("🌐 Server starting on %s", addr)
+			err = server.ListenAndServe()
+		}
+		if err != nil && err != http.ErrServerClosed {
 			logrus.Fatalf("Server failed to start: %v", err)
 		}
 	}()
 
+	// gRPC API on its own port
+	var grpcServer *grpc.Server
+	if cfg.GRPC.Enabled {
+		grpcServer = setupGRPCServer(cfg, storageManager, zeroGClient, metadataStore, jobQueue, filePipeline, authenticator, apiKeys, policyEngine, quotaTracker, server.TLSConfig)
+		listener, err := net.Listen("tcp", fmt.Sprintf("%s:%d", cfg.GRPC.Host, cfg.GRPC.Port))
+		if err != nil {
+			logrus.Fatalf("Failed to listen for gRPC: %v", err)
+		}
+		go func() {
+			logrus.Infof("📡 gRPC server starting on %s", listener.Addr())
+			if err := grpcServer.Serve(listener); err != nil {
+				logrus.Fatalf("gRPC server failed: %v", err)
+			}
+		}()
+	}
+
 	// Wait for interrupt signal
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 
 	logrus.Info("🛑 Shutting down NebularVault Agent...")
+	prober.Drain()
+	stop()
 
 	// Graceful shutdown
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
-	if err := server.Shutdown(ctx); err != nil {
+	if err := server.Shutdown(shutdownCtx); err != nil {
 		logrus.Errorf("Server forced to shutdown: %v", err)
 	}
+	if grpcServer != nil {
+		stopped := make(chan struct{})
+		go func() {
+			grpcServer.GracefulStop()
+			close(stopped)
+		}()
+		select {
+		case <-stopped:
+		case <-shutdownCtx.Done():
+			logrus.Error("gRPC server forced to shutdown")
+			grpcServer.Stop()
+		}
+	}
+	// Interrupted jobs are requeued before the store closes
+	jobQueue.Wait()
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		logrus.Errorf("Failed to flush traces: %v", err)
+	}
 
 	logrus.Info("✅ NebularVault Agent stopped gracefully")
 }
 
-func setupLogging(level string) {
-	logrus.SetFormatter(&logrus.JSONFormatter{
-		TimestampFormat: time.RFC3339,
+// setupLogging configures the standard logger every component is given.
+// --log-level overrides logging.level when set.
+func setupLogging(cmd *cobra.Command, cfg *config.Config) io.Closer {
+	level := cfg.Logging.Level
+	if cmd.Flags().Changed("log-level") {
+		level = logLevel
+	}
+
+	logger, closer, err := logging.Setup(logging.Config{
+		Level:      level,
+		Format:     cfg.Logging.Format,
+		Output:     cfg.Logging.Output,
+		MaxSizeMB:  cfg.Logging.MaxSizeMB,
+		MaxBackups: cfg.Logging.MaxBackups,
+		MaxAgeDays: cfg.Logging.MaxAgeDays,
+		Compress:   cfg.Logging.Compress,
+		Secrets:    []string{cfg.Network.PrivateKey, cfg.Auth.SessionSecret},
+	})
+	if err != nil {
+		logrus.Fatalf("Failed to set up logging: %v", err)
+	}
+
+	// gin's own messages, such as route listings in debug mode, go through
+	// the same logger
+	gin.DefaultWriter = logger.WriterLevel(logrus.DebugLevel)
+	gin.DefaultErrorWriter = logger.WriterLevel(logrus.ErrorLevel)
+	return closer
+}
+
+func setupIndexer(cfg *config.Config, store *metadata.Store) (*indexer.Indexer, error) {
+	client, err := ethclient.Dial(cfg.Network.RPCURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", cfg.Network.RPCURL, err)
+	}
+
+	return indexer.NewIndexer(client, store, indexer.Config{
+		VaultAddress:  common.HexToAddress(cfg.Network.ContractAddress),
+		StartBlock:    cfg.Indexer.StartBlock,
+		BatchSize:     cfg.Indexer.BatchSize,
+		PollInterval:  cfg.Indexer.PollInterval,
+		Confirmations: cfg.Indexer.Confirmations,
+		ReorgDepth:    cfg.Indexer.ReorgDepth,
+	}, logrus.StandardLogger())
+}
+
+// setupTLS loads the server certificate, reloading it when renewed on
+// disk, and the CA client certificates are checked against
+func setupTLS(cfg *config.Config) (*tls.Config, error) {
+	reloader, err := certs.NewReloader(cfg.Security.CertFile, cfg.Security.KeyFile, logrus.StandardLogger())
+	if err != nil {
+		return nil, err
+	}
+	return certs.ServerConfig(reloader, cfg.Security.ClientAuth, cfg.Security.ClientCAFile)
+}
+
+// apiKeyFile is where API keys are kept, shared by the server and the
+// apikey commands
+func apiKeyFile(cfg *config.Config) string {
+	return filepath.Join(cfg.Storage.DataDir, "api-keys.json")
+}
+
+func setupZeroGClient(cfg *config.Config) (*zerog.ZeroGClient, error) {
+	return zerog.NewZeroGClient(&zerog.ZeroGConfig{
+		IndexerEndpoint:  cfg.Network.IndexerEndpoint,
+		TransferEndpoint: cfg.Network.TransferEndpoint,
+		CoreEndpoint:     cfg.Network.CoreEndpoint,
+		RPCURL:           cfg.Network.RPCURL,
+		ChainID:          cfg.Network.ChainID,
+		ContractAddress:  cfg.Network.ContractAddress,
+		PrivateKey:       cfg.Network.PrivateKey,
+		Timeout:          cfg.Network.Timeout,
+	}, logrus.StandardLogger())
+}
+
+func setupAnchorer(cfg *config.Config, contractClient *contracts.ContractClient, metadataStore *metadata.Store, jobQueue *jobs.Queue) *anchor.Anchorer {
+	return anchor.NewAnchorer(contractClient, metadataStore, jobQueue, anchor.Config{
+		Workers:       cfg.Chain.AnchorWorkers,
+		Timeout:       cfg.Chain.AnchorTimeout,
+		MaxAttempts:   cfg.Jobs.MaxAttempts,
+		Confirmations: cfg.Chain.Confirmations,
+	}, logrus.StandardLogger())
+}
+
+func setupPipeline(cfg *config.Config, storageManager *storage.StorageManager, zeroGClient *zerog.ZeroGClient, metadataStore *metadata.Store, anchorer *anchor.Anchorer, jobQueue *jobs.Queue) *pipeline.Pipeline {
+	return pipeline.New(storageManager, zeroGClient, metadataStore, anchorer, jobQueue, pipeline.Config{
+		SpoolDir:    cfg.Jobs.SpoolDir,
+		KeepChunks:  cfg.Jobs.KeepChunks,
+		SessionTTL:  cfg.Jobs.UploadSessionTTL,
+		Workers:     cfg.Jobs.Workers,
+		MaxAttempts: cfg.Jobs.MaxAttempts,
+		Backoff:     cfg.Jobs.Backoff,
+	}, logrus.StandardLogger())
+}
+
+func setupContractClient(cfg *config.Config) (*contracts.ContractClient, error) {
+	var maxFeePerGas *big.Int
+	if cfg.Chain.MaxFeePerGasGwei > 0 {
+		maxFeePerGas = new(big.Int).Mul(big.NewInt(cfg.Chain.MaxFeePerGasGwei), big.NewInt(1e9))
+	}
+
+	return contracts.NewContractClient(&contracts.ContractConfig{
+		RPCURL:             cfg.Network.RPCURL,
+		ChainID:            cfg.Network.ChainID,
+		ContractAddress:    cfg.Network.ContractAddress,
+		PrivateKey:         cfg.Network.PrivateKey,
+		Timeout:            cfg.Network.Timeout,
+		Confirmations:      cfg.Chain.Confirmations,
+		PollInterval:       cfg.Chain.PollInterval,
+		PendingTxFile:      filepath.Join(cfg.Storage.DataDir, "pending-transactions.json"),
+		StuckTimeout:       cfg.Chain.StuckTimeout,
+		GasBumpPercent:     cfg.Chain.GasBumpPercent,
+		MaxGasBumps:        cfg.Chain.MaxGasBumps,
+		FeeStrategy:        contracts.FeeStrategy(cfg.Chain.FeeStrategy),
+		MaxFeePerGas:       maxFeePerGas,
+		GasLimitMultiplier: cfg.Chain.GasLimitMultiplier,
+	}, logrus.StandardLogger())
+}
+
+// setupProber registers a readiness check for each dependency; the contract
+// check runs only when chain integration is enabled
+func setupProber(cfg *config.Config, zeroGClient *zerog.ZeroGClient, metadataStore *metadata.Store, contractClient *contracts.ContractClient) *health.Prober {
+	prober := health.NewProber(cfg.Health.CheckTimeout)
+
+	prober.Register("zerog", func(ctx context.Context) (string, error) {
+		resp, err := zeroGClient.HealthCheck(ctx)
+		if err != nil {
+			return "", err
+		}
+		return resp.Message, nil
 	})
+	if contractClient != nil {
+		prober.Register("contract", func(ctx context.Context) (string, error) {
+			if err := contractClient.HealthCheck(ctx); err != nil {
+				return "", err
+			}
+			return fmt.Sprintf("chain %d, vault at %s", cfg.Network.ChainID, cfg.Network.ContractAddress), nil
+		})
+	}
+	prober.Register("metadata", func(context.Context) (string, error) {
+		if err := metadataStore.Check(); err != nil {
+			return "", err
+		}
+		return "writable", nil
+	})
+	prober.Register("disk", health.DiskSpace(cfg.Storage.DataDir, cfg.Health.MinFreeDiskMB<<20))
+
+	return prober
+}
+
+// setupGRPCServer serves VaultService over the REST API's backends, with
+// the same credentials, scopes and TLS
+func setupGRPCServer(cfg *config.Config, storageManager *storage.StorageManager, zeroGClient *zerog.ZeroGClient, metadataStore *metadata.Store, jobQueue *jobs.Queue, filePipeline *pipeline.Pipeline, authenticator *auth.Authenticator, apiKeys *apikey.Store, policyEngine *policy.Engine, quotaTracker *quota.Tracker, tlsConfig *tls.Config) *grpc.Server {
+	logUnary, logStream := middleware.GRPCLogger(logrus.StandardLogger())
+	unary := []grpc.UnaryServerInterceptor{logUnary}
+	stream := []grpc.StreamServerInterceptor{logStream}
+	if authenticator != nil {
+		authUnary, authStream := middleware.GRPCAuthenticate(authenticator, apiKeys, grpcapi.MethodScopes)
+		unary = append(unary, authUnary)
+		stream = append(stream, authStream)
+	}
 
-	switch level {
-	case "debug":
-		logrus.SetLevel(logrus.DebugLevel)
-	case "info":
-		logrus.SetLevel(logrus.InfoLevel)
-	case "warn":
-		logrus.SetLevel(logrus.WarnLevel)
-	case "error":
-		logrus.SetLevel(logrus.ErrorLevel)
-	default:
-		logrus.SetLevel(logrus.InfoLevel)
+	options := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(stream...),
+		grpc.MaxRecvMsgSize(cfg.GRPC.MaxMessageSize),
+		grpc.MaxSendMsgSize(cfg.GRPC.MaxMessageSize),
+	}
+	if tlsConfig != nil {
+		options = append(options, grpc.Creds(credentials.NewTLS(tlsConfig)))
 	}
+
+	server := grpc.NewServer(options...)
+	vaultv1.RegisterVaultServiceServer(server, grpcapi.NewServer(storageManager, zeroGClient, metadataStore, filePipeline, jobQueue, policyEngine, quotaTracker, grpcapi.Config{
+		MaxFileSize: cfg.Storage.MaxFileSize,
+	}, logrus.StandardLogger()))
+	return server
 }
 
-func setupServer(cfg *config.Config, storageManager *storage.StorageManager, zeroGClient *zerog.ZeroGClient) *http.Server {
+func setupServer(cfg *config.Config, storageManager *storage.StorageManager, zeroGClient *zerog.ZeroGClient, metadataStore *metadata.Store, eventIndexer *indexer.Indexer, contractClient *contracts.ContractClient, anchorer *anchor.Anchorer, jobQueue *jobs.Queue, filePipeline *pipeline.Pipeline, webhooks *webhook.Dispatcher, authenticator *auth.Authenticator, apiKeys *apikey.Store, policyEngine *policy.Engine, quotaTracker *quota.Tracker, prober *health.Prober) *http.Server {
 	if cfg.Logging.Level == "debug" {
 		gin.SetMode(gin.DebugMode)
 	} else {
@@ -150,35 +520,148 @@ func setupServer(cfg *config.Config, storageManager *storage.StorageManager, zer
 	}
 
 	router := gin.New()
+	if err := router.SetTrustedProxies(cfg.Security.TrustedProxies); err != nil {
+		logrus.Warnf("Invalid trusted proxies, trusting none: %v", err)
+		router.SetTrustedProxies(nil)
+	}
 
 	// Middleware
-	router.Use(middleware.Logger())
+	router.Use(middleware.RequestID())
+	router.Use(middleware.DebugErrors(cfg.Server.DebugErrors))
+	router.Use(middleware.Logger(logrus.StandardLogger()))
 	router.Use(middleware.Recovery())
-	router.Use(middleware.CORS())
-	router.Use(middleware.RateLimit(cfg.Security.RateLimit, cfg.Security.RateLimitWindow))
+	if cfg.Metrics.Enabled {
+		router.Use(metrics.Middleware())
+	}
+	if cfg.Tracing.Enabled {
+		router.Use(tracing.Middleware())
+	}
+	router.Use(middleware.AllowedHosts(cfg.Security.AllowedHosts))
+	if cfg.Security.EnableTLS {
+		router.Use(middleware.SecurityHeaders())
+	}
+	router.Use(middleware.CORS(middleware.CORSPolicy{
+		AllowedOrigins:   cfg.Security.CORS.AllowedOrigins,
+		AllowedMethods:   cfg.Security.CORS.AllowedMethods,
+		AllowedHeaders:   cfg.Security.CORS.AllowedHeaders,
+		ExposedHeaders:   cfg.Security.CORS.ExposedHeaders,
+		MaxAge:           cfg.Security.CORS.MaxAge,
+		AllowCredentials: cfg.Security.CORS.AllowCredentials,
+	}))
+
+	// Rate limits count per client, so they run once the caller is known.
+	// Uploads and metadata reads have budgets of their own.
+	limit := middleware.RateLimit(middleware.NewRateLimiter(cfg.Security.RateLimit, cfg.Security.RateLimitWindow))
+	uploadLimit := middleware.RateLimit(middleware.NewRateLimiter(cfg.Security.UploadRateLimit, cfg.Security.UploadRateLimitWindow))
+	readLimit := middleware.RateLimit(middleware.NewRateLimiter(cfg.Security.ReadRateLimit, cfg.Security.ReadRateLimitWindow))
 
-	// Health check
+	// Health checks
 	router.GET("/health", handlers.HealthCheck)
+	router.GET("/livez", handlers.Livez)
+	router.GET("/readyz", handlers.Readyz(prober))
+	if cfg.Metrics.Enabled {
+		scrape := []gin.HandlerFunc{gin.WrapH(metrics.Handler())}
+		if authenticator != nil {
+			scrape = append([]gin.HandlerFunc{middleware.Authenticate(authenticator, apiKeys), middleware.RequireScope(apikey.ScopeAdmin, apikey.ScopeAdmin)}, scrape...)
+		}
+		router.GET(cfg.Metrics.Path, scrape...)
+	}
 
-	// API routes
+	// API routes, described by an OpenAPI document
+	router.GET("/api/v1/openapi.json", handlers.OpenAPI(router.Routes, authenticator != nil))
 	api := router.Group("/api/v1")
+	if authenticator != nil {
+		signIn := api.Group("/auth", limit)
+		{
+			signIn.GET("/nonce", handlers.GetNonce(authenticator))
+			signIn.POST("/verify", handlers.SignIn(authenticator))
+		}
+
+		// Everything else requires a session or an API key
+		api = api.Group("", middleware.Authenticate(authenticator, apiKeys))
+		api.GET("/auth/session", limit, handlers.GetSession)
+	}
 	{
 		// File operations
-		files := api.Group("/files")
+		files := api.Group("/files", middleware.RequireScope(apikey.ScopeFilesRead, apikey.ScopeFilesWrite))
+		{
+			files.GET("", readLimit, handlers.ListFiles(metadataStore))
+			files.POST("/upload", uploadLimit, middleware.RequestSizeLimit(cfg.Storage.MaxFileSize), handlers.UploadFile(filePipeline, jobQueue, quotaTracker))
+			files.POST("/uploads", uploadLimit, handlers.CreateUpload(filePipeline, quotaTracker, cfg.Storage.MaxFileSize))
+			files.GET("/uploads/:id", readLimit, handlers.GetUpload(filePipeline))
+			files.PATCH("/uploads/:id", limit, middleware.RequestSizeLimit(cfg.Storage.MaxFileSize), handlers.AppendUpload(filePipeline, jobQueue, quotaTracker))
+			files.DELETE("/uploads/:id", limit, handlers.CancelUpload(filePipeline))
+			files.GET("/download/:hash", limit, handlers.DownloadFile(storageManager, zeroGClient, policyEngine))
+			files.GET("/:hash/content", limit, handlers.DownloadContent(metadataStore, filePipeline, policyEngine))
+			files.GET("/metadata/:hash", readLimit, handlers.GetFileMetadata(metadataStore, policyEngine))
+			files.GET("/proof/:hash", limit, handlers.GetProof(zeroGClient, policyEngine))
+			if anchorer != nil {
+				files.POST("/anchor/:hash", limit, handlers.RetryAnchor(anchorer, policyEngine))
+			}
+			if contractClient != nil {
+				files.POST("/:hash/anchor/estimate", limit, handlers.EstimateAnchor(contractClient, metadataStore, policyEngine))
+				files.DELETE("/:hash", limit, handlers.DeleteFile(contractClient, policyEngine, webhooks))
+				files.POST("/:hash/verify", limit, handlers.VerifyFileProof(contractClient, policyEngine))
+				files.GET("/:hash/access", readLimit, handlers.GetFileAccess(contractClient, policyEngine))
+				files.POST("/:hash/access", limit, handlers.GrantFileAccess(contractClient, policyEngine, webhooks))
+				files.DELETE("/:hash/access/:address", limit, handlers.RevokeFileAccess(contractClient, policyEngine))
+			}
+		}
+
+		// Background jobs
+		jobRoutes := api.Group("/jobs", middleware.RequireScope(apikey.ScopeFilesRead, apikey.ScopeFilesWrite))
+		{
+			jobRoutes.GET("", readLimit, handlers.ListJobs(jobQueue))
+			jobRoutes.POST("", limit, handlers.CreateJob(filePipeline, jobQueue, policyEngine))
+			jobRoutes.GET("/:id", readLimit, handlers.GetJob(jobQueue))
+			jobRoutes.GET("/:id/events", readLimit, handlers.JobEvents(jobQueue))
+		}
+
+		// Webhook subscriptions and their delivery log
+		webhookRoutes := api.Group("/webhooks", middleware.RequireScope(apikey.ScopeAdmin, apikey.ScopeAdmin), limit)
 		{
-			files.POST("/upload", handlers.UploadFile(storageManager, zeroGClient))
-			files.GET("/download/:hash", handlers.DownloadFile(storageManager, zeroGClient))
-			files.GET("/metadata/:hash", handlers.GetFileMetadata(storageManager))
-			files.GET("/proof/:hash", handlers.GetProof(zeroGClient))
+			webhookRoutes.GET("", handlers.ListWebhooks(metadataStore))
+			webhookRoutes.POST("", handlers.CreateWebhook(webhooks))
+			webhookRoutes.GET("/dead-letters", handlers.ListDeadLetters(metadataStore))
+			webhookRoutes.GET("/:id", handlers.GetWebhook(metadataStore))
+			webhookRoutes.DELETE("/:id", handlers.DeleteWebhook(metadataStore))
+			webhookRoutes.GET("/:id/deliveries", handlers.ListWebhookDeliveries(metadataStore))
+			webhookRoutes.POST("/:id/deliveries/:delivery/redeliver", handlers.RedeliverWebhook(webhooks, metadataStore))
+		}
+
+		// On-chain users and vault statistics
+		if contractClient != nil {
+			users := api.Group("/users", middleware.RequireScope(apikey.ScopeFilesRead, apikey.ScopeAdmin), limit)
+			{
+				users.POST("", handlers.RegisterUser(contractClient))
+				users.GET("/:address", handlers.GetUserProfile(contractClient))
+				if quotaTracker != nil {
+					users.GET("/:address/quota", handlers.GetStorageQuota(quotaTracker))
+				}
+			}
+
+			api.GET("/stats", middleware.RequireScope(apikey.ScopeFilesRead, apikey.ScopeAdmin), limit, handlers.GetSystemStats(contractClient))
 		}
 
-		// Storage operations
-		storage := api.Group("/storage")
+		// Storage operations; these read and write server-side paths
+		storage := api.Group("/storage", middleware.RequireScope(apikey.ScopeFilesRead, apikey.ScopeAdmin), limit)
 		{
 			storage.POST("/chunk", handlers.ChunkFile(storageManager))
 			storage.POST("/reconstruct", handlers.ReconstructFile(storageManager))
 			storage.GET("/verify/:hash", handlers.VerifyFileIntegrity(storageManager))
 		}
+
+		// Indexed chain state
+		chain := api.Group("/chain", middleware.RequireScope(apikey.ScopeFilesRead, apikey.ScopeFilesRead), readLimit)
+		{
+			chain.GET("/files", handlers.ListChainFiles(metadataStore))
+			chain.GET("/files/:hash", handlers.GetChainFile(metadataStore))
+			chain.GET("/files/:hash/events", handlers.GetFileEvents(metadataStore))
+			chain.GET("/users/:address/events", handlers.GetUserEvents(metadataStore))
+			if eventIndexer != nil {
+				chain.GET("/status", handlers.GetIndexerStatus(eventIndexer))
+			}
+		}
 	}
 
 	return &http.Server{
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"nebularvault-agent/config"
+	"nebularvault-agent/internal/anchor"
+	"nebularvault-agent/internal/apikey"
+	"nebularvault-agent/internal/auth"
+	"nebularvault-agent/internal/contracts"
+	"nebularvault-agent/internal/handlers"
+	"nebularvault-agent/internal/health"
+	"nebularvault-agent/internal/indexer"
+	"nebularvault-agent/internal/jobs"
+	"nebularvault-agent/internal/metadata"
+	"nebularvault-agent/internal/openapi"
+	"nebularvault-agent/internal/pipeline"
+	"nebularvault-agent/internal/policy"
+	"nebularvault-agent/internal/quota"
+	"nebularvault-agent/internal/storage"
+	"nebularvault-agent/internal/webhook"
+	"nebularvault-agent/internal/zerog"
+)
+
+// TestSetupServer_MatchesOpenAPI fails when a route is served without being
+// documented, or documented without being served
+func TestSetupServer_MatchesOpenAPI(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "config.yaml"), []byte("auth:\n  domain: vault.example\n"), 0600); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	cfg, err := config.LoadConfig(dir)
+	if err != nil {
+		t.Fatalf("Failed to load config: %v", err)
+	}
+	cfg.Metrics.Enabled = true
+	cfg.Metrics.Path = "/metrics"
+
+	authenticator, err := auth.NewAuthenticator(auth.Config{Domain: "vault.example", ChainID: 1})
+	if err != nil {
+		t.Fatalf("Failed to create authenticator: %v", err)
+	}
+	keys, err := apikey.Open(filepath.Join(t.TempDir(), "api-keys.json"))
+	if err != nil {
+		t.Fatalf("Failed to open key store: %v", err)
+	}
+
+	// Every optional feature is enabled so every route is registered;
+	// handlers are built but never run
+	srv := setupServer(cfg, &storage.StorageManager{}, &zerog.ZeroGClient{}, &metadata.Store{}, &indexer.Indexer{},
+		&contracts.ContractClient{}, &anchor.Anchorer{}, &jobs.Queue{}, &pipeline.Pipeline{}, &webhook.Dispatcher{},
+		authenticator, keys, &policy.Engine{}, &quota.Tracker{}, &health.Prober{})
+	router := srv.Handler.(*gin.Engine)
+
+	documented := map[string]bool{}
+	for _, route := range handlers.Routes {
+		documented[route.Method+" "+route.Path] = true
+	}
+	served := map[string]bool{}
+	for _, route := range router.Routes() {
+		key := route.Method + " " + route.Path
+		served[key] = true
+		if !documented[key] {
+			t.Errorf("%s is served but missing from handlers.Routes", key)
+		}
+	}
+	for key := range documented {
+		if !served[key] {
+			t.Errorf("%s is in handlers.Routes but not served", key)
+		}
+	}
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("Expected 401 for metrics without credentials, got %d", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/openapi.json", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected 200 for the OpenAPI document, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var doc openapi.Document
+	if err := json.Unmarshal(rec.Body.Bytes(), &doc); err != nil {
+		t.Fatalf("Failed to decode OpenAPI document: %v", err)
+	}
+	if doc.OpenAPI != openapi.Version {
+		t.Errorf("Expected OpenAPI %s, got %q", openapi.Version, doc.OpenAPI)
+	}
+
+	operations := 0
+	for _, item := range doc.Paths {
+		operations += len(item)
+	}
+	if operations != len(router.Routes()) {
+		t.Errorf("Expected %d documented operations, got %d", len(router.Routes()), operations)
+	}
+
+	// Sign-in and the document itself are the only public API routes
+	public := map[string]bool{
+		"GET /api/v1/openapi.json": true,
+		"GET /api/v1/auth/nonce":   true,
+		"POST /api/v1/auth/verify": true,
+	}
+	for _, route := range router.Routes() {
+		op := doc.Paths[openapi.Path(route.Path)][strings.ToLower(route.Method)]
+		if op == nil {
+			t.Errorf("%s %s is missing from the served document", route.Method, route.Path)
+			continue
+		}
+		key := route.Method + " " + route.Path
+		if strings.HasPrefix(route.Path, "/api/v1/") && (op.Security == nil) != public[key] {
+			t.Errorf("%s has the wrong security requirements", key)
+		}
+	}
+}
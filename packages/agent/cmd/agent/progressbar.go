@@ -0,0 +1,97 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"nebularvault-agent/internal/progress"
+)
+
+const barWidth = 30
+
+// progressBar draws a file command's progress on one line of stderr,
+// redrawn in place. It draws nothing when stderr is not a terminal.
+type progressBar struct {
+	mu    sync.Mutex
+	out   io.Writer
+	drawn bool
+}
+
+func newProgressBar(enabled bool) *progressBar {
+	bar := &progressBar{}
+	if enabled && isTerminal(os.Stderr) {
+		bar.out = os.Stderr
+	}
+	return bar
+}
+
+// Set shows done of total under label, followed by detail
+func (b *progressBar) Set(label string, done, total int64, detail string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.out == nil {
+		return
+	}
+
+	percent := progress.Percent(done, total)
+	filled := min(percent, 100) * barWidth / 100
+	fmt.Fprintf(b.out, "\r%-10s [%s%s] %3d%%  %s\033[K",
+		label, strings.Repeat("=", filled), strings.Repeat(" ", barWidth-filled), percent, detail)
+	b.drawn = true
+}
+
+// Report draws the events of the pipeline's stages
+func (b *progressBar) Report(event progress.Event) {
+	count := func(key string) int64 {
+		switch v := event.Data[key].(type) {
+		case int:
+			return int64(v)
+		case int64:
+			return v
+		}
+		return 0
+	}
+
+	switch event.Stage {
+	case progress.Chunking:
+		b.Set("chunking", count("bytes"), count("total_bytes"), formatSize(count("bytes")))
+	case progress.ChunkUploaded:
+		b.Set("uploading", count("bytes"), count("total_bytes"),
+			fmt.Sprintf("%d/%d chunks", count("uploaded"), count("chunks")))
+	case progress.ChunkVerified:
+		b.Set("verifying", count("checked"), count("chunks"),
+			fmt.Sprintf("%d/%d chunks, %d missing", count("checked"), count("chunks"), count("missing")))
+	}
+}
+
+// Done ends the bar's line so output can follow it
+func (b *progressBar) Done() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.drawn {
+		fmt.Fprintln(b.out)
+		b.drawn = false
+	}
+}
+
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// formatSize renders a byte count in binary units
+func formatSize(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/spf13/viper"
@@ -11,10 +12,19 @@ import (
 
 type Config struct {
 	Server   ServerConfig   `mapstructure:"server"`
+	GRPC     GRPCConfig     `mapstructure:"grpc"`
 	Storage  StorageConfig  `mapstructure:"storage"`
 	Logging  LoggingConfig  `mapstructure:"logging"`
 	Network  NetworkConfig  `mapstructure:"network"`
 	Security SecurityConfig `mapstructure:"security"`
+	Indexer  IndexerConfig  `mapstructure:"indexer"`
+	Chain    ChainConfig    `mapstructure:"chain"`
+	Auth     AuthConfig     `mapstructure:"auth"`
+	Metrics  MetricsConfig  `mapstructure:"metrics"`
+	Tracing  TracingConfig  `mapstructure:"tracing"`
+	Health   HealthConfig   `mapstructure:"health"`
+	Jobs     JobsConfig     `mapstructure:"jobs"`
+	Webhooks WebhooksConfig `mapstructure:"webhooks"`
 }
 
 type ServerConfig struct {
@@ -23,6 +33,15 @@ type ServerConfig struct {
 	ReadTimeout  time.Duration `mapstructure:"read_timeout"`
 	WriteTimeout time.Duration `mapstructure:"write_timeout"`
 	IdleTimeout  time.Duration `mapstructure:"idle_timeout"`
+	// DebugErrors adds the underlying cause to error responses
+	DebugErrors bool `mapstructure:"debug_errors"`
+}
+
+type GRPCConfig struct {
+	Enabled        bool   `mapstructure:"enabled"`
+	Host           string `mapstructure:"host"`
+	Port           int    `mapstructure:"port"`
+	MaxMessageSize int    `mapstructure:"max_message_size"`
 }
 
 type StorageConfig struct {
@@ -31,12 +50,17 @@ type StorageConfig struct {
 	ChunkSize      int    `mapstructure:"chunk_size"`
 	TempDir        string `mapstructure:"temp_dir"`
 	CleanupPeriod  time.Duration `mapstructure:"cleanup_period"`
+	QuotaCacheTTL  time.Duration `mapstructure:"quota_cache_ttl"`
 }
 
 type LoggingConfig struct {
-	Level  string `mapstructure:"level"`
-	Format string `mapstructure:"format"`
-	Output string `mapstructure:"output"`
+	Level      string `mapstructure:"level"`
+	Format     string `mapstructure:"format"`
+	Output     string `mapstructure:"output"`
+	MaxSizeMB  int    `mapstructure:"max_size_mb"`
+	MaxBackups int    `mapstructure:"max_backups"`
+	MaxAgeDays int    `mapstructure:"max_age_days"`
+	Compress   bool   `mapstructure:"compress"`
 }
 
 type NetworkConfig struct {
@@ -59,6 +83,95 @@ type SecurityConfig struct {
 	AllowedHosts  []string `mapstructure:"allowed_hosts"`
 	RateLimit     int    `mapstructure:"rate_limit"`
 	RateLimitWindow time.Duration `mapstructure:"rate_limit_window"`
+	UploadRateLimit       int           `mapstructure:"upload_rate_limit"`
+	UploadRateLimitWindow time.Duration `mapstructure:"upload_rate_limit_window"`
+	ReadRateLimit         int           `mapstructure:"read_rate_limit"`
+	ReadRateLimitWindow   time.Duration `mapstructure:"read_rate_limit_window"`
+	TrustedProxies        []string      `mapstructure:"trusted_proxies"`
+	ClientAuth            string        `mapstructure:"client_auth"`
+	ClientCAFile          string        `mapstructure:"client_ca_file"`
+	CORS                  CORSConfig    `mapstructure:"cors"`
+}
+
+type CORSConfig struct {
+	AllowedOrigins   []string      `mapstructure:"allowed_origins"`
+	AllowedMethods   []string      `mapstructure:"allowed_methods"`
+	AllowedHeaders   []string      `mapstructure:"allowed_headers"`
+	ExposedHeaders   []string      `mapstructure:"exposed_headers"`
+	MaxAge           time.Duration `mapstructure:"max_age"`
+	AllowCredentials bool          `mapstructure:"allow_credentials"`
+}
+
+type MetricsConfig struct {
+	Enabled bool   `mapstructure:"enabled"`
+	Path    string `mapstructure:"path"`
+}
+
+type TracingConfig struct {
+	Enabled     bool              `mapstructure:"enabled"`
+	ServiceName string            `mapstructure:"service_name"`
+	Exporter    string            `mapstructure:"exporter"`
+	Endpoint    string            `mapstructure:"endpoint"`
+	Headers     map[string]string `mapstructure:"headers"`
+	FilePath    string            `mapstructure:"file_path"`
+	SampleRatio float64           `mapstructure:"sample_ratio"`
+}
+
+type HealthConfig struct {
+	CheckTimeout  time.Duration `mapstructure:"check_timeout"`
+	MinFreeDiskMB uint64        `mapstructure:"min_free_disk_mb"`
+}
+
+type JobsConfig struct {
+	Workers          int           `mapstructure:"workers"`
+	MaxAttempts      int           `mapstructure:"max_attempts"`
+	Backoff          time.Duration `mapstructure:"backoff"`
+	Retention        time.Duration `mapstructure:"retention"`
+	SpoolDir         string        `mapstructure:"spool_dir"`
+	KeepChunks       bool          `mapstructure:"keep_chunks"`
+	UploadSessionTTL time.Duration `mapstructure:"upload_session_ttl"`
+}
+
+type WebhooksConfig struct {
+	Workers     int           `mapstructure:"workers"`
+	MaxAttempts int           `mapstructure:"max_attempts"`
+	Backoff     time.Duration `mapstructure:"backoff"`
+	MaxBackoff  time.Duration `mapstructure:"max_backoff"`
+	Timeout     time.Duration `mapstructure:"timeout"`
+	Retention   time.Duration `mapstructure:"retention"`
+}
+
+type IndexerConfig struct {
+	Enabled       bool          `mapstructure:"enabled"`
+	StartBlock    uint64        `mapstructure:"start_block"`
+	BatchSize     uint64        `mapstructure:"batch_size"`
+	PollInterval  time.Duration `mapstructure:"poll_interval"`
+	Confirmations uint64        `mapstructure:"confirmations"`
+	ReorgDepth    uint64        `mapstructure:"reorg_depth"`
+}
+
+type ChainConfig struct {
+	Enabled            bool          `mapstructure:"enabled"`
+	Confirmations      uint64        `mapstructure:"confirmations"`
+	PollInterval       time.Duration `mapstructure:"poll_interval"`
+	StuckTimeout       time.Duration `mapstructure:"stuck_timeout"`
+	GasBumpPercent     int64         `mapstructure:"gas_bump_percent"`
+	MaxGasBumps        int           `mapstructure:"max_gas_bumps"`
+	FeeStrategy        string        `mapstructure:"fee_strategy"`
+	MaxFeePerGasGwei   int64         `mapstructure:"max_fee_per_gas_gwei"`
+	GasLimitMultiplier float64       `mapstructure:"gas_limit_multiplier"`
+	AnchorWorkers      int           `mapstructure:"anchor_workers"`
+	AnchorTimeout      time.Duration `mapstructure:"anchor_timeout"`
+}
+
+type AuthConfig struct {
+	Enabled        bool          `mapstructure:"enabled"`
+	Domain         string        `mapstructure:"domain"`
+	NonceTTL       time.Duration `mapstructure:"nonce_ttl"`
+	SessionTTL     time.Duration `mapstructure:"session_ttl"`
+	SessionSecret  string        `mapstructure:"session_secret"`
+	PolicyCacheTTL time.Duration `mapstructure:"policy_cache_ttl"`
+	ReadPermission string        `mapstructure:"read_permission"`
 }
 
 var AppConfig *Config
@@ -111,6 +224,13 @@ func setDefaults() {
 	viper.SetDefault("server.read_timeout", "30s")
 	viper.SetDefault("server.write_timeout", "30s")
 	viper.SetDefault("server.idle_timeout", "120s")
+	viper.SetDefault("server.debug_errors", false)
+	
+	// gRPC defaults
+	viper.SetDefault("grpc.enabled", false)
+	viper.SetDefault("grpc.host", "0.0.0.0")
+	viper.SetDefault("grpc.port", 9090)
+	viper.SetDefault("grpc.max_message_size", 4194304) // 4MB
 	
 	// Storage defaults
 	viper.SetDefault("storage.data_dir", "./data")
@@ -118,11 +238,16 @@ func setDefaults() {
 	viper.SetDefault("storage.chunk_size", 1024) // 1KB chunks
 	viper.SetDefault("storage.temp_dir", "./temp")
 	viper.SetDefault("storage.cleanup_period", "1h")
+	viper.SetDefault("storage.quota_cache_ttl", "30s")
 	
 	// Logging defaults
 	viper.SetDefault("logging.level", "info")
 	viper.SetDefault("logging.format", "json")
 	viper.SetDefault("logging.output", "stdout")
+	viper.SetDefault("logging.max_size_mb", 100)
+	viper.SetDefault("logging.max_backups", 5)
+	viper.SetDefault("logging.max_age_days", 28)
+	viper.SetDefault("logging.compress", true)
 	
 	// Network defaults
 	viper.SetDefault("network.indexer_endpoint", "https://indexer.0g.ai")
@@ -138,8 +263,83 @@ func setDefaults() {
 	
 	// Security defaults
 	viper.SetDefault("security.enable_tls", false)
+	viper.SetDefault("security.client_auth", "none")
 	viper.SetDefault("security.rate_limit", 100)
 	viper.SetDefault("security.rate_limit_window", "15m")
+	viper.SetDefault("security.upload_rate_limit", 20)
+	viper.SetDefault("security.upload_rate_limit_window", "1m")
+	viper.SetDefault("security.read_rate_limit", 300)
+	viper.SetDefault("security.read_rate_limit_window", "1m")
+	viper.SetDefault("security.trusted_proxies", []string{})
+	viper.SetDefault("security.cors.allowed_origins", []string{"http://localhost:3000"}) // web UI dev server
+	viper.SetDefault("security.cors.allowed_methods", []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"})
+	viper.SetDefault("security.cors.allowed_headers", []string{"Authorization", "Content-Type", "X-API-Key", "Range", "Upload-Offset"})
+	viper.SetDefault("security.cors.exposed_headers", []string{"Content-Disposition", "Content-Range", "Upload-Offset", "Location", "RateLimit-Policy", "RateLimit-Limit", "RateLimit-Remaining", "RateLimit-Reset", "Retry-After"})
+	viper.SetDefault("security.cors.max_age", "10m")
+	viper.SetDefault("security.cors.allow_credentials", false)
+	
+	// Indexer defaults
+	viper.SetDefault("indexer.enabled", false)
+	viper.SetDefault("indexer.start_block", 0)
+	viper.SetDefault("indexer.batch_size", 2000)
+	viper.SetDefault("indexer.poll_interval", "5s")
+	viper.SetDefault("indexer.confirmations", 0)
+	viper.SetDefault("indexer.reorg_depth", 128)
+	
+	// Chain defaults
+	viper.SetDefault("chain.enabled", false)
+	viper.SetDefault("chain.confirmations", 1)
+	viper.SetDefault("chain.poll_interval", "2s")
+	viper.SetDefault("chain.stuck_timeout", "2m")
+	viper.SetDefault("chain.gas_bump_percent", 10)
+	viper.SetDefault("chain.max_gas_bumps", 5)
+	viper.SetDefault("chain.fee_strategy", "standard")
+	viper.SetDefault("chain.max_fee_per_gas_gwei", 0)
+	viper.SetDefault("chain.gas_limit_multiplier", 1.2)
+	viper.SetDefault("chain.anchor_workers", 1)
+	viper.SetDefault("chain.anchor_timeout", "10m")
+	
+	// Auth defaults
+	viper.SetDefault("auth.enabled", true)
+	viper.SetDefault("auth.domain", "")
+	viper.SetDefault("auth.nonce_ttl", "5m")
+	viper.SetDefault("auth.session_ttl", "1h")
+	viper.SetDefault("auth.session_secret", "")
+	viper.SetDefault("auth.policy_cache_ttl", "1m")
+	viper.SetDefault("auth.read_permission", "READ_ANY_FILE")
+	
+	// Metrics defaults
+	viper.SetDefault("metrics.enabled", false)
+	viper.SetDefault("metrics.path", "/metrics")
+	
+	// Tracing defaults
+	viper.SetDefault("tracing.enabled", false)
+	viper.SetDefault("tracing.service_name", "nebularvault-agent")
+	viper.SetDefault("tracing.exporter", "otlp")
+	viper.SetDefault("tracing.endpoint", "")
+	viper.SetDefault("tracing.file_path", "./data/traces.jsonl")
+	viper.SetDefault("tracing.sample_ratio", 1.0)
+	
+	// Health defaults
+	viper.SetDefault("health.check_timeout", "5s")
+	viper.SetDefault("health.min_free_disk_mb", 512)
+	
+	// Jobs defaults
+	viper.SetDefault("jobs.workers", 2)
+	viper.SetDefault("jobs.max_attempts", 5)
+	viper.SetDefault("jobs.backoff", "5s")
+	viper.SetDefault("jobs.retention", "168h")
+	viper.SetDefault("jobs.spool_dir", "./data/spool")
+	viper.SetDefault("jobs.keep_chunks", true)
+	viper.SetDefault("jobs.upload_session_ttl", "24h")
+	
+	// Webhooks defaults
+	viper.SetDefault("webhooks.workers", 2)
+	viper.SetDefault("webhooks.max_attempts", 8)
+	viper.SetDefault("webhooks.backoff", "10s")
+	viper.SetDefault("webhooks.max_backoff", "1h")
+	viper.SetDefault("webhooks.timeout", "10s")
+	viper.SetDefault("webhooks.retention", "168h")
 }
 
 func validateConfig(config *Config) error {
@@ -148,6 +348,10 @@ func validateConfig(config *Config) error {
 		return fmt.Errorf("invalid server port: %d", config.Server.Port)
 	}
 	
+	if config.GRPC.Enabled && (config.GRPC.Port <= 0 || config.GRPC.Port > 65535 || config.GRPC.Port == config.Server.Port) {
+		return fmt.Errorf("invalid grpc port: %d", config.GRPC.Port)
+	}
+	
 	// Validate storage config
 	if config.Storage.MaxFileSize <= 0 {
 		return fmt.Errorf("invalid max file size: %d", config.Storage.MaxFileSize)
@@ -157,6 +361,87 @@ func validateConfig(config *Config) error {
 		return fmt.Errorf("invalid chunk size: %d", config.Storage.ChunkSize)
 	}
 	
+	switch config.Logging.Format {
+	case "json", "text":
+	default:
+		return fmt.Errorf("invalid log format: %s", config.Logging.Format)
+	}
+	
+	if config.Security.EnableTLS {
+		if config.Security.CertFile == "" || config.Security.KeyFile == "" {
+			return fmt.Errorf("TLS requires security.cert_file and security.key_file")
+		}
+		switch config.Security.ClientAuth {
+		case "", "none":
+		case "optional", "require":
+			if config.Security.ClientCAFile == "" {
+				return fmt.Errorf("client_auth %q requires security.client_ca_file", config.Security.ClientAuth)
+			}
+		default:
+			return fmt.Errorf("invalid client_auth: %s", config.Security.ClientAuth)
+		}
+	}
+	
+	for _, origin := range config.Security.CORS.AllowedOrigins {
+		if origin == "*" && config.Security.CORS.AllowCredentials {
+			return fmt.Errorf("security.cors.allow_credentials cannot be used with the \"*\" origin")
+		}
+		if strings.Count(origin, "*") > 1 || (origin != "*" && strings.Contains(origin, "*") && !strings.Contains(origin, "://*.")) {
+			return fmt.Errorf("invalid CORS origin pattern: %s", origin)
+		}
+	}
+	
+	if config.Tracing.Enabled {
+		switch config.Tracing.Exporter {
+		case "otlp", "stdout":
+		case "file":
+			if config.Tracing.FilePath == "" {
+				return fmt.Errorf("tracing exporter \"file\" requires tracing.file_path")
+			}
+		default:
+			return fmt.Errorf("invalid tracing exporter: %s", config.Tracing.Exporter)
+		}
+		if config.Tracing.SampleRatio < 0 || config.Tracing.SampleRatio > 1 {
+			return fmt.Errorf("invalid tracing sample ratio: %v", config.Tracing.SampleRatio)
+		}
+	}
+	
+	if config.Health.CheckTimeout <= 0 {
+		return fmt.Errorf("invalid health check timeout: %v", config.Health.CheckTimeout)
+	}
+	
+	if config.Auth.Enabled && config.Auth.Domain == "" {
+		return fmt.Errorf("auth.domain is required when auth is enabled")
+	}
+	
+	if config.Jobs.Workers < 1 {
+		return fmt.Errorf("invalid job workers: %d", config.Jobs.Workers)
+	}
+	
+	if config.Jobs.MaxAttempts < 1 {
+		return fmt.Errorf("invalid job max attempts: %d", config.Jobs.MaxAttempts)
+	}
+	
+	if config.Jobs.SpoolDir == "" {
+		return fmt.Errorf("jobs.spool_dir is required")
+	}
+	
+	if config.Jobs.UploadSessionTTL <= 0 {
+		return fmt.Errorf("invalid upload session TTL: %v", config.Jobs.UploadSessionTTL)
+	}
+	
+	if config.Webhooks.MaxAttempts < 1 {
+		return fmt.Errorf("invalid webhook max attempts: %d", config.Webhooks.MaxAttempts)
+	}
+	
+	if config.Webhooks.Timeout <= 0 {
+		return fmt.Errorf("invalid webhook timeout: %v", config.Webhooks.Timeout)
+	}
+	
+	if config.Chain.Enabled && config.Network.PrivateKey == "" {
+		return fmt.Errorf("chain integration requires network.private_key")
+	}
+	
 	// Create necessary directories
 	if err := createDirectories(config); err != nil {
 		return fmt.Errorf("failed to create directories: %w", err)
@@ -0,0 +1,292 @@
+package anchor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/sirupsen/logrus"
+
+	"nebularvault-agent/internal/contracts"
+	"nebularvault-agent/internal/jobs"
+	"nebularvault-agent/internal/metadata"
+	"nebularvault-agent/internal/progress"
+)
+
+// ErrAnchored is returned when retrying a file that is already anchored
+var ErrAnchored = errors.New("file is already anchored")
+
+// Chain is the contract access the anchorer needs; *contracts.ContractClient
+// satisfies it
+type Chain interface {
+	UploadFileWithVerification(req *contracts.FileUploadRequest) (*contracts.FileUploadResponse, error)
+	WaitForTransaction(ctx context.Context, txHash string) (*types.Receipt, error)
+}
+
+// JobType is the job queue type anchors run as, keyed by content hash
+const JobType = "anchor"
+
+// Config controls anchoring concurrency, retries and how long to wait for a
+// receipt
+type Config struct {
+	Workers     int
+	Timeout     time.Duration
+	MaxAttempts int
+	Backoff     time.Duration
+	// Confirmations is how many blocks the chain client waits for, reported
+	// with each confirmed anchor
+	Confirmations uint64
+}
+
+// Anchorer registers uploaded files on chain as background jobs and records
+// the outcome on each file's metadata record
+type Anchorer struct {
+	chain  Chain
+	store  *metadata.Store
+	queue  *jobs.Queue
+	config Config
+	logger *logrus.Logger
+}
+
+// NewAnchorer creates an anchorer that writes anchor status into store and
+// registers its jobs with queue
+func NewAnchorer(chain Chain, store *metadata.Store, queue *jobs.Queue, config Config, logger *logrus.Logger) *Anchorer {
+	if config.Timeout <= 0 {
+		config.Timeout = 10 * time.Minute
+	}
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = 5
+	}
+	if config.Backoff <= 0 {
+		config.Backoff = 30 * time.Second
+	}
+
+	a := &Anchorer{
+		chain:  chain,
+		store:  store,
+		queue:  queue,
+		config: config,
+		logger: logger,
+	}
+	queue.Register(JobType, jobs.Policy{
+		Workers:     config.Workers,
+		MaxAttempts: config.MaxAttempts,
+		Backoff:     config.Backoff,
+	}, a.run)
+	return a
+}
+
+// Start queues files left pending without a job, as by an agent that
+// predates the job queue. Files with a job resume with the queue.
+func (a *Anchorer) Start(ctx context.Context) error {
+	pending, err := a.store.ListFiles(func(record *metadata.FileRecord) bool {
+		return record.Anchor != nil && record.Anchor.Status == metadata.AnchorPending
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list pending anchors: %w", err)
+	}
+
+	for _, record := range pending {
+		if _, err := a.Enqueue(record.Hash); err != nil {
+			return err
+		}
+	}
+	if len(pending) > 0 {
+		a.logger.Infof("Resuming %d pending anchors", len(pending))
+	}
+	return nil
+}
+
+// Enqueue schedules a file whose record is pending to be anchored,
+// returning the file's anchor job
+func (a *Anchorer) Enqueue(hash string) (*metadata.Job, error) {
+	return a.queue.Enqueue(JobType, hash, "", hash)
+}
+
+// Retry marks a failed or never-anchored file pending and schedules it
+func (a *Anchorer) Retry(hash string) (*metadata.FileRecord, *metadata.Job, error) {
+	record, err := a.store.UpdateFile(hash, func(record *metadata.FileRecord) {
+		if record.Anchor == nil || record.Anchor.Status == metadata.AnchorFailed {
+			record.Anchor = &metadata.Anchor{Status: metadata.AnchorPending, UpdatedAt: time.Now()}
+		}
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if record.Anchor.Status == metadata.AnchorConfirmed {
+		return record, nil, ErrAnchored
+	}
+
+	job, err := a.Enqueue(record.Hash)
+	if err != nil {
+		return nil, nil, err
+	}
+	return record, job, nil
+}
+
+// run is the anchor job runner. The file stays pending while attempts are
+// retried and is marked failed once the job gives up.
+func (a *Anchorer) run(ctx context.Context, job *metadata.Job) (interface{}, error) {
+	var hash string
+	if err := job.DecodePayload(&hash); err != nil {
+		return nil, jobs.Permanent(err)
+	}
+
+	anchor, err := a.anchor(ctx, hash)
+	if err != nil && ctx.Err() == nil && (jobs.IsPermanent(err) || job.LastAttempt()) {
+		a.fail(hash, err)
+	}
+	return anchor, err
+}
+
+// anchor submits a pending file and waits for its transaction. A file that
+// already has a transaction from an earlier attempt is only waited on.
+func (a *Anchorer) anchor(ctx context.Context, hash string) (*metadata.Anchor, error) {
+	record, err := a.store.GetFile(hash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load file for anchoring: %w", err)
+	}
+	if record.Anchor == nil || record.Anchor.Status != metadata.AnchorPending {
+		return record.Anchor, nil
+	}
+
+	txHash := record.Anchor.TxHash
+	var blockNumber uint64
+	if txHash == "" {
+		req, err := Request(record)
+		if err != nil {
+			return nil, jobs.Permanent(err)
+		}
+
+		// A transaction that was broadcast but not yet seen mined is waited
+		// on below rather than reported as failed
+		resp, err := a.chain.UploadFileWithVerification(req)
+		if reverted(err) {
+			return nil, jobs.Permanent(err)
+		}
+		if err != nil && (resp == nil || resp.TxHash == "") {
+			return nil, err
+		}
+		txHash = resp.TxHash
+		if err == nil {
+			blockNumber = resp.BlockNumber
+		}
+
+		if _, err := a.store.UpdateFile(hash, func(record *metadata.FileRecord) {
+			record.Anchor.TxHash = txHash
+			record.Anchor.UpdatedAt = time.Now()
+		}); err != nil {
+			a.logger.WithError(err).WithField("hash", hash).Error("Failed to record anchor transaction")
+		}
+	}
+	progress.Report(ctx, progress.TxSubmitted, map[string]interface{}{
+		"hash":    hash,
+		"tx_hash": txHash,
+	})
+
+	// The client already waited when it is configured with confirmations
+	if blockNumber == 0 {
+		waitCtx, cancel := context.WithTimeout(ctx, a.config.Timeout)
+		receipt, err := a.chain.WaitForTransaction(waitCtx, txHash)
+		cancel()
+		if err != nil {
+			if reverted(err) {
+				return nil, jobs.Permanent(err)
+			}
+			return nil, err
+		}
+		blockNumber = receipt.BlockNumber.Uint64()
+	}
+
+	record, err = a.store.UpdateFile(hash, func(record *metadata.FileRecord) {
+		record.Anchor.Status = metadata.AnchorConfirmed
+		record.Anchor.BlockNumber = blockNumber
+		record.Anchor.Error = ""
+		record.Anchor.UpdatedAt = time.Now()
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to record anchor confirmation: %w", err)
+	}
+
+	progress.Report(ctx, progress.TxConfirmed, map[string]interface{}{
+		"hash":          hash,
+		"tx_hash":       txHash,
+		"block_number":  blockNumber,
+		"confirmations": a.config.Confirmations,
+	})
+	a.logger.WithFields(logrus.Fields{
+		"hash":   hash,
+		"txHash": txHash,
+		"block":  blockNumber,
+	}).Info("File anchored on chain")
+	return record.Anchor, nil
+}
+
+// reverted reports whether the vault rejected a transaction, which
+// retrying will not change
+func reverted(err error) bool {
+	var revertErr *contracts.RevertError
+	if errors.As(err, &revertErr) {
+		return true
+	}
+	_, ok := contracts.RevertReason(err)
+	return ok
+}
+
+func (a *Anchorer) fail(hash string, err error) {
+	reason := err.Error()
+	var revertErr *contracts.RevertError
+	if errors.As(err, &revertErr) && revertErr.Reason != "" {
+		reason = revertErr.Reason
+	} else if decoded, ok := contracts.RevertReason(err); ok {
+		reason = decoded
+	}
+
+	if _, updateErr := a.store.UpdateFile(hash, func(record *metadata.FileRecord) {
+		if record.Anchor == nil {
+			return
+		}
+		record.Anchor.Status = metadata.AnchorFailed
+		record.Anchor.Error = reason
+		record.Anchor.UpdatedAt = time.Now()
+	}); updateErr != nil {
+		a.logger.WithError(updateErr).WithField("hash", hash).Error("Failed to record anchor failure")
+	}
+
+	a.logger.WithError(err).WithField("hash", hash).Warn("Failed to anchor file on chain")
+}
+
+// Request builds the uploadFileWithVerification call for a file, proving
+// its first chunk
+func Request(record *metadata.FileRecord) (*contracts.FileUploadRequest, error) {
+	proof, err := Proof(record, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build proof: %w", err)
+	}
+
+	req := &contracts.FileUploadRequest{
+		FileHash:   "0x" + record.Hash,
+		Filename:   record.Filename,
+		Size:       uint64(record.Size),
+		MerkleRoot: record.MerkleRoot,
+	}
+	proof.Apply(req)
+	return req, nil
+}
+
+// Proof proves the chunk at index of a file against a keccak256 tree over
+// its chunk hashes, the tree its anchor is verified with
+func Proof(record *metadata.FileRecord, index int) (*contracts.MerkleProof, error) {
+	leaves := make([]common.Hash, 0, len(record.ChunkHashes))
+	for _, chunkHash := range record.ChunkHashes {
+		leaves = append(leaves, common.HexToHash(chunkHash))
+	}
+	if len(leaves) == 0 {
+		// An empty file has no chunks; prove the content hash itself
+		leaves = append(leaves, common.HexToHash(record.Hash))
+	}
+	return contracts.BuildMerkleProof(leaves, index)
+}
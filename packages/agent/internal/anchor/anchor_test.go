@@ -0,0 +1,253 @@
+package anchor
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/hex"
+	"math/big"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/sirupsen/logrus"
+
+	"nebularvault-agent/internal/contracts"
+	"nebularvault-agent/internal/jobs"
+	"nebularvault-agent/internal/metadata"
+)
+
+type testChain struct {
+	backend *simulated.Backend
+	vault   common.Address
+	store   *metadata.Store
+}
+
+func newTestChain(t *testing.T, keys ...*ecdsa.PrivateKey) *testChain {
+	t.Helper()
+
+	alloc := types.GenesisAlloc{}
+	for _, key := range keys {
+		alloc[crypto.PubkeyToAddress(key.PublicKey)] = types.Account{Balance: new(big.Int).Mul(big.NewInt(1000), big.NewInt(1e18))}
+	}
+	backend := simulated.NewBackend(alloc, simulated.WithBlockGasLimit(30_000_000))
+	t.Cleanup(func() { backend.Close() })
+
+	deployer, err := bind.NewKeyedTransactorWithChainID(keys[0], big.NewInt(1337))
+	if err != nil {
+		t.Fatalf("Failed to create deployer: %v", err)
+	}
+	vault, _, _, err := contracts.DeployNebulaVaultContract(deployer, backend.Client())
+	if err != nil {
+		t.Fatalf("Failed to deploy NebulaVault: %v", err)
+	}
+	backend.Commit()
+
+	store, err := metadata.Open(filepath.Join(t.TempDir(), "metadata"))
+	if err != nil {
+		t.Fatalf("Failed to open metadata store: %v", err)
+	}
+	t.Cleanup(func() { store.Close() })
+
+	// Mine continuously so anchors can confirm
+	stop, done := make(chan struct{}), make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(20 * time.Millisecond)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				backend.Commit()
+			}
+		}
+	}()
+	// Cleanups run last-registered first, so mining stops before the
+	// backend closes
+	t.Cleanup(func() {
+		close(stop)
+		<-done
+	})
+
+	return &testChain{backend: backend, vault: vault, store: store}
+}
+
+func (c *testChain) newClient(t *testing.T, key *ecdsa.PrivateKey) *contracts.ContractClient {
+	t.Helper()
+
+	client, err := contracts.NewContractClientWithBackend(&contracts.ContractConfig{
+		ChainID:         1337,
+		ContractAddress: c.vault.Hex(),
+		PrivateKey:      hex.EncodeToString(crypto.FromECDSA(key)),
+		PollInterval:    10 * time.Millisecond,
+		Timeout:         10 * time.Second,
+	}, c.backend.Client(), logrus.New())
+	if err != nil {
+		t.Fatalf("Failed to create contract client: %v", err)
+	}
+	return client
+}
+
+func (c *testChain) putPending(t *testing.T, hash, filename string) {
+	t.Helper()
+
+	if err := c.store.PutFile(&metadata.FileRecord{
+		ID:          filename,
+		Filename:    filename,
+		Size:        2048,
+		Hash:        hash,
+		MerkleRoot:  strings.Repeat("cd", 32),
+		ChunkHashes: []string{strings.Repeat("01", 32), strings.Repeat("02", 32), strings.Repeat("03", 32)},
+		Anchor:      &metadata.Anchor{Status: metadata.AnchorPending, UpdatedAt: time.Now()},
+	}); err != nil {
+		t.Fatalf("Failed to store file record: %v", err)
+	}
+}
+
+// startAnchorer starts an anchorer and the job queue it runs on
+func (c *testChain) startAnchorer(t *testing.T, client *contracts.ContractClient) *Anchorer {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	queue := jobs.NewQueue(c.store, jobs.Config{}, logrus.New())
+	a := NewAnchorer(client, c.store, queue, Config{Backoff: 10 * time.Millisecond}, logrus.New())
+	if err := queue.Start(ctx); err != nil {
+		t.Fatalf("Failed to start job queue: %v", err)
+	}
+	if err := a.Start(ctx); err != nil {
+		t.Fatalf("Failed to start anchorer: %v", err)
+	}
+	t.Cleanup(func() {
+		cancel()
+		queue.Wait()
+	})
+	return a
+}
+
+// waitSettled polls the store until a file's anchor leaves the pending state
+func (c *testChain) waitSettled(t *testing.T, hash string) *metadata.Anchor {
+	t.Helper()
+
+	deadline := time.Now().Add(10 * time.Second)
+	for time.Now().Before(deadline) {
+		record, err := c.store.GetFile(hash)
+		if err != nil {
+			t.Fatalf("Failed to get file record: %v", err)
+		}
+		if record.Anchor.Status != metadata.AnchorPending {
+			return record.Anchor
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	t.Fatalf("Timed out waiting for %s to be anchored", hash)
+	return nil
+}
+
+func TestAnchorer_AnchorsPendingFiles(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	c := newTestChain(t, key)
+
+	client := c.newClient(t, key)
+	resp, err := client.RegisterUser("alice")
+	if err != nil {
+		t.Fatalf("Failed to register user: %v", err)
+	}
+	if _, err := client.WaitForTransaction(context.Background(), resp.TxHash); err != nil {
+		t.Fatalf("Failed to wait for registration: %v", err)
+	}
+
+	// Left pending by a previous run, so Start must pick it up
+	hash := strings.Repeat("aa", 32)
+	c.putPending(t, hash, "report.pdf")
+
+	a := c.startAnchorer(t, client)
+
+	anchor := c.waitSettled(t, hash)
+	if anchor.Status != metadata.AnchorConfirmed || anchor.TxHash == "" || anchor.BlockNumber == 0 {
+		t.Fatalf("Expected a confirmed anchor, got %+v", anchor)
+	}
+
+	onChain, err := client.GetFileMetadata("0x" + hash)
+	if err != nil {
+		t.Fatalf("Failed to read anchored file: %v", err)
+	}
+	if onChain["filename"] != "report.pdf" || onChain["merkleRoot"] != strings.Repeat("cd", 32) {
+		t.Errorf("Unexpected on-chain metadata: %+v", onChain)
+	}
+
+	if _, _, err := a.Retry(hash); err != ErrAnchored {
+		t.Errorf("Expected retrying an anchored file to fail with ErrAnchored, got %v", err)
+	}
+}
+
+func TestAnchorer_RecordsFailures(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	c := newTestChain(t, key)
+
+	// The account was never registered, so the vault rejects the upload
+	a := c.startAnchorer(t, c.newClient(t, key))
+
+	hash := strings.Repeat("bb", 32)
+	c.putPending(t, hash, "rejected.txt")
+	job, err := a.Enqueue(hash)
+	if err != nil {
+		t.Fatalf("Failed to enqueue anchor: %v", err)
+	}
+
+	anchor := c.waitSettled(t, hash)
+	if anchor.Status != metadata.AnchorFailed || anchor.Error != "User not registered or inactive" {
+		t.Fatalf("Expected a failed anchor with the revert reason, got %+v", anchor)
+	}
+
+	// A revert is not retried
+	job, err = a.queue.WaitJob(context.Background(), job.ID)
+	if err != nil {
+		t.Fatalf("Failed to wait for anchor job: %v", err)
+	}
+	if job.Status != metadata.JobFailed || job.Attempts != 1 {
+		t.Errorf("Expected the job to fail after one attempt, got %s after %d", job.Status, job.Attempts)
+	}
+
+	record, retried, err := a.Retry(hash)
+	if err != nil {
+		t.Fatalf("Failed to retry anchor: %v", err)
+	}
+	if record.Anchor.Status != metadata.AnchorPending || record.Anchor.Error != "" {
+		t.Errorf("Expected retry to reset the anchor to pending, got %+v", record.Anchor)
+	}
+	if retried.ID == job.ID {
+		t.Error("Expected retry to queue a new job")
+	}
+	c.waitSettled(t, hash)
+}
+
+func TestRequest(t *testing.T) {
+	req, err := Request(&metadata.FileRecord{
+		Filename:    "empty.txt",
+		Hash:        strings.Repeat("ee", 32),
+		MerkleRoot:  "",
+		ChunkHashes: nil,
+	})
+	if err != nil {
+		t.Fatalf("Failed to build request: %v", err)
+	}
+	if req.FileHash != "0x"+strings.Repeat("ee", 32) {
+		t.Errorf("Expected 0x-prefixed file hash, got %s", req.FileHash)
+	}
+	if len(req.Proof) == 0 || len(req.Proof) != len(req.Indices) {
+		t.Errorf("Expected a non-empty proof with matching indices, got %d and %d", len(req.Proof), len(req.Indices))
+	}
+}
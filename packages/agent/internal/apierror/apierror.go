@@ -0,0 +1,158 @@
+// Package apierror defines the typed errors the agent's packages return and
+// the RFC 7807 problem documents the API reports them in. Each error carries
+// a stable machine-readable code that decides its HTTP status.
+package apierror
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Code identifies a kind of failure. Codes are part of the API and never
+// change meaning once published.
+type Code string
+
+const (
+	InvalidRequest      Code = "invalid_request"
+	InvalidHash         Code = "invalid_hash"
+	InvalidPath         Code = "invalid_path"
+	Unauthorized        Code = "unauthorized"
+	Forbidden           Code = "forbidden"
+	NotFound            Code = "not_found"
+	FileNotFound        Code = "file_not_found"
+	ChunkNotFound       Code = "chunk_not_found"
+	Conflict            Code = "conflict"
+	Gone                Code = "gone"
+	LengthRequired      Code = "length_required"
+	PayloadTooLarge     Code = "payload_too_large"
+	RangeNotSatisfiable Code = "range_not_satisfiable"
+	MisdirectedRequest  Code = "misdirected_request"
+	ContractReverted    Code = "contract_reverted"
+	QuotaExceeded       Code = "quota_exceeded"
+	RateLimited         Code = "rate_limited"
+	Internal            Code = "internal_error"
+	ZeroGUnavailable    Code = "zerog_unavailable"
+	ChainUnavailable    Code = "chain_unavailable"
+	Unavailable         Code = "unavailable"
+	ZeroGTimeout        Code = "zerog_timeout"
+	ChainTimeout        Code = "chain_timeout"
+)
+
+// codes gives each code its HTTP status and a short title for it
+var codes = map[Code]struct {
+	status int
+	title  string
+}{
+	InvalidRequest:      {http.StatusBadRequest, "Invalid request"},
+	InvalidHash:         {http.StatusBadRequest, "Invalid hash"},
+	InvalidPath:         {http.StatusBadRequest, "Invalid path"},
+	Unauthorized:        {http.StatusUnauthorized, "Unauthorized"},
+	Forbidden:           {http.StatusForbidden, "Forbidden"},
+	NotFound:            {http.StatusNotFound, "Not found"},
+	FileNotFound:        {http.StatusNotFound, "File not found"},
+	ChunkNotFound:       {http.StatusNotFound, "Chunk not found"},
+	Conflict:            {http.StatusConflict, "Conflict"},
+	Gone:                {http.StatusGone, "Gone"},
+	LengthRequired:      {http.StatusLengthRequired, "Length required"},
+	PayloadTooLarge:     {http.StatusRequestEntityTooLarge, "Payload too large"},
+	RangeNotSatisfiable: {http.StatusRequestedRangeNotSatisfiable, "Range not satisfiable"},
+	MisdirectedRequest:  {http.StatusMisdirectedRequest, "Misdirected request"},
+	ContractReverted:    {http.StatusUnprocessableEntity, "Contract call reverted"},
+	QuotaExceeded:       {http.StatusRequestEntityTooLarge, "Quota exceeded"},
+	RateLimited:         {http.StatusTooManyRequests, "Rate limit exceeded"},
+	Internal:            {http.StatusInternalServerError, "Internal server error"},
+	ZeroGUnavailable:    {http.StatusBadGateway, "0G Storage unavailable"},
+	ChainUnavailable:    {http.StatusBadGateway, "Blockchain unavailable"},
+	Unavailable:         {http.StatusServiceUnavailable, "Service unavailable"},
+	ZeroGTimeout:        {http.StatusGatewayTimeout, "0G Storage timed out"},
+	ChainTimeout:        {http.StatusGatewayTimeout, "Blockchain timed out"},
+}
+
+// Status is the HTTP status the code is reported with; unknown codes are
+// internal errors
+func (c Code) Status() int {
+	if info, ok := codes[c]; ok {
+		return info.status
+	}
+	return http.StatusInternalServerError
+}
+
+// Title describes the code in a few words
+func (c Code) Title() string {
+	if info, ok := codes[c]; ok {
+		return info.title
+	}
+	return http.StatusText(c.Status())
+}
+
+// Codes lists every defined code
+func Codes() []Code {
+	list := make([]Code, 0, len(codes))
+	for code := range codes {
+		list = append(list, code)
+	}
+	return list
+}
+
+// Error is a failure with a code. Message is safe to show clients; Err is the
+// underlying cause, which is only shown when debugging.
+type Error struct {
+	Code    Code
+	Message string
+	Err     error
+}
+
+// New returns an error with a code and message
+func New(code Code, message string) *Error {
+	return &Error{Code: code, Message: message}
+}
+
+// Newf returns an error with a code and formatted message
+func Newf(code Code, format string, args ...interface{}) *Error {
+	return New(code, fmt.Sprintf(format, args...))
+}
+
+// Wrap returns an error with a code and message caused by err
+func Wrap(err error, code Code, message string) *Error {
+	return &Error{Code: code, Message: message, Err: err}
+}
+
+func (e *Error) Error() string {
+	if e.Err == nil {
+		return e.Message
+	}
+	return e.Message + ": " + e.Err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Is matches errors with the same code, so errors.Is(err, New(code, ""))
+// tests for a code
+func (e *Error) Is(target error) bool {
+	var t *Error
+	return errors.As(target, &t) && t.Code == e.Code
+}
+
+// From returns the outermost typed error in err's chain, or an internal error
+// wrapping err if it has none. It returns nil for nil.
+func From(err error) *Error {
+	if err == nil {
+		return nil
+	}
+	var e *Error
+	if errors.As(err, &e) {
+		return e
+	}
+	return Wrap(err, Internal, Internal.Title())
+}
+
+// CodeOf returns the code of err: Internal if it has none, and empty for nil
+func CodeOf(err error) Code {
+	if e := From(err); e != nil {
+		return e.Code
+	}
+	return ""
+}
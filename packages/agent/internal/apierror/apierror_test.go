@@ -0,0 +1,63 @@
+package apierror
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestCodes(t *testing.T) {
+	for _, code := range Codes() {
+		if status := code.Status(); status < 400 || status > 599 {
+			t.Errorf("Expected %s to map to an error status, got %d", code, status)
+		}
+		if code.Title() == "" {
+			t.Errorf("Expected %s to have a title", code)
+		}
+	}
+	if status := Code("unknown").Status(); status != http.StatusInternalServerError {
+		t.Errorf("Expected an unknown code to be a 500, got %d", status)
+	}
+}
+
+func TestFrom(t *testing.T) {
+	cause := errors.New("open /data/x: no such file or directory")
+	err := fmt.Errorf("chunking: %w", Wrap(cause, FileNotFound, "File not found"))
+
+	if e := From(err); e.Code != FileNotFound || e.Message != "File not found" {
+		t.Errorf("Expected the wrapped file_not_found error, got %+v", e)
+	}
+	if !errors.Is(err, New(FileNotFound, "")) {
+		t.Error("Expected errors.Is to match on the code")
+	}
+	if errors.Is(err, New(ChunkNotFound, "")) {
+		t.Error("Expected errors.Is not to match another code")
+	}
+	if !errors.Is(err, cause) {
+		t.Error("Expected the cause to stay reachable")
+	}
+
+	if e := From(cause); e.Code != Internal || e.Err != cause {
+		t.Errorf("Expected an untyped error to become internal, got %+v", e)
+	}
+	if From(nil) != nil {
+		t.Error("Expected nil for nil")
+	}
+}
+
+func TestNewProblem(t *testing.T) {
+	err := Wrap(errors.New("context deadline exceeded"), ZeroGTimeout, "Failed to download from 0G Storage")
+
+	problem := NewProblem(err, false)
+	if problem.Status != http.StatusGatewayTimeout || problem.Code != ZeroGTimeout || problem.Type != TypePrefix+"zerog_timeout" {
+		t.Errorf("Expected a zerog_timeout 504, got %+v", problem)
+	}
+	if problem.Detail != "Failed to download from 0G Storage" || problem.Debug != "" {
+		t.Errorf("Expected the message without the cause, got %+v", problem)
+	}
+
+	if problem := NewProblem(err, true); problem.Debug != "context deadline exceeded" {
+		t.Errorf("Expected the cause as debug detail, got %q", problem.Debug)
+	}
+}
@@ -0,0 +1,48 @@
+package apierror
+
+// ContentType is the media type of problem documents
+const ContentType = "application/problem+json"
+
+// TypePrefix prefixes a code to form its problem type URI
+const TypePrefix = "urn:nebularvault:problem:"
+
+// Problem is an RFC 7807 problem document. Code, RequestID, Debug and Data
+// are extension members: Debug is the underlying cause, set only when debug
+// errors are enabled, and Data is state a client may need to recover, such
+// as an upload session's received bytes.
+type Problem struct {
+	Type      string      `json:"type"`
+	Title     string      `json:"title"`
+	Status    int         `json:"status"`
+	Detail    string      `json:"detail,omitempty"`
+	Instance  string      `json:"instance,omitempty"`
+	Code      Code        `json:"code"`
+	RequestID string      `json:"request_id,omitempty"`
+	Debug     string      `json:"debug,omitempty"`
+	Data      interface{} `json:"data,omitempty"`
+}
+
+// NewProblem describes err as a problem document. Only its message is used
+// as the detail; the cause is added as Debug when debug is set.
+func NewProblem(err error, debug bool) *Problem {
+	e := From(err)
+	problem := &Problem{
+		Type:   TypePrefix + string(e.Code),
+		Title:  e.Code.Title(),
+		Status: e.Code.Status(),
+		Detail: e.Message,
+		Code:   e.Code,
+	}
+	if debug && e.Err != nil {
+		problem.Debug = e.Err.Error()
+	}
+	return problem
+}
+
+// Error returns the problem's detail, or its title if it has none
+func (p *Problem) Error() string {
+	if p.Detail != "" {
+		return p.Detail
+	}
+	return p.Title
+}
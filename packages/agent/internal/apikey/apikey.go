@@ -0,0 +1,338 @@
+package apikey
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Scopes a key can be granted. Admin implies every other scope.
+const (
+	ScopeFilesRead  = "files:read"
+	ScopeFilesWrite = "files:write"
+	ScopeAdmin      = "admin"
+)
+
+// Prefix marks agent API keys so they are recognisable in headers and
+// secret scanners
+const Prefix = "nvk_"
+
+var (
+	// ErrInvalidKey is returned for a key that is malformed, unknown,
+	// revoked or expired
+	ErrInvalidKey = errors.New("invalid API key")
+
+	// ErrNotFound is returned when revoking a key ID that does not exist
+	ErrNotFound = errors.New("API key not found")
+)
+
+// ValidScope reports whether scope is one the agent enforces
+func ValidScope(scope string) bool {
+	switch scope {
+	case ScopeFilesRead, ScopeFilesWrite, ScopeAdmin:
+		return true
+	}
+	return false
+}
+
+// Key is a stored API key. Only the SHA-256 of the secret is kept.
+type Key struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Hash       string     `json:"hash"`
+	Scopes     []string   `json:"scopes"`
+	CreatedAt  time.Time  `json:"created_at"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+}
+
+// HasScope reports whether the key grants scope
+func (k *Key) HasScope(scope string) bool {
+	for _, s := range k.Scopes {
+		if s == scope || s == ScopeAdmin {
+			return true
+		}
+	}
+	return false
+}
+
+// Active reports whether the key can still be used at now
+func (k *Key) Active(now time.Time) bool {
+	return k.RevokedAt == nil && (k.ExpiresAt == nil || now.Before(*k.ExpiresAt))
+}
+
+// Store keeps API keys in a JSON file in the data directory, so the CLI can
+// manage keys while the agent is running. Every change rewrites the file
+// atomically; the agent picks up changes made by other processes when the
+// file's modification time or size moves.
+type Store struct {
+	path string
+	now  func() time.Time
+
+	mu       sync.Mutex
+	keys     map[string]*Key
+	modTime  time.Time
+	size     int64
+	lastUsed map[string]time.Time
+}
+
+// Open loads the key file at path, which need not exist yet
+func Open(path string) (*Store, error) {
+	s := &Store{
+		path:     path,
+		now:      time.Now,
+		keys:     make(map[string]*Key),
+		lastUsed: make(map[string]time.Time),
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.reloadLocked(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Create generates a key and returns it along with the secret, which is
+// shown once and cannot be recovered
+func (s *Store) Create(name string, scopes []string, ttl time.Duration) (*Key, string, error) {
+	if len(scopes) == 0 {
+		return nil, "", fmt.Errorf("at least one scope is required")
+	}
+	for _, scope := range scopes {
+		if !ValidScope(scope) {
+			return nil, "", fmt.Errorf("unknown scope %q", scope)
+		}
+	}
+
+	id, err := randomHex(8)
+	if err != nil {
+		return nil, "", err
+	}
+	secret, err := randomHex(32)
+	if err != nil {
+		return nil, "", err
+	}
+
+	now := s.now().UTC()
+	key := &Key{
+		ID:        id,
+		Name:      name,
+		Hash:      hashSecret(secret),
+		Scopes:    scopes,
+		CreatedAt: now,
+	}
+	if ttl > 0 {
+		expiresAt := now.Add(ttl)
+		key.ExpiresAt = &expiresAt
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.reloadLocked(); err != nil {
+		return nil, "", err
+	}
+	s.keys[id] = key
+	if err := s.saveLocked(); err != nil {
+		return nil, "", err
+	}
+	return key, Prefix + id + "_" + secret, nil
+}
+
+// Revoke disables a key
+func (s *Store) Revoke(id string) (*Key, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.reloadLocked(); err != nil {
+		return nil, err
+	}
+
+	key, ok := s.keys[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	if key.RevokedAt == nil {
+		now := s.now().UTC()
+		key.RevokedAt = &now
+		if err := s.saveLocked(); err != nil {
+			return nil, err
+		}
+	}
+	return key, nil
+}
+
+// List returns every key, oldest first
+func (s *Store) List() ([]*Key, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.reloadLocked(); err != nil {
+		return nil, err
+	}
+
+	keys := make([]*Key, 0, len(s.keys))
+	for _, key := range s.keys {
+		copied := *key
+		keys = append(keys, &copied)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].CreatedAt.Before(keys[j].CreatedAt) })
+	return keys, nil
+}
+
+// Authenticate checks a presented key and records its use. Use times are
+// kept in memory until Flush so reads do not rewrite the file.
+func (s *Store) Authenticate(presented string) (*Key, error) {
+	id, secret, ok := strings.Cut(strings.TrimPrefix(presented, Prefix), "_")
+	if !ok || !strings.HasPrefix(presented, Prefix) {
+		return nil, ErrInvalidKey
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.reloadLocked(); err != nil {
+		return nil, err
+	}
+
+	key, found := s.keys[id]
+	if !found || subtle.ConstantTimeCompare([]byte(key.Hash), []byte(hashSecret(secret))) != 1 {
+		return nil, ErrInvalidKey
+	}
+	now := s.now().UTC()
+	if !key.Active(now) {
+		return nil, ErrInvalidKey
+	}
+
+	s.lastUsed[id] = now
+	copied := *key
+	copied.LastUsedAt = &now
+	return &copied, nil
+}
+
+// Flush writes buffered last-used times to the key file
+func (s *Store) Flush() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.lastUsed) == 0 {
+		return nil
+	}
+	if err := s.reloadLocked(); err != nil {
+		return err
+	}
+
+	for id, usedAt := range s.lastUsed {
+		if key, ok := s.keys[id]; ok {
+			usedAt := usedAt
+			key.LastUsedAt = &usedAt
+		}
+	}
+	if err := s.saveLocked(); err != nil {
+		return err
+	}
+	s.lastUsed = make(map[string]time.Time)
+	return nil
+}
+
+// Run flushes last-used times every interval until ctx is cancelled, then
+// once more
+func (s *Store) Run(ctx context.Context, interval time.Duration, logger *logrus.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			if err := s.Flush(); err != nil {
+				logger.WithError(err).Error("Failed to record API key usage")
+			}
+			return
+		case <-ticker.C:
+			if err := s.Flush(); err != nil {
+				logger.WithError(err).Error("Failed to record API key usage")
+			}
+		}
+	}
+}
+
+// reloadLocked rereads the key file if another process changed it
+func (s *Store) reloadLocked() error {
+	info, err := os.Stat(s.path)
+	if os.IsNotExist(err) {
+		s.keys = make(map[string]*Key)
+		s.modTime, s.size = time.Time{}, 0
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to stat API key file: %w", err)
+	}
+	if info.ModTime().Equal(s.modTime) && info.Size() == s.size {
+		return nil
+	}
+
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return fmt.Errorf("failed to read API key file: %w", err)
+	}
+	var keys []*Key
+	if err := json.Unmarshal(data, &keys); err != nil {
+		return fmt.Errorf("failed to decode API key file: %w", err)
+	}
+
+	s.keys = make(map[string]*Key, len(keys))
+	for _, key := range keys {
+		s.keys[key.ID] = key
+	}
+	s.modTime, s.size = info.ModTime(), info.Size()
+	return nil
+}
+
+func (s *Store) saveLocked() error {
+	keys := make([]*Key, 0, len(s.keys))
+	for _, key := range s.keys {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].CreatedAt.Before(keys[j].CreatedAt) })
+
+	data, err := json.MarshalIndent(keys, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode API keys: %w", err)
+	}
+
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return fmt.Errorf("failed to write API key file: %w", err)
+	}
+	if err := os.Rename(tmp, s.path); err != nil {
+		return fmt.Errorf("failed to write API key file: %w", err)
+	}
+
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return fmt.Errorf("failed to stat API key file: %w", err)
+	}
+	s.modTime, s.size = info.ModTime(), info.Size()
+	return nil
+}
+
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+func randomHex(n int) (string, error) {
+	buf := make([]byte, n)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate random bytes: %w", err)
+	}
+	return hex.EncodeToString(buf), nil
+}
@@ -0,0 +1,129 @@
+package apikey
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func openTestStore(t *testing.T, path string) *Store {
+	t.Helper()
+
+	store, err := Open(path)
+	if err != nil {
+		t.Fatalf("Failed to open key store: %v", err)
+	}
+	return store
+}
+
+func TestStore_CreateAndAuthenticate(t *testing.T) {
+	store := openTestStore(t, filepath.Join(t.TempDir(), "api-keys.json"))
+
+	key, secret, err := store.Create("billing", []string{ScopeFilesRead}, 0)
+	if err != nil {
+		t.Fatalf("Failed to create key: %v", err)
+	}
+	if !strings.HasPrefix(secret, Prefix+key.ID+"_") {
+		t.Errorf("Expected secret to carry the key ID, got %s", secret)
+	}
+	if strings.Contains(key.Hash, strings.TrimPrefix(secret, Prefix+key.ID+"_")) {
+		t.Error("Expected only the hash of the secret to be stored")
+	}
+
+	authed, err := store.Authenticate(secret)
+	if err != nil {
+		t.Fatalf("Failed to authenticate: %v", err)
+	}
+	if authed.ID != key.ID || !authed.HasScope(ScopeFilesRead) || authed.HasScope(ScopeFilesWrite) {
+		t.Errorf("Unexpected authenticated key: %+v", authed)
+	}
+
+	for _, presented := range []string{
+		"",
+		"nvk_",
+		secret + "0",
+		Prefix + "0000000000000000_" + strings.Repeat("0", 64),
+		strings.TrimPrefix(secret, Prefix),
+	} {
+		if _, err := store.Authenticate(presented); !errors.Is(err, ErrInvalidKey) {
+			t.Errorf("Expected %q to be rejected, got %v", presented, err)
+		}
+	}
+
+	if _, _, err := store.Create("bad", []string{"files:delete"}, 0); err == nil {
+		t.Error("Expected an unknown scope to be rejected")
+	}
+}
+
+func TestStore_RevokeAndExpiry(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "api-keys.json")
+	server := openTestStore(t, path)
+
+	key, secret, err := server.Create("indexer", []string{ScopeAdmin}, time.Hour)
+	if err != nil {
+		t.Fatalf("Failed to create key: %v", err)
+	}
+	if _, err := server.Authenticate(secret); err != nil {
+		t.Fatalf("Failed to authenticate: %v", err)
+	}
+
+	// The CLI revokes through its own store while the server keeps running
+	cli := openTestStore(t, path)
+	if _, err := cli.Revoke(key.ID); err != nil {
+		t.Fatalf("Failed to revoke key: %v", err)
+	}
+	if _, err := cli.Revoke("missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected revoking an unknown key to fail with ErrNotFound, got %v", err)
+	}
+	if _, err := server.Authenticate(secret); !errors.Is(err, ErrInvalidKey) {
+		t.Errorf("Expected a revoked key to be rejected, got %v", err)
+	}
+
+	_, secret, err = cli.Create("temporary", []string{ScopeFilesRead}, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to create key: %v", err)
+	}
+	server.now = func() time.Time { return time.Now().Add(2 * time.Minute) }
+	if _, err := server.Authenticate(secret); !errors.Is(err, ErrInvalidKey) {
+		t.Errorf("Expected an expired key to be rejected, got %v", err)
+	}
+}
+
+func TestStore_FlushLastUsed(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "api-keys.json")
+	server := openTestStore(t, path)
+
+	key, secret, err := server.Create("backend", []string{ScopeFilesWrite}, 0)
+	if err != nil {
+		t.Fatalf("Failed to create key: %v", err)
+	}
+	if _, err := server.Authenticate(secret); err != nil {
+		t.Fatalf("Failed to authenticate: %v", err)
+	}
+
+	// A key created by the CLI in the meantime must survive the flush
+	cli := openTestStore(t, path)
+	if _, _, err := cli.Create("other", []string{ScopeFilesRead}, 0); err != nil {
+		t.Fatalf("Failed to create key: %v", err)
+	}
+
+	if err := server.Flush(); err != nil {
+		t.Fatalf("Failed to flush: %v", err)
+	}
+
+	keys, err := openTestStore(t, path).List()
+	if err != nil {
+		t.Fatalf("Failed to list keys: %v", err)
+	}
+	if len(keys) != 2 {
+		t.Fatalf("Expected 2 keys, got %d", len(keys))
+	}
+	if keys[0].ID != key.ID || keys[0].LastUsedAt == nil {
+		t.Errorf("Expected %s to have a last-used time, got %+v", key.ID, keys[0])
+	}
+	if keys[1].LastUsedAt != nil {
+		t.Errorf("Expected the unused key to have no last-used time, got %v", keys[1].LastUsedAt)
+	}
+}
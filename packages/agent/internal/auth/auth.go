@@ -0,0 +1,232 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	// ErrInvalidMessage is returned for a sign-in message that is malformed
+	// or was not meant for this agent
+	ErrInvalidMessage = errors.New("invalid sign-in message")
+
+	// ErrInvalidSignature is returned when a signature does not recover to
+	// the message's address
+	ErrInvalidSignature = errors.New("invalid signature")
+
+	// ErrInvalidNonce is returned for a nonce that was not issued by this
+	// agent, has expired or was already used
+	ErrInvalidNonce = errors.New("invalid or expired nonce")
+
+	// ErrInvalidSession is returned for a session token that is malformed,
+	// tampered with or expired
+	ErrInvalidSession = errors.New("invalid or expired session")
+)
+
+// Config controls sign-in and session lifetimes
+type Config struct {
+	// Domain is the host the SIWE message must be issued for. It is
+	// required, so a message signed for another site never opens a session.
+	Domain  string
+	ChainID int64
+
+	NonceTTL   time.Duration
+	SessionTTL time.Duration
+
+	// Secret signs session tokens; a random one is generated when empty,
+	// so sessions do not survive a restart
+	Secret []byte
+}
+
+// Session is an authenticated wallet session
+type Session struct {
+	Token     string    `json:"token"`
+	Address   string    `json:"address"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// Authenticator issues sign-in nonces, verifies signed SIWE messages and
+// issues and checks session tokens
+type Authenticator struct {
+	config Config
+	now    func() time.Time
+
+	mu     sync.Mutex
+	nonces map[string]time.Time
+}
+
+// NewAuthenticator creates an authenticator
+func NewAuthenticator(config Config) (*Authenticator, error) {
+	if config.Domain == "" {
+		return nil, errors.New("a sign-in domain is required")
+	}
+	if config.NonceTTL <= 0 {
+		config.NonceTTL = 5 * time.Minute
+	}
+	if config.SessionTTL <= 0 {
+		config.SessionTTL = time.Hour
+	}
+	if len(config.Secret) == 0 {
+		config.Secret = make([]byte, 32)
+		if _, err := rand.Read(config.Secret); err != nil {
+			return nil, fmt.Errorf("failed to generate session secret: %w", err)
+		}
+	}
+
+	return &Authenticator{
+		config: config,
+		now:    time.Now,
+		nonces: make(map[string]time.Time),
+	}, nil
+}
+
+// Nonce issues a single-use nonce for a sign-in message
+func (a *Authenticator) Nonce() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	nonce := hex.EncodeToString(buf)
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	now := a.now()
+	for n, expiry := range a.nonces {
+		if now.After(expiry) {
+			delete(a.nonces, n)
+		}
+	}
+	a.nonces[nonce] = now.Add(a.config.NonceTTL)
+	return nonce, nil
+}
+
+// SignIn verifies a signed SIWE message and opens a session for its address
+func (a *Authenticator) SignIn(text, signature string) (*Session, error) {
+	msg, err := ParseMessage(text)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidMessage, err)
+	}
+	if err := a.checkMessage(msg); err != nil {
+		return nil, err
+	}
+
+	signer, err := RecoverSigner(text, signature)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+	if signer != msg.Address {
+		return nil, ErrInvalidSignature
+	}
+
+	// Only burn the nonce once the signature is known to be good
+	if !a.consumeNonce(msg.Nonce) {
+		return nil, ErrInvalidNonce
+	}
+
+	return a.issueSession(msg.Address)
+}
+
+func (a *Authenticator) checkMessage(msg *Message) error {
+	now := a.now()
+	switch {
+	case msg.Domain != a.config.Domain:
+		return fmt.Errorf("%w: domain %q does not match %q", ErrInvalidMessage, msg.Domain, a.config.Domain)
+	case msg.ChainID != a.config.ChainID:
+		return fmt.Errorf("%w: chain ID %d does not match %d", ErrInvalidMessage, msg.ChainID, a.config.ChainID)
+	case msg.Version != "1":
+		return fmt.Errorf("%w: unsupported version %q", ErrInvalidMessage, msg.Version)
+	case msg.ExpirationTime != nil && now.After(*msg.ExpirationTime):
+		return fmt.Errorf("%w: message expired", ErrInvalidMessage)
+	case msg.NotBefore != nil && now.Before(*msg.NotBefore):
+		return fmt.Errorf("%w: message not yet valid", ErrInvalidMessage)
+	}
+	return nil
+}
+
+func (a *Authenticator) consumeNonce(nonce string) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	expiry, ok := a.nonces[nonce]
+	if !ok {
+		return false
+	}
+	delete(a.nonces, nonce)
+	return !a.now().After(expiry)
+}
+
+// sessionClaims is the signed payload of a session token
+type sessionClaims struct {
+	Address   string `json:"sub"`
+	IssuedAt  int64  `json:"iat"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+// issueSession creates a token of the form <payload>.<hmac>, both
+// base64url-encoded, so sessions need no server-side storage
+func (a *Authenticator) issueSession(address common.Address) (*Session, error) {
+	now := a.now()
+	expiresAt := now.Add(a.config.SessionTTL)
+
+	payload, err := json.Marshal(sessionClaims{
+		Address:   address.Hex(),
+		IssuedAt:  now.Unix(),
+		ExpiresAt: expiresAt.Unix(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode session: %w", err)
+	}
+
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	return &Session{
+		Token:     encoded + "." + base64.RawURLEncoding.EncodeToString(a.sign(encoded)),
+		Address:   address.Hex(),
+		ExpiresAt: time.Unix(expiresAt.Unix(), 0).UTC(),
+	}, nil
+}
+
+// VerifySession checks a session token and returns the session it encodes
+func (a *Authenticator) VerifySession(token string) (*Session, error) {
+	encoded, sig, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, ErrInvalidSession
+	}
+	mac, err := base64.RawURLEncoding.DecodeString(sig)
+	if err != nil || !hmac.Equal(mac, a.sign(encoded)) {
+		return nil, ErrInvalidSession
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, ErrInvalidSession
+	}
+	var claims sessionClaims
+	if err := json.Unmarshal(payload, &claims); err != nil || !common.IsHexAddress(claims.Address) {
+		return nil, ErrInvalidSession
+	}
+
+	expiresAt := time.Unix(claims.ExpiresAt, 0).UTC()
+	if !a.now().Before(expiresAt) {
+		return nil, ErrInvalidSession
+	}
+
+	return &Session{Token: token, Address: claims.Address, ExpiresAt: expiresAt}, nil
+}
+
+func (a *Authenticator) sign(payload string) []byte {
+	mac := hmac.New(sha256.New, a.config.Secret)
+	mac.Write([]byte(payload))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,215 @@
+package auth
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func newTestAuthenticator(t *testing.T) *Authenticator {
+	t.Helper()
+
+	a, err := NewAuthenticator(Config{
+		Domain:     "vault.example",
+		ChainID:    16601,
+		SessionTTL: time.Hour,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create authenticator: %v", err)
+	}
+	return a
+}
+
+func newMessage(t *testing.T, key *ecdsa.PrivateKey, nonce string) *Message {
+	t.Helper()
+
+	expiry := time.Now().Add(10 * time.Minute)
+	return &Message{
+		Domain:         "vault.example",
+		Address:        crypto.PubkeyToAddress(key.PublicKey),
+		Statement:      "Sign in to NebulaVault.",
+		URI:            "https://vault.example/login",
+		Version:        "1",
+		ChainID:        16601,
+		Nonce:          nonce,
+		IssuedAt:       time.Now().Add(-time.Minute).Truncate(time.Second),
+		ExpirationTime: &expiry,
+		Resources:      []string{"https://vault.example/files"},
+	}
+}
+
+// personalSign signs text the way a wallet's personal_sign does, with v as
+// 27 or 28
+func personalSign(t *testing.T, key *ecdsa.PrivateKey, text string) string {
+	t.Helper()
+
+	sig, err := crypto.Sign(accounts.TextHash([]byte(text)), key)
+	if err != nil {
+		t.Fatalf("Failed to sign message: %v", err)
+	}
+	sig[crypto.RecoveryIDOffset] += 27
+	return hexutil.Encode(sig)
+}
+
+func TestParseMessage_RoundTrip(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	msg := newMessage(t, key, "abcdef0123456789")
+
+	parsed, err := ParseMessage(msg.String())
+	if err != nil {
+		t.Fatalf("Failed to parse message: %v", err)
+	}
+	if parsed.String() != msg.String() {
+		t.Errorf("Round trip changed the message:\n%s\n---\n%s", parsed.String(), msg.String())
+	}
+	if parsed.Statement != msg.Statement || parsed.Nonce != msg.Nonce || len(parsed.Resources) != 1 {
+		t.Errorf("Unexpected parsed message: %+v", parsed)
+	}
+
+	msg.Statement = ""
+	if _, err := ParseMessage(msg.String()); err != nil {
+		t.Errorf("Expected a message without a statement to parse, got %v", err)
+	}
+
+	for _, text := range []string{
+		"",
+		"vault.example wants you to sign in with your Ethereum account:\nnot-an-address",
+		strings.Replace(msg.String(), "Nonce: ", "Nonse: ", 1),
+	} {
+		if _, err := ParseMessage(text); err == nil {
+			t.Errorf("Expected %q to be rejected", text)
+		}
+	}
+}
+
+func TestAuthenticator_SignIn(t *testing.T) {
+	a := newTestAuthenticator(t)
+	key, _ := crypto.GenerateKey()
+
+	nonce, err := a.Nonce()
+	if err != nil {
+		t.Fatalf("Failed to issue nonce: %v", err)
+	}
+	text := newMessage(t, key, nonce).String()
+
+	session, err := a.SignIn(text, personalSign(t, key, text))
+	if err != nil {
+		t.Fatalf("Failed to sign in: %v", err)
+	}
+	if session.Address != crypto.PubkeyToAddress(key.PublicKey).Hex() {
+		t.Errorf("Expected session for %s, got %s", crypto.PubkeyToAddress(key.PublicKey).Hex(), session.Address)
+	}
+
+	verified, err := a.VerifySession(session.Token)
+	if err != nil {
+		t.Fatalf("Failed to verify session: %v", err)
+	}
+	if verified.Address != session.Address {
+		t.Errorf("Expected verified address %s, got %s", session.Address, verified.Address)
+	}
+
+	if _, err := a.SignIn(text, personalSign(t, key, text)); !errors.Is(err, ErrInvalidNonce) {
+		t.Errorf("Expected replaying a message to fail with ErrInvalidNonce, got %v", err)
+	}
+}
+
+func TestAuthenticator_SignInRejects(t *testing.T) {
+	a := newTestAuthenticator(t)
+	key, _ := crypto.GenerateKey()
+	other, _ := crypto.GenerateKey()
+
+	nonce, _ := a.Nonce()
+	msg := newMessage(t, key, nonce)
+	text := msg.String()
+
+	if _, err := a.SignIn(text, personalSign(t, other, text)); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("Expected a signature from another key to fail, got %v", err)
+	}
+	if _, err := a.SignIn(text, "0x1234"); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("Expected a malformed signature to fail, got %v", err)
+	}
+
+	// A rejected signature must not burn the nonce
+	if _, err := a.SignIn(text, personalSign(t, key, text)); err != nil {
+		t.Errorf("Expected the nonce to survive failed attempts, got %v", err)
+	}
+
+	unknown := newMessage(t, key, "0000000000000000").String()
+	if _, err := a.SignIn(unknown, personalSign(t, key, unknown)); !errors.Is(err, ErrInvalidNonce) {
+		t.Errorf("Expected an unissued nonce to fail, got %v", err)
+	}
+
+	for name, mutate := range map[string]func(*Message){
+		"domain":  func(m *Message) { m.Domain = "evil.example" },
+		"chain":   func(m *Message) { m.ChainID = 1 },
+		"expired": func(m *Message) { past := time.Now().Add(-time.Minute); m.ExpirationTime = &past },
+		"early":   func(m *Message) { future := time.Now().Add(time.Hour); m.NotBefore = &future },
+	} {
+		nonce, _ := a.Nonce()
+		msg := newMessage(t, key, nonce)
+		mutate(msg)
+		text := msg.String()
+		if _, err := a.SignIn(text, personalSign(t, key, text)); !errors.Is(err, ErrInvalidMessage) {
+			t.Errorf("Expected a message with a bad %s to fail with ErrInvalidMessage, got %v", name, err)
+		}
+	}
+}
+
+func TestNewAuthenticator_RequiresDomain(t *testing.T) {
+	if _, err := NewAuthenticator(Config{ChainID: 16601}); err == nil {
+		t.Error("Expected an authenticator without a domain to be refused")
+	}
+}
+
+func TestAuthenticator_NonceExpiry(t *testing.T) {
+	a := newTestAuthenticator(t)
+	key, _ := crypto.GenerateKey()
+
+	nonce, _ := a.Nonce()
+	a.now = func() time.Time { return time.Now().Add(6 * time.Minute) }
+
+	msg := newMessage(t, key, nonce)
+	msg.ExpirationTime = nil
+	text := msg.String()
+	if _, err := a.SignIn(text, personalSign(t, key, text)); !errors.Is(err, ErrInvalidNonce) {
+		t.Errorf("Expected an expired nonce to fail, got %v", err)
+	}
+}
+
+func TestAuthenticator_VerifySession(t *testing.T) {
+	a := newTestAuthenticator(t)
+	key, _ := crypto.GenerateKey()
+
+	session, err := a.issueSession(crypto.PubkeyToAddress(key.PublicKey))
+	if err != nil {
+		t.Fatalf("Failed to issue session: %v", err)
+	}
+
+	payload, sig, _ := strings.Cut(session.Token, ".")
+	for _, token := range []string{
+		"",
+		payload,
+		payload + "." + sig + "x",
+		strings.ToUpper(payload[:1]) + payload[1:] + "." + sig,
+	} {
+		if _, err := a.VerifySession(token); !errors.Is(err, ErrInvalidSession) {
+			t.Errorf("Expected token %q to be rejected, got %v", token, err)
+		}
+	}
+
+	other := newTestAuthenticator(t)
+	if _, err := other.VerifySession(session.Token); !errors.Is(err, ErrInvalidSession) {
+		t.Errorf("Expected a token signed with another secret to be rejected, got %v", err)
+	}
+
+	a.now = func() time.Time { return time.Now().Add(2 * time.Hour) }
+	if _, err := a.VerifySession(session.Token); !errors.Is(err, ErrInvalidSession) {
+		t.Errorf("Expected an expired session to be rejected, got %v", err)
+	}
+}
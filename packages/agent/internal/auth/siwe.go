@@ -0,0 +1,168 @@
+package auth
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const messageHeader = " wants you to sign in with your Ethereum account:"
+
+// Message is an EIP-4361 Sign-In with Ethereum message
+type Message struct {
+	Domain         string
+	Address        common.Address
+	Statement      string
+	URI            string
+	Version        string
+	ChainID        int64
+	Nonce          string
+	IssuedAt       time.Time
+	ExpirationTime *time.Time
+	NotBefore      *time.Time
+	RequestID      string
+	Resources      []string
+}
+
+// ParseMessage parses the plain-text form of a SIWE message
+func ParseMessage(text string) (*Message, error) {
+	lines := strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+	if len(lines) < 2 || !strings.HasSuffix(lines[0], messageHeader) {
+		return nil, fmt.Errorf("missing sign-in header")
+	}
+
+	msg := &Message{Domain: strings.TrimSuffix(lines[0], messageHeader)}
+	if msg.Domain == "" {
+		return nil, fmt.Errorf("missing domain")
+	}
+	if !common.IsHexAddress(lines[1]) {
+		return nil, fmt.Errorf("invalid address %q", lines[1])
+	}
+	msg.Address = common.HexToAddress(lines[1])
+
+	// The statement is optional and sits between blank lines before the fields
+	i := 2
+	var statement []string
+	for ; i < len(lines) && !strings.HasPrefix(lines[i], "URI: "); i++ {
+		if lines[i] != "" {
+			statement = append(statement, lines[i])
+		}
+	}
+	msg.Statement = strings.Join(statement, "\n")
+
+	var err error
+	for ; i < len(lines); i++ {
+		line := lines[i]
+		switch {
+		case line == "":
+		case strings.HasPrefix(line, "URI: "):
+			msg.URI = strings.TrimPrefix(line, "URI: ")
+		case strings.HasPrefix(line, "Version: "):
+			msg.Version = strings.TrimPrefix(line, "Version: ")
+		case strings.HasPrefix(line, "Chain ID: "):
+			msg.ChainID, err = strconv.ParseInt(strings.TrimPrefix(line, "Chain ID: "), 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid chain ID: %w", err)
+			}
+		case strings.HasPrefix(line, "Nonce: "):
+			msg.Nonce = strings.TrimPrefix(line, "Nonce: ")
+		case strings.HasPrefix(line, "Issued At: "):
+			msg.IssuedAt, err = time.Parse(time.RFC3339, strings.TrimPrefix(line, "Issued At: "))
+			if err != nil {
+				return nil, fmt.Errorf("invalid issued at: %w", err)
+			}
+		case strings.HasPrefix(line, "Expiration Time: "):
+			t, err := time.Parse(time.RFC3339, strings.TrimPrefix(line, "Expiration Time: "))
+			if err != nil {
+				return nil, fmt.Errorf("invalid expiration time: %w", err)
+			}
+			msg.ExpirationTime = &t
+		case strings.HasPrefix(line, "Not Before: "):
+			t, err := time.Parse(time.RFC3339, strings.TrimPrefix(line, "Not Before: "))
+			if err != nil {
+				return nil, fmt.Errorf("invalid not before: %w", err)
+			}
+			msg.NotBefore = &t
+		case strings.HasPrefix(line, "Request ID: "):
+			msg.RequestID = strings.TrimPrefix(line, "Request ID: ")
+		case line == "Resources:":
+			for i+1 < len(lines) && strings.HasPrefix(lines[i+1], "- ") {
+				i++
+				msg.Resources = append(msg.Resources, strings.TrimPrefix(lines[i], "- "))
+			}
+		default:
+			return nil, fmt.Errorf("unexpected line %q", line)
+		}
+	}
+
+	switch {
+	case msg.URI == "":
+		return nil, fmt.Errorf("missing URI")
+	case msg.Version == "":
+		return nil, fmt.Errorf("missing version")
+	case msg.ChainID == 0:
+		return nil, fmt.Errorf("missing chain ID")
+	case msg.Nonce == "":
+		return nil, fmt.Errorf("missing nonce")
+	case msg.IssuedAt.IsZero():
+		return nil, fmt.Errorf("missing issued at")
+	}
+	return msg, nil
+}
+
+// String renders the message in the form a wallet signs
+func (m *Message) String() string {
+	var b strings.Builder
+	b.WriteString(m.Domain + messageHeader + "\n")
+	b.WriteString(m.Address.Hex() + "\n\n")
+	if m.Statement != "" {
+		b.WriteString(m.Statement + "\n\n")
+	}
+	fmt.Fprintf(&b, "URI: %s\nVersion: %s\nChain ID: %d\nNonce: %s\nIssued At: %s",
+		m.URI, m.Version, m.ChainID, m.Nonce, m.IssuedAt.UTC().Format(time.RFC3339))
+	if m.ExpirationTime != nil {
+		b.WriteString("\nExpiration Time: " + m.ExpirationTime.UTC().Format(time.RFC3339))
+	}
+	if m.NotBefore != nil {
+		b.WriteString("\nNot Before: " + m.NotBefore.UTC().Format(time.RFC3339))
+	}
+	if m.RequestID != "" {
+		b.WriteString("\nRequest ID: " + m.RequestID)
+	}
+	if len(m.Resources) > 0 {
+		b.WriteString("\nResources:")
+		for _, resource := range m.Resources {
+			b.WriteString("\n- " + resource)
+		}
+	}
+	return b.String()
+}
+
+// RecoverSigner returns the account that produced an EIP-191 personal_sign
+// signature over text
+func RecoverSigner(text, signature string) (common.Address, error) {
+	sig, err := hexutil.Decode(signature)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("invalid signature encoding: %w", err)
+	}
+	if len(sig) != crypto.SignatureLength {
+		return common.Address{}, fmt.Errorf("invalid signature length %d", len(sig))
+	}
+
+	// Wallets produce v as 27 or 28; SigToPub expects 0 or 1
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+
+	pub, err := crypto.SigToPub(accounts.TextHash([]byte(text)), sig)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to recover signer: %w", err)
+	}
+	return crypto.PubkeyToAddress(*pub), nil
+}
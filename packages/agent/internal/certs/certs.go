@@ -0,0 +1,142 @@
+package certs
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Client certificate policies for ServerConfig
+const (
+	ClientAuthNone     = "none"
+	ClientAuthOptional = "optional"
+	ClientAuthRequire  = "require"
+)
+
+// checkInterval bounds how often the certificate files are stat'ed
+const checkInterval = time.Second
+
+// fileState identifies a version of a file on disk
+type fileState struct {
+	modTime time.Time
+	size    int64
+}
+
+// Reloader serves a certificate pair from disk and picks up a renewed pair
+// when either file changes, without restarting the server. A pair that
+// fails to load is logged and the previous one kept.
+type Reloader struct {
+	certFile string
+	keyFile  string
+	logger   *logrus.Logger
+	now      func() time.Time
+
+	mu      sync.Mutex
+	cert    *tls.Certificate
+	state   [2]fileState
+	checked time.Time
+}
+
+// NewReloader loads the certificate pair, failing if it cannot
+func NewReloader(certFile, keyFile string, logger *logrus.Logger) (*Reloader, error) {
+	r := &Reloader{
+		certFile: certFile,
+		keyFile:  keyFile,
+		logger:   logger,
+		now:      time.Now,
+	}
+	state, err := r.stat()
+	if err != nil {
+		return nil, err
+	}
+	if err := r.load(state); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// GetCertificate returns the current certificate, reloading it first if
+// the files have changed. It is meant for tls.Config.GetCertificate.
+func (r *Reloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+	if now.Sub(r.checked) < checkInterval {
+		return r.cert, nil
+	}
+	r.checked = now
+
+	state, err := r.stat()
+	if err != nil {
+		r.logger.Warnf("Failed to check TLS certificate, keeping the current one: %v", err)
+		return r.cert, nil
+	}
+	if state == r.state {
+		return r.cert, nil
+	}
+	if err := r.load(state); err != nil {
+		r.logger.Warnf("Failed to reload TLS certificate, keeping the current one: %v", err)
+		return r.cert, nil
+	}
+	r.logger.Infof("Reloaded TLS certificate from %s", r.certFile)
+	return r.cert, nil
+}
+
+func (r *Reloader) load(state [2]fileState) error {
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return fmt.Errorf("failed to load certificate pair: %w", err)
+	}
+	r.cert, r.state = &cert, state
+	return nil
+}
+
+func (r *Reloader) stat() ([2]fileState, error) {
+	var state [2]fileState
+	for i, path := range []string{r.certFile, r.keyFile} {
+		info, err := os.Stat(path)
+		if err != nil {
+			return state, err
+		}
+		state[i] = fileState{modTime: info.ModTime(), size: info.Size()}
+	}
+	return state, nil
+}
+
+// ServerConfig builds the TLS configuration for the agent's listener.
+// clientAuth is one of the ClientAuth policies; unless it is none, client
+// certificates are verified against the CAs in clientCAFile.
+func ServerConfig(reloader *Reloader, clientAuth, clientCAFile string) (*tls.Config, error) {
+	config := &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: reloader.GetCertificate,
+	}
+
+	switch clientAuth {
+	case "", ClientAuthNone:
+		return config, nil
+	case ClientAuthOptional:
+		config.ClientAuth = tls.VerifyClientCertIfGiven
+	case ClientAuthRequire:
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	default:
+		return nil, fmt.Errorf("unknown client auth policy %q", clientAuth)
+	}
+
+	pem, err := os.ReadFile(clientCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read client CA: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificates found in %s", clientCAFile)
+	}
+	config.ClientCAs = pool
+	return config, nil
+}
@@ -0,0 +1,263 @@
+package certs
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"log"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// authority is a self-signed CA that issues test certificates
+type authority struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newAuthority(t *testing.T, name string) *authority {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate CA key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("Failed to create CA certificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("Failed to parse CA certificate: %v", err)
+	}
+	return &authority{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue signs a leaf certificate and returns it and its key as PEM
+func (a *authority) issue(t *testing.T, serial int64, usage x509.ExtKeyUsage) (certPEM, keyPEM []byte) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, a.cert, &key.PublicKey, a.key)
+	if err != nil {
+		t.Fatalf("Failed to create certificate: %v", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("Failed to encode key: %v", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+// client issues a certificate a client can present
+func (a *authority) client(t *testing.T, serial int64) tls.Certificate {
+	t.Helper()
+
+	pair, err := tls.X509KeyPair(a.issue(t, serial, x509.ExtKeyUsageClientAuth))
+	if err != nil {
+		t.Fatalf("Failed to load client pair: %v", err)
+	}
+	return pair
+}
+
+func writeFile(t *testing.T, path string, data []byte, modTime time.Time) {
+	t.Helper()
+
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		t.Fatalf("Failed to write %s: %v", path, err)
+	}
+	if err := os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatalf("Failed to set mtime of %s: %v", path, err)
+	}
+}
+
+// serve runs an HTTPS server with config and returns its address
+func serve(t *testing.T, config *tls.Config) string {
+	t.Helper()
+
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", config)
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	server := &http.Server{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			io.WriteString(w, "ok")
+		}),
+		// Rejected handshakes are expected
+		ErrorLog: log.New(io.Discard, "", 0),
+	}
+	go server.Serve(listener)
+	t.Cleanup(func() { server.Close() })
+	return listener.Addr().String()
+}
+
+// get makes a request on a fresh connection and returns the server's
+// certificate serial number. A client certificate is presented even if the
+// server does not name its CA.
+func get(addr string, roots *x509.CertPool, clientCert *tls.Certificate) (int64, error) {
+	client := &http.Client{Transport: &http.Transport{
+		DisableKeepAlives: true,
+		TLSClientConfig: &tls.Config{
+			RootCAs:    roots,
+			ServerName: "localhost",
+			GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+				if clientCert == nil {
+					return &tls.Certificate{}, nil
+				}
+				return clientCert, nil
+			},
+		},
+	}}
+	resp, err := client.Get("https://" + addr)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if _, err := io.ReadAll(resp.Body); err != nil {
+		return 0, err
+	}
+	return resp.TLS.PeerCertificates[0].SerialNumber.Int64(), nil
+}
+
+func quietLogger() *logrus.Logger {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	return logger
+}
+
+func TestReloader_PicksUpRenewedCertificate(t *testing.T) {
+	ca := newAuthority(t, "Test CA")
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key")
+
+	certPEM, keyPEM := ca.issue(t, 100, x509.ExtKeyUsageServerAuth)
+	issued := time.Now().Add(-time.Minute)
+	writeFile(t, certFile, certPEM, issued)
+	writeFile(t, keyFile, keyPEM, issued)
+
+	reloader, err := NewReloader(certFile, keyFile, quietLogger())
+	if err != nil {
+		t.Fatalf("Failed to load certificate: %v", err)
+	}
+	now := time.Now()
+	reloader.now = func() time.Time { return now }
+
+	config, err := ServerConfig(reloader, ClientAuthNone, "")
+	if err != nil {
+		t.Fatalf("Failed to build TLS config: %v", err)
+	}
+	addr := serve(t, config)
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+
+	if serial, err := get(addr, roots, nil); err != nil || serial != 100 {
+		t.Fatalf("Expected certificate 100, got %d (%v)", serial, err)
+	}
+
+	// A broken renewal keeps the working certificate
+	writeFile(t, certFile, []byte("not a certificate"), issued.Add(time.Second))
+	now = now.Add(2 * checkInterval)
+	if serial, err := get(addr, roots, nil); err != nil || serial != 100 {
+		t.Errorf("Expected certificate 100 to be kept, got %d (%v)", serial, err)
+	}
+
+	certPEM, keyPEM = ca.issue(t, 200, x509.ExtKeyUsageServerAuth)
+	writeFile(t, certFile, certPEM, issued.Add(2*time.Second))
+	writeFile(t, keyFile, keyPEM, issued.Add(2*time.Second))
+	now = now.Add(2 * checkInterval)
+	if serial, err := get(addr, roots, nil); err != nil || serial != 200 {
+		t.Errorf("Expected renewed certificate 200, got %d (%v)", serial, err)
+	}
+
+	if _, err := NewReloader(filepath.Join(dir, "missing.crt"), keyFile, quietLogger()); err == nil {
+		t.Error("Expected a missing certificate to fail")
+	}
+}
+
+func TestServerConfig_ClientCertificates(t *testing.T) {
+	ca := newAuthority(t, "Test CA")
+	other := newAuthority(t, "Other CA")
+	dir := t.TempDir()
+
+	certFile, keyFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key")
+	certPEM, keyPEM := ca.issue(t, 100, x509.ExtKeyUsageServerAuth)
+	writeFile(t, certFile, certPEM, time.Now())
+	writeFile(t, keyFile, keyPEM, time.Now())
+	caFile := filepath.Join(dir, "clients.pem")
+	writeFile(t, caFile, ca.pem, time.Now())
+
+	reloader, err := NewReloader(certFile, keyFile, quietLogger())
+	if err != nil {
+		t.Fatalf("Failed to load certificate: %v", err)
+	}
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+	service := ca.client(t, 300)
+	stranger := other.client(t, 400)
+
+	cases := []struct {
+		policy string
+		cert   *tls.Certificate
+		ok     bool
+	}{
+		{ClientAuthRequire, nil, false},
+		{ClientAuthRequire, &service, true},
+		{ClientAuthRequire, &stranger, false},
+		{ClientAuthOptional, nil, true},
+		{ClientAuthOptional, &service, true},
+		{ClientAuthOptional, &stranger, false},
+	}
+	for _, tc := range cases {
+		config, err := ServerConfig(reloader, tc.policy, caFile)
+		if err != nil {
+			t.Fatalf("Failed to build TLS config: %v", err)
+		}
+		_, err = get(serve(t, config), roots, tc.cert)
+		if (err == nil) != tc.ok {
+			t.Errorf("%s with certificate %v: expected ok=%v, got %v", tc.policy, tc.cert != nil, tc.ok, err)
+		}
+	}
+
+	if _, err := ServerConfig(reloader, "sometimes", caFile); err == nil {
+		t.Error("Expected an unknown policy to be rejected")
+	}
+	if _, err := ServerConfig(reloader, ClientAuthRequire, filepath.Join(dir, "missing.pem")); err == nil {
+		t.Error("Expected a missing CA file to be rejected")
+	}
+}
@@ -0,0 +1,2728 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package contracts
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// AccessControlContractMetaData contains all meta data concerning the AccessControlContract contract.
+var AccessControlContractMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"previousAdminRole\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"newAdminRole\",\"type\":\"bytes32\"}],\"name\":\"RoleAdminChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"name\":\"RoleGranted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"name\":\"RoleRevoked\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"newQuota\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"updater\",\"type\":\"address\"}],\"name\":\"StorageQuotaUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"username\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"timestamp\",\"type\":\"uint256\"}],\"name\":\"UserRegistered\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"granter\",\"type\":\"address\"}],\"name\":\"UserRoleGranted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"revoker\",\"type\":\"address\"}],\"name\":\"UserRoleRevoked\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"moderator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"timestamp\",\"type\":\"uint256\"}],\"name\":\"UserSuspended\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"moderator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"timestamp\",\"type\":\"uint256\"}],\"name\":\"UserUnsuspended\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"ADMIN_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"DEFAULT_ADMIN_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"DOWNLOADER_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"MODERATOR_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"UPLOADER_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"VERIFIER_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"defaultStorageQuota\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getActiveUsers\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"}],\"name\":\"getRoleAdmin\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getTotalUsers\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"username\",\"type\":\"string\"}],\"name\":\"getUserAddress\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"}],\"name\":\"getUserProfile\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"}],\"name\":\"getUserStorageInfo\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"quota\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"used\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"available\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"}],\"name\":\"getUsername\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"grantRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"},{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"}],\"name\":\"grantRoleToUser\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"},{\"internalType\":\"bytes32\",\"name\":\"permission\",\"type\":\"bytes32\"}],\"name\":\"hasPermission\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"hasRole\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"}],\"name\":\"isUserActive\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"}],\"name\":\"isUserRegistered\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"maxStorageQuota\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"reduction\",\"type\":\"uint256\"}],\"name\":\"reduceStorageUsage\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"username\",\"type\":\"string\"}],\"name\":\"registerUser\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"registeredUsers\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"renounceRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"revokeRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"},{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"}],\"name\":\"revokeRoleFromUser\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"newDefaultQuota\",\"type\":\"uint256\"}],\"name\":\"setDefaultStorageQuota\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"newMaxQuota\",\"type\":\"uint256\"}],\"name\":\"setMaxStorageQuota\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"},{\"internalType\":\"bytes32\",\"name\":\"permission\",\"type\":\"bytes32\"},{\"internalType\":\"bool\",\"name\":\"allowed\",\"type\":\"bool\"}],\"name\":\"setPermission\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes4\",\"name\":\"interfaceId\",\"type\":\"bytes4\"}],\"name\":\"supportsInterface\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"}],\"name\":\"suspendUser\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalUsers\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"}],\"name\":\"unsuspendUser\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"updateActivity\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"newQuota\",\"type\":\"uint256\"}],\"name\":\"updateStorageQuota\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"additionalUsage\",\"type\":\"uint256\"}],\"name\":\"updateStorageUsage\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"userProfiles\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"username\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"registrationTimestamp\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"lastActivityTimestamp\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"storageQuota\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"storageUsed\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"isSuspended\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"name\":\"usernameToAddress\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+	Bin: "0x608060405263400000006006556419000000006007553480156200002257600080fd5b50600180556200003460003362000116565b620000607fa49807205ce4d355092ef5a8a18f56e8913cf4a201fbe287825b095693c217753362000116565b6200008c7f922390b27f65d828a1f7695b2eaae33fd0be87496792afddba1d25446a8fcb913362000116565b620000b87fa3e884b9717ba66c8dd31f7abb98c86660643264f5743d122de83f0cad5c96553362000116565b620000e47f0ce23c3e399818cfee81a7ab0880f714e53d7672b08df0fa62f2843416e1ea093362000116565b620001107f71f3d55856e4058ed06ee057d79ada615f65cdf5f9ee88181b914225088f834f3362000116565b620001b7565b6000828152602081815260408083206001600160a01b038516845290915290205460ff16620001b3576000828152602081815260408083206001600160a01b03851684529091529020805460ff19166001179055620001723390565b6001600160a01b0316816001600160a01b0316837f2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d60405160405180910390a45b5050565b611dd980620001c76000396000f3fe608060405234801561001057600080fd5b506004361061023d5760003560e01c8063704f1b941161013b578063bff1f9e1116100b8578063d547741f1161007c578063d547741f14610565578063e7705db614610578578063e98e44481461059f578063f5593607146104cd578063f825f143146105b257600080fd5b8063bff1f9e114610503578063c60dd0a71461050c578063c8ea8eed1461051f578063cddc32a414610532578063ce43c0321461054557600080fd5b8063987ee156116100ff578063987ee156146104ba5780639be572f6146104cd5780639ee20b15146104d5578063a217fddf146104e8578063b0619e85146104f057600080fd5b8063704f1b941461044f57806375b238fc14610462578063797669c9146104775780637daf37121461049e57806391d14854146104a757600080fd5b80632f2ff15d116101c95780634985e85c1161018d5780634985e85c146103a75780634e9e691a146103d2578063604c101d1461040057806361cc0766146104275780636b515fa71461043c57600080fd5b80632f2ff15d14610340578063332d56d71461035357806336568abe146103785780633b424f091461038b578063478656e51461039e57600080fd5b80631b0e1ffa116102105780631b0e1ffa146102ce5780631e153acb146102d65780631fbeef27146102e9578063248a9ca3146102fc5780632daa7cec1461032d57600080fd5b806301ffc9a714610242578063039d4cae1461026a5780630e50cee51461027f578063163f7522146102a2575b600080fd5b610255610250366004611825565b6105e6565b60405190151581526020015b60405180910390f35b61027d61027836600461186b565b61061d565b005b61025561028d366004611895565b60046020526000908152604090205460ff1681565b6102556102b0366004611895565b6001600160a01b031660009081526004602052604090205460ff1690565b61027d610685565b61027d6102e4366004611895565b6106d5565b61027d6102f73660046118b0565b610803565b61031f61030a3660046118b0565b60009081526020819052604090206001015490565b604051908152602001610261565b61027d61033b36600461186b565b610821565b61027d61034e3660046118c9565b610916565b610366610361366004611895565b610940565b60405161026196959493929190611945565b61027d6103863660046118c9565b610a00565b61027d610399366004611983565b610a7e565b61031f60075481565b6103ba6103b53660046119de565b610b08565b6040516001600160a01b039091168152602001610261565b6103e56103e0366004611895565b610b39565b60408051938452602084019290925290820152606001610261565b61031f7fa3e884b9717ba66c8dd31f7abb98c86660643264f5743d122de83f0cad5c965581565b61031f600080516020611d6483398151915281565b61027d61044a3660046118b0565b610bbd565b61027d61045d3660046119de565b610bdb565b61031f600080516020611d8483398151915281565b61031f7f71f3d55856e4058ed06ee057d79ada615f65cdf5f9ee88181b914225088f834f81565b61031f60065481565b6102556104b53660046118c9565b610e8e565b6103666104c8366004611895565b610eb7565b60055461031f565b6102556104e3366004611895565b610fde565b61031f600081565b6102556104fe36600461186b565b611024565b61031f60055481565b61027d61051a36600461186b565b61108c565b61027d61052d36600461186b565b61116f565b61027d610540366004611895565b611206565b610558610553366004611895565b611320565b6040516102619190611a8f565b61027d6105733660046118c9565b611403565b61031f7f0ce23c3e399818cfee81a7ab0880f714e53d7672b08df0fa62f2843416e1ea0981565b61027d6105ad36600461186b565b611428565b6103ba6105c03660046119de565b80516020818301810180516003825292820191909301209152546001600160a01b031681565b60006001600160e01b03198216637965db0b60e01b148061061757506301ffc9a760e01b6001600160e01b03198316145b92915050565b600080516020611d84833981519152610635816114c8565b61063f82846114d5565b60405182815233906001600160a01b038516907f52baf7d6d37ffa8e8c78a88bd162825565c66d8b8fcb356693cd4704c0d09109906020015b60405180910390a3505050565b3360009081526004602052604090205460ff166106bd5760405162461bcd60e51b81526004016106b490611aa2565b60405180910390fd5b33600090815260026020819052604090912042910155565b7f71f3d55856e4058ed06ee057d79ada615f65cdf5f9ee88181b914225088f834f6106ff816114c8565b6001600160a01b03821660009081526004602052604090205460ff166107375760405162461bcd60e51b81526004016106b490611aa2565b6001600160a01b03821660009081526002602052604090206005015460ff161561079c5760405162461bcd60e51b8152602060048201526016602482015275155cd95c88185b1c9958591e481cdd5cdc195b99195960521b60448201526064016106b4565b6001600160a01b03821660008181526002602052604090819020600501805460ff19166001179055513391907ff7760221cf53f8188ca0c1ddef184060c3cd7d0f8cfa168caa43a1c88f7da26a906107f79042815260200190565b60405180910390a35050565b600080516020611d8483398151915261081b816114c8565b50600655565b600080516020611d84833981519152610839816114c8565b6001600160a01b03831660009081526004602052604090205460ff166108715760405162461bcd60e51b81526004016106b490611aa2565b6007548211156108c35760405162461bcd60e51b815260206004820152601d60248201527f51756f74612065786365656473206d6178696d756d20616c6c6f77656400000060448201526064016106b4565b6001600160a01b03831660008181526002602052604090819020600301849055513391907f2349967681dfd5781e7d2990653e9f851fce73efd2702f52b551b2969a7d8a9b906106789086815260200190565b600082815260208190526040902060010154610931816114c8565b61093b838361153a565b505050565b60026020526000908152604090208054819061095b90611acf565b80601f016020809104026020016040519081016040528092919081815260200182805461098790611acf565b80156109d45780601f106109a9576101008083540402835291602001916109d4565b820191906000526020600020905b8154815290600101906020018083116109b757829003601f168201915b505050600184015460028501546003860154600487015460059097015495969295919450925060ff1686565b6001600160a01b0381163314610a705760405162461bcd60e51b815260206004820152602f60248201527f416363657373436f6e74726f6c3a2063616e206f6e6c792072656e6f756e636560448201526e103937b632b9903337b91039b2b63360891b60648201526084016106b4565b610a7a82826114d5565b5050565b600080516020611d84833981519152610a96816114c8565b6001600160a01b03841660009081526004602052604090205460ff16610ace5760405162461bcd60e51b81526004016106b490611aa2565b506001600160a01b039290921660009081526002602090815260408083209383526006909301905220805460ff1916911515919091179055565b6000600382604051610b1a9190611b09565b908152604051908190036020019020546001600160a01b031692915050565b6001600160a01b0381166000908152600460205260408120548190819060ff16610b755760405162461bcd60e51b81526004016106b490611aa2565b6001600160a01b0384166000908152600260205260409020600381015460048201549094509250828411610baa576000610bb4565b610bb48385611b3b565b93959294505050565b600080516020611d84833981519152610bd5816114c8565b50600755565b80600381511015610c235760405162461bcd60e51b8152602060048201526012602482015271155cd95c9b985b59481d1bdbc81cda1bdc9d60721b60448201526064016106b4565b602081511115610c695760405162461bcd60e51b8152602060048201526011602482015270557365726e616d6520746f6f206c6f6e6760781b60448201526064016106b4565b60006001600160a01b0316600382604051610c849190611b09565b908152604051908190036020019020546001600160a01b031614610ce35760405162461bcd60e51b81526020600482015260166024820152752ab9b2b93730b6b29030b63932b0b23c903a30b5b2b760511b60448201526064016106b4565b610ceb6115be565b3360009081526004602052604090205460ff1615610d4b5760405162461bcd60e51b815260206004820152601760248201527f5573657220616c7265616479207265676973746572656400000000000000000060448201526064016106b4565b33600090815260026020526040902080610d658482611b9c565b50426001820181905560028201556006546003808301919091556000600483015560058201805460ff19169055604051339190610da3908690611b09565b908152604080516020928190038301902080546001600160a01b0319166001600160a01b0394909416939093179092553360009081526004909152908120805460ff191660011790556005805491610dfa83611c5c565b9190505550610e17600080516020611d648339815191523361153a565b610e417fa3e884b9717ba66c8dd31f7abb98c86660643264f5743d122de83f0cad5c96553361153a565b336001600160a01b03167f89105a1c6a3c2fbd471255c66a31ccab604af5697f67d7e2e9a0028c5e4dbd918442604051610e7c929190611c75565b60405180910390a250610a7a60018055565b6000918252602082815260408084206001600160a01b0393909316845291905290205460ff1690565b6001600160a01b03811660009081526004602052604081205460609190819081908190819060ff16610efb5760405162461bcd60e51b81526004016106b490611aa2565b6001600160a01b0387166000908152600260208190526040909120600181015491810154600382015460048301546005840154845494958695909493929160ff16908690610f4890611acf565b80601f0160208091040260200160405190810160405280929190818152602001828054610f7490611acf565b8015610fc15780601f10610f9657610100808354040283529160200191610fc1565b820191906000526020600020905b815481529060010190602001808311610fa457829003601f168201915b505050505095509650965096509650965096505091939550919395565b6001600160a01b03811660009081526004602052604081205460ff1680156106175750506001600160a01b031660009081526002602052604090206005015460ff161590565b6001600160a01b03821660009081526004602052604081205460ff1661105c5760405162461bcd60e51b81526004016106b490611aa2565b506001600160a01b0391909116600090815260026020908152604080832093835260069093019052205460ff1690565b600080516020611d648339815191526110a4816114c8565b6001600160a01b03831660009081526004602052604090205460ff166110dc5760405162461bcd60e51b81526004016106b490611aa2565b6001600160a01b038316600090815260026020526040902060038101546004820154611109908590611c97565b11156111505760405162461bcd60e51b815260206004820152601660248201527514dd1bdc9859d9481c5d5bdd1848195e18d95959195960521b60448201526064016106b4565b828160040160008282546111649190611c97565b909155505050505050565b600080516020611d84833981519152611187816114c8565b6001600160a01b03831660009081526004602052604090205460ff166111bf5760405162461bcd60e51b81526004016106b490611aa2565b6111c9828461153a565b60405182815233906001600160a01b038516907fc872def599bcebf2e8a06f60f9b0bb89c9f470341c992898f1e23b9b372e6ca690602001610678565b7f71f3d55856e4058ed06ee057d79ada615f65cdf5f9ee88181b914225088f834f611230816114c8565b6001600160a01b03821660009081526004602052604090205460ff166112685760405162461bcd60e51b81526004016106b490611aa2565b6001600160a01b03821660009081526002602052604090206005015460ff166112c85760405162461bcd60e51b8152602060048201526012602482015271155cd95c881b9bdd081cdd5cdc195b99195960721b60448201526064016106b4565b6001600160a01b03821660008181526002602052604090819020600501805460ff19169055513391907f5c3cbcec29ca81b0c591e9481e017bc106603d1df4653b35f75165348c80c728906107f79042815260200190565b6001600160a01b03811660009081526004602052604090205460609060ff1661135b5760405162461bcd60e51b81526004016106b490611aa2565b6001600160a01b0382166000908152600260205260409020805461137e90611acf565b80601f01602080910402602001604051908101604052809291908181526020018280546113aa90611acf565b80156113f75780601f106113cc576101008083540402835291602001916113f7565b820191906000526020600020905b8154815290600101906020018083116113da57829003601f168201915b50505050509050919050565b60008281526020819052604090206001015461141e816114c8565b61093b83836114d5565b600080516020611d64833981519152611440816114c8565b6001600160a01b03831660009081526004602052604090205460ff166114785760405162461bcd60e51b81526004016106b490611aa2565b6001600160a01b0383166000908152600260205260409020600481015483116114ba57828160040160008282546114af9190611b3b565b909155506114c29050565b600060048201555b50505050565b6114d28133611617565b50565b6114df8282610e8e565b15610a7a576000828152602081815260408083206001600160a01b0385168085529252808320805460ff1916905551339285917ff6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b9190a45050565b6115448282610e8e565b610a7a576000828152602081815260408083206001600160a01b03851684529091529020805460ff1916600117905561157a3390565b6001600160a01b0316816001600160a01b0316837f2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d60405160405180910390a45050565b6002600154036116105760405162461bcd60e51b815260206004820152601f60248201527f5265656e7472616e637947756172643a207265656e7472616e742063616c6c0060448201526064016106b4565b6002600155565b6116218282610e8e565b610a7a5761162e81611670565b611639836020611682565b60405160200161164a929190611caa565b60408051601f198184030181529082905262461bcd60e51b82526106b491600401611a8f565b60606106176001600160a01b03831660145b60606000611691836002611d1f565b61169c906002611c97565b67ffffffffffffffff8111156116b4576116b46119c8565b6040519080825280601f01601f1916602001820160405280156116de576020820181803683370190505b509050600360fc1b816000815181106116f9576116f9611d36565b60200101906001600160f81b031916908160001a905350600f60fb1b8160018151811061172857611728611d36565b60200101906001600160f81b031916908160001a905350600061174c846002611d1f565b611757906001611c97565b90505b60018111156117cf576f181899199a1a9b1b9c1cb0b131b232b360811b85600f166010811061178b5761178b611d36565b1a60f81b8282815181106117a1576117a1611d36565b60200101906001600160f81b031916908160001a90535060049490941c936117c881611d4c565b905061175a565b50831561181e5760405162461bcd60e51b815260206004820181905260248201527f537472696e67733a20686578206c656e67746820696e73756666696369656e7460448201526064016106b4565b9392505050565b60006020828403121561183757600080fd5b81356001600160e01b03198116811461181e57600080fd5b80356001600160a01b038116811461186657600080fd5b919050565b6000806040838503121561187e57600080fd5b6118878361184f565b946020939093013593505050565b6000602082840312156118a757600080fd5b61181e8261184f565b6000602082840312156118c257600080fd5b5035919050565b600080604083850312156118dc57600080fd5b823591506118ec6020840161184f565b90509250929050565b60005b838110156119105781810151838201526020016118f8565b50506000910152565b600081518084526119318160208601602086016118f5565b601f01601f19169290920160200192915050565b60c08152600061195860c0830189611919565b602083019790975250604081019490945260608401929092526080830152151560a090910152919050565b60008060006060848603121561199857600080fd5b6119a18461184f565b925060208401359150604084013580151581146119bd57600080fd5b809150509250925092565b634e487b7160e01b600052604160045260246000fd5b6000602082840312156119f057600080fd5b813567ffffffffffffffff80821115611a0857600080fd5b818401915084601f830112611a1c57600080fd5b813581811115611a2e57611a2e6119c8565b604051601f8201601f19908116603f01168101908382118183101715611a5657611a566119c8565b81604052828152876020848701011115611a6f57600080fd5b826020860160208301376000928101602001929092525095945050505050565b60208152600061181e6020830184611919565b602080825260139082015272155cd95c881b9bdd081c9959da5cdd195c9959606a1b604082015260600190565b600181811c90821680611ae357607f821691505b602082108103611b0357634e487b7160e01b600052602260045260246000fd5b50919050565b60008251611b1b8184602087016118f5565b9190910192915050565b634e487b7160e01b600052601160045260246000fd5b8181038181111561061757610617611b25565b601f82111561093b57600081815260208120601f850160051c81016020861015611b755750805b601f850160051c820191505b81811015611b9457828155600101611b81565b505050505050565b815167ffffffffffffffff811115611bb657611bb66119c8565b611bca81611bc48454611acf565b84611b4e565b602080601f831160018114611bff5760008415611be75750858301515b600019600386901b1c1916600185901b178555611b94565b600085815260208120601f198616915b82811015611c2e57888601518255948401946001909101908401611c0f565b5085821015611c4c5787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b600060018201611c6e57611c6e611b25565b5060010190565b604081526000611c886040830185611919565b90508260208301529392505050565b8082018082111561061757610617611b25565b7f416363657373436f6e74726f6c3a206163636f756e7420000000000000000000815260008351611ce28160178501602088016118f5565b7001034b99036b4b9b9b4b733903937b6329607d1b6017918401918201528351611d138160288401602088016118f5565b01602801949350505050565b808202811582820484141761061757610617611b25565b634e487b7160e01b600052603260045260246000fd5b600081611d5b57611d5b611b25565b50600019019056fe922390b27f65d828a1f7695b2eaae33fd0be87496792afddba1d25446a8fcb91a49807205ce4d355092ef5a8a18f56e8913cf4a201fbe287825b095693c21775a26469706673582212206b71c629ccacc406f486651c2ea8b772498a9affd054ef3171d0b8332db5c16a64736f6c63430008130033",
+}
+
+// AccessControlContractABI is the input ABI used to generate the binding from.
+// Deprecated: Use AccessControlContractMetaData.ABI instead.
+var AccessControlContractABI = AccessControlContractMetaData.ABI
+
+// AccessControlContractBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use AccessControlContractMetaData.Bin instead.
+var AccessControlContractBin = AccessControlContractMetaData.Bin
+
+// DeployAccessControlContract deploys a new Ethereum contract, binding an instance of AccessControlContract to it.
+func DeployAccessControlContract(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *AccessControlContract, error) {
+	parsed, err := AccessControlContractMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(AccessControlContractBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &AccessControlContract{AccessControlContractCaller: AccessControlContractCaller{contract: contract}, AccessControlContractTransactor: AccessControlContractTransactor{contract: contract}, AccessControlContractFilterer: AccessControlContractFilterer{contract: contract}}, nil
+}
+
+// AccessControlContract is an auto generated Go binding around an Ethereum contract.
+type AccessControlContract struct {
+	AccessControlContractCaller     // Read-only binding to the contract
+	AccessControlContractTransactor // Write-only binding to the contract
+	AccessControlContractFilterer   // Log filterer for contract events
+}
+
+// AccessControlContractCaller is an auto generated read-only Go binding around an Ethereum contract.
+type AccessControlContractCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// AccessControlContractTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type AccessControlContractTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// AccessControlContractFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type AccessControlContractFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// AccessControlContractSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type AccessControlContractSession struct {
+	Contract     *AccessControlContract // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts          // Call options to use throughout this session
+	TransactOpts bind.TransactOpts      // Transaction auth options to use throughout this session
+}
+
+// AccessControlContractCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type AccessControlContractCallerSession struct {
+	Contract *AccessControlContractCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                // Call options to use throughout this session
+}
+
+// AccessControlContractTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type AccessControlContractTransactorSession struct {
+	Contract     *AccessControlContractTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                // Transaction auth options to use throughout this session
+}
+
+// AccessControlContractRaw is an auto generated low-level Go binding around an Ethereum contract.
+type AccessControlContractRaw struct {
+	Contract *AccessControlContract // Generic contract binding to access the raw methods on
+}
+
+// AccessControlContractCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type AccessControlContractCallerRaw struct {
+	Contract *AccessControlContractCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// AccessControlContractTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type AccessControlContractTransactorRaw struct {
+	Contract *AccessControlContractTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewAccessControlContract creates a new instance of AccessControlContract, bound to a specific deployed contract.
+func NewAccessControlContract(address common.Address, backend bind.ContractBackend) (*AccessControlContract, error) {
+	contract, err := bindAccessControlContract(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &AccessControlContract{AccessControlContractCaller: AccessControlContractCaller{contract: contract}, AccessControlContractTransactor: AccessControlContractTransactor{contract: contract}, AccessControlContractFilterer: AccessControlContractFilterer{contract: contract}}, nil
+}
+
+// NewAccessControlContractCaller creates a new read-only instance of AccessControlContract, bound to a specific deployed contract.
+func NewAccessControlContractCaller(address common.Address, caller bind.ContractCaller) (*AccessControlContractCaller, error) {
+	contract, err := bindAccessControlContract(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &AccessControlContractCaller{contract: contract}, nil
+}
+
+// NewAccessControlContractTransactor creates a new write-only instance of AccessControlContract, bound to a specific deployed contract.
+func NewAccessControlContractTransactor(address common.Address, transactor bind.ContractTransactor) (*AccessControlContractTransactor, error) {
+	contract, err := bindAccessControlContract(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &AccessControlContractTransactor{contract: contract}, nil
+}
+
+// NewAccessControlContractFilterer creates a new log filterer instance of AccessControlContract, bound to a specific deployed contract.
+func NewAccessControlContractFilterer(address common.Address, filterer bind.ContractFilterer) (*AccessControlContractFilterer, error) {
+	contract, err := bindAccessControlContract(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &AccessControlContractFilterer{contract: contract}, nil
+}
+
+// bindAccessControlContract binds a generic wrapper to an already deployed contract.
+func bindAccessControlContract(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := AccessControlContractMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_AccessControlContract *AccessControlContractRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _AccessControlContract.Contract.AccessControlContractCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_AccessControlContract *AccessControlContractRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _AccessControlContract.Contract.AccessControlContractTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_AccessControlContract *AccessControlContractRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _AccessControlContract.Contract.AccessControlContractTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_AccessControlContract *AccessControlContractCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _AccessControlContract.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_AccessControlContract *AccessControlContractTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _AccessControlContract.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_AccessControlContract *AccessControlContractTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _AccessControlContract.Contract.contract.Transact(opts, method, params...)
+}
+
+// ADMINROLE is a free data retrieval call binding the contract method 0x75b238fc.
+//
+// Solidity: function ADMIN_ROLE() view returns(bytes32)
+func (_AccessControlContract *AccessControlContractCaller) ADMINROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _AccessControlContract.contract.Call(opts, &out, "ADMIN_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// ADMINROLE is a free data retrieval call binding the contract method 0x75b238fc.
+//
+// Solidity: function ADMIN_ROLE() view returns(bytes32)
+func (_AccessControlContract *AccessControlContractSession) ADMINROLE() ([32]byte, error) {
+	return _AccessControlContract.Contract.ADMINROLE(&_AccessControlContract.CallOpts)
+}
+
+// ADMINROLE is a free data retrieval call binding the contract method 0x75b238fc.
+//
+// Solidity: function ADMIN_ROLE() view returns(bytes32)
+func (_AccessControlContract *AccessControlContractCallerSession) ADMINROLE() ([32]byte, error) {
+	return _AccessControlContract.Contract.ADMINROLE(&_AccessControlContract.CallOpts)
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_AccessControlContract *AccessControlContractCaller) DEFAULTADMINROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _AccessControlContract.contract.Call(opts, &out, "DEFAULT_ADMIN_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_AccessControlContract *AccessControlContractSession) DEFAULTADMINROLE() ([32]byte, error) {
+	return _AccessControlContract.Contract.DEFAULTADMINROLE(&_AccessControlContract.CallOpts)
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_AccessControlContract *AccessControlContractCallerSession) DEFAULTADMINROLE() ([32]byte, error) {
+	return _AccessControlContract.Contract.DEFAULTADMINROLE(&_AccessControlContract.CallOpts)
+}
+
+// DOWNLOADERROLE is a free data retrieval call binding the contract method 0x604c101d.
+//
+// Solidity: function DOWNLOADER_ROLE() view returns(bytes32)
+func (_AccessControlContract *AccessControlContractCaller) DOWNLOADERROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _AccessControlContract.contract.Call(opts, &out, "DOWNLOADER_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// DOWNLOADERROLE is a free data retrieval call binding the contract method 0x604c101d.
+//
+// Solidity: function DOWNLOADER_ROLE() view returns(bytes32)
+func (_AccessControlContract *AccessControlContractSession) DOWNLOADERROLE() ([32]byte, error) {
+	return _AccessControlContract.Contract.DOWNLOADERROLE(&_AccessControlContract.CallOpts)
+}
+
+// DOWNLOADERROLE is a free data retrieval call binding the contract method 0x604c101d.
+//
+// Solidity: function DOWNLOADER_ROLE() view returns(bytes32)
+func (_AccessControlContract *AccessControlContractCallerSession) DOWNLOADERROLE() ([32]byte, error) {
+	return _AccessControlContract.Contract.DOWNLOADERROLE(&_AccessControlContract.CallOpts)
+}
+
+// MODERATORROLE is a free data retrieval call binding the contract method 0x797669c9.
+//
+// Solidity: function MODERATOR_ROLE() view returns(bytes32)
+func (_AccessControlContract *AccessControlContractCaller) MODERATORROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _AccessControlContract.contract.Call(opts, &out, "MODERATOR_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// MODERATORROLE is a free data retrieval call binding the contract method 0x797669c9.
+//
+// Solidity: function MODERATOR_ROLE() view returns(bytes32)
+func (_AccessControlContract *AccessControlContractSession) MODERATORROLE() ([32]byte, error) {
+	return _AccessControlContract.Contract.MODERATORROLE(&_AccessControlContract.CallOpts)
+}
+
+// MODERATORROLE is a free data retrieval call binding the contract method 0x797669c9.
+//
+// Solidity: function MODERATOR_ROLE() view returns(bytes32)
+func (_AccessControlContract *AccessControlContractCallerSession) MODERATORROLE() ([32]byte, error) {
+	return _AccessControlContract.Contract.MODERATORROLE(&_AccessControlContract.CallOpts)
+}
+
+// UPLOADERROLE is a free data retrieval call binding the contract method 0x61cc0766.
+//
+// Solidity: function UPLOADER_ROLE() view returns(bytes32)
+func (_AccessControlContract *AccessControlContractCaller) UPLOADERROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _AccessControlContract.contract.Call(opts, &out, "UPLOADER_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// UPLOADERROLE is a free data retrieval call binding the contract method 0x61cc0766.
+//
+// Solidity: function UPLOADER_ROLE() view returns(bytes32)
+func (_AccessControlContract *AccessControlContractSession) UPLOADERROLE() ([32]byte, error) {
+	return _AccessControlContract.Contract.UPLOADERROLE(&_AccessControlContract.CallOpts)
+}
+
+// UPLOADERROLE is a free data retrieval call binding the contract method 0x61cc0766.
+//
+// Solidity: function UPLOADER_ROLE() view returns(bytes32)
+func (_AccessControlContract *AccessControlContractCallerSession) UPLOADERROLE() ([32]byte, error) {
+	return _AccessControlContract.Contract.UPLOADERROLE(&_AccessControlContract.CallOpts)
+}
+
+// VERIFIERROLE is a free data retrieval call binding the contract method 0xe7705db6.
+//
+// Solidity: function VERIFIER_ROLE() view returns(bytes32)
+func (_AccessControlContract *AccessControlContractCaller) VERIFIERROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _AccessControlContract.contract.Call(opts, &out, "VERIFIER_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// VERIFIERROLE is a free data retrieval call binding the contract method 0xe7705db6.
+//
+// Solidity: function VERIFIER_ROLE() view returns(bytes32)
+func (_AccessControlContract *AccessControlContractSession) VERIFIERROLE() ([32]byte, error) {
+	return _AccessControlContract.Contract.VERIFIERROLE(&_AccessControlContract.CallOpts)
+}
+
+// VERIFIERROLE is a free data retrieval call binding the contract method 0xe7705db6.
+//
+// Solidity: function VERIFIER_ROLE() view returns(bytes32)
+func (_AccessControlContract *AccessControlContractCallerSession) VERIFIERROLE() ([32]byte, error) {
+	return _AccessControlContract.Contract.VERIFIERROLE(&_AccessControlContract.CallOpts)
+}
+
+// DefaultStorageQuota is a free data retrieval call binding the contract method 0x7daf3712.
+//
+// Solidity: function defaultStorageQuota() view returns(uint256)
+func (_AccessControlContract *AccessControlContractCaller) DefaultStorageQuota(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _AccessControlContract.contract.Call(opts, &out, "defaultStorageQuota")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// DefaultStorageQuota is a free data retrieval call binding the contract method 0x7daf3712.
+//
+// Solidity: function defaultStorageQuota() view returns(uint256)
+func (_AccessControlContract *AccessControlContractSession) DefaultStorageQuota() (*big.Int, error) {
+	return _AccessControlContract.Contract.DefaultStorageQuota(&_AccessControlContract.CallOpts)
+}
+
+// DefaultStorageQuota is a free data retrieval call binding the contract method 0x7daf3712.
+//
+// Solidity: function defaultStorageQuota() view returns(uint256)
+func (_AccessControlContract *AccessControlContractCallerSession) DefaultStorageQuota() (*big.Int, error) {
+	return _AccessControlContract.Contract.DefaultStorageQuota(&_AccessControlContract.CallOpts)
+}
+
+// GetActiveUsers is a free data retrieval call binding the contract method 0xf5593607.
+//
+// Solidity: function getActiveUsers() view returns(uint256)
+func (_AccessControlContract *AccessControlContractCaller) GetActiveUsers(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _AccessControlContract.contract.Call(opts, &out, "getActiveUsers")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetActiveUsers is a free data retrieval call binding the contract method 0xf5593607.
+//
+// Solidity: function getActiveUsers() view returns(uint256)
+func (_AccessControlContract *AccessControlContractSession) GetActiveUsers() (*big.Int, error) {
+	return _AccessControlContract.Contract.GetActiveUsers(&_AccessControlContract.CallOpts)
+}
+
+// GetActiveUsers is a free data retrieval call binding the contract method 0xf5593607.
+//
+// Solidity: function getActiveUsers() view returns(uint256)
+func (_AccessControlContract *AccessControlContractCallerSession) GetActiveUsers() (*big.Int, error) {
+	return _AccessControlContract.Contract.GetActiveUsers(&_AccessControlContract.CallOpts)
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_AccessControlContract *AccessControlContractCaller) GetRoleAdmin(opts *bind.CallOpts, role [32]byte) ([32]byte, error) {
+	var out []interface{}
+	err := _AccessControlContract.contract.Call(opts, &out, "getRoleAdmin", role)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_AccessControlContract *AccessControlContractSession) GetRoleAdmin(role [32]byte) ([32]byte, error) {
+	return _AccessControlContract.Contract.GetRoleAdmin(&_AccessControlContract.CallOpts, role)
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_AccessControlContract *AccessControlContractCallerSession) GetRoleAdmin(role [32]byte) ([32]byte, error) {
+	return _AccessControlContract.Contract.GetRoleAdmin(&_AccessControlContract.CallOpts, role)
+}
+
+// GetTotalUsers is a free data retrieval call binding the contract method 0x9be572f6.
+//
+// Solidity: function getTotalUsers() view returns(uint256)
+func (_AccessControlContract *AccessControlContractCaller) GetTotalUsers(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _AccessControlContract.contract.Call(opts, &out, "getTotalUsers")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetTotalUsers is a free data retrieval call binding the contract method 0x9be572f6.
+//
+// Solidity: function getTotalUsers() view returns(uint256)
+func (_AccessControlContract *AccessControlContractSession) GetTotalUsers() (*big.Int, error) {
+	return _AccessControlContract.Contract.GetTotalUsers(&_AccessControlContract.CallOpts)
+}
+
+// GetTotalUsers is a free data retrieval call binding the contract method 0x9be572f6.
+//
+// Solidity: function getTotalUsers() view returns(uint256)
+func (_AccessControlContract *AccessControlContractCallerSession) GetTotalUsers() (*big.Int, error) {
+	return _AccessControlContract.Contract.GetTotalUsers(&_AccessControlContract.CallOpts)
+}
+
+// GetUserAddress is a free data retrieval call binding the contract method 0x4985e85c.
+//
+// Solidity: function getUserAddress(string username) view returns(address)
+func (_AccessControlContract *AccessControlContractCaller) GetUserAddress(opts *bind.CallOpts, username string) (common.Address, error) {
+	var out []interface{}
+	err := _AccessControlContract.contract.Call(opts, &out, "getUserAddress", username)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetUserAddress is a free data retrieval call binding the contract method 0x4985e85c.
+//
+// Solidity: function getUserAddress(string username) view returns(address)
+func (_AccessControlContract *AccessControlContractSession) GetUserAddress(username string) (common.Address, error) {
+	return _AccessControlContract.Contract.GetUserAddress(&_AccessControlContract.CallOpts, username)
+}
+
+// GetUserAddress is a free data retrieval call binding the contract method 0x4985e85c.
+//
+// Solidity: function getUserAddress(string username) view returns(address)
+func (_AccessControlContract *AccessControlContractCallerSession) GetUserAddress(username string) (common.Address, error) {
+	return _AccessControlContract.Contract.GetUserAddress(&_AccessControlContract.CallOpts, username)
+}
+
+// GetUserProfile is a free data retrieval call binding the contract method 0x987ee156.
+//
+// Solidity: function getUserProfile(address user) view returns(string, uint256, uint256, uint256, uint256, bool)
+func (_AccessControlContract *AccessControlContractCaller) GetUserProfile(opts *bind.CallOpts, user common.Address) (string, *big.Int, *big.Int, *big.Int, *big.Int, bool, error) {
+	var out []interface{}
+	err := _AccessControlContract.contract.Call(opts, &out, "getUserProfile", user)
+
+	if err != nil {
+		return *new(string), *new(*big.Int), *new(*big.Int), *new(*big.Int), *new(*big.Int), *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+	out1 := *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	out2 := *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	out3 := *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+	out4 := *abi.ConvertType(out[4], new(*big.Int)).(**big.Int)
+	out5 := *abi.ConvertType(out[5], new(bool)).(*bool)
+
+	return out0, out1, out2, out3, out4, out5, err
+
+}
+
+// GetUserProfile is a free data retrieval call binding the contract method 0x987ee156.
+//
+// Solidity: function getUserProfile(address user) view returns(string, uint256, uint256, uint256, uint256, bool)
+func (_AccessControlContract *AccessControlContractSession) GetUserProfile(user common.Address) (string, *big.Int, *big.Int, *big.Int, *big.Int, bool, error) {
+	return _AccessControlContract.Contract.GetUserProfile(&_AccessControlContract.CallOpts, user)
+}
+
+// GetUserProfile is a free data retrieval call binding the contract method 0x987ee156.
+//
+// Solidity: function getUserProfile(address user) view returns(string, uint256, uint256, uint256, uint256, bool)
+func (_AccessControlContract *AccessControlContractCallerSession) GetUserProfile(user common.Address) (string, *big.Int, *big.Int, *big.Int, *big.Int, bool, error) {
+	return _AccessControlContract.Contract.GetUserProfile(&_AccessControlContract.CallOpts, user)
+}
+
+// GetUserStorageInfo is a free data retrieval call binding the contract method 0x4e9e691a.
+//
+// Solidity: function getUserStorageInfo(address user) view returns(uint256 quota, uint256 used, uint256 available)
+func (_AccessControlContract *AccessControlContractCaller) GetUserStorageInfo(opts *bind.CallOpts, user common.Address) (struct {
+	Quota     *big.Int
+	Used      *big.Int
+	Available *big.Int
+}, error) {
+	var out []interface{}
+	err := _AccessControlContract.contract.Call(opts, &out, "getUserStorageInfo", user)
+
+	outstruct := new(struct {
+		Quota     *big.Int
+		Used      *big.Int
+		Available *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Quota = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.Used = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.Available = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// GetUserStorageInfo is a free data retrieval call binding the contract method 0x4e9e691a.
+//
+// Solidity: function getUserStorageInfo(address user) view returns(uint256 quota, uint256 used, uint256 available)
+func (_AccessControlContract *AccessControlContractSession) GetUserStorageInfo(user common.Address) (struct {
+	Quota     *big.Int
+	Used      *big.Int
+	Available *big.Int
+}, error) {
+	return _AccessControlContract.Contract.GetUserStorageInfo(&_AccessControlContract.CallOpts, user)
+}
+
+// GetUserStorageInfo is a free data retrieval call binding the contract method 0x4e9e691a.
+//
+// Solidity: function getUserStorageInfo(address user) view returns(uint256 quota, uint256 used, uint256 available)
+func (_AccessControlContract *AccessControlContractCallerSession) GetUserStorageInfo(user common.Address) (struct {
+	Quota     *big.Int
+	Used      *big.Int
+	Available *big.Int
+}, error) {
+	return _AccessControlContract.Contract.GetUserStorageInfo(&_AccessControlContract.CallOpts, user)
+}
+
+// GetUsername is a free data retrieval call binding the contract method 0xce43c032.
+//
+// Solidity: function getUsername(address user) view returns(string)
+func (_AccessControlContract *AccessControlContractCaller) GetUsername(opts *bind.CallOpts, user common.Address) (string, error) {
+	var out []interface{}
+	err := _AccessControlContract.contract.Call(opts, &out, "getUsername", user)
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// GetUsername is a free data retrieval call binding the contract method 0xce43c032.
+//
+// Solidity: function getUsername(address user) view returns(string)
+func (_AccessControlContract *AccessControlContractSession) GetUsername(user common.Address) (string, error) {
+	return _AccessControlContract.Contract.GetUsername(&_AccessControlContract.CallOpts, user)
+}
+
+// GetUsername is a free data retrieval call binding the contract method 0xce43c032.
+//
+// Solidity: function getUsername(address user) view returns(string)
+func (_AccessControlContract *AccessControlContractCallerSession) GetUsername(user common.Address) (string, error) {
+	return _AccessControlContract.Contract.GetUsername(&_AccessControlContract.CallOpts, user)
+}
+
+// HasPermission is a free data retrieval call binding the contract method 0xb0619e85.
+//
+// Solidity: function hasPermission(address user, bytes32 permission) view returns(bool)
+func (_AccessControlContract *AccessControlContractCaller) HasPermission(opts *bind.CallOpts, user common.Address, permission [32]byte) (bool, error) {
+	var out []interface{}
+	err := _AccessControlContract.contract.Call(opts, &out, "hasPermission", user, permission)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// HasPermission is a free data retrieval call binding the contract method 0xb0619e85.
+//
+// Solidity: function hasPermission(address user, bytes32 permission) view returns(bool)
+func (_AccessControlContract *AccessControlContractSession) HasPermission(user common.Address, permission [32]byte) (bool, error) {
+	return _AccessControlContract.Contract.HasPermission(&_AccessControlContract.CallOpts, user, permission)
+}
+
+// HasPermission is a free data retrieval call binding the contract method 0xb0619e85.
+//
+// Solidity: function hasPermission(address user, bytes32 permission) view returns(bool)
+func (_AccessControlContract *AccessControlContractCallerSession) HasPermission(user common.Address, permission [32]byte) (bool, error) {
+	return _AccessControlContract.Contract.HasPermission(&_AccessControlContract.CallOpts, user, permission)
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_AccessControlContract *AccessControlContractCaller) HasRole(opts *bind.CallOpts, role [32]byte, account common.Address) (bool, error) {
+	var out []interface{}
+	err := _AccessControlContract.contract.Call(opts, &out, "hasRole", role, account)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_AccessControlContract *AccessControlContractSession) HasRole(role [32]byte, account common.Address) (bool, error) {
+	return _AccessControlContract.Contract.HasRole(&_AccessControlContract.CallOpts, role, account)
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_AccessControlContract *AccessControlContractCallerSession) HasRole(role [32]byte, account common.Address) (bool, error) {
+	return _AccessControlContract.Contract.HasRole(&_AccessControlContract.CallOpts, role, account)
+}
+
+// IsUserActive is a free data retrieval call binding the contract method 0x9ee20b15.
+//
+// Solidity: function isUserActive(address user) view returns(bool)
+func (_AccessControlContract *AccessControlContractCaller) IsUserActive(opts *bind.CallOpts, user common.Address) (bool, error) {
+	var out []interface{}
+	err := _AccessControlContract.contract.Call(opts, &out, "isUserActive", user)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsUserActive is a free data retrieval call binding the contract method 0x9ee20b15.
+//
+// Solidity: function isUserActive(address user) view returns(bool)
+func (_AccessControlContract *AccessControlContractSession) IsUserActive(user common.Address) (bool, error) {
+	return _AccessControlContract.Contract.IsUserActive(&_AccessControlContract.CallOpts, user)
+}
+
+// IsUserActive is a free data retrieval call binding the contract method 0x9ee20b15.
+//
+// Solidity: function isUserActive(address user) view returns(bool)
+func (_AccessControlContract *AccessControlContractCallerSession) IsUserActive(user common.Address) (bool, error) {
+	return _AccessControlContract.Contract.IsUserActive(&_AccessControlContract.CallOpts, user)
+}
+
+// IsUserRegistered is a free data retrieval call binding the contract method 0x163f7522.
+//
+// Solidity: function isUserRegistered(address user) view returns(bool)
+func (_AccessControlContract *AccessControlContractCaller) IsUserRegistered(opts *bind.CallOpts, user common.Address) (bool, error) {
+	var out []interface{}
+	err := _AccessControlContract.contract.Call(opts, &out, "isUserRegistered", user)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsUserRegistered is a free data retrieval call binding the contract method 0x163f7522.
+//
+// Solidity: function isUserRegistered(address user) view returns(bool)
+func (_AccessControlContract *AccessControlContractSession) IsUserRegistered(user common.Address) (bool, error) {
+	return _AccessControlContract.Contract.IsUserRegistered(&_AccessControlContract.CallOpts, user)
+}
+
+// IsUserRegistered is a free data retrieval call binding the contract method 0x163f7522.
+//
+// Solidity: function isUserRegistered(address user) view returns(bool)
+func (_AccessControlContract *AccessControlContractCallerSession) IsUserRegistered(user common.Address) (bool, error) {
+	return _AccessControlContract.Contract.IsUserRegistered(&_AccessControlContract.CallOpts, user)
+}
+
+// MaxStorageQuota is a free data retrieval call binding the contract method 0x478656e5.
+//
+// Solidity: function maxStorageQuota() view returns(uint256)
+func (_AccessControlContract *AccessControlContractCaller) MaxStorageQuota(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _AccessControlContract.contract.Call(opts, &out, "maxStorageQuota")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MaxStorageQuota is a free data retrieval call binding the contract method 0x478656e5.
+//
+// Solidity: function maxStorageQuota() view returns(uint256)
+func (_AccessControlContract *AccessControlContractSession) MaxStorageQuota() (*big.Int, error) {
+	return _AccessControlContract.Contract.MaxStorageQuota(&_AccessControlContract.CallOpts)
+}
+
+// MaxStorageQuota is a free data retrieval call binding the contract method 0x478656e5.
+//
+// Solidity: function maxStorageQuota() view returns(uint256)
+func (_AccessControlContract *AccessControlContractCallerSession) MaxStorageQuota() (*big.Int, error) {
+	return _AccessControlContract.Contract.MaxStorageQuota(&_AccessControlContract.CallOpts)
+}
+
+// RegisteredUsers is a free data retrieval call binding the contract method 0x0e50cee5.
+//
+// Solidity: function registeredUsers(address ) view returns(bool)
+func (_AccessControlContract *AccessControlContractCaller) RegisteredUsers(opts *bind.CallOpts, arg0 common.Address) (bool, error) {
+	var out []interface{}
+	err := _AccessControlContract.contract.Call(opts, &out, "registeredUsers", arg0)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// RegisteredUsers is a free data retrieval call binding the contract method 0x0e50cee5.
+//
+// Solidity: function registeredUsers(address ) view returns(bool)
+func (_AccessControlContract *AccessControlContractSession) RegisteredUsers(arg0 common.Address) (bool, error) {
+	return _AccessControlContract.Contract.RegisteredUsers(&_AccessControlContract.CallOpts, arg0)
+}
+
+// RegisteredUsers is a free data retrieval call binding the contract method 0x0e50cee5.
+//
+// Solidity: function registeredUsers(address ) view returns(bool)
+func (_AccessControlContract *AccessControlContractCallerSession) RegisteredUsers(arg0 common.Address) (bool, error) {
+	return _AccessControlContract.Contract.RegisteredUsers(&_AccessControlContract.CallOpts, arg0)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_AccessControlContract *AccessControlContractCaller) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+	var out []interface{}
+	err := _AccessControlContract.contract.Call(opts, &out, "supportsInterface", interfaceId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_AccessControlContract *AccessControlContractSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _AccessControlContract.Contract.SupportsInterface(&_AccessControlContract.CallOpts, interfaceId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_AccessControlContract *AccessControlContractCallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _AccessControlContract.Contract.SupportsInterface(&_AccessControlContract.CallOpts, interfaceId)
+}
+
+// TotalUsers is a free data retrieval call binding the contract method 0xbff1f9e1.
+//
+// Solidity: function totalUsers() view returns(uint256)
+func (_AccessControlContract *AccessControlContractCaller) TotalUsers(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _AccessControlContract.contract.Call(opts, &out, "totalUsers")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalUsers is a free data retrieval call binding the contract method 0xbff1f9e1.
+//
+// Solidity: function totalUsers() view returns(uint256)
+func (_AccessControlContract *AccessControlContractSession) TotalUsers() (*big.Int, error) {
+	return _AccessControlContract.Contract.TotalUsers(&_AccessControlContract.CallOpts)
+}
+
+// TotalUsers is a free data retrieval call binding the contract method 0xbff1f9e1.
+//
+// Solidity: function totalUsers() view returns(uint256)
+func (_AccessControlContract *AccessControlContractCallerSession) TotalUsers() (*big.Int, error) {
+	return _AccessControlContract.Contract.TotalUsers(&_AccessControlContract.CallOpts)
+}
+
+// UserProfiles is a free data retrieval call binding the contract method 0x332d56d7.
+//
+// Solidity: function userProfiles(address ) view returns(string username, uint256 registrationTimestamp, uint256 lastActivityTimestamp, uint256 storageQuota, uint256 storageUsed, bool isSuspended)
+func (_AccessControlContract *AccessControlContractCaller) UserProfiles(opts *bind.CallOpts, arg0 common.Address) (struct {
+	Username              string
+	RegistrationTimestamp *big.Int
+	LastActivityTimestamp *big.Int
+	StorageQuota          *big.Int
+	StorageUsed           *big.Int
+	IsSuspended           bool
+}, error) {
+	var out []interface{}
+	err := _AccessControlContract.contract.Call(opts, &out, "userProfiles", arg0)
+
+	outstruct := new(struct {
+		Username              string
+		RegistrationTimestamp *big.Int
+		LastActivityTimestamp *big.Int
+		StorageQuota          *big.Int
+		StorageUsed           *big.Int
+		IsSuspended           bool
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Username = *abi.ConvertType(out[0], new(string)).(*string)
+	outstruct.RegistrationTimestamp = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.LastActivityTimestamp = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	outstruct.StorageQuota = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+	outstruct.StorageUsed = *abi.ConvertType(out[4], new(*big.Int)).(**big.Int)
+	outstruct.IsSuspended = *abi.ConvertType(out[5], new(bool)).(*bool)
+
+	return *outstruct, err
+
+}
+
+// UserProfiles is a free data retrieval call binding the contract method 0x332d56d7.
+//
+// Solidity: function userProfiles(address ) view returns(string username, uint256 registrationTimestamp, uint256 lastActivityTimestamp, uint256 storageQuota, uint256 storageUsed, bool isSuspended)
+func (_AccessControlContract *AccessControlContractSession) UserProfiles(arg0 common.Address) (struct {
+	Username              string
+	RegistrationTimestamp *big.Int
+	LastActivityTimestamp *big.Int
+	StorageQuota          *big.Int
+	StorageUsed           *big.Int
+	IsSuspended           bool
+}, error) {
+	return _AccessControlContract.Contract.UserProfiles(&_AccessControlContract.CallOpts, arg0)
+}
+
+// UserProfiles is a free data retrieval call binding the contract method 0x332d56d7.
+//
+// Solidity: function userProfiles(address ) view returns(string username, uint256 registrationTimestamp, uint256 lastActivityTimestamp, uint256 storageQuota, uint256 storageUsed, bool isSuspended)
+func (_AccessControlContract *AccessControlContractCallerSession) UserProfiles(arg0 common.Address) (struct {
+	Username              string
+	RegistrationTimestamp *big.Int
+	LastActivityTimestamp *big.Int
+	StorageQuota          *big.Int
+	StorageUsed           *big.Int
+	IsSuspended           bool
+}, error) {
+	return _AccessControlContract.Contract.UserProfiles(&_AccessControlContract.CallOpts, arg0)
+}
+
+// UsernameToAddress is a free data retrieval call binding the contract method 0xf825f143.
+//
+// Solidity: function usernameToAddress(string ) view returns(address)
+func (_AccessControlContract *AccessControlContractCaller) UsernameToAddress(opts *bind.CallOpts, arg0 string) (common.Address, error) {
+	var out []interface{}
+	err := _AccessControlContract.contract.Call(opts, &out, "usernameToAddress", arg0)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// UsernameToAddress is a free data retrieval call binding the contract method 0xf825f143.
+//
+// Solidity: function usernameToAddress(string ) view returns(address)
+func (_AccessControlContract *AccessControlContractSession) UsernameToAddress(arg0 string) (common.Address, error) {
+	return _AccessControlContract.Contract.UsernameToAddress(&_AccessControlContract.CallOpts, arg0)
+}
+
+// UsernameToAddress is a free data retrieval call binding the contract method 0xf825f143.
+//
+// Solidity: function usernameToAddress(string ) view returns(address)
+func (_AccessControlContract *AccessControlContractCallerSession) UsernameToAddress(arg0 string) (common.Address, error) {
+	return _AccessControlContract.Contract.UsernameToAddress(&_AccessControlContract.CallOpts, arg0)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_AccessControlContract *AccessControlContractTransactor) GrantRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _AccessControlContract.contract.Transact(opts, "grantRole", role, account)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_AccessControlContract *AccessControlContractSession) GrantRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _AccessControlContract.Contract.GrantRole(&_AccessControlContract.TransactOpts, role, account)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_AccessControlContract *AccessControlContractTransactorSession) GrantRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _AccessControlContract.Contract.GrantRole(&_AccessControlContract.TransactOpts, role, account)
+}
+
+// GrantRoleToUser is a paid mutator transaction binding the contract method 0xc8ea8eed.
+//
+// Solidity: function grantRoleToUser(address user, bytes32 role) returns()
+func (_AccessControlContract *AccessControlContractTransactor) GrantRoleToUser(opts *bind.TransactOpts, user common.Address, role [32]byte) (*types.Transaction, error) {
+	return _AccessControlContract.contract.Transact(opts, "grantRoleToUser", user, role)
+}
+
+// GrantRoleToUser is a paid mutator transaction binding the contract method 0xc8ea8eed.
+//
+// Solidity: function grantRoleToUser(address user, bytes32 role) returns()
+func (_AccessControlContract *AccessControlContractSession) GrantRoleToUser(user common.Address, role [32]byte) (*types.Transaction, error) {
+	return _AccessControlContract.Contract.GrantRoleToUser(&_AccessControlContract.TransactOpts, user, role)
+}
+
+// GrantRoleToUser is a paid mutator transaction binding the contract method 0xc8ea8eed.
+//
+// Solidity: function grantRoleToUser(address user, bytes32 role) returns()
+func (_AccessControlContract *AccessControlContractTransactorSession) GrantRoleToUser(user common.Address, role [32]byte) (*types.Transaction, error) {
+	return _AccessControlContract.Contract.GrantRoleToUser(&_AccessControlContract.TransactOpts, user, role)
+}
+
+// ReduceStorageUsage is a paid mutator transaction binding the contract method 0xe98e4448.
+//
+// Solidity: function reduceStorageUsage(address user, uint256 reduction) returns()
+func (_AccessControlContract *AccessControlContractTransactor) ReduceStorageUsage(opts *bind.TransactOpts, user common.Address, reduction *big.Int) (*types.Transaction, error) {
+	return _AccessControlContract.contract.Transact(opts, "reduceStorageUsage", user, reduction)
+}
+
+// ReduceStorageUsage is a paid mutator transaction binding the contract method 0xe98e4448.
+//
+// Solidity: function reduceStorageUsage(address user, uint256 reduction) returns()
+func (_AccessControlContract *AccessControlContractSession) ReduceStorageUsage(user common.Address, reduction *big.Int) (*types.Transaction, error) {
+	return _AccessControlContract.Contract.ReduceStorageUsage(&_AccessControlContract.TransactOpts, user, reduction)
+}
+
+// ReduceStorageUsage is a paid mutator transaction binding the contract method 0xe98e4448.
+//
+// Solidity: function reduceStorageUsage(address user, uint256 reduction) returns()
+func (_AccessControlContract *AccessControlContractTransactorSession) ReduceStorageUsage(user common.Address, reduction *big.Int) (*types.Transaction, error) {
+	return _AccessControlContract.Contract.ReduceStorageUsage(&_AccessControlContract.TransactOpts, user, reduction)
+}
+
+// RegisterUser is a paid mutator transaction binding the contract method 0x704f1b94.
+//
+// Solidity: function registerUser(string username) returns()
+func (_AccessControlContract *AccessControlContractTransactor) RegisterUser(opts *bind.TransactOpts, username string) (*types.Transaction, error) {
+	return _AccessControlContract.contract.Transact(opts, "registerUser", username)
+}
+
+// RegisterUser is a paid mutator transaction binding the contract method 0x704f1b94.
+//
+// Solidity: function registerUser(string username) returns()
+func (_AccessControlContract *AccessControlContractSession) RegisterUser(username string) (*types.Transaction, error) {
+	return _AccessControlContract.Contract.RegisterUser(&_AccessControlContract.TransactOpts, username)
+}
+
+// RegisterUser is a paid mutator transaction binding the contract method 0x704f1b94.
+//
+// Solidity: function registerUser(string username) returns()
+func (_AccessControlContract *AccessControlContractTransactorSession) RegisterUser(username string) (*types.Transaction, error) {
+	return _AccessControlContract.Contract.RegisterUser(&_AccessControlContract.TransactOpts, username)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address account) returns()
+func (_AccessControlContract *AccessControlContractTransactor) RenounceRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _AccessControlContract.contract.Transact(opts, "renounceRole", role, account)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address account) returns()
+func (_AccessControlContract *AccessControlContractSession) RenounceRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _AccessControlContract.Contract.RenounceRole(&_AccessControlContract.TransactOpts, role, account)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address account) returns()
+func (_AccessControlContract *AccessControlContractTransactorSession) RenounceRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _AccessControlContract.Contract.RenounceRole(&_AccessControlContract.TransactOpts, role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_AccessControlContract *AccessControlContractTransactor) RevokeRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _AccessControlContract.contract.Transact(opts, "revokeRole", role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_AccessControlContract *AccessControlContractSession) RevokeRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _AccessControlContract.Contract.RevokeRole(&_AccessControlContract.TransactOpts, role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_AccessControlContract *AccessControlContractTransactorSession) RevokeRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _AccessControlContract.Contract.RevokeRole(&_AccessControlContract.TransactOpts, role, account)
+}
+
+// RevokeRoleFromUser is a paid mutator transaction binding the contract method 0x039d4cae.
+//
+// Solidity: function revokeRoleFromUser(address user, bytes32 role) returns()
+func (_AccessControlContract *AccessControlContractTransactor) RevokeRoleFromUser(opts *bind.TransactOpts, user common.Address, role [32]byte) (*types.Transaction, error) {
+	return _AccessControlContract.contract.Transact(opts, "revokeRoleFromUser", user, role)
+}
+
+// RevokeRoleFromUser is a paid mutator transaction binding the contract method 0x039d4cae.
+//
+// Solidity: function revokeRoleFromUser(address user, bytes32 role) returns()
+func (_AccessControlContract *AccessControlContractSession) RevokeRoleFromUser(user common.Address, role [32]byte) (*types.Transaction, error) {
+	return _AccessControlContract.Contract.RevokeRoleFromUser(&_AccessControlContract.TransactOpts, user, role)
+}
+
+// RevokeRoleFromUser is a paid mutator transaction binding the contract method 0x039d4cae.
+//
+// Solidity: function revokeRoleFromUser(address user, bytes32 role) returns()
+func (_AccessControlContract *AccessControlContractTransactorSession) RevokeRoleFromUser(user common.Address, role [32]byte) (*types.Transaction, error) {
+	return _AccessControlContract.Contract.RevokeRoleFromUser(&_AccessControlContract.TransactOpts, user, role)
+}
+
+// SetDefaultStorageQuota is a paid mutator transaction binding the contract method 0x1fbeef27.
+//
+// Solidity: function setDefaultStorageQuota(uint256 newDefaultQuota) returns()
+func (_AccessControlContract *AccessControlContractTransactor) SetDefaultStorageQuota(opts *bind.TransactOpts, newDefaultQuota *big.Int) (*types.Transaction, error) {
+	return _AccessControlContract.contract.Transact(opts, "setDefaultStorageQuota", newDefaultQuota)
+}
+
+// SetDefaultStorageQuota is a paid mutator transaction binding the contract method 0x1fbeef27.
+//
+// Solidity: function setDefaultStorageQuota(uint256 newDefaultQuota) returns()
+func (_AccessControlContract *AccessControlContractSession) SetDefaultStorageQuota(newDefaultQuota *big.Int) (*types.Transaction, error) {
+	return _AccessControlContract.Contract.SetDefaultStorageQuota(&_AccessControlContract.TransactOpts, newDefaultQuota)
+}
+
+// SetDefaultStorageQuota is a paid mutator transaction binding the contract method 0x1fbeef27.
+//
+// Solidity: function setDefaultStorageQuota(uint256 newDefaultQuota) returns()
+func (_AccessControlContract *AccessControlContractTransactorSession) SetDefaultStorageQuota(newDefaultQuota *big.Int) (*types.Transaction, error) {
+	return _AccessControlContract.Contract.SetDefaultStorageQuota(&_AccessControlContract.TransactOpts, newDefaultQuota)
+}
+
+// SetMaxStorageQuota is a paid mutator transaction binding the contract method 0x6b515fa7.
+//
+// Solidity: function setMaxStorageQuota(uint256 newMaxQuota) returns()
+func (_AccessControlContract *AccessControlContractTransactor) SetMaxStorageQuota(opts *bind.TransactOpts, newMaxQuota *big.Int) (*types.Transaction, error) {
+	return _AccessControlContract.contract.Transact(opts, "setMaxStorageQuota", newMaxQuota)
+}
+
+// SetMaxStorageQuota is a paid mutator transaction binding the contract method 0x6b515fa7.
+//
+// Solidity: function setMaxStorageQuota(uint256 newMaxQuota) returns()
+func (_AccessControlContract *AccessControlContractSession) SetMaxStorageQuota(newMaxQuota *big.Int) (*types.Transaction, error) {
+	return _AccessControlContract.Contract.SetMaxStorageQuota(&_AccessControlContract.TransactOpts, newMaxQuota)
+}
+
+// SetMaxStorageQuota is a paid mutator transaction binding the contract method 0x6b515fa7.
+//
+// Solidity: function setMaxStorageQuota(uint256 newMaxQuota) returns()
+func (_AccessControlContract *AccessControlContractTransactorSession) SetMaxStorageQuota(newMaxQuota *big.Int) (*types.Transaction, error) {
+	return _AccessControlContract.Contract.SetMaxStorageQuota(&_AccessControlContract.TransactOpts, newMaxQuota)
+}
+
+// SetPermission is a paid mutator transaction binding the contract method 0x3b424f09.
+//
+// Solidity: function setPermission(address user, bytes32 permission, bool allowed) returns()
+func (_AccessControlContract *AccessControlContractTransactor) SetPermission(opts *bind.TransactOpts, user common.Address, permission [32]byte, allowed bool) (*types.Transaction, error) {
+	return _AccessControlContract.contract.Transact(opts, "setPermission", user, permission, allowed)
+}
+
+// SetPermission is a paid mutator transaction binding the contract method 0x3b424f09.
+//
+// Solidity: function setPermission(address user, bytes32 permission, bool allowed) returns()
+func (_AccessControlContract *AccessControlContractSession) SetPermission(user common.Address, permission [32]byte, allowed bool) (*types.Transaction, error) {
+	return _AccessControlContract.Contract.SetPermission(&_AccessControlContract.TransactOpts, user, permission, allowed)
+}
+
+// SetPermission is a paid mutator transaction binding the contract method 0x3b424f09.
+//
+// Solidity: function setPermission(address user, bytes32 permission, bool allowed) returns()
+func (_AccessControlContract *AccessControlContractTransactorSession) SetPermission(user common.Address, permission [32]byte, allowed bool) (*types.Transaction, error) {
+	return _AccessControlContract.Contract.SetPermission(&_AccessControlContract.TransactOpts, user, permission, allowed)
+}
+
+// SuspendUser is a paid mutator transaction binding the contract method 0x1e153acb.
+//
+// Solidity: function suspendUser(address user) returns()
+func (_AccessControlContract *AccessControlContractTransactor) SuspendUser(opts *bind.TransactOpts, user common.Address) (*types.Transaction, error) {
+	return _AccessControlContract.contract.Transact(opts, "suspendUser", user)
+}
+
+// SuspendUser is a paid mutator transaction binding the contract method 0x1e153acb.
+//
+// Solidity: function suspendUser(address user) returns()
+func (_AccessControlContract *AccessControlContractSession) SuspendUser(user common.Address) (*types.Transaction, error) {
+	return _AccessControlContract.Contract.SuspendUser(&_AccessControlContract.TransactOpts, user)
+}
+
+// SuspendUser is a paid mutator transaction binding the contract method 0x1e153acb.
+//
+// Solidity: function suspendUser(address user) returns()
+func (_AccessControlContract *AccessControlContractTransactorSession) SuspendUser(user common.Address) (*types.Transaction, error) {
+	return _AccessControlContract.Contract.SuspendUser(&_AccessControlContract.TransactOpts, user)
+}
+
+// UnsuspendUser is a paid mutator transaction binding the contract method 0xcddc32a4.
+//
+// Solidity: function unsuspendUser(address user) returns()
+func (_AccessControlContract *AccessControlContractTransactor) UnsuspendUser(opts *bind.TransactOpts, user common.Address) (*types.Transaction, error) {
+	return _AccessControlContract.contract.Transact(opts, "unsuspendUser", user)
+}
+
+// UnsuspendUser is a paid mutator transaction binding the contract method 0xcddc32a4.
+//
+// Solidity: function unsuspendUser(address user) returns()
+func (_AccessControlContract *AccessControlContractSession) UnsuspendUser(user common.Address) (*types.Transaction, error) {
+	return _AccessControlContract.Contract.UnsuspendUser(&_AccessControlContract.TransactOpts, user)
+}
+
+// UnsuspendUser is a paid mutator transaction binding the contract method 0xcddc32a4.
+//
+// Solidity: function unsuspendUser(address user) returns()
+func (_AccessControlContract *AccessControlContractTransactorSession) UnsuspendUser(user common.Address) (*types.Transaction, error) {
+	return _AccessControlContract.Contract.UnsuspendUser(&_AccessControlContract.TransactOpts, user)
+}
+
+// UpdateActivity is a paid mutator transaction binding the contract method 0x1b0e1ffa.
+//
+// Solidity: function updateActivity() returns()
+func (_AccessControlContract *AccessControlContractTransactor) UpdateActivity(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _AccessControlContract.contract.Transact(opts, "updateActivity")
+}
+
+// UpdateActivity is a paid mutator transaction binding the contract method 0x1b0e1ffa.
+//
+// Solidity: function updateActivity() returns()
+func (_AccessControlContract *AccessControlContractSession) UpdateActivity() (*types.Transaction, error) {
+	return _AccessControlContract.Contract.UpdateActivity(&_AccessControlContract.TransactOpts)
+}
+
+// UpdateActivity is a paid mutator transaction binding the contract method 0x1b0e1ffa.
+//
+// Solidity: function updateActivity() returns()
+func (_AccessControlContract *AccessControlContractTransactorSession) UpdateActivity() (*types.Transaction, error) {
+	return _AccessControlContract.Contract.UpdateActivity(&_AccessControlContract.TransactOpts)
+}
+
+// UpdateStorageQuota is a paid mutator transaction binding the contract method 0x2daa7cec.
+//
+// Solidity: function updateStorageQuota(address user, uint256 newQuota) returns()
+func (_AccessControlContract *AccessControlContractTransactor) UpdateStorageQuota(opts *bind.TransactOpts, user common.Address, newQuota *big.Int) (*types.Transaction, error) {
+	return _AccessControlContract.contract.Transact(opts, "updateStorageQuota", user, newQuota)
+}
+
+// UpdateStorageQuota is a paid mutator transaction binding the contract method 0x2daa7cec.
+//
+// Solidity: function updateStorageQuota(address user, uint256 newQuota) returns()
+func (_AccessControlContract *AccessControlContractSession) UpdateStorageQuota(user common.Address, newQuota *big.Int) (*types.Transaction, error) {
+	return _AccessControlContract.Contract.UpdateStorageQuota(&_AccessControlContract.TransactOpts, user, newQuota)
+}
+
+// UpdateStorageQuota is a paid mutator transaction binding the contract method 0x2daa7cec.
+//
+// Solidity: function updateStorageQuota(address user, uint256 newQuota) returns()
+func (_AccessControlContract *AccessControlContractTransactorSession) UpdateStorageQuota(user common.Address, newQuota *big.Int) (*types.Transaction, error) {
+	return _AccessControlContract.Contract.UpdateStorageQuota(&_AccessControlContract.TransactOpts, user, newQuota)
+}
+
+// UpdateStorageUsage is a paid mutator transaction binding the contract method 0xc60dd0a7.
+//
+// Solidity: function updateStorageUsage(address user, uint256 additionalUsage) returns()
+func (_AccessControlContract *AccessControlContractTransactor) UpdateStorageUsage(opts *bind.TransactOpts, user common.Address, additionalUsage *big.Int) (*types.Transaction, error) {
+	return _AccessControlContract.contract.Transact(opts, "updateStorageUsage", user, additionalUsage)
+}
+
+// UpdateStorageUsage is a paid mutator transaction binding the contract method 0xc60dd0a7.
+//
+// Solidity: function updateStorageUsage(address user, uint256 additionalUsage) returns()
+func (_AccessControlContract *AccessControlContractSession) UpdateStorageUsage(user common.Address, additionalUsage *big.Int) (*types.Transaction, error) {
+	return _AccessControlContract.Contract.UpdateStorageUsage(&_AccessControlContract.TransactOpts, user, additionalUsage)
+}
+
+// UpdateStorageUsage is a paid mutator transaction binding the contract method 0xc60dd0a7.
+//
+// Solidity: function updateStorageUsage(address user, uint256 additionalUsage) returns()
+func (_AccessControlContract *AccessControlContractTransactorSession) UpdateStorageUsage(user common.Address, additionalUsage *big.Int) (*types.Transaction, error) {
+	return _AccessControlContract.Contract.UpdateStorageUsage(&_AccessControlContract.TransactOpts, user, additionalUsage)
+}
+
+// AccessControlContractRoleAdminChangedIterator is returned from FilterRoleAdminChanged and is used to iterate over the raw logs and unpacked data for RoleAdminChanged events raised by the AccessControlContract contract.
+type AccessControlContractRoleAdminChangedIterator struct {
+	Event *AccessControlContractRoleAdminChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AccessControlContractRoleAdminChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AccessControlContractRoleAdminChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AccessControlContractRoleAdminChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AccessControlContractRoleAdminChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AccessControlContractRoleAdminChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AccessControlContractRoleAdminChanged represents a RoleAdminChanged event raised by the AccessControlContract contract.
+type AccessControlContractRoleAdminChanged struct {
+	Role              [32]byte
+	PreviousAdminRole [32]byte
+	NewAdminRole      [32]byte
+	Raw               types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleAdminChanged is a free log retrieval operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_AccessControlContract *AccessControlContractFilterer) FilterRoleAdminChanged(opts *bind.FilterOpts, role [][32]byte, previousAdminRole [][32]byte, newAdminRole [][32]byte) (*AccessControlContractRoleAdminChangedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var previousAdminRoleRule []interface{}
+	for _, previousAdminRoleItem := range previousAdminRole {
+		previousAdminRoleRule = append(previousAdminRoleRule, previousAdminRoleItem)
+	}
+	var newAdminRoleRule []interface{}
+	for _, newAdminRoleItem := range newAdminRole {
+		newAdminRoleRule = append(newAdminRoleRule, newAdminRoleItem)
+	}
+
+	logs, sub, err := _AccessControlContract.contract.FilterLogs(opts, "RoleAdminChanged", roleRule, previousAdminRoleRule, newAdminRoleRule)
+	if err != nil {
+		return nil, err
+	}
+	return &AccessControlContractRoleAdminChangedIterator{contract: _AccessControlContract.contract, event: "RoleAdminChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleAdminChanged is a free log subscription operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_AccessControlContract *AccessControlContractFilterer) WatchRoleAdminChanged(opts *bind.WatchOpts, sink chan<- *AccessControlContractRoleAdminChanged, role [][32]byte, previousAdminRole [][32]byte, newAdminRole [][32]byte) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var previousAdminRoleRule []interface{}
+	for _, previousAdminRoleItem := range previousAdminRole {
+		previousAdminRoleRule = append(previousAdminRoleRule, previousAdminRoleItem)
+	}
+	var newAdminRoleRule []interface{}
+	for _, newAdminRoleItem := range newAdminRole {
+		newAdminRoleRule = append(newAdminRoleRule, newAdminRoleItem)
+	}
+
+	logs, sub, err := _AccessControlContract.contract.WatchLogs(opts, "RoleAdminChanged", roleRule, previousAdminRoleRule, newAdminRoleRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AccessControlContractRoleAdminChanged)
+				if err := _AccessControlContract.contract.UnpackLog(event, "RoleAdminChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleAdminChanged is a log parse operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_AccessControlContract *AccessControlContractFilterer) ParseRoleAdminChanged(log types.Log) (*AccessControlContractRoleAdminChanged, error) {
+	event := new(AccessControlContractRoleAdminChanged)
+	if err := _AccessControlContract.contract.UnpackLog(event, "RoleAdminChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AccessControlContractRoleGrantedIterator is returned from FilterRoleGranted and is used to iterate over the raw logs and unpacked data for RoleGranted events raised by the AccessControlContract contract.
+type AccessControlContractRoleGrantedIterator struct {
+	Event *AccessControlContractRoleGranted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AccessControlContractRoleGrantedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AccessControlContractRoleGranted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AccessControlContractRoleGranted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AccessControlContractRoleGrantedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AccessControlContractRoleGrantedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AccessControlContractRoleGranted represents a RoleGranted event raised by the AccessControlContract contract.
+type AccessControlContractRoleGranted struct {
+	Role    [32]byte
+	Account common.Address
+	Sender  common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleGranted is a free log retrieval operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_AccessControlContract *AccessControlContractFilterer) FilterRoleGranted(opts *bind.FilterOpts, role [][32]byte, account []common.Address, sender []common.Address) (*AccessControlContractRoleGrantedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _AccessControlContract.contract.FilterLogs(opts, "RoleGranted", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &AccessControlContractRoleGrantedIterator{contract: _AccessControlContract.contract, event: "RoleGranted", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleGranted is a free log subscription operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_AccessControlContract *AccessControlContractFilterer) WatchRoleGranted(opts *bind.WatchOpts, sink chan<- *AccessControlContractRoleGranted, role [][32]byte, account []common.Address, sender []common.Address) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _AccessControlContract.contract.WatchLogs(opts, "RoleGranted", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AccessControlContractRoleGranted)
+				if err := _AccessControlContract.contract.UnpackLog(event, "RoleGranted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleGranted is a log parse operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_AccessControlContract *AccessControlContractFilterer) ParseRoleGranted(log types.Log) (*AccessControlContractRoleGranted, error) {
+	event := new(AccessControlContractRoleGranted)
+	if err := _AccessControlContract.contract.UnpackLog(event, "RoleGranted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AccessControlContractRoleRevokedIterator is returned from FilterRoleRevoked and is used to iterate over the raw logs and unpacked data for RoleRevoked events raised by the AccessControlContract contract.
+type AccessControlContractRoleRevokedIterator struct {
+	Event *AccessControlContractRoleRevoked // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AccessControlContractRoleRevokedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AccessControlContractRoleRevoked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AccessControlContractRoleRevoked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AccessControlContractRoleRevokedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AccessControlContractRoleRevokedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AccessControlContractRoleRevoked represents a RoleRevoked event raised by the AccessControlContract contract.
+type AccessControlContractRoleRevoked struct {
+	Role    [32]byte
+	Account common.Address
+	Sender  common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleRevoked is a free log retrieval operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_AccessControlContract *AccessControlContractFilterer) FilterRoleRevoked(opts *bind.FilterOpts, role [][32]byte, account []common.Address, sender []common.Address) (*AccessControlContractRoleRevokedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _AccessControlContract.contract.FilterLogs(opts, "RoleRevoked", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &AccessControlContractRoleRevokedIterator{contract: _AccessControlContract.contract, event: "RoleRevoked", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleRevoked is a free log subscription operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_AccessControlContract *AccessControlContractFilterer) WatchRoleRevoked(opts *bind.WatchOpts, sink chan<- *AccessControlContractRoleRevoked, role [][32]byte, account []common.Address, sender []common.Address) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _AccessControlContract.contract.WatchLogs(opts, "RoleRevoked", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AccessControlContractRoleRevoked)
+				if err := _AccessControlContract.contract.UnpackLog(event, "RoleRevoked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleRevoked is a log parse operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_AccessControlContract *AccessControlContractFilterer) ParseRoleRevoked(log types.Log) (*AccessControlContractRoleRevoked, error) {
+	event := new(AccessControlContractRoleRevoked)
+	if err := _AccessControlContract.contract.UnpackLog(event, "RoleRevoked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AccessControlContractStorageQuotaUpdatedIterator is returned from FilterStorageQuotaUpdated and is used to iterate over the raw logs and unpacked data for StorageQuotaUpdated events raised by the AccessControlContract contract.
+type AccessControlContractStorageQuotaUpdatedIterator struct {
+	Event *AccessControlContractStorageQuotaUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AccessControlContractStorageQuotaUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AccessControlContractStorageQuotaUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AccessControlContractStorageQuotaUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AccessControlContractStorageQuotaUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AccessControlContractStorageQuotaUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AccessControlContractStorageQuotaUpdated represents a StorageQuotaUpdated event raised by the AccessControlContract contract.
+type AccessControlContractStorageQuotaUpdated struct {
+	User     common.Address
+	NewQuota *big.Int
+	Updater  common.Address
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterStorageQuotaUpdated is a free log retrieval operation binding the contract event 0x2349967681dfd5781e7d2990653e9f851fce73efd2702f52b551b2969a7d8a9b.
+//
+// Solidity: event StorageQuotaUpdated(address indexed user, uint256 newQuota, address indexed updater)
+func (_AccessControlContract *AccessControlContractFilterer) FilterStorageQuotaUpdated(opts *bind.FilterOpts, user []common.Address, updater []common.Address) (*AccessControlContractStorageQuotaUpdatedIterator, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	var updaterRule []interface{}
+	for _, updaterItem := range updater {
+		updaterRule = append(updaterRule, updaterItem)
+	}
+
+	logs, sub, err := _AccessControlContract.contract.FilterLogs(opts, "StorageQuotaUpdated", userRule, updaterRule)
+	if err != nil {
+		return nil, err
+	}
+	return &AccessControlContractStorageQuotaUpdatedIterator{contract: _AccessControlContract.contract, event: "StorageQuotaUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchStorageQuotaUpdated is a free log subscription operation binding the contract event 0x2349967681dfd5781e7d2990653e9f851fce73efd2702f52b551b2969a7d8a9b.
+//
+// Solidity: event StorageQuotaUpdated(address indexed user, uint256 newQuota, address indexed updater)
+func (_AccessControlContract *AccessControlContractFilterer) WatchStorageQuotaUpdated(opts *bind.WatchOpts, sink chan<- *AccessControlContractStorageQuotaUpdated, user []common.Address, updater []common.Address) (event.Subscription, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	var updaterRule []interface{}
+	for _, updaterItem := range updater {
+		updaterRule = append(updaterRule, updaterItem)
+	}
+
+	logs, sub, err := _AccessControlContract.contract.WatchLogs(opts, "StorageQuotaUpdated", userRule, updaterRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AccessControlContractStorageQuotaUpdated)
+				if err := _AccessControlContract.contract.UnpackLog(event, "StorageQuotaUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseStorageQuotaUpdated is a log parse operation binding the contract event 0x2349967681dfd5781e7d2990653e9f851fce73efd2702f52b551b2969a7d8a9b.
+//
+// Solidity: event StorageQuotaUpdated(address indexed user, uint256 newQuota, address indexed updater)
+func (_AccessControlContract *AccessControlContractFilterer) ParseStorageQuotaUpdated(log types.Log) (*AccessControlContractStorageQuotaUpdated, error) {
+	event := new(AccessControlContractStorageQuotaUpdated)
+	if err := _AccessControlContract.contract.UnpackLog(event, "StorageQuotaUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AccessControlContractUserRegisteredIterator is returned from FilterUserRegistered and is used to iterate over the raw logs and unpacked data for UserRegistered events raised by the AccessControlContract contract.
+type AccessControlContractUserRegisteredIterator struct {
+	Event *AccessControlContractUserRegistered // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AccessControlContractUserRegisteredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AccessControlContractUserRegistered)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AccessControlContractUserRegistered)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AccessControlContractUserRegisteredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AccessControlContractUserRegisteredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AccessControlContractUserRegistered represents a UserRegistered event raised by the AccessControlContract contract.
+type AccessControlContractUserRegistered struct {
+	User      common.Address
+	Username  string
+	Timestamp *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterUserRegistered is a free log retrieval operation binding the contract event 0x89105a1c6a3c2fbd471255c66a31ccab604af5697f67d7e2e9a0028c5e4dbd91.
+//
+// Solidity: event UserRegistered(address indexed user, string username, uint256 timestamp)
+func (_AccessControlContract *AccessControlContractFilterer) FilterUserRegistered(opts *bind.FilterOpts, user []common.Address) (*AccessControlContractUserRegisteredIterator, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	logs, sub, err := _AccessControlContract.contract.FilterLogs(opts, "UserRegistered", userRule)
+	if err != nil {
+		return nil, err
+	}
+	return &AccessControlContractUserRegisteredIterator{contract: _AccessControlContract.contract, event: "UserRegistered", logs: logs, sub: sub}, nil
+}
+
+// WatchUserRegistered is a free log subscription operation binding the contract event 0x89105a1c6a3c2fbd471255c66a31ccab604af5697f67d7e2e9a0028c5e4dbd91.
+//
+// Solidity: event UserRegistered(address indexed user, string username, uint256 timestamp)
+func (_AccessControlContract *AccessControlContractFilterer) WatchUserRegistered(opts *bind.WatchOpts, sink chan<- *AccessControlContractUserRegistered, user []common.Address) (event.Subscription, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	logs, sub, err := _AccessControlContract.contract.WatchLogs(opts, "UserRegistered", userRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AccessControlContractUserRegistered)
+				if err := _AccessControlContract.contract.UnpackLog(event, "UserRegistered", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUserRegistered is a log parse operation binding the contract event 0x89105a1c6a3c2fbd471255c66a31ccab604af5697f67d7e2e9a0028c5e4dbd91.
+//
+// Solidity: event UserRegistered(address indexed user, string username, uint256 timestamp)
+func (_AccessControlContract *AccessControlContractFilterer) ParseUserRegistered(log types.Log) (*AccessControlContractUserRegistered, error) {
+	event := new(AccessControlContractUserRegistered)
+	if err := _AccessControlContract.contract.UnpackLog(event, "UserRegistered", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AccessControlContractUserRoleGrantedIterator is returned from FilterUserRoleGranted and is used to iterate over the raw logs and unpacked data for UserRoleGranted events raised by the AccessControlContract contract.
+type AccessControlContractUserRoleGrantedIterator struct {
+	Event *AccessControlContractUserRoleGranted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AccessControlContractUserRoleGrantedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AccessControlContractUserRoleGranted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AccessControlContractUserRoleGranted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AccessControlContractUserRoleGrantedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AccessControlContractUserRoleGrantedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AccessControlContractUserRoleGranted represents a UserRoleGranted event raised by the AccessControlContract contract.
+type AccessControlContractUserRoleGranted struct {
+	User    common.Address
+	Role    [32]byte
+	Granter common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterUserRoleGranted is a free log retrieval operation binding the contract event 0xc872def599bcebf2e8a06f60f9b0bb89c9f470341c992898f1e23b9b372e6ca6.
+//
+// Solidity: event UserRoleGranted(address indexed user, bytes32 role, address indexed granter)
+func (_AccessControlContract *AccessControlContractFilterer) FilterUserRoleGranted(opts *bind.FilterOpts, user []common.Address, granter []common.Address) (*AccessControlContractUserRoleGrantedIterator, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	var granterRule []interface{}
+	for _, granterItem := range granter {
+		granterRule = append(granterRule, granterItem)
+	}
+
+	logs, sub, err := _AccessControlContract.contract.FilterLogs(opts, "UserRoleGranted", userRule, granterRule)
+	if err != nil {
+		return nil, err
+	}
+	return &AccessControlContractUserRoleGrantedIterator{contract: _AccessControlContract.contract, event: "UserRoleGranted", logs: logs, sub: sub}, nil
+}
+
+// WatchUserRoleGranted is a free log subscription operation binding the contract event 0xc872def599bcebf2e8a06f60f9b0bb89c9f470341c992898f1e23b9b372e6ca6.
+//
+// Solidity: event UserRoleGranted(address indexed user, bytes32 role, address indexed granter)
+func (_AccessControlContract *AccessControlContractFilterer) WatchUserRoleGranted(opts *bind.WatchOpts, sink chan<- *AccessControlContractUserRoleGranted, user []common.Address, granter []common.Address) (event.Subscription, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	var granterRule []interface{}
+	for _, granterItem := range granter {
+		granterRule = append(granterRule, granterItem)
+	}
+
+	logs, sub, err := _AccessControlContract.contract.WatchLogs(opts, "UserRoleGranted", userRule, granterRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AccessControlContractUserRoleGranted)
+				if err := _AccessControlContract.contract.UnpackLog(event, "UserRoleGranted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUserRoleGranted is a log parse operation binding the contract event 0xc872def599bcebf2e8a06f60f9b0bb89c9f470341c992898f1e23b9b372e6ca6.
+//
+// Solidity: event UserRoleGranted(address indexed user, bytes32 role, address indexed granter)
+func (_AccessControlContract *AccessControlContractFilterer) ParseUserRoleGranted(log types.Log) (*AccessControlContractUserRoleGranted, error) {
+	event := new(AccessControlContractUserRoleGranted)
+	if err := _AccessControlContract.contract.UnpackLog(event, "UserRoleGranted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AccessControlContractUserRoleRevokedIterator is returned from FilterUserRoleRevoked and is used to iterate over the raw logs and unpacked data for UserRoleRevoked events raised by the AccessControlContract contract.
+type AccessControlContractUserRoleRevokedIterator struct {
+	Event *AccessControlContractUserRoleRevoked // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AccessControlContractUserRoleRevokedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AccessControlContractUserRoleRevoked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AccessControlContractUserRoleRevoked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AccessControlContractUserRoleRevokedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AccessControlContractUserRoleRevokedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AccessControlContractUserRoleRevoked represents a UserRoleRevoked event raised by the AccessControlContract contract.
+type AccessControlContractUserRoleRevoked struct {
+	User    common.Address
+	Role    [32]byte
+	Revoker common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterUserRoleRevoked is a free log retrieval operation binding the contract event 0x52baf7d6d37ffa8e8c78a88bd162825565c66d8b8fcb356693cd4704c0d09109.
+//
+// Solidity: event UserRoleRevoked(address indexed user, bytes32 role, address indexed revoker)
+func (_AccessControlContract *AccessControlContractFilterer) FilterUserRoleRevoked(opts *bind.FilterOpts, user []common.Address, revoker []common.Address) (*AccessControlContractUserRoleRevokedIterator, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	var revokerRule []interface{}
+	for _, revokerItem := range revoker {
+		revokerRule = append(revokerRule, revokerItem)
+	}
+
+	logs, sub, err := _AccessControlContract.contract.FilterLogs(opts, "UserRoleRevoked", userRule, revokerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &AccessControlContractUserRoleRevokedIterator{contract: _AccessControlContract.contract, event: "UserRoleRevoked", logs: logs, sub: sub}, nil
+}
+
+// WatchUserRoleRevoked is a free log subscription operation binding the contract event 0x52baf7d6d37ffa8e8c78a88bd162825565c66d8b8fcb356693cd4704c0d09109.
+//
+// Solidity: event UserRoleRevoked(address indexed user, bytes32 role, address indexed revoker)
+func (_AccessControlContract *AccessControlContractFilterer) WatchUserRoleRevoked(opts *bind.WatchOpts, sink chan<- *AccessControlContractUserRoleRevoked, user []common.Address, revoker []common.Address) (event.Subscription, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	var revokerRule []interface{}
+	for _, revokerItem := range revoker {
+		revokerRule = append(revokerRule, revokerItem)
+	}
+
+	logs, sub, err := _AccessControlContract.contract.WatchLogs(opts, "UserRoleRevoked", userRule, revokerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AccessControlContractUserRoleRevoked)
+				if err := _AccessControlContract.contract.UnpackLog(event, "UserRoleRevoked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUserRoleRevoked is a log parse operation binding the contract event 0x52baf7d6d37ffa8e8c78a88bd162825565c66d8b8fcb356693cd4704c0d09109.
+//
+// Solidity: event UserRoleRevoked(address indexed user, bytes32 role, address indexed revoker)
+func (_AccessControlContract *AccessControlContractFilterer) ParseUserRoleRevoked(log types.Log) (*AccessControlContractUserRoleRevoked, error) {
+	event := new(AccessControlContractUserRoleRevoked)
+	if err := _AccessControlContract.contract.UnpackLog(event, "UserRoleRevoked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AccessControlContractUserSuspendedIterator is returned from FilterUserSuspended and is used to iterate over the raw logs and unpacked data for UserSuspended events raised by the AccessControlContract contract.
+type AccessControlContractUserSuspendedIterator struct {
+	Event *AccessControlContractUserSuspended // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AccessControlContractUserSuspendedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AccessControlContractUserSuspended)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AccessControlContractUserSuspended)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AccessControlContractUserSuspendedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AccessControlContractUserSuspendedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AccessControlContractUserSuspended represents a UserSuspended event raised by the AccessControlContract contract.
+type AccessControlContractUserSuspended struct {
+	User      common.Address
+	Moderator common.Address
+	Timestamp *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterUserSuspended is a free log retrieval operation binding the contract event 0xf7760221cf53f8188ca0c1ddef184060c3cd7d0f8cfa168caa43a1c88f7da26a.
+//
+// Solidity: event UserSuspended(address indexed user, address indexed moderator, uint256 timestamp)
+func (_AccessControlContract *AccessControlContractFilterer) FilterUserSuspended(opts *bind.FilterOpts, user []common.Address, moderator []common.Address) (*AccessControlContractUserSuspendedIterator, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+	var moderatorRule []interface{}
+	for _, moderatorItem := range moderator {
+		moderatorRule = append(moderatorRule, moderatorItem)
+	}
+
+	logs, sub, err := _AccessControlContract.contract.FilterLogs(opts, "UserSuspended", userRule, moderatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &AccessControlContractUserSuspendedIterator{contract: _AccessControlContract.contract, event: "UserSuspended", logs: logs, sub: sub}, nil
+}
+
+// WatchUserSuspended is a free log subscription operation binding the contract event 0xf7760221cf53f8188ca0c1ddef184060c3cd7d0f8cfa168caa43a1c88f7da26a.
+//
+// Solidity: event UserSuspended(address indexed user, address indexed moderator, uint256 timestamp)
+func (_AccessControlContract *AccessControlContractFilterer) WatchUserSuspended(opts *bind.WatchOpts, sink chan<- *AccessControlContractUserSuspended, user []common.Address, moderator []common.Address) (event.Subscription, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+	var moderatorRule []interface{}
+	for _, moderatorItem := range moderator {
+		moderatorRule = append(moderatorRule, moderatorItem)
+	}
+
+	logs, sub, err := _AccessControlContract.contract.WatchLogs(opts, "UserSuspended", userRule, moderatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AccessControlContractUserSuspended)
+				if err := _AccessControlContract.contract.UnpackLog(event, "UserSuspended", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUserSuspended is a log parse operation binding the contract event 0xf7760221cf53f8188ca0c1ddef184060c3cd7d0f8cfa168caa43a1c88f7da26a.
+//
+// Solidity: event UserSuspended(address indexed user, address indexed moderator, uint256 timestamp)
+func (_AccessControlContract *AccessControlContractFilterer) ParseUserSuspended(log types.Log) (*AccessControlContractUserSuspended, error) {
+	event := new(AccessControlContractUserSuspended)
+	if err := _AccessControlContract.contract.UnpackLog(event, "UserSuspended", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AccessControlContractUserUnsuspendedIterator is returned from FilterUserUnsuspended and is used to iterate over the raw logs and unpacked data for UserUnsuspended events raised by the AccessControlContract contract.
+type AccessControlContractUserUnsuspendedIterator struct {
+	Event *AccessControlContractUserUnsuspended // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AccessControlContractUserUnsuspendedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AccessControlContractUserUnsuspended)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AccessControlContractUserUnsuspended)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AccessControlContractUserUnsuspendedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AccessControlContractUserUnsuspendedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AccessControlContractUserUnsuspended represents a UserUnsuspended event raised by the AccessControlContract contract.
+type AccessControlContractUserUnsuspended struct {
+	User      common.Address
+	Moderator common.Address
+	Timestamp *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterUserUnsuspended is a free log retrieval operation binding the contract event 0x5c3cbcec29ca81b0c591e9481e017bc106603d1df4653b35f75165348c80c728.
+//
+// Solidity: event UserUnsuspended(address indexed user, address indexed moderator, uint256 timestamp)
+func (_AccessControlContract *AccessControlContractFilterer) FilterUserUnsuspended(opts *bind.FilterOpts, user []common.Address, moderator []common.Address) (*AccessControlContractUserUnsuspendedIterator, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+	var moderatorRule []interface{}
+	for _, moderatorItem := range moderator {
+		moderatorRule = append(moderatorRule, moderatorItem)
+	}
+
+	logs, sub, err := _AccessControlContract.contract.FilterLogs(opts, "UserUnsuspended", userRule, moderatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &AccessControlContractUserUnsuspendedIterator{contract: _AccessControlContract.contract, event: "UserUnsuspended", logs: logs, sub: sub}, nil
+}
+
+// WatchUserUnsuspended is a free log subscription operation binding the contract event 0x5c3cbcec29ca81b0c591e9481e017bc106603d1df4653b35f75165348c80c728.
+//
+// Solidity: event UserUnsuspended(address indexed user, address indexed moderator, uint256 timestamp)
+func (_AccessControlContract *AccessControlContractFilterer) WatchUserUnsuspended(opts *bind.WatchOpts, sink chan<- *AccessControlContractUserUnsuspended, user []common.Address, moderator []common.Address) (event.Subscription, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+	var moderatorRule []interface{}
+	for _, moderatorItem := range moderator {
+		moderatorRule = append(moderatorRule, moderatorItem)
+	}
+
+	logs, sub, err := _AccessControlContract.contract.WatchLogs(opts, "UserUnsuspended", userRule, moderatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AccessControlContractUserUnsuspended)
+				if err := _AccessControlContract.contract.UnpackLog(event, "UserUnsuspended", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUserUnsuspended is a log parse operation binding the contract event 0x5c3cbcec29ca81b0c591e9481e017bc106603d1df4653b35f75165348c80c728.
+//
+// Solidity: event UserUnsuspended(address indexed user, address indexed moderator, uint256 timestamp)
+func (_AccessControlContract *AccessControlContractFilterer) ParseUserUnsuspended(log types.Log) (*AccessControlContractUserUnsuspended, error) {
+	event := new(AccessControlContractUserUnsuspended)
+	if err := _AccessControlContract.contract.UnpackLog(event, "UserUnsuspended", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
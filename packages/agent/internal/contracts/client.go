@@ -2,15 +2,21 @@ package contracts
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 	"time"
 
+	ethereum "github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+
+	"nebularvault-agent/internal/tracing"
 )
 
 // ContractConfig holds configuration for smart contract interactions
@@ -22,14 +28,41 @@ type ContractConfig struct {
 	GasLimit         uint64
 	GasPrice         *big.Int
 	Timeout          time.Duration
+
+	// Confirmations is how many blocks to wait for before a transaction
+	// counts as done; zero returns as soon as it is broadcast
+	Confirmations  uint64
+	PollInterval   time.Duration
+	PendingTxFile  string
+	StuckTimeout   time.Duration
+	GasBumpPercent int64
+	MaxGasBumps    int
+
+	// Fee pricing applies when GasPrice is unset; a zero GasLimit is
+	// estimated per call and padded by GasLimitMultiplier
+	FeeStrategy        FeeStrategy
+	FeeHistoryBlocks   uint64
+	MaxFeePerGas       *big.Int
+	GasLimitMultiplier float64
+}
+
+// Backend is the subset of the Ethereum RPC client used by ContractClient.
+// *ethclient.Client satisfies it, as does go-ethereum's simulated backend.
+type Backend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+	ChainID(ctx context.Context) (*big.Int, error)
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+	FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error)
 }
 
 // ContractClient handles smart contract interactions
 type ContractClient struct {
 	config     *ContractConfig
-	client     *ethclient.Client
+	client     Backend
 	auth       *bind.TransactOpts
 	contract   *NebulaVaultContract
+	txm        *TxManager
 	logger     *logrus.Logger
 }
 
@@ -46,37 +79,89 @@ type FileUploadRequest struct {
 
 // FileUploadResponse represents the response from file upload
 type FileUploadResponse struct {
-	Success bool   `json:"success"`
-	TxHash  string `json:"tx_hash"`
-	Message string `json:"message"`
+	Success     bool   `json:"success"`
+	TxHash      string `json:"tx_hash"`
+	BlockNumber uint64 `json:"block_number,omitempty"`
+	GasUsed     uint64 `json:"gas_used,omitempty"`
+	Message     string `json:"message"`
 }
 
 // FileDownloadResponse represents the response from file download
 type FileDownloadResponse struct {
-	Success bool   `json:"success"`
-	TxHash  string `json:"tx_hash"`
-	Message string `json:"message"`
+	Success     bool   `json:"success"`
+	TxHash      string `json:"tx_hash"`
+	BlockNumber uint64 `json:"block_number,omitempty"`
+	GasUsed     uint64 `json:"gas_used,omitempty"`
+	Message     string `json:"message"`
 }
 
 // ProofVerificationResponse represents the response from proof verification
 type ProofVerificationResponse struct {
-	Success bool   `json:"success"`
-	TxHash  string `json:"tx_hash"`
-	Valid   bool   `json:"valid"`
-	Message string `json:"message"`
+	Success     bool   `json:"success"`
+	TxHash      string `json:"tx_hash"`
+	BlockNumber uint64 `json:"block_number,omitempty"`
+	GasUsed     uint64 `json:"gas_used,omitempty"`
+	Valid       bool   `json:"valid"`
+	Message     string `json:"message"`
 }
 
-// NewContractClient creates a new smart contract client
-func NewContractClient(config *ContractConfig) (*ContractClient, error) {
-	logger := logrus.New()
-	logger.SetLevel(logrus.InfoLevel)
+// TransactionResponse represents the outcome of a state-changing call
+type TransactionResponse struct {
+	Success     bool   `json:"success"`
+	TxHash      string `json:"tx_hash"`
+	BlockNumber uint64 `json:"block_number,omitempty"`
+	GasUsed     uint64 `json:"gas_used,omitempty"`
+	Message     string `json:"message"`
+}
+
+// UserProfile is a user's registration and storage state on chain
+type UserProfile struct {
+	Address               string `json:"address"`
+	Username              string `json:"username"`
+	RegistrationTimestamp uint64 `json:"registration_timestamp"`
+	LastActivityTimestamp uint64 `json:"last_activity_timestamp"`
+	StorageQuota          uint64 `json:"storage_quota"`
+	StorageUsed           uint64 `json:"storage_used"`
+	IsSuspended           bool   `json:"is_suspended"`
+}
+
+// SystemStats are the vault-wide counters
+type SystemStats struct {
+	TotalUsers     uint64 `json:"total_users"`
+	TotalFiles     uint64 `json:"total_files"`
+	TotalVerified  uint64 `json:"total_verified"`
+	TotalUploads   uint64 `json:"total_uploads"`
+	TotalDownloads uint64 `json:"total_downloads"`
+}
+
+// FileAccess lists the accounts that have been granted access to a file.
+// Revoked accounts stay listed with Authorized set to false.
+type FileAccess struct {
+	FileHash string        `json:"file_hash"`
+	Uploader string        `json:"uploader"`
+	Grants   []AccessGrant `json:"grants"`
+}
+
+// AccessGrant is one account's access to a file
+type AccessGrant struct {
+	Address    string `json:"address"`
+	Authorized bool   `json:"authorized"`
+}
 
+// NewContractClient creates a new smart contract client
+func NewContractClient(config *ContractConfig, logger *logrus.Logger) (*ContractClient, error) {
 	// Connect to Ethereum client
 	client, err := ethclient.Dial(config.RPCURL)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to Ethereum client: %w", err)
 	}
 
+	return NewContractClientWithBackend(config, client, logger)
+}
+
+// NewContractClientWithBackend creates a smart contract client on top of an
+// existing backend connection
+func NewContractClientWithBackend(config *ContractConfig, client Backend, logger *logrus.Logger) (*ContractClient, error) {
 	// Parse private key
 	privateKey, err := crypto.HexToECDSA(config.PrivateKey)
 	if err != nil {
@@ -100,11 +185,31 @@ func NewContractClient(config *ContractConfig) (*ContractClient, error) {
 		return nil, fmt.Errorf("failed to create contract instance: %w", err)
 	}
 
+	// All transactions share one account, so nonces and fees are managed centrally
+	txm, err := NewTxManager(client, auth, TxManagerConfig{
+		Confirmations:  config.Confirmations,
+		PollInterval:   config.PollInterval,
+		StuckTimeout:   config.StuckTimeout,
+		GasBumpPercent: config.GasBumpPercent,
+		MaxGasBumps:    config.MaxGasBumps,
+		StateFile:      config.PendingTxFile,
+		Fees: FeeConfig{
+			Strategy:           config.FeeStrategy,
+			HistoryBlocks:      config.FeeHistoryBlocks,
+			MaxFeePerGas:       config.MaxFeePerGas,
+			GasLimitMultiplier: config.GasLimitMultiplier,
+		},
+	}, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create transaction manager: %w", err)
+	}
+
 	return &ContractClient{
 		config:   config,
 		client:   client,
 		auth:     auth,
 		contract: contract,
+		txm:      txm,
 		logger:   logger,
 	}, nil
 }
@@ -113,11 +218,25 @@ func NewContractClient(config *ContractConfig) (*ContractClient, error) {
 func (c *ContractClient) RegisterUser(username string) (*FileUploadResponse, error) {
 	c.logger.WithField("username", username).Info("Registering user on blockchain...")
 
-	tx, err := c.contract.RegisterUser(c.auth, username)
+	// NebulaVault.registerUser forwards the call with the vault as msg.sender,
+	// which registers the vault instead of us, so go to AccessControl directly
+	accessControl, err := c.accessControlContract()
+	if err != nil {
+		c.logger.WithError(err).Error("Failed to register user")
+		return &FileUploadResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to register user: %v", err),
+		}, err
+	}
+
+	tx, receipt, err := c.transact("registerUser", nil, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return accessControl.RegisterUser(opts, username)
+	})
 	if err != nil {
 		c.logger.WithError(err).Error("Failed to register user")
 		return &FileUploadResponse{
 			Success: false,
+			TxHash:  txHashHex(tx),
 			Message: fmt.Sprintf("Failed to register user: %v", err),
 		}, err
 	}
@@ -125,9 +244,11 @@ func (c *ContractClient) RegisterUser(username string) (*FileUploadResponse, err
 	c.logger.WithField("txHash", tx.Hash().Hex()).Info("User registration transaction sent")
 
 	return &FileUploadResponse{
-		Success: true,
-		TxHash:  tx.Hash().Hex(),
-		Message: "User registered successfully on blockchain",
+		Success:     true,
+		TxHash:      tx.Hash().Hex(),
+		BlockNumber: receiptBlock(receipt),
+		GasUsed:     receiptGas(receipt),
+		Message:     "User registered successfully on blockchain",
 	}, nil
 }
 
@@ -140,24 +261,22 @@ func (c *ContractClient) UploadFile(req *FileUploadRequest) (*FileUploadResponse
 		"merkleRoot": req.MerkleRoot,
 	}).Info("Uploading file to blockchain...")
 
-	// Convert string hash to bytes32
-	fileHash := common.HexToHash(req.FileHash)
-
-	// Set storage fee
-	c.auth.Value = big.NewInt(1000000000000000) // 0.001 ETH
+	// Upload file with the storage fee attached
+	fee, err := c.StorageFee()
+	if err != nil {
+		c.logger.WithError(err).Error("Failed to upload file")
+		return &FileUploadResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to upload file: %v", err),
+		}, err
+	}
 
-	// Upload file
-	tx, err := c.contract.UploadFile(
-		c.auth,
-		fileHash,
-		req.Filename,
-		big.NewInt(int64(req.Size)),
-		req.MerkleRoot,
-	)
+	tx, receipt, err := c.transact("uploadFile", fee, c.uploadFileCall(req))
 	if err != nil {
 		c.logger.WithError(err).Error("Failed to upload file")
 		return &FileUploadResponse{
 			Success: false,
+			TxHash:  txHashHex(tx),
 			Message: fmt.Sprintf("Failed to upload file: %v", err),
 		}, err
 	}
@@ -165,9 +284,11 @@ func (c *ContractClient) UploadFile(req *FileUploadRequest) (*FileUploadResponse
 	c.logger.WithField("txHash", tx.Hash().Hex()).Info("File upload transaction sent")
 
 	return &FileUploadResponse{
-		Success: true,
-		TxHash:  tx.Hash().Hex(),
-		Message: "File uploaded successfully to blockchain",
+		Success:     true,
+		TxHash:      tx.Hash().Hex(),
+		BlockNumber: receiptBlock(receipt),
+		GasUsed:     receiptGas(receipt),
+		Message:     "File uploaded successfully to blockchain",
 	}, nil
 }
 
@@ -180,41 +301,22 @@ func (c *ContractClient) UploadFileWithVerification(req *FileUploadRequest) (*Fi
 		"merkleRoot": req.MerkleRoot,
 	}).Info("Uploading file with verification to blockchain...")
 
-	// Convert string hash to bytes32
-	fileHash := common.HexToHash(req.FileHash)
-	merkleRoot := common.HexToHash(req.MerkleRoot)
-	leafHash := common.HexToHash(req.LeafHash)
-
-	// Convert proof strings to bytes32 array
-	var proof []common.Hash
-	for _, p := range req.Proof {
-		proof = append(proof, common.HexToHash(p))
-	}
-
-	// Convert indices to uint256 array
-	var indices []*big.Int
-	for _, idx := range req.Indices {
-		indices = append(indices, big.NewInt(int64(idx)))
+	// Upload file with verification and the storage fee attached
+	fee, err := c.StorageFee()
+	if err != nil {
+		c.logger.WithError(err).Error("Failed to upload file with verification")
+		return &FileUploadResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to upload file with verification: %v", err),
+		}, err
 	}
 
-	// Set storage fee
-	c.auth.Value = big.NewInt(1000000000000000) // 0.001 ETH
-
-	// Upload file with verification
-	tx, err := c.contract.UploadFileWithVerification(
-		c.auth,
-		fileHash,
-		req.Filename,
-		big.NewInt(int64(req.Size)),
-		req.MerkleRoot,
-		proof,
-		indices,
-		leafHash,
-	)
+	tx, receipt, err := c.transact("uploadFileWithVerification", fee, c.uploadFileWithVerificationCall(req))
 	if err != nil {
 		c.logger.WithError(err).Error("Failed to upload file with verification")
 		return &FileUploadResponse{
 			Success: false,
+			TxHash:  txHashHex(tx),
 			Message: fmt.Sprintf("Failed to upload file with verification: %v", err),
 		}, err
 	}
@@ -222,23 +324,118 @@ func (c *ContractClient) UploadFileWithVerification(req *FileUploadRequest) (*Fi
 	c.logger.WithField("txHash", tx.Hash().Hex()).Info("File upload with verification transaction sent")
 
 	return &FileUploadResponse{
-		Success: true,
-		TxHash:  tx.Hash().Hex(),
-		Message: "File uploaded with verification successfully to blockchain",
+		Success:     true,
+		TxHash:      tx.Hash().Hex(),
+		BlockNumber: receiptBlock(receipt),
+		GasUsed:     receiptGas(receipt),
+		Message:     "File uploaded with verification successfully to blockchain",
 	}, nil
 }
 
+// EstimateUploadFile prices an UploadFile call, including the storage fee,
+// without sending anything
+func (c *ContractClient) EstimateUploadFile(req *FileUploadRequest) (*CostEstimate, error) {
+	return c.estimateUpload("uploadFile", c.uploadFileCall(req))
+}
+
+// EstimateUploadFileWithVerification prices an UploadFileWithVerification
+// call, including the storage fee, without sending anything
+func (c *ContractClient) EstimateUploadFileWithVerification(req *FileUploadRequest) (*CostEstimate, error) {
+	return c.estimateUpload("uploadFileWithVerification", c.uploadFileWithVerificationCall(req))
+}
+
+func (c *ContractClient) estimateUpload(method string, call func(*bind.TransactOpts) (*types.Transaction, error)) (*CostEstimate, error) {
+	fee, err := c.StorageFee()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	estimate, err := c.txm.Simulate(ctx, method, fee, call)
+	if err != nil {
+		return nil, callError(fmt.Errorf("failed to estimate %s: %w", method, err))
+	}
+	return estimate, nil
+}
+
+// StorageFee reads the fee FileStorage currently charges per upload
+func (c *ContractClient) StorageFee() (*big.Int, error) {
+	address, err := c.contract.FileStorage(nil)
+	if err != nil {
+		return nil, callError(fmt.Errorf("failed to resolve file storage contract: %w", err))
+	}
+
+	fileStorage, err := NewFileStorageContractCaller(address, c.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind file storage contract: %w", err)
+	}
+
+	fee, err := fileStorage.StorageFee(nil)
+	if err != nil {
+		return nil, callError(fmt.Errorf("failed to read storage fee: %w", err))
+	}
+	return fee, nil
+}
+
+func (c *ContractClient) uploadFileCall(req *FileUploadRequest) func(*bind.TransactOpts) (*types.Transaction, error) {
+	return func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return c.contract.UploadFile(
+			opts,
+			common.HexToHash(req.FileHash),
+			req.Filename,
+			new(big.Int).SetUint64(req.Size),
+			req.MerkleRoot,
+		)
+	}
+}
+
+func (c *ContractClient) uploadFileWithVerificationCall(req *FileUploadRequest) func(*bind.TransactOpts) (*types.Transaction, error) {
+	// Convert string hashes to bytes32
+	fileHash := common.HexToHash(req.FileHash)
+	leafHash := common.HexToHash(req.LeafHash)
+
+	// Convert proof strings to bytes32 array
+	var proof [][32]byte
+	for _, p := range req.Proof {
+		proof = append(proof, common.HexToHash(p))
+	}
+
+	// Convert indices to uint256 array
+	var indices []*big.Int
+	for _, idx := range req.Indices {
+		indices = append(indices, new(big.Int).SetUint64(idx))
+	}
+
+	return func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return c.contract.UploadFileWithVerification(
+			opts,
+			fileHash,
+			req.Filename,
+			new(big.Int).SetUint64(req.Size),
+			req.MerkleRoot,
+			proof,
+			indices,
+			leafHash,
+		)
+	}
+}
+
 // DownloadFile records file download on the blockchain
 func (c *ContractClient) DownloadFile(fileHash string) (*FileDownloadResponse, error) {
 	c.logger.WithField("fileHash", fileHash).Info("Recording file download on blockchain...")
 
 	hash := common.HexToHash(fileHash)
 
-	tx, err := c.contract.DownloadFile(c.auth, hash)
+	tx, receipt, err := c.transact("downloadFile", nil, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return c.contract.DownloadFile(opts, hash)
+	})
 	if err != nil {
 		c.logger.WithError(err).Error("Failed to record file download")
 		return &FileDownloadResponse{
 			Success: false,
+			TxHash:  txHashHex(tx),
 			Message: fmt.Sprintf("Failed to record file download: %v", err),
 		}, err
 	}
@@ -246,9 +443,11 @@ func (c *ContractClient) DownloadFile(fileHash string) (*FileDownloadResponse, e
 	c.logger.WithField("txHash", tx.Hash().Hex()).Info("File download transaction sent")
 
 	return &FileDownloadResponse{
-		Success: true,
-		TxHash:  tx.Hash().Hex(),
-		Message: "File download recorded successfully on blockchain",
+		Success:     true,
+		TxHash:      tx.Hash().Hex(),
+		BlockNumber: receiptBlock(receipt),
+		GasUsed:     receiptGas(receipt),
+		Message:     "File download recorded successfully on blockchain",
 	}, nil
 }
 
@@ -265,7 +464,7 @@ func (c *ContractClient) VerifyProof(req *FileUploadRequest) (*ProofVerification
 	leafHash := common.HexToHash(req.LeafHash)
 
 	// Convert proof strings to bytes32 array
-	var proof []common.Hash
+	var proof [][32]byte
 	for _, p := range req.Proof {
 		proof = append(proof, common.HexToHash(p))
 	}
@@ -273,22 +472,25 @@ func (c *ContractClient) VerifyProof(req *FileUploadRequest) (*ProofVerification
 	// Convert indices to uint256 array
 	var indices []*big.Int
 	for _, idx := range req.Indices {
-		indices = append(indices, big.NewInt(int64(idx)))
+		indices = append(indices, new(big.Int).SetUint64(idx))
 	}
 
 	// Verify proof
-	tx, err := c.contract.VerifyFileProof(
-		c.auth,
-		fileHash,
-		merkleRoot,
-		proof,
-		indices,
-		leafHash,
-	)
+	tx, receipt, err := c.transact("verifyFileProof", nil, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return c.contract.VerifyFileProof(
+			opts,
+			fileHash,
+			merkleRoot,
+			proof,
+			indices,
+			leafHash,
+		)
+	})
 	if err != nil {
 		c.logger.WithError(err).Error("Failed to verify proof")
 		return &ProofVerificationResponse{
 			Success: false,
+			TxHash:  txHashHex(tx),
 			Message: fmt.Sprintf("Failed to verify proof: %v", err),
 		}, err
 	}
@@ -296,10 +498,12 @@ func (c *ContractClient) VerifyProof(req *FileUploadRequest) (*ProofVerification
 	c.logger.WithField("txHash", tx.Hash().Hex()).Info("Proof verification transaction sent")
 
 	return &ProofVerificationResponse{
-		Success: true,
-		TxHash:  tx.Hash().Hex(),
-		Valid:   true, // This would be determined by the contract
-		Message: "Proof verified successfully on blockchain",
+		Success:     true,
+		TxHash:      tx.Hash().Hex(),
+		BlockNumber: receiptBlock(receipt),
+		GasUsed:     receiptGas(receipt),
+		Valid:       c.proofValidity(receipt),
+		Message:     "Proof verified successfully on blockchain",
 	}, nil
 }
 
@@ -309,20 +513,20 @@ func (c *ContractClient) GetFileMetadata(fileHash string) (map[string]interface{
 
 	hash := common.HexToHash(fileHash)
 
-	metadata, err := c.contract.GetFileMetadata(nil, hash)
+	storedHash, uploader, filename, size, uploadTimestamp, merkleRoot, isActive, err := c.contract.GetFileMetadata(nil, hash)
 	if err != nil {
 		c.logger.WithError(err).Error("Failed to get file metadata")
-		return nil, err
+		return nil, callError(err)
 	}
 
 	result := map[string]interface{}{
-		"fileHash":        metadata[0].Hex(),
-		"uploader":        metadata[1].Hex(),
-		"filename":        metadata[2],
-		"size":            metadata[3].Uint64(),
-		"uploadTimestamp": metadata[4].Uint64(),
-		"merkleRoot":      metadata[5],
-		"isActive":        metadata[6],
+		"fileHash":        common.Hash(storedHash).Hex(),
+		"uploader":        uploader.Hex(),
+		"filename":        filename,
+		"size":            size.Uint64(),
+		"uploadTimestamp": uploadTimestamp.Uint64(),
+		"merkleRoot":      merkleRoot,
+		"isActive":        isActive,
 	}
 
 	c.logger.WithField("metadata", result).Info("File metadata retrieved")
@@ -331,24 +535,25 @@ func (c *ContractClient) GetFileMetadata(fileHash string) (map[string]interface{
 }
 
 // GetUserProfile retrieves user profile from the blockchain
-func (c *ContractClient) GetUserProfile(userAddress string) (map[string]interface{}, error) {
+func (c *ContractClient) GetUserProfile(userAddress string) (*UserProfile, error) {
 	c.logger.WithField("userAddress", userAddress).Info("Retrieving user profile from blockchain...")
 
 	address := common.HexToAddress(userAddress)
 
-	profile, err := c.contract.GetUserProfile(nil, address)
+	username, registeredAt, lastActivity, storageQuota, storageUsed, isSuspended, err := c.contract.GetUserProfile(nil, address)
 	if err != nil {
 		c.logger.WithError(err).Error("Failed to get user profile")
-		return nil, err
+		return nil, callError(err)
 	}
 
-	result := map[string]interface{}{
-		"username":              profile[0],
-		"registrationTimestamp": profile[1].Uint64(),
-		"lastActivityTimestamp": profile[2].Uint64(),
-		"storageQuota":          profile[3].Uint64(),
-		"storageUsed":           profile[4].Uint64(),
-		"isSuspended":           profile[5],
+	result := &UserProfile{
+		Address:               address.Hex(),
+		Username:              username,
+		RegistrationTimestamp: registeredAt.Uint64(),
+		LastActivityTimestamp: lastActivity.Uint64(),
+		StorageQuota:          storageQuota.Uint64(),
+		StorageUsed:           storageUsed.Uint64(),
+		IsSuspended:           isSuspended,
 	}
 
 	c.logger.WithField("profile", result).Info("User profile retrieved")
@@ -357,21 +562,21 @@ func (c *ContractClient) GetUserProfile(userAddress string) (map[string]interfac
 }
 
 // GetSystemStats retrieves system statistics from the blockchain
-func (c *ContractClient) GetSystemStats() (map[string]interface{}, error) {
+func (c *ContractClient) GetSystemStats() (*SystemStats, error) {
 	c.logger.Info("Retrieving system statistics from blockchain...")
 
 	stats, err := c.contract.GetSystemStats(nil)
 	if err != nil {
 		c.logger.WithError(err).Error("Failed to get system stats")
-		return nil, err
+		return nil, callError(err)
 	}
 
-	result := map[string]interface{}{
-		"totalUsers":      stats[0].Uint64(),
-		"totalFiles":      stats[1].Uint64(),
-		"totalVerified":   stats[2].Uint64(),
-		"totalUploads":    stats[3].Uint64(),
-		"totalDownloads": stats[4].Uint64(),
+	result := &SystemStats{
+		TotalUsers:     stats.TotalUsers.Uint64(),
+		TotalFiles:     stats.TotalFiles.Uint64(),
+		TotalVerified:  stats.TotalVerified.Uint64(),
+		TotalUploads:   stats.TotalUploadsCount.Uint64(),
+		TotalDownloads: stats.TotalDownloadsCount.Uint64(),
 	}
 
 	c.logger.WithField("stats", result).Info("System statistics retrieved")
@@ -379,18 +584,184 @@ func (c *ContractClient) GetSystemStats() (map[string]interface{}, error) {
 	return result, nil
 }
 
-// HealthCheck checks the health of the contract connection
-func (c *ContractClient) HealthCheck() error {
-	c.logger.Info("Performing contract health check...")
+// GetFileAccess retrieves the accounts granted access to a file. It fails
+// with the contract's revert if the file does not exist.
+func (c *ContractClient) GetFileAccess(fileHash string) (*FileAccess, error) {
+	c.logger.WithField("fileHash", fileHash).Info("Retrieving file access from blockchain...")
+
+	hash := common.HexToHash(fileHash)
+
+	_, uploader, _, _, _, _, _, err := c.contract.GetFileMetadata(nil, hash)
+	if err != nil {
+		c.logger.WithError(err).Error("Failed to get file access")
+		return nil, callError(err)
+	}
+
+	fileStorage, err := c.fileStorageContract()
+	if err != nil {
+		c.logger.WithError(err).Error("Failed to get file access")
+		return nil, callError(err)
+	}
+
+	users, err := fileStorage.GetFileUsers(nil, hash)
+	if err != nil {
+		c.logger.WithError(err).Error("Failed to get file access")
+		return nil, callError(err)
+	}
+
+	result := &FileAccess{
+		FileHash: hash.Hex(),
+		Uploader: uploader.Hex(),
+		Grants:   make([]AccessGrant, 0, len(users)),
+	}
+	for _, user := range users {
+		authorized, err := fileStorage.IsUserAuthorized(nil, hash, user)
+		if err != nil {
+			c.logger.WithError(err).Error("Failed to get file access")
+			return nil, callError(err)
+		}
+		result.Grants = append(result.Grants, AccessGrant{Address: user.Hex(), Authorized: authorized})
+	}
+
+	return result, nil
+}
+
+// IsUserAuthorized reports whether an account has been granted access to a
+// file. Unknown files have no grants.
+func (c *ContractClient) IsUserAuthorized(fileHash, userAddress string) (bool, error) {
+	fileStorage, err := c.fileStorageContract()
+	if err != nil {
+		return false, err
+	}
+
+	authorized, err := fileStorage.IsUserAuthorized(nil, common.HexToHash(fileHash), common.HexToAddress(userAddress))
+	return authorized, callError(err)
+}
+
+// UserStatus reports whether an account is registered with the vault and,
+// if so, whether it is active rather than suspended
+func (c *ContractClient) UserStatus(userAddress string) (registered, active bool, err error) {
+	accessControl, err := c.accessControlContract()
+	if err != nil {
+		return false, false, err
+	}
+
+	address := common.HexToAddress(userAddress)
+	if registered, err = accessControl.IsUserRegistered(nil, address); err != nil || !registered {
+		return false, false, callError(err)
+	}
+	active, err = accessControl.IsUserActive(nil, address)
+	return registered, active, callError(err)
+}
+
+// StorageInfo returns a registered account's storage quota and the usage
+// recorded against it, in bytes
+func (c *ContractClient) StorageInfo(userAddress string) (quota, used uint64, err error) {
+	accessControl, err := c.accessControlContract()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	info, err := accessControl.GetUserStorageInfo(nil, common.HexToAddress(userAddress))
+	if err != nil {
+		return 0, 0, callError(err)
+	}
+	return info.Quota.Uint64(), info.Used.Uint64(), nil
+}
+
+// HasPermission reports whether a registered account holds the named
+// AccessControl permission. Permissions are keyed by the keccak256 hash of
+// their name, as roles are.
+func (c *ContractClient) HasPermission(userAddress, permission string) (bool, error) {
+	accessControl, err := c.accessControlContract()
+	if err != nil {
+		return false, err
+	}
+
+	granted, err := accessControl.HasPermission(nil, common.HexToAddress(userAddress), crypto.Keccak256Hash([]byte(permission)))
+	return granted, callError(err)
+}
+
+// AuthorizeUser grants an account access to a file
+func (c *ContractClient) AuthorizeUser(fileHash, userAddress string) (*TransactionResponse, error) {
+	c.logger.WithFields(logrus.Fields{
+		"fileHash":    fileHash,
+		"userAddress": userAddress,
+	}).Info("Authorizing user on blockchain...")
+
+	hash := common.HexToHash(fileHash)
+	user := common.HexToAddress(userAddress)
+
+	return c.sendTransaction("authorizeUser", "authorize user", "User authorized successfully on blockchain", func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return c.contract.AuthorizeUser(opts, hash, user)
+	})
+}
+
+// RevokeUser withdraws an account's access to a file
+func (c *ContractClient) RevokeUser(fileHash, userAddress string) (*TransactionResponse, error) {
+	c.logger.WithFields(logrus.Fields{
+		"fileHash":    fileHash,
+		"userAddress": userAddress,
+	}).Info("Revoking user on blockchain...")
+
+	hash := common.HexToHash(fileHash)
+	user := common.HexToAddress(userAddress)
+
+	return c.sendTransaction("revokeUser", "revoke user", "User revoked successfully on blockchain", func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return c.contract.RevokeUser(opts, hash, user)
+	})
+}
+
+// DeleteFile marks a file inactive on the blockchain
+func (c *ContractClient) DeleteFile(fileHash string) (*TransactionResponse, error) {
+	c.logger.WithField("fileHash", fileHash).Info("Deleting file on blockchain...")
+
+	hash := common.HexToHash(fileHash)
+
+	return c.sendTransaction("deleteFile", "delete file", "File deleted successfully on blockchain", func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return c.contract.DeleteFile(opts, hash)
+	})
+}
+
+// sendTransaction runs a state-changing call that returns nothing beyond
+// its receipt, logging failures as "Failed to <action>"
+func (c *ContractClient) sendTransaction(method, action, message string, fn func(*bind.TransactOpts) (*types.Transaction, error)) (*TransactionResponse, error) {
+	tx, receipt, err := c.transact(method, nil, fn)
+	if err != nil {
+		c.logger.WithError(err).Errorf("Failed to %s", action)
+		return &TransactionResponse{
+			Success: false,
+			TxHash:  txHashHex(tx),
+			Message: fmt.Sprintf("Failed to %s: %v", action, err),
+		}, err
+	}
+
+	c.logger.WithField("txHash", tx.Hash().Hex()).Infof("%s transaction sent", method)
+
+	return &TransactionResponse{
+		Success:     true,
+		TxHash:      tx.Hash().Hex(),
+		BlockNumber: receiptBlock(receipt),
+		GasUsed:     receiptGas(receipt),
+		Message:     message,
+	}, nil
+}
+
+// HealthCheck checks that the RPC endpoint serves the configured chain and
+// that the vault contract is deployed on it
+func (c *ContractClient) HealthCheck(ctx context.Context) error {
+	c.logger.Debug("Performing contract health check...")
 
-	// Check if we can connect to the blockchain
-	_, err := c.client.ChainID(context.Background())
+	chainID, err := c.client.ChainID(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to get chain ID: %w", err)
 	}
+	if chainID.Cmp(big.NewInt(c.config.ChainID)) != 0 {
+		return fmt.Errorf("RPC endpoint serves chain %s, expected %d", chainID, c.config.ChainID)
+	}
 
 	// Check if contract is deployed
-	code, err := c.client.CodeAt(context.Background(), common.HexToAddress(c.config.ContractAddress), nil)
+	code, err := c.client.CodeAt(ctx, common.HexToAddress(c.config.ContractAddress), nil)
 	if err != nil {
 		return fmt.Errorf("failed to get contract code: %w", err)
 	}
@@ -399,14 +770,146 @@ func (c *ContractClient) HealthCheck() error {
 		return fmt.Errorf("contract not deployed at address %s", c.config.ContractAddress)
 	}
 
-	c.logger.Info("Contract health check passed")
+	c.logger.Debug("Contract health check passed")
 	return nil
 }
 
+// accessControlContract binds the AccessControl contract the vault currently
+// points at
+func (c *ContractClient) accessControlContract() (*AccessControlContract, error) {
+	address, err := c.contract.AccessControl(nil)
+	if err != nil {
+		return nil, callError(fmt.Errorf("failed to resolve access control contract: %w", err))
+	}
+
+	return NewAccessControlContract(address, c.client)
+}
+
+// fileStorageContract binds the FileStorage contract the vault currently
+// points at
+func (c *ContractClient) fileStorageContract() (*FileStorageContract, error) {
+	address, err := c.contract.FileStorage(nil)
+	if err != nil {
+		return nil, callError(fmt.Errorf("failed to resolve file storage contract: %w", err))
+	}
+
+	return NewFileStorageContract(address, c.client)
+}
+
+// transact sends a transaction through the transaction manager and, when
+// confirmations are configured, waits for it to be mined. The transaction is
+// returned even if waiting fails so callers can report its hash; failures
+// are typed by callError.
+func (c *ContractClient) transact(method string, value *big.Int, fn func(*bind.TransactOpts) (*types.Transaction, error)) (tx *types.Transaction, receipt *types.Receipt, err error) {
+	ctx, cancel := c.context()
+	defer cancel()
+	ctx, span := tracing.Start(ctx, "contract."+method, attribute.String("contract.method", method))
+	defer func() { tracing.End(span, err) }()
+
+	tx, err = c.txm.Send(ctx, method, value, fn)
+	if err != nil {
+		return nil, nil, callError(err)
+	}
+	span.SetAttributes(attribute.String("tx.hash", tx.Hash().Hex()), attribute.Int64("tx.nonce", int64(tx.Nonce())))
+
+	if c.config.Confirmations == 0 {
+		return tx, nil, nil
+	}
+
+	receipt, err = c.txm.WaitMined(ctx, tx.Hash())
+	if receipt != nil {
+		span.SetAttributes(attribute.Int64("tx.block", receipt.BlockNumber.Int64()), attribute.Int64("tx.gas_used", int64(receipt.GasUsed)))
+	}
+	return tx, receipt, callError(err)
+}
+
+// context bounds a contract operation by the configured timeout
+func (c *ContractClient) context() (context.Context, context.CancelFunc) {
+	if c.config.Timeout > 0 {
+		return context.WithTimeout(context.Background(), c.config.Timeout)
+	}
+	return context.WithCancel(context.Background())
+}
+
+// proofValidity reads the verdict from the ProofVerified event in a receipt.
+// Without a receipt the outcome is unknown and the proof is reported valid,
+// as the transaction was accepted.
+func (c *ContractClient) proofValidity(receipt *types.Receipt) bool {
+	if receipt == nil {
+		return true
+	}
+
+	filterer, err := NewProofVerificationContractFilterer(common.Address{}, nil)
+	if err != nil {
+		return true
+	}
+	for _, log := range receipt.Logs {
+		if event, err := filterer.ParseProofVerified(*log); err == nil {
+			return event.IsValid
+		}
+	}
+
+	return true
+}
+
+// Start resumes tracking of transactions left pending by a previous run
+func (c *ContractClient) Start(ctx context.Context) {
+	c.txm.Start(ctx)
+}
+
+// PendingTransactions returns the transactions still awaiting confirmation
+func (c *ContractClient) PendingTransactions() []PendingTx {
+	return c.txm.Pending()
+}
+
+// WaitForTransaction blocks until a transaction sent by this client has the
+// configured confirmations, returning a RevertError if it failed. A
+// transaction that was already settled before a restart is looked up by its
+// receipt instead.
+func (c *ContractClient) WaitForTransaction(ctx context.Context, txHash string) (*types.Receipt, error) {
+	hash := common.HexToHash(txHash)
+	receipt, err := c.txm.WaitMined(ctx, hash)
+	if !errors.Is(err, ErrTxNotTracked) {
+		return receipt, err
+	}
+
+	receipt, err = c.client.TransactionReceipt(ctx, hash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get receipt for %s: %w", txHash, err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return receipt, &RevertError{TxHash: hash}
+	}
+	return receipt, nil
+}
+
+func txHashHex(tx *types.Transaction) string {
+	if tx == nil {
+		return ""
+	}
+	return tx.Hash().Hex()
+}
+
+func receiptBlock(receipt *types.Receipt) uint64 {
+	if receipt == nil {
+		return 0
+	}
+	return receipt.BlockNumber.Uint64()
+}
+
+func receiptGas(receipt *types.Receipt) uint64 {
+	if receipt == nil {
+		return 0
+	}
+	return receipt.GasUsed
+}
+
 // Close closes the contract client connection
 func (c *ContractClient) Close() error {
 	c.logger.Info("Closing contract client...")
-	c.client.Close()
+	if closer, ok := c.client.(interface{ Close() }); ok {
+		closer.Close()
+	}
 	c.logger.Info("Contract client closed")
 	return nil
 }
@@ -0,0 +1,509 @@
+package contracts
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/sirupsen/logrus"
+
+	"nebularvault-agent/internal/apierror"
+)
+
+// simulatedChainID is the chain ID go-ethereum's simulated backend always uses
+const simulatedChainID = 1337
+
+type testHarness struct {
+	backend  *simulated.Backend
+	owner    *ecdsa.PrivateKey
+	stranger *ecdsa.PrivateKey
+	address  common.Address
+	vault    *NebulaVaultContract
+	client   *ContractClient
+}
+
+// newTestHarness deploys the compiled NebulaVault artifacts onto a fresh
+// simulated chain and returns a ContractClient bound to the deployer account
+func newTestHarness(t *testing.T) *testHarness {
+	t.Helper()
+
+	owner, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("Failed to generate owner key: %v", err)
+	}
+	stranger, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("Failed to generate stranger key: %v", err)
+	}
+
+	balance := new(big.Int).Mul(big.NewInt(1000), big.NewInt(1e18))
+	backend := simulated.NewBackend(types.GenesisAlloc{
+		crypto.PubkeyToAddress(owner.PublicKey):    {Balance: balance},
+		crypto.PubkeyToAddress(stranger.PublicKey): {Balance: balance},
+	}, simulated.WithBlockGasLimit(30_000_000))
+	t.Cleanup(func() { backend.Close() })
+
+	deployer, err := bind.NewKeyedTransactorWithChainID(owner, big.NewInt(simulatedChainID))
+	if err != nil {
+		t.Fatalf("Failed to create deployer: %v", err)
+	}
+
+	address, _, vault, err := DeployNebulaVaultContract(deployer, backend.Client())
+	if err != nil {
+		t.Fatalf("Failed to deploy NebulaVault: %v", err)
+	}
+	backend.Commit()
+
+	h := &testHarness{
+		backend:  backend,
+		owner:    owner,
+		stranger: stranger,
+		address:  address,
+		vault:    vault,
+	}
+	h.client = h.newClient(t, owner)
+	return h
+}
+
+func (h *testHarness) newClient(t *testing.T, key *ecdsa.PrivateKey) *ContractClient {
+	t.Helper()
+
+	client, err := NewContractClientWithBackend(&ContractConfig{
+		ChainID:         simulatedChainID,
+		ContractAddress: h.address.Hex(),
+		PrivateKey:      hex.EncodeToString(crypto.FromECDSA(key)),
+	}, h.backend.Client(), logrus.New())
+	if err != nil {
+		t.Fatalf("Failed to create contract client: %v", err)
+	}
+	return client
+}
+
+// mine commits the pending block and fails the test unless txHash succeeded
+func (h *testHarness) mine(t *testing.T, txHash string) {
+	t.Helper()
+
+	h.backend.Commit()
+	receipt, err := h.backend.Client().TransactionReceipt(context.Background(), common.HexToHash(txHash))
+	if err != nil {
+		t.Fatalf("Failed to get receipt for %s: %v", txHash, err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		t.Fatalf("Transaction %s reverted", txHash)
+	}
+}
+
+func (h *testHarness) register(t *testing.T, client *ContractClient, username string) {
+	t.Helper()
+
+	resp, err := client.RegisterUser(username)
+	if err != nil {
+		t.Fatalf("Failed to register user: %v", err)
+	}
+	h.mine(t, resp.TxHash)
+}
+
+func expectRevert(t *testing.T, err error, reason string) {
+	t.Helper()
+
+	if err == nil {
+		t.Fatalf("Expected revert %q, got success", reason)
+	}
+	if !strings.Contains(err.Error(), reason) {
+		t.Errorf("Expected revert %q, got %v", reason, err)
+	}
+}
+
+func testUpload(hash, filename string, size uint64) *FileUploadRequest {
+	return &FileUploadRequest{
+		FileHash:   hash,
+		Filename:   filename,
+		Size:       size,
+		MerkleRoot: "0x" + strings.Repeat("ab", 32),
+		Proof:      []string{"0x" + strings.Repeat("01", 32)},
+		Indices:    []uint64{1},
+		LeafHash:   "0x" + strings.Repeat("02", 32),
+	}
+}
+
+func TestContractClient_RegisterUser(t *testing.T) {
+	h := newTestHarness(t)
+	h.register(t, h.client, "alice")
+
+	profile, err := h.client.GetUserProfile(crypto.PubkeyToAddress(h.owner.PublicKey).Hex())
+	if err != nil {
+		t.Fatalf("Failed to get user profile: %v", err)
+	}
+	if profile.Username != "alice" {
+		t.Errorf("Expected username 'alice', got '%v'", profile.Username)
+	}
+	if profile.StorageQuota != uint64(1<<30) {
+		t.Errorf("Expected default quota of 1GB, got %v", profile.StorageQuota)
+	}
+	if profile.IsSuspended {
+		t.Error("Expected new user not to be suspended")
+	}
+
+	_, err = h.client.RegisterUser("alice2")
+	expectRevert(t, err, "User already registered")
+
+	_, err = h.newClient(t, h.stranger).RegisterUser("alice")
+	expectRevert(t, err, "Username already taken")
+
+	_, err = h.client.GetUserProfile(crypto.PubkeyToAddress(h.stranger.PublicKey).Hex())
+	expectRevert(t, err, "User not registered")
+
+	registered, active, err := h.client.UserStatus(profile.Address)
+	if err != nil || !registered || !active {
+		t.Errorf("Expected alice to be registered and active, got %v, %v, %v", registered, active, err)
+	}
+	registered, active, err = h.client.UserStatus(crypto.PubkeyToAddress(h.stranger.PublicKey).Hex())
+	if err != nil || registered || active {
+		t.Errorf("Expected stranger to be unregistered, got %v, %v, %v", registered, active, err)
+	}
+	if allowed, err := h.client.HasPermission(profile.Address, "READ_ANY_FILE"); err != nil || allowed {
+		t.Errorf("Expected no custom permission, got %v, %v", allowed, err)
+	}
+	if quota, used, err := h.client.StorageInfo(profile.Address); err != nil || quota != profile.StorageQuota || used != 0 {
+		t.Errorf("Expected the default quota unused, got %d, %d, %v", quota, used, err)
+	}
+}
+
+func TestContractClient_UploadFile(t *testing.T) {
+	h := newTestHarness(t)
+	fileHash := "0x" + strings.Repeat("11", 32)
+
+	_, err := h.client.UploadFile(testUpload(fileHash, "early.txt", 1024))
+	expectRevert(t, err, "User not registered or inactive")
+
+	h.register(t, h.client, "alice")
+
+	resp, err := h.client.UploadFile(testUpload(fileHash, "report.pdf", 2048))
+	if err != nil {
+		t.Fatalf("Failed to upload file: %v", err)
+	}
+	h.mine(t, resp.TxHash)
+
+	metadata, err := h.client.GetFileMetadata(fileHash)
+	if err != nil {
+		t.Fatalf("Failed to get file metadata: %v", err)
+	}
+	if metadata["fileHash"] != common.HexToHash(fileHash).Hex() {
+		t.Errorf("Expected file hash %s, got %v", fileHash, metadata["fileHash"])
+	}
+	if metadata["filename"] != "report.pdf" {
+		t.Errorf("Expected filename 'report.pdf', got '%v'", metadata["filename"])
+	}
+	if metadata["size"] != uint64(2048) {
+		t.Errorf("Expected size 2048, got %v", metadata["size"])
+	}
+	if metadata["isActive"] != true {
+		t.Error("Expected uploaded file to be active")
+	}
+
+	stats, err := h.client.GetSystemStats()
+	if err != nil {
+		t.Fatalf("Failed to get system stats: %v", err)
+	}
+	if stats.TotalFiles != 1 || stats.TotalUploads != 1 || stats.TotalVerified != 1 {
+		t.Errorf("Unexpected system stats after trusted upload: %+v", stats)
+	}
+
+	_, err = h.client.UploadFile(testUpload(fileHash, "report.pdf", 2048))
+	expectRevert(t, err, "File already exists")
+
+	_, err = h.client.UploadFile(testUpload("0x"+strings.Repeat("12", 32), "huge.bin", 100*1024*1024+1))
+	expectRevert(t, err, "File size exceeds maximum allowed")
+
+	_, err = h.client.GetFileMetadata("0x" + strings.Repeat("13", 32))
+	expectRevert(t, err, "File does not exist")
+}
+
+// TestContractClient_QuotaExceeded charges an uploader's storage past its
+// quota and checks the revert decodes to quota_exceeded
+func TestContractClient_QuotaExceeded(t *testing.T) {
+	h := newTestHarness(t)
+	accessControl, err := h.client.accessControlContract()
+	if err != nil {
+		t.Fatalf("Failed to bind access control: %v", err)
+	}
+	user := crypto.PubkeyToAddress(h.stranger.PublicKey)
+
+	// Registering directly with AccessControl grants the caller UPLOADER_ROLE
+	uploader, err := bind.NewKeyedTransactorWithChainID(h.stranger, big.NewInt(simulatedChainID))
+	if err != nil {
+		t.Fatalf("Failed to create transactor: %v", err)
+	}
+	tx, err := accessControl.RegisterUser(uploader, "uploader")
+	if err != nil {
+		t.Fatalf("Failed to register uploader: %v", err)
+	}
+	h.mine(t, tx.Hash().Hex())
+
+	quota, _, err := h.client.StorageInfo(user.Hex())
+	if err != nil {
+		t.Fatalf("Failed to get storage info: %v", err)
+	}
+	tx, err = accessControl.UpdateStorageUsage(uploader, user, new(big.Int).SetUint64(quota))
+	if err != nil {
+		t.Fatalf("Failed to use the whole quota: %v", err)
+	}
+	h.mine(t, tx.Hash().Hex())
+	if _, used, err := h.client.StorageInfo(user.Hex()); err != nil || used != quota {
+		t.Fatalf("Expected %d bytes used, got %d, %v", quota, used, err)
+	}
+
+	_, err = accessControl.UpdateStorageUsage(uploader, user, big.NewInt(1))
+	expectRevert(t, err, "Storage quota exceeded")
+	if err := callError(err); apierror.CodeOf(err) != apierror.QuotaExceeded || apierror.From(err).Message != "Storage quota exceeded" {
+		t.Errorf("Expected quota_exceeded for the revert, got %v (%s)", err, apierror.CodeOf(err))
+	}
+}
+
+func TestContractClient_DownloadFile(t *testing.T) {
+	h := newTestHarness(t)
+	h.register(t, h.client, "alice")
+
+	trusted := "0x" + strings.Repeat("21", 32)
+	resp, err := h.client.UploadFile(testUpload(trusted, "trusted.txt", 10))
+	if err != nil {
+		t.Fatalf("Failed to upload file: %v", err)
+	}
+	h.mine(t, resp.TxHash)
+
+	// The storage fee of the upload must not be attached to the
+	// non-payable download that follows it.
+	download, err := h.client.DownloadFile(trusted)
+	if err != nil {
+		t.Fatalf("Failed to record download: %v", err)
+	}
+	h.mine(t, download.TxHash)
+
+	stats, err := h.client.GetSystemStats()
+	if err != nil {
+		t.Fatalf("Failed to get system stats: %v", err)
+	}
+	if stats.TotalDownloads != 1 {
+		t.Errorf("Expected 1 download, got %v", stats.TotalDownloads)
+	}
+
+	unverified := "0x" + strings.Repeat("22", 32)
+	resp, err = h.client.UploadFileWithVerification(testUpload(unverified, "pending.txt", 10))
+	if err != nil {
+		t.Fatalf("Failed to upload file with verification: %v", err)
+	}
+	h.mine(t, resp.TxHash)
+
+	_, err = h.client.DownloadFile(unverified)
+	expectRevert(t, err, "File not verified")
+
+	_, err = h.newClient(t, h.stranger).DownloadFile(trusted)
+	expectRevert(t, err, "User not registered or inactive")
+}
+
+func TestContractClient_UploadFileWithVerification(t *testing.T) {
+	h := newTestHarness(t)
+	h.register(t, h.client, "alice")
+
+	fileHash := "0x" + strings.Repeat("31", 32)
+	resp, err := h.client.UploadFileWithVerification(testUpload(fileHash, "proof.txt", 64))
+	if err != nil {
+		t.Fatalf("Failed to upload file with verification: %v", err)
+	}
+	h.mine(t, resp.TxHash)
+
+	verified, err := h.vault.IsFileVerified(nil, common.HexToHash(fileHash))
+	if err != nil {
+		t.Fatalf("Failed to check verification: %v", err)
+	}
+	if verified {
+		t.Error("Expected file uploaded with a proof to await verification")
+	}
+
+	empty := testUpload("0x"+strings.Repeat("32", 32), "empty.txt", 64)
+	empty.Proof, empty.Indices = nil, nil
+	_, err = h.client.UploadFileWithVerification(empty)
+	expectRevert(t, err, "Proof cannot be empty")
+
+	// Mark a file verified on a ProofVerification owned by the test so the
+	// vault rejects a second proof submission for it.
+	deployer, err := bind.NewKeyedTransactorWithChainID(h.owner, big.NewInt(simulatedChainID))
+	if err != nil {
+		t.Fatalf("Failed to create deployer: %v", err)
+	}
+	pvAddress, _, pv, err := DeployProofVerificationContract(deployer, h.backend.Client())
+	if err != nil {
+		t.Fatalf("Failed to deploy ProofVerification: %v", err)
+	}
+	h.backend.Commit()
+
+	tx, err := h.vault.UpgradeProofVerification(deployer, pvAddress)
+	if err != nil {
+		t.Fatalf("Failed to upgrade ProofVerification: %v", err)
+	}
+	h.mine(t, tx.Hash().Hex())
+
+	already := "0x" + strings.Repeat("33", 32)
+	tx, err = pv.EmergencyVerify(deployer, common.HexToHash(already), true)
+	if err != nil {
+		t.Fatalf("Failed to emergency verify: %v", err)
+	}
+	h.mine(t, tx.Hash().Hex())
+
+	_, err = h.client.UploadFileWithVerification(testUpload(already, "again.txt", 64))
+	expectRevert(t, err, "File already verified")
+}
+
+func TestContractClient_VerifyProof(t *testing.T) {
+	h := newTestHarness(t)
+	h.register(t, h.client, "alice")
+
+	fileHash := "0x" + strings.Repeat("41", 32)
+	resp, err := h.client.UploadFileWithVerification(testUpload(fileHash, "verify.txt", 64))
+	if err != nil {
+		t.Fatalf("Failed to upload file with verification: %v", err)
+	}
+	h.mine(t, resp.TxHash)
+
+	// A right-child proof of depth one hashes leaf||sibling into the root
+	leaf := common.HexToHash("0x" + strings.Repeat("0a", 32))
+	sibling := common.HexToHash("0x" + strings.Repeat("0b", 32))
+	root := crypto.Keccak256Hash(leaf.Bytes(), sibling.Bytes())
+
+	req := &FileUploadRequest{
+		FileHash:   fileHash,
+		MerkleRoot: root.Hex(),
+		Proof:      []string{sibling.Hex()},
+		Indices:    []uint64{1},
+		LeafHash:   leaf.Hex(),
+	}
+	verify, err := h.client.VerifyProof(req)
+	if err != nil {
+		t.Fatalf("Failed to verify proof: %v", err)
+	}
+	h.mine(t, verify.TxHash)
+
+	verified, err := h.vault.IsFileVerified(nil, common.HexToHash(fileHash))
+	if err != nil {
+		t.Fatalf("Failed to check verification: %v", err)
+	}
+	if !verified {
+		t.Error("Expected file to be verified after a valid proof")
+	}
+
+	stats, err := h.client.GetSystemStats()
+	if err != nil {
+		t.Fatalf("Failed to get system stats: %v", err)
+	}
+	if stats.TotalVerified != 1 {
+		t.Errorf("Expected 1 verified file, got %v", stats.TotalVerified)
+	}
+
+	_, err = h.client.VerifyProof(req)
+	expectRevert(t, err, "Already verified by this address")
+
+	req.Proof = append(req.Proof, sibling.Hex())
+	_, err = h.client.VerifyProof(req)
+	expectRevert(t, err, "Proof and indices length mismatch")
+}
+
+func TestContractClient_FileAccess(t *testing.T) {
+	h := newTestHarness(t)
+	h.register(t, h.client, "alice")
+	stranger := crypto.PubkeyToAddress(h.stranger.PublicKey).Hex()
+
+	fileHash := "0x" + strings.Repeat("41", 32)
+	_, err := h.client.AuthorizeUser(fileHash, stranger)
+	expectRevert(t, err, "File does not exist")
+
+	resp, err := h.client.UploadFile(testUpload(fileHash, "shared.txt", 10))
+	if err != nil {
+		t.Fatalf("Failed to upload file: %v", err)
+	}
+	h.mine(t, resp.TxHash)
+
+	grant, err := h.client.AuthorizeUser(fileHash, stranger)
+	if err != nil {
+		t.Fatalf("Failed to authorize user: %v", err)
+	}
+	h.mine(t, grant.TxHash)
+
+	access, err := h.client.GetFileAccess(fileHash)
+	if err != nil {
+		t.Fatalf("Failed to get file access: %v", err)
+	}
+	if !hasGrant(access, stranger, true) {
+		t.Errorf("Expected %s to be authorized, got %+v", stranger, access.Grants)
+	}
+	if authorized, err := h.client.IsUserAuthorized(fileHash, stranger); err != nil || !authorized {
+		t.Errorf("Expected %s to be authorized, got %v, %v", stranger, authorized, err)
+	}
+
+	revoke, err := h.client.RevokeUser(fileHash, stranger)
+	if err != nil {
+		t.Fatalf("Failed to revoke user: %v", err)
+	}
+	h.mine(t, revoke.TxHash)
+
+	access, err = h.client.GetFileAccess(fileHash)
+	if err != nil {
+		t.Fatalf("Failed to get file access: %v", err)
+	}
+	if !hasGrant(access, stranger, false) {
+		t.Errorf("Expected %s to be revoked, got %+v", stranger, access.Grants)
+	}
+	if authorized, err := h.client.IsUserAuthorized(fileHash, stranger); err != nil || authorized {
+		t.Errorf("Expected %s to be revoked, got %v, %v", stranger, authorized, err)
+	}
+
+	del, err := h.client.DeleteFile(fileHash)
+	if err != nil {
+		t.Fatalf("Failed to delete file: %v", err)
+	}
+	h.mine(t, del.TxHash)
+
+	_, err = h.client.DeleteFile(fileHash)
+	expectRevert(t, err, "File is not active")
+
+	_, err = h.client.GetFileAccess("0x" + strings.Repeat("42", 32))
+	expectRevert(t, err, "File does not exist")
+}
+
+func hasGrant(access *FileAccess, address string, authorized bool) bool {
+	for _, grant := range access.Grants {
+		if grant.Address == address {
+			return grant.Authorized == authorized
+		}
+	}
+	return false
+}
+
+func TestContractClient_HealthCheck(t *testing.T) {
+	h := newTestHarness(t)
+
+	ctx := context.Background()
+
+	if err := h.client.HealthCheck(ctx); err != nil {
+		t.Errorf("Expected health check to pass, got %v", err)
+	}
+
+	client := h.newClient(t, h.owner)
+	client.config.ContractAddress = crypto.PubkeyToAddress(h.stranger.PublicKey).Hex()
+	if err := client.HealthCheck(ctx); err == nil {
+		t.Error("Expected health check to fail without contract code")
+	}
+
+	client = h.newClient(t, h.owner)
+	client.config.ChainID = 1
+	if err := client.HealthCheck(ctx); err == nil || !strings.Contains(err.Error(), "expected 1") {
+		t.Errorf("Expected health check to fail on a chain ID mismatch, got %v", err)
+	}
+}
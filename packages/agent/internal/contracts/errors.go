@@ -0,0 +1,65 @@
+package contracts
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+
+	"nebularvault-agent/internal/apierror"
+)
+
+// revertCodes maps lowercase fragments of contract revert reasons to the
+// code that describes them; the first match wins
+var revertCodes = []struct {
+	fragment string
+	code     apierror.Code
+}{
+	{"file does not exist", apierror.FileNotFound},
+	{"does not exist", apierror.NotFound},
+	{"user not registered or inactive", apierror.Forbidden},
+	{"user not registered", apierror.NotFound},
+	{"already", apierror.Conflict},
+	{"quota exceeded", apierror.QuotaExceeded},
+	{"not active", apierror.Gone},
+	{"only file owner", apierror.Forbidden},
+	{"not authorized", apierror.Forbidden},
+	{"suspended", apierror.Forbidden},
+	{"paused", apierror.Unavailable},
+}
+
+// callError types a failed contract call. A revert is the contract refusing
+// the request, so it keeps its reason as the message; anything else means
+// the chain could not be reached in time or at all.
+func callError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var typed *apierror.Error
+	if errors.As(err, &typed) {
+		return err
+	}
+
+	reason, reverted := RevertReason(err)
+	var revertErr *RevertError
+	if errors.As(err, &revertErr) {
+		reason, reverted = revertErr.Reason, true
+	}
+	if reverted {
+		if reason == "" {
+			return apierror.Wrap(err, apierror.ContractReverted, "Transaction reverted")
+		}
+		for _, rc := range revertCodes {
+			if strings.Contains(strings.ToLower(reason), rc.fragment) {
+				return apierror.Wrap(err, rc.code, reason)
+			}
+		}
+		return apierror.Wrap(err, apierror.ContractReverted, reason)
+	}
+
+	var netErr net.Error
+	if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout()) {
+		return apierror.Wrap(err, apierror.ChainTimeout, "Blockchain call timed out")
+	}
+	return apierror.Wrap(err, apierror.ChainUnavailable, "Blockchain call failed")
+}
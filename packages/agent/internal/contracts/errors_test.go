@@ -0,0 +1,44 @@
+package contracts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"nebularvault-agent/internal/apierror"
+)
+
+func TestCallError(t *testing.T) {
+	tests := []struct {
+		err     error
+		code    apierror.Code
+		message string
+	}{
+		{errors.New("dial tcp: connection refused"), apierror.ChainUnavailable, "Blockchain call failed"},
+		{fmt.Errorf("failed to send: %w", context.DeadlineExceeded), apierror.ChainTimeout, "Blockchain call timed out"},
+		{&RevertError{Reason: "Proof too long"}, apierror.ContractReverted, "Proof too long"},
+		{&RevertError{}, apierror.ContractReverted, "Transaction reverted"},
+		{errors.New("execution reverted: Already verified by this address"), apierror.Conflict, "Already verified by this address"},
+		{errors.New("execution reverted: File does not exist"), apierror.FileNotFound, "File does not exist"},
+		{errors.New("execution reverted: User not registered or inactive"), apierror.Forbidden, "User not registered or inactive"},
+		{errors.New("execution reverted: User not registered"), apierror.NotFound, "User not registered"},
+	}
+	for _, tt := range tests {
+		err := apierror.From(callError(tt.err))
+		if err.Code != tt.code || err.Message != tt.message {
+			t.Errorf("callError(%v) = %s %q, expected %s %q", tt.err, err.Code, err.Message, tt.code, tt.message)
+		}
+		if !errors.Is(err, tt.err) {
+			t.Errorf("Expected callError(%v) to wrap its cause", tt.err)
+		}
+	}
+
+	if callError(nil) != nil {
+		t.Error("Expected nil for nil")
+	}
+	typed := apierror.New(apierror.InvalidHash, "Invalid hash")
+	if callError(typed) != typed {
+		t.Error("Expected a typed error to pass through")
+	}
+}
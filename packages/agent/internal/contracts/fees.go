@@ -0,0 +1,201 @@
+package contracts
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// FeeStrategy selects how aggressively transaction fees are priced
+type FeeStrategy string
+
+const (
+	FeeStrategySlow     FeeStrategy = "slow"
+	FeeStrategyStandard FeeStrategy = "standard"
+	FeeStrategyFast     FeeStrategy = "fast"
+	FeeStrategyLegacy   FeeStrategy = "legacy"
+)
+
+// feeStrategyParams maps each EIP-1559 strategy to the priority fee
+// percentile sampled from recent blocks and the multiple of the next base
+// fee the fee cap tolerates
+var feeStrategyParams = map[FeeStrategy]struct {
+	percentile        float64
+	baseFeeMultiplier int64
+}{
+	FeeStrategySlow:     {percentile: 10, baseFeeMultiplier: 1},
+	FeeStrategyStandard: {percentile: 50, baseFeeMultiplier: 2},
+	FeeStrategyFast:     {percentile: 90, baseFeeMultiplier: 3},
+}
+
+// FeeConfig controls fee pricing and gas limits for outgoing transactions
+type FeeConfig struct {
+	Strategy           FeeStrategy
+	HistoryBlocks      uint64
+	MaxFeePerGas       *big.Int
+	GasLimitMultiplier float64
+}
+
+// Fees is a fee suggestion; GasPrice is only set for legacy pricing
+type Fees struct {
+	GasTipCap *big.Int `json:"gas_tip_cap,omitempty"`
+	GasFeeCap *big.Int `json:"gas_fee_cap,omitempty"`
+	GasPrice  *big.Int `json:"gas_price,omitempty"`
+	BaseFee   *big.Int `json:"base_fee,omitempty"`
+}
+
+// CostEstimate is the expected cost of a transaction, computed without
+// sending it
+type CostEstimate struct {
+	Method       string   `json:"method"`
+	GasLimit     uint64   `json:"gas_limit"`
+	Fees         *Fees    `json:"fees"`
+	Value        *big.Int `json:"value"`
+	ExpectedCost *big.Int `json:"expected_cost"`
+	MaxCost      *big.Int `json:"max_cost"`
+}
+
+// FeeOracle suggests fees from eth_feeHistory according to a strategy
+type FeeOracle struct {
+	backend Backend
+	config  FeeConfig
+}
+
+// NewFeeOracle creates a fee oracle, defaulting to the standard strategy
+func NewFeeOracle(backend Backend, config FeeConfig) (*FeeOracle, error) {
+	if config.Strategy == "" {
+		config.Strategy = FeeStrategyStandard
+	}
+	if _, ok := feeStrategyParams[config.Strategy]; !ok && config.Strategy != FeeStrategyLegacy {
+		return nil, fmt.Errorf("unknown fee strategy %q", config.Strategy)
+	}
+	if config.HistoryBlocks == 0 {
+		config.HistoryBlocks = 20
+	}
+	if config.GasLimitMultiplier < 1 {
+		config.GasLimitMultiplier = 1
+	}
+
+	return &FeeOracle{
+		backend: backend,
+		config:  config,
+	}, nil
+}
+
+// SuggestFees prices a transaction for inclusion in the next few blocks
+func (o *FeeOracle) SuggestFees(ctx context.Context) (*Fees, error) {
+	if o.config.Strategy == FeeStrategyLegacy {
+		gasPrice, err := o.backend.SuggestGasPrice(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to suggest gas price: %w", err)
+		}
+		return &Fees{GasPrice: o.cap(gasPrice)}, nil
+	}
+
+	params := feeStrategyParams[o.config.Strategy]
+	history, err := o.backend.FeeHistory(ctx, o.config.HistoryBlocks, nil, []float64{params.percentile})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get fee history: %w", err)
+	}
+	if len(history.BaseFee) == 0 {
+		return nil, fmt.Errorf("fee history returned no base fees")
+	}
+
+	// The last base fee is the one projected for the next block
+	baseFee := history.BaseFee[len(history.BaseFee)-1]
+
+	tip := medianReward(history.Reward)
+	if tip == nil {
+		// Empty blocks carry no priority fees to sample
+		if tip, err = o.backend.SuggestGasTipCap(ctx); err != nil {
+			return nil, fmt.Errorf("failed to suggest gas tip: %w", err)
+		}
+	}
+
+	feeCap := new(big.Int).Mul(baseFee, big.NewInt(params.baseFeeMultiplier))
+	feeCap.Add(feeCap, tip)
+	feeCap = o.cap(feeCap)
+	if tip.Cmp(feeCap) > 0 {
+		tip = new(big.Int).Set(feeCap)
+	}
+
+	return &Fees{
+		GasTipCap: tip,
+		GasFeeCap: feeCap,
+		BaseFee:   baseFee,
+	}, nil
+}
+
+// Apply prices opts with fees
+func (f *Fees) Apply(opts *bind.TransactOpts) {
+	if f.GasPrice != nil {
+		opts.GasPrice = f.GasPrice
+		return
+	}
+	opts.GasTipCap = f.GasTipCap
+	opts.GasFeeCap = f.GasFeeCap
+}
+
+// Estimate fills in the gas limit and cost of tx, which must have been
+// built with NoSend from opts priced by fees
+func (f *Fees) Estimate(method string, tx *types.Transaction) *CostEstimate {
+	perGasMax := f.GasFeeCap
+	perGasExpected := f.GasPrice
+	if f.GasPrice != nil {
+		perGasMax = f.GasPrice
+	} else {
+		perGasExpected = new(big.Int).Add(f.BaseFee, f.GasTipCap)
+		if perGasExpected.Cmp(f.GasFeeCap) > 0 {
+			perGasExpected = f.GasFeeCap
+		}
+	}
+
+	gas := new(big.Int).SetUint64(tx.Gas())
+	expected := new(big.Int).Mul(gas, perGasExpected)
+	expected.Add(expected, tx.Value())
+	maxCost := new(big.Int).Mul(gas, perGasMax)
+	maxCost.Add(maxCost, tx.Value())
+
+	return &CostEstimate{
+		Method:       method,
+		GasLimit:     tx.Gas(),
+		Fees:         f,
+		Value:        tx.Value(),
+		ExpectedCost: expected,
+		MaxCost:      maxCost,
+	}
+}
+
+// GasLimit pads an estimated gas amount by the configured multiplier
+func (o *FeeOracle) GasLimit(estimated uint64) uint64 {
+	return uint64(float64(estimated) * o.config.GasLimitMultiplier)
+}
+
+// cap clamps a per-gas fee to MaxFeePerGas when one is configured
+func (o *FeeOracle) cap(fee *big.Int) *big.Int {
+	if o.config.MaxFeePerGas != nil && fee.Cmp(o.config.MaxFeePerGas) > 0 {
+		return new(big.Int).Set(o.config.MaxFeePerGas)
+	}
+	return fee
+}
+
+// medianReward returns the median of the non-zero sampled priority fees, or
+// nil when no block paid any
+func medianReward(rewards [][]*big.Int) *big.Int {
+	var samples []*big.Int
+	for _, block := range rewards {
+		if len(block) > 0 && block[0] != nil && block[0].Sign() > 0 {
+			samples = append(samples, block[0])
+		}
+	}
+	if len(samples) == 0 {
+		return nil
+	}
+
+	sort.Slice(samples, func(i, j int) bool { return samples[i].Cmp(samples[j]) < 0 })
+	return new(big.Int).Set(samples[len(samples)/2])
+}
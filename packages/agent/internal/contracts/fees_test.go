@@ -0,0 +1,159 @@
+package contracts
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+func TestFeeOracle_Strategies(t *testing.T) {
+	h := newTestHarness(t)
+	ctx := context.Background()
+
+	suggest := func(config FeeConfig) *Fees {
+		t.Helper()
+		oracle, err := NewFeeOracle(h.backend.Client(), config)
+		if err != nil {
+			t.Fatalf("Failed to create fee oracle: %v", err)
+		}
+		fees, err := oracle.SuggestFees(ctx)
+		if err != nil {
+			t.Fatalf("Failed to suggest fees: %v", err)
+		}
+		return fees
+	}
+
+	slow := suggest(FeeConfig{Strategy: FeeStrategySlow})
+	standard := suggest(FeeConfig{})
+	fast := suggest(FeeConfig{Strategy: FeeStrategyFast})
+
+	for name, fees := range map[string]*Fees{"slow": slow, "standard": standard, "fast": fast} {
+		if fees.GasPrice != nil {
+			t.Errorf("Expected %s strategy to price with EIP-1559 fees", name)
+		}
+		if fees.GasFeeCap.Cmp(fees.BaseFee) < 0 {
+			t.Errorf("Expected %s fee cap %s to cover base fee %s", name, fees.GasFeeCap, fees.BaseFee)
+		}
+		if fees.GasTipCap.Cmp(fees.GasFeeCap) > 0 {
+			t.Errorf("Expected %s tip %s not to exceed fee cap %s", name, fees.GasTipCap, fees.GasFeeCap)
+		}
+	}
+	if slow.GasFeeCap.Cmp(standard.GasFeeCap) > 0 || standard.GasFeeCap.Cmp(fast.GasFeeCap) > 0 {
+		t.Errorf("Expected fee caps to grow with urgency, got %s, %s, %s", slow.GasFeeCap, standard.GasFeeCap, fast.GasFeeCap)
+	}
+
+	legacy := suggest(FeeConfig{Strategy: FeeStrategyLegacy})
+	if legacy.GasPrice == nil || legacy.GasFeeCap != nil {
+		t.Errorf("Expected legacy strategy to suggest only a gas price, got %+v", legacy)
+	}
+
+	capped := suggest(FeeConfig{Strategy: FeeStrategyFast, MaxFeePerGas: big.NewInt(1000)})
+	if capped.GasFeeCap.Cmp(big.NewInt(1000)) != 0 || capped.GasTipCap.Cmp(big.NewInt(1000)) > 0 {
+		t.Errorf("Expected fees capped at 1000 wei, got %+v", capped)
+	}
+
+	if _, err := NewFeeOracle(h.backend.Client(), FeeConfig{Strategy: "ludicrous"}); err == nil {
+		t.Error("Expected unknown fee strategy to be rejected")
+	}
+}
+
+func TestMedianReward(t *testing.T) {
+	rewards := [][]*big.Int{{big.NewInt(0)}, {big.NewInt(30)}, {big.NewInt(10)}, {big.NewInt(20)}, {}}
+	if median := medianReward(rewards); median.Cmp(big.NewInt(20)) != 0 {
+		t.Errorf("Expected median reward 20, got %s", median)
+	}
+
+	if median := medianReward([][]*big.Int{{big.NewInt(0)}}); median != nil {
+		t.Errorf("Expected no median for empty blocks, got %s", median)
+	}
+}
+
+func TestContractClient_EstimateUploadFile(t *testing.T) {
+	h := newTestHarness(t)
+	h.register(t, h.client, "alice")
+
+	req := testUpload("0x"+strings.Repeat("61", 32), "estimate.txt", 512)
+	estimate, err := h.client.EstimateUploadFile(req)
+	if err != nil {
+		t.Fatalf("Failed to estimate upload: %v", err)
+	}
+
+	if estimate.Method != "uploadFile" || estimate.GasLimit == 0 {
+		t.Errorf("Unexpected estimate: %+v", estimate)
+	}
+	if estimate.Value.Cmp(big.NewInt(1000000000000000)) != 0 {
+		t.Errorf("Expected storage fee of 0.001 ETH, got %s", estimate.Value)
+	}
+	if estimate.ExpectedCost.Cmp(estimate.Value) <= 0 || estimate.MaxCost.Cmp(estimate.ExpectedCost) < 0 {
+		t.Errorf("Expected value < expected cost <= max cost, got %s, %s, %s", estimate.Value, estimate.ExpectedCost, estimate.MaxCost)
+	}
+
+	// Estimating must not consume a nonce or send anything
+	if pending := h.client.PendingTransactions(); len(pending) != 1 {
+		t.Errorf("Expected only the registration to be pending, got %d", len(pending))
+	}
+
+	padded := h.newClient(t, h.owner)
+	padded.txm.fees.config.GasLimitMultiplier = 1.5
+	paddedEstimate, err := padded.EstimateUploadFile(req)
+	if err != nil {
+		t.Fatalf("Failed to estimate upload: %v", err)
+	}
+	if paddedEstimate.GasLimit != uint64(float64(estimate.GasLimit)*1.5) {
+		t.Errorf("Expected gas limit padded to %d, got %d", uint64(float64(estimate.GasLimit)*1.5), paddedEstimate.GasLimit)
+	}
+
+	withProof, err := h.client.EstimateUploadFileWithVerification(req)
+	if err != nil {
+		t.Fatalf("Failed to estimate upload with verification: %v", err)
+	}
+	if withProof.GasLimit <= estimate.GasLimit {
+		t.Errorf("Expected proof submission to cost more gas than %d, got %d", estimate.GasLimit, withProof.GasLimit)
+	}
+}
+
+func TestContractClient_ReadsStorageFeeFromContract(t *testing.T) {
+	h := newTestHarness(t)
+	h.register(t, h.client, "alice")
+
+	// Point the vault at a FileStorage the test owns so the fee can change
+	deployer, err := bind.NewKeyedTransactorWithChainID(h.owner, big.NewInt(simulatedChainID))
+	if err != nil {
+		t.Fatalf("Failed to create deployer: %v", err)
+	}
+	fsAddress, _, fileStorage, err := DeployFileStorageContract(deployer, h.backend.Client())
+	if err != nil {
+		t.Fatalf("Failed to deploy FileStorage: %v", err)
+	}
+	h.backend.Commit()
+
+	tx, err := h.vault.UpgradeFileStorage(deployer, fsAddress)
+	if err != nil {
+		t.Fatalf("Failed to upgrade FileStorage: %v", err)
+	}
+	h.mine(t, tx.Hash().Hex())
+
+	newFee := big.NewInt(2000000000000000) // 0.002 ETH
+	tx, err = fileStorage.SetStorageFee(deployer, newFee)
+	if err != nil {
+		t.Fatalf("Failed to set storage fee: %v", err)
+	}
+	h.mine(t, tx.Hash().Hex())
+
+	fee, err := h.client.StorageFee()
+	if err != nil {
+		t.Fatalf("Failed to read storage fee: %v", err)
+	}
+	if fee.Cmp(newFee) != 0 {
+		t.Errorf("Expected storage fee %s, got %s", newFee, fee)
+	}
+
+	resp, err := h.client.UploadFile(testUpload("0x"+strings.Repeat("62", 32), "fee.txt", 128))
+	if err != nil {
+		t.Fatalf("Failed to upload with the contract's storage fee: %v", err)
+	}
+	h.mine(t, resp.TxHash)
+}
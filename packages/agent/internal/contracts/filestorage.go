@@ -0,0 +1,1834 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package contracts
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// FileStorageContractMetaData contains all meta data concerning the FileStorageContract contract.
+var FileStorageContractMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"fileHash\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"deleter\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"timestamp\",\"type\":\"uint256\"}],\"name\":\"FileDeleted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"fileHash\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"downloader\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"timestamp\",\"type\":\"uint256\"}],\"name\":\"FileDownloaded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"fileHash\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"uploader\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"filename\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"size\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"timestamp\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"merkleRoot\",\"type\":\"string\"}],\"name\":\"FileUploaded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"fileHash\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"verifier\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"isValid\",\"type\":\"bool\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"timestamp\",\"type\":\"uint256\"}],\"name\":\"ProofVerified\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"fileHash\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"}],\"name\":\"authorizeUser\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"authorizedDownloaders\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"authorizedUploaders\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"fileHash\",\"type\":\"bytes32\"}],\"name\":\"deleteFile\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"fileHash\",\"type\":\"bytes32\"}],\"name\":\"downloadFile\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"fileUsers\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"name\":\"files\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"fileHash\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"uploader\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"filename\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"size\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"uploadTimestamp\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"merkleRoot\",\"type\":\"string\"},{\"internalType\":\"bool\",\"name\":\"isActive\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"fileHash\",\"type\":\"bytes32\"}],\"name\":\"getFileMetadata\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"fileHash\",\"type\":\"bytes32\"}],\"name\":\"getFileUsers\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"fileHash\",\"type\":\"bytes32\"}],\"name\":\"getProof\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32[]\",\"name\":\"\",\"type\":\"bytes32[]\"},{\"internalType\":\"uint256[]\",\"name\":\"\",\"type\":\"uint256[]\"},{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getTotalFiles\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"}],\"name\":\"getUserFiles\",\"outputs\":[{\"internalType\":\"bytes32[]\",\"name\":\"\",\"type\":\"bytes32[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"fileHash\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"}],\"name\":\"isUserAuthorized\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"maxFileSize\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"name\":\"proofs\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"merkleRoot\",\"type\":\"bytes32\"},{\"internalType\":\"bool\",\"name\":\"isValid\",\"type\":\"bool\"},{\"internalType\":\"uint256\",\"name\":\"verificationTimestamp\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"publicUploadEnabled\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"fileHash\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"}],\"name\":\"revokeUser\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"downloader\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"authorized\",\"type\":\"bool\"}],\"name\":\"setAuthorizedDownloader\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"uploader\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"authorized\",\"type\":\"bool\"}],\"name\":\"setAuthorizedUploader\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"newMaxSize\",\"type\":\"uint256\"}],\"name\":\"setMaxFileSize\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bool\",\"name\":\"enabled\",\"type\":\"bool\"}],\"name\":\"setPublicUploadEnabled\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"newFee\",\"type\":\"uint256\"}],\"name\":\"setStorageFee\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"storageFee\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"fileHash\",\"type\":\"bytes32\"},{\"internalType\":\"string\",\"name\":\"filename\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"size\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"merkleRoot\",\"type\":\"string\"}],\"name\":\"uploadFile\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"userFiles\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"fileHash\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"merkleRoot\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32[]\",\"name\":\"proof\",\"type\":\"bytes32[]\"},{\"internalType\":\"uint256[]\",\"name\":\"indices\",\"type\":\"uint256[]\"}],\"name\":\"verifyProof\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"withdrawFees\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	Bin: "0x6080604052630640000060095566038d7ea4c68000600a55600b805460ff1916600117905534801561003057600080fd5b5061003a33610075565b6001808055336000908152600760209081526040808320805460ff1990811686179091556008909252909120805490911690911790556100c5565b600080546001600160a01b038381166001600160a01b0319831681178455604051919092169283917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e09190a35050565b6120df806100d46000396000f3fe6080604052600436106101cd5760003560e01c8063766e0d33116100f7578063be5b9b6011610095578063dfa7ff3411610064578063dfa7ff34146105cf578063e23527f0146105ef578063f2fde38b14610609578063f9ec53151461062957600080fd5b8063be5b9b6014610515578063cc7ac3b614610535578063cee4dc8c14610565578063d881ae87146105af57600080fd5b80639103edbf116100d15780639103edbf1461049557806398c9adff146104b55780639ad64f93146104d5578063a30e992c146104f557600080fd5b8063766e0d331461044c578063773f0b18146104615780638da5cb5b1461047757600080fd5b8063436bc49c1161016f57806367233ed81161013e57806367233ed8146103e45780636ab799f1146103f7578063715018a614610417578063718f38a61461042c57600080fd5b8063436bc49c14610320578063444d95b014610340578063476343ee1461039c57806365f35371146103b157600080fd5b80631b80bb3a116101ab5780631b80bb3a146102555780631fc379221461028657806320033f0e146102b35780633b8f4908146102f357600080fd5b80631376d017146101d257806315391a58146101fb57806317f4a5211461021d575b600080fd5b3480156101de57600080fd5b506101e860095481565b6040519081526020015b60405180910390f35b34801561020757600080fd5b5061021b610216366004611911565b610649565b005b34801561022957600080fd5b5061023d610238366004611933565b610664565b6040516001600160a01b0390911681526020016101f2565b34801561026157600080fd5b50610275610270366004611955565b61069c565b6040516101f29594939291906119a9565b34801561029257600080fd5b506102a66102a1366004611a25565b6107ca565b6040516101f29190611a40565b3480156102bf57600080fd5b506102e36102ce366004611a25565b60086020526000908152604090205460ff1681565b60405190151581526020016101f2565b3480156102ff57600080fd5b5061031361030e366004611955565b610836565b6040516101f29190611a53565b34801561032c57600080fd5b5061021b61033b366004611aa0565b6108a1565b34801561034c57600080fd5b5061038161035b366004611955565b600460208190526000918252604090912080546003820154919092015460ff9091169083565b604080519384529115156020840152908201526060016101f2565b3480156103a857600080fd5b5061021b6108d4565b3480156103bd57600080fd5b506103d16103cc366004611955565b61095d565b6040516101f29796959493929190611b19565b61021b6103f2366004611c2f565b610b20565b34801561040357600080fd5b5061021b610412366004611955565b610dbf565b34801561042357600080fd5b5061021b610f2e565b34801561043857600080fd5b506101e8610447366004611ca6565b610f42565b34801561045857600080fd5b506101e8610f73565b34801561046d57600080fd5b506101e8600a5481565b34801561048357600080fd5b506000546001600160a01b031661023d565b3480156104a157600080fd5b5061021b6104b0366004611cd0565b610f83565b3480156104c157600080fd5b506103d16104d0366004611955565b61113c565b3480156104e157600080fd5b5061021b6104f0366004611955565b611294565b34801561050157600080fd5b5061021b610510366004611955565b6112a1565b34801561052157600080fd5b5061021b610530366004611cd0565b6114ad565b34801561054157600080fd5b506102e3610550366004611a25565b60076020526000908152604090205460ff1681565b34801561057157600080fd5b506102e3610580366004611cd0565b60008281526003602090815260408083206001600160a01b038516845260070190915290205460ff1692915050565b3480156105bb57600080fd5b5061021b6105ca366004611aa0565b6115a8565b3480156105db57600080fd5b5061021b6105ea366004611955565b6115db565b3480156105fb57600080fd5b50600b546102e39060ff1681565b34801561061557600080fd5b5061021b610624366004611a25565b6115e8565b34801561063557600080fd5b506102e3610644366004611d82565b611661565b610651611799565b600b805460ff1916911515919091179055565b6006602052816000526040600020818154811061068057600080fd5b6000918252602090912001546001600160a01b03169150829050565b60008181526003602052604081206001015460609081908390819086906001600160a01b03166106e75760405162461bcd60e51b81526004016106de90611e44565b60405180910390fd5b6000878152600460208181526040928390208054600382015493820154600183018054875181870281018701909852808852939692959094600288019460ff9092169391869183018282801561075c57602002820191906000526020600020905b815481526020019060010190808311610748575b50505050509350828054806020026020016040519081016040528092919081815260200182805480156107ae57602002820191906000526020600020905b81548152602001906001019080831161079a575b5050505050925096509650965096509650505091939590929450565b6001600160a01b03811660009081526005602090815260409182902080548351818402810184019094528084526060939283018282801561082a57602002820191906000526020600020905b815481526020019060010190808311610816575b50505050509050919050565b60008181526006602090815260409182902080548351818402810184019094528084526060939283018282801561082a57602002820191906000526020600020905b81546001600160a01b031681526001909101906020018083116108785750505050509050919050565b6108a9611799565b6001600160a01b03919091166000908152600860205260409020805460ff1916911515919091179055565b6108dc611799565b47806109205760405162461bcd60e51b81526020600482015260136024820152724e6f206665657320746f20776974686472617760681b60448201526064016106de565b600080546040516001600160a01b039091169183156108fc02918491818181858888f19350505050158015610959573d6000803e3d6000fd5b5050565b6000818152600360205260408120600101548190606090829081908390829088906001600160a01b03166109a35760405162461bcd60e51b81526004016106de90611e44565b6000898152600360208190526040909120805460018201549282015460048301546006840154600285018054959694956001600160a01b039095169490939291600588019160ff9091169085906109f990611e71565b80601f0160208091040260200160405190810160405280929190818152602001828054610a2590611e71565b8015610a725780601f10610a4757610100808354040283529160200191610a72565b820191906000526020600020905b815481529060010190602001808311610a5557829003601f168201915b50505050509450818054610a8590611e71565b80601f0160208091040260200160405190810160405280929190818152602001828054610ab190611e71565b8015610afe5780601f10610ad357610100808354040283529160200191610afe565b820191906000526020600020905b815481529060010190602001808311610ae157829003601f168201915b5050505050915098509850985098509850985098505050919395979092949650565b3360009081526007602052604090205460ff1680610b405750600b5460ff165b610b8c5760405162461bcd60e51b815260206004820152601e60248201527f4e6f7420617574686f72697a656420746f2075706c6f61642066696c6573000060448201526064016106de565b610b946117f3565b6000848152600360205260409020600101546001600160a01b031615610bf25760405162461bcd60e51b815260206004820152601360248201527246696c6520616c72656164792065786973747360681b60448201526064016106de565b600954821115610c4e5760405162461bcd60e51b815260206004820152602160248201527f46696c652073697a652065786365656473206d6178696d756d20616c6c6f77656044820152601960fa1b60648201526084016106de565b600a54341015610ca05760405162461bcd60e51b815260206004820152601860248201527f496e73756666696369656e742073746f7261676520666565000000000000000060448201526064016106de565b610cae600280546001019055565b60008481526003602052604090208481556001810180546001600160a01b0319163317905560028101610ce18582611efa565b506003810183905542600482015560058101610cfd8382611efa565b5060068181018054600160ff1991821681179092553360008181526007860160209081526040808320805490951686179094556005815283822080548087018255908352818320018b90558a82529485528281208054948501815581529390932090910180546001600160a01b031916831790555186907f4fd65ab323e60be3274cd82bbed2685014bf4cc64c0c30e1fc979f683080d54e90610da7908890889042908990611fba565b60405180910390a350610db960018055565b50505050565b60008181526003602052604090206001015481906001600160a01b0316610df85760405162461bcd60e51b81526004016106de90611e44565b600082815260036020526040902060060154829060ff16610e505760405162461bcd60e51b815260206004820152601260248201527146696c65206973206e6f742061637469766560701b60448201526064016106de565b600083815260036020526040902060018101546001600160a01b0316331480610e8357506000546001600160a01b031633145b610ee45760405162461bcd60e51b815260206004820152602c60248201527f4f6e6c792066696c65206f776e6572206f7220636f6e7472616374206f776e6560448201526b722063616e2064656c65746560a01b60648201526084016106de565b60068101805460ff19169055604051428152339085907f44784d2638eb6c2b2ef3dace8a8d89a892a4d4aedf4719f97d1864e303fbda94906020015b60405180910390a350505050565b610f36611799565b610f40600061184c565b565b60056020528160005260406000208181548110610f5e57600080fd5b90600052602060002001600091509150505481565b6000610f7e60025490565b905090565b60008281526003602052604090206001015482906001600160a01b0316610fbc5760405162461bcd60e51b81526004016106de90611e44565b600083815260036020526040902060018101546001600160a01b0316331480610fef57506000546001600160a01b031633145b6110595760405162461bcd60e51b815260206004820152603560248201527f4f6e6c792066696c65206f776e6572206f7220636f6e7472616374206f776e65604482015274722063616e20617574686f72697a6520757365727360581b60648201526084016106de565b6001600160a01b03831660009081526007820160205260408120805460ff19166001179055805b6000868152600660205260409020548110156110f757600086815260066020526040902080546001600160a01b0387169190839081106110c2576110c2611ff6565b6000918252602090912001546001600160a01b0316036110e557600191506110f7565b806110ef8161200c565b915050611080565b50806111355760008581526006602090815260408220805460018101825590835291200180546001600160a01b0319166001600160a01b0386161790555b5050505050565b60036020526000908152604090208054600182015460028301805492936001600160a01b039092169261116e90611e71565b80601f016020809104026020016040519081016040528092919081815260200182805461119a90611e71565b80156111e75780601f106111bc576101008083540402835291602001916111e7565b820191906000526020600020905b8154815290600101906020018083116111ca57829003601f168201915b50505050509080600301549080600401549080600501805461120890611e71565b80601f016020809104026020016040519081016040528092919081815260200182805461123490611e71565b80156112815780601f1061125657610100808354040283529160200191611281565b820191906000526020600020905b81548152906001019060200180831161126457829003601f168201915b5050506006909301549192505060ff1687565b61129c611799565b600955565b60008181526003602052604090206001015481906001600160a01b03166112da5760405162461bcd60e51b81526004016106de90611e44565b600082815260036020526040902060060154829060ff166113325760405162461bcd60e51b815260206004820152601260248201527146696c65206973206e6f742061637469766560701b60448201526064016106de565b3360009081526008602052604090205460ff168061138257506000805260036020527f3617319a054d772f909f7c479a2cebe5066e836a939412e32403c99029b92f00546001600160a01b031615155b6113ce5760405162461bcd60e51b815260206004820181905260248201527f4e6f7420617574686f72697a656420746f20646f776e6c6f61642066696c657360448201526064016106de565b6000838152600360209081526040808320338452600781019092529091205460ff1680611407575060018101546001600160a01b031633145b8061142157503360009081526008602052604090205460ff165b6114795760405162461bcd60e51b8152602060048201526024808201527f4e6f7420617574686f72697a656420746f20646f776e6c6f616420746869732060448201526366696c6560e01b60648201526084016106de565b604051428152339085907f134689260d977c8820d39f35ab2a85b84776f032a8d64b2a65feaaff5490ac0f90602001610f20565b60008281526003602052604090206001015482906001600160a01b03166114e65760405162461bcd60e51b81526004016106de90611e44565b600083815260036020526040902060018101546001600160a01b031633148061151957506000546001600160a01b031633145b6115805760405162461bcd60e51b815260206004820152603260248201527f4f6e6c792066696c65206f776e6572206f7220636f6e7472616374206f776e65604482015271722063616e207265766f6b6520757365727360701b60648201526084016106de565b6001600160a01b03909216600090815260079092016020525060409020805460ff1916905550565b6115b0611799565b6001600160a01b03919091166000908152600760205260409020805460ff1916911515919091179055565b6115e3611799565b600a55565b6115f0611799565b6001600160a01b0381166116555760405162461bcd60e51b815260206004820152602660248201527f4f776e61626c653a206e6577206f776e657220697320746865207a65726f206160448201526564647265737360d01b60648201526084016106de565b61165e8161184c565b50565b60008481526003602052604081206001015485906001600160a01b031661169a5760405162461bcd60e51b81526004016106de90611e44565b600086815260036020908152604080832090519092916116bf91600585019101612033565b60408051808303601f1901815282825280516020918201208184018b9052825180850383018152938301835283519382019390932060008c815260048352929092208a8155895192909314935061171d9160018401918a019061189c565b508551611733906002830190602089019061189c565b5060038101805460ff1916831515908117909155426004830181905560408051928352602083019190915233918b917fd70445ae79bfa82bd8c52e90ca89940dff8279c6c39f91265be2f4ae43e4cae2910160405180910390a350979650505050505050565b6000546001600160a01b03163314610f405760405162461bcd60e51b815260206004820181905260248201527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e657260448201526064016106de565b6002600154036118455760405162461bcd60e51b815260206004820152601f60248201527f5265656e7472616e637947756172643a207265656e7472616e742063616c6c0060448201526064016106de565b6002600155565b600080546001600160a01b038381166001600160a01b0319831681178455604051919092169283917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e09190a35050565b8280548282559060005260206000209081019282156118d7579160200282015b828111156118d75782518255916020019190600101906118bc565b506118e39291506118e7565b5090565b5b808211156118e357600081556001016118e8565b8035801515811461190c57600080fd5b919050565b60006020828403121561192357600080fd5b61192c826118fc565b9392505050565b6000806040838503121561194657600080fd5b50508035926020909101359150565b60006020828403121561196757600080fd5b5035919050565b600081518084526020808501945080840160005b8381101561199e57815187529582019590820190600101611982565b509495945050505050565b8581526000602060a0818401526119c360a084018861196e565b838103604085015286518082528288019183019060005b818110156119f6578351835292840192918401916001016119da565b50509515156060850152505050608001529392505050565b80356001600160a01b038116811461190c57600080fd5b600060208284031215611a3757600080fd5b61192c82611a0e565b60208152600061192c602083018461196e565b6020808252825182820181905260009190848201906040850190845b81811015611a945783516001600160a01b031683529284019291840191600101611a6f565b50909695505050505050565b60008060408385031215611ab357600080fd5b611abc83611a0e565b9150611aca602084016118fc565b90509250929050565b6000815180845260005b81811015611af957602081850181015186830182015201611add565b506000602082860101526020601f19601f83011685010191505092915050565b8781526001600160a01b038716602082015260e060408201819052600090611b4390830188611ad3565b86606084015285608084015282810360a0840152611b618186611ad3565b91505082151560c083015298975050505050505050565b634e487b7160e01b600052604160045260246000fd5b604051601f8201601f1916810167ffffffffffffffff81118282101715611bb757611bb7611b78565b604052919050565b600082601f830112611bd057600080fd5b813567ffffffffffffffff811115611bea57611bea611b78565b611bfd601f8201601f1916602001611b8e565b818152846020838601011115611c1257600080fd5b816020850160208301376000918101602001919091529392505050565b60008060008060808587031215611c4557600080fd5b84359350602085013567ffffffffffffffff80821115611c6457600080fd5b611c7088838901611bbf565b9450604087013593506060870135915080821115611c8d57600080fd5b50611c9a87828801611bbf565b91505092959194509250565b60008060408385031215611cb957600080fd5b611cc283611a0e565b946020939093013593505050565b60008060408385031215611ce357600080fd5b82359150611aca60208401611a0e565b600067ffffffffffffffff821115611d0d57611d0d611b78565b5060051b60200190565b600082601f830112611d2857600080fd5b81356020611d3d611d3883611cf3565b611b8e565b82815260059290921b84018101918181019086841115611d5c57600080fd5b8286015b84811015611d775780358352918301918301611d60565b509695505050505050565b60008060008060808587031215611d9857600080fd5b843593506020808601359350604086013567ffffffffffffffff80821115611dbf57600080fd5b818801915088601f830112611dd357600080fd5b8135611de1611d3882611cf3565b81815260059190911b8301840190848101908b831115611e0057600080fd5b938501935b82851015611e1e57843582529385019390850190611e05565b965050506060880135925080831115611e3657600080fd5b5050611c9a87828801611d17565b602080825260139082015272119a5b1948191bd95cc81b9bdd08195e1a5cdd606a1b604082015260600190565b600181811c90821680611e8557607f821691505b602082108103611ea557634e487b7160e01b600052602260045260246000fd5b50919050565b601f821115611ef557600081815260208120601f850160051c81016020861015611ed25750805b601f850160051c820191505b81811015611ef157828155600101611ede565b5050505b505050565b815167ffffffffffffffff811115611f1457611f14611b78565b611f2881611f228454611e71565b84611eab565b602080601f831160018114611f5d5760008415611f455750858301515b600019600386901b1c1916600185901b178555611ef1565b600085815260208120601f198616915b82811015611f8c57888601518255948401946001909101908401611f6d565b5085821015611faa5787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b608081526000611fcd6080830187611ad3565b8560208401528460408401528281036060840152611feb8185611ad3565b979650505050505050565b634e487b7160e01b600052603260045260246000fd5b60006001820161202c57634e487b7160e01b600052601160045260246000fd5b5060010190565b600080835461204181611e71565b60018281168015612059576001811461206e5761209d565b60ff198416875282151583028701945061209d565b8760005260208060002060005b858110156120945781548a82015290840190820161207b565b50505082870194505b5092969550505050505056fea26469706673582212209a241b11f483ff4ed0919d783b9fa588fddff8c772488686d150bb4554e7f6ea64736f6c63430008130033",
+}
+
+// FileStorageContractABI is the input ABI used to generate the binding from.
+// Deprecated: Use FileStorageContractMetaData.ABI instead.
+var FileStorageContractABI = FileStorageContractMetaData.ABI
+
+// FileStorageContractBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use FileStorageContractMetaData.Bin instead.
+var FileStorageContractBin = FileStorageContractMetaData.Bin
+
+// DeployFileStorageContract deploys a new Ethereum contract, binding an instance of FileStorageContract to it.
+func DeployFileStorageContract(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *FileStorageContract, error) {
+	parsed, err := FileStorageContractMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(FileStorageContractBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &FileStorageContract{FileStorageContractCaller: FileStorageContractCaller{contract: contract}, FileStorageContractTransactor: FileStorageContractTransactor{contract: contract}, FileStorageContractFilterer: FileStorageContractFilterer{contract: contract}}, nil
+}
+
+// FileStorageContract is an auto generated Go binding around an Ethereum contract.
+type FileStorageContract struct {
+	FileStorageContractCaller     // Read-only binding to the contract
+	FileStorageContractTransactor // Write-only binding to the contract
+	FileStorageContractFilterer   // Log filterer for contract events
+}
+
+// FileStorageContractCaller is an auto generated read-only Go binding around an Ethereum contract.
+type FileStorageContractCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// FileStorageContractTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type FileStorageContractTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// FileStorageContractFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type FileStorageContractFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// FileStorageContractSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type FileStorageContractSession struct {
+	Contract     *FileStorageContract // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts        // Call options to use throughout this session
+	TransactOpts bind.TransactOpts    // Transaction auth options to use throughout this session
+}
+
+// FileStorageContractCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type FileStorageContractCallerSession struct {
+	Contract *FileStorageContractCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts              // Call options to use throughout this session
+}
+
+// FileStorageContractTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type FileStorageContractTransactorSession struct {
+	Contract     *FileStorageContractTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts              // Transaction auth options to use throughout this session
+}
+
+// FileStorageContractRaw is an auto generated low-level Go binding around an Ethereum contract.
+type FileStorageContractRaw struct {
+	Contract *FileStorageContract // Generic contract binding to access the raw methods on
+}
+
+// FileStorageContractCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type FileStorageContractCallerRaw struct {
+	Contract *FileStorageContractCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// FileStorageContractTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type FileStorageContractTransactorRaw struct {
+	Contract *FileStorageContractTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewFileStorageContract creates a new instance of FileStorageContract, bound to a specific deployed contract.
+func NewFileStorageContract(address common.Address, backend bind.ContractBackend) (*FileStorageContract, error) {
+	contract, err := bindFileStorageContract(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &FileStorageContract{FileStorageContractCaller: FileStorageContractCaller{contract: contract}, FileStorageContractTransactor: FileStorageContractTransactor{contract: contract}, FileStorageContractFilterer: FileStorageContractFilterer{contract: contract}}, nil
+}
+
+// NewFileStorageContractCaller creates a new read-only instance of FileStorageContract, bound to a specific deployed contract.
+func NewFileStorageContractCaller(address common.Address, caller bind.ContractCaller) (*FileStorageContractCaller, error) {
+	contract, err := bindFileStorageContract(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &FileStorageContractCaller{contract: contract}, nil
+}
+
+// NewFileStorageContractTransactor creates a new write-only instance of FileStorageContract, bound to a specific deployed contract.
+func NewFileStorageContractTransactor(address common.Address, transactor bind.ContractTransactor) (*FileStorageContractTransactor, error) {
+	contract, err := bindFileStorageContract(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &FileStorageContractTransactor{contract: contract}, nil
+}
+
+// NewFileStorageContractFilterer creates a new log filterer instance of FileStorageContract, bound to a specific deployed contract.
+func NewFileStorageContractFilterer(address common.Address, filterer bind.ContractFilterer) (*FileStorageContractFilterer, error) {
+	contract, err := bindFileStorageContract(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &FileStorageContractFilterer{contract: contract}, nil
+}
+
+// bindFileStorageContract binds a generic wrapper to an already deployed contract.
+func bindFileStorageContract(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := FileStorageContractMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_FileStorageContract *FileStorageContractRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _FileStorageContract.Contract.FileStorageContractCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_FileStorageContract *FileStorageContractRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FileStorageContract.Contract.FileStorageContractTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_FileStorageContract *FileStorageContractRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _FileStorageContract.Contract.FileStorageContractTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_FileStorageContract *FileStorageContractCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _FileStorageContract.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_FileStorageContract *FileStorageContractTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FileStorageContract.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_FileStorageContract *FileStorageContractTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _FileStorageContract.Contract.contract.Transact(opts, method, params...)
+}
+
+// AuthorizedDownloaders is a free data retrieval call binding the contract method 0x20033f0e.
+//
+// Solidity: function authorizedDownloaders(address ) view returns(bool)
+func (_FileStorageContract *FileStorageContractCaller) AuthorizedDownloaders(opts *bind.CallOpts, arg0 common.Address) (bool, error) {
+	var out []interface{}
+	err := _FileStorageContract.contract.Call(opts, &out, "authorizedDownloaders", arg0)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// AuthorizedDownloaders is a free data retrieval call binding the contract method 0x20033f0e.
+//
+// Solidity: function authorizedDownloaders(address ) view returns(bool)
+func (_FileStorageContract *FileStorageContractSession) AuthorizedDownloaders(arg0 common.Address) (bool, error) {
+	return _FileStorageContract.Contract.AuthorizedDownloaders(&_FileStorageContract.CallOpts, arg0)
+}
+
+// AuthorizedDownloaders is a free data retrieval call binding the contract method 0x20033f0e.
+//
+// Solidity: function authorizedDownloaders(address ) view returns(bool)
+func (_FileStorageContract *FileStorageContractCallerSession) AuthorizedDownloaders(arg0 common.Address) (bool, error) {
+	return _FileStorageContract.Contract.AuthorizedDownloaders(&_FileStorageContract.CallOpts, arg0)
+}
+
+// AuthorizedUploaders is a free data retrieval call binding the contract method 0xcc7ac3b6.
+//
+// Solidity: function authorizedUploaders(address ) view returns(bool)
+func (_FileStorageContract *FileStorageContractCaller) AuthorizedUploaders(opts *bind.CallOpts, arg0 common.Address) (bool, error) {
+	var out []interface{}
+	err := _FileStorageContract.contract.Call(opts, &out, "authorizedUploaders", arg0)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// AuthorizedUploaders is a free data retrieval call binding the contract method 0xcc7ac3b6.
+//
+// Solidity: function authorizedUploaders(address ) view returns(bool)
+func (_FileStorageContract *FileStorageContractSession) AuthorizedUploaders(arg0 common.Address) (bool, error) {
+	return _FileStorageContract.Contract.AuthorizedUploaders(&_FileStorageContract.CallOpts, arg0)
+}
+
+// AuthorizedUploaders is a free data retrieval call binding the contract method 0xcc7ac3b6.
+//
+// Solidity: function authorizedUploaders(address ) view returns(bool)
+func (_FileStorageContract *FileStorageContractCallerSession) AuthorizedUploaders(arg0 common.Address) (bool, error) {
+	return _FileStorageContract.Contract.AuthorizedUploaders(&_FileStorageContract.CallOpts, arg0)
+}
+
+// FileUsers is a free data retrieval call binding the contract method 0x17f4a521.
+//
+// Solidity: function fileUsers(bytes32 , uint256 ) view returns(address)
+func (_FileStorageContract *FileStorageContractCaller) FileUsers(opts *bind.CallOpts, arg0 [32]byte, arg1 *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _FileStorageContract.contract.Call(opts, &out, "fileUsers", arg0, arg1)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// FileUsers is a free data retrieval call binding the contract method 0x17f4a521.
+//
+// Solidity: function fileUsers(bytes32 , uint256 ) view returns(address)
+func (_FileStorageContract *FileStorageContractSession) FileUsers(arg0 [32]byte, arg1 *big.Int) (common.Address, error) {
+	return _FileStorageContract.Contract.FileUsers(&_FileStorageContract.CallOpts, arg0, arg1)
+}
+
+// FileUsers is a free data retrieval call binding the contract method 0x17f4a521.
+//
+// Solidity: function fileUsers(bytes32 , uint256 ) view returns(address)
+func (_FileStorageContract *FileStorageContractCallerSession) FileUsers(arg0 [32]byte, arg1 *big.Int) (common.Address, error) {
+	return _FileStorageContract.Contract.FileUsers(&_FileStorageContract.CallOpts, arg0, arg1)
+}
+
+// Files is a free data retrieval call binding the contract method 0x98c9adff.
+//
+// Solidity: function files(bytes32 ) view returns(bytes32 fileHash, address uploader, string filename, uint256 size, uint256 uploadTimestamp, string merkleRoot, bool isActive)
+func (_FileStorageContract *FileStorageContractCaller) Files(opts *bind.CallOpts, arg0 [32]byte) (struct {
+	FileHash        [32]byte
+	Uploader        common.Address
+	Filename        string
+	Size            *big.Int
+	UploadTimestamp *big.Int
+	MerkleRoot      string
+	IsActive        bool
+}, error) {
+	var out []interface{}
+	err := _FileStorageContract.contract.Call(opts, &out, "files", arg0)
+
+	outstruct := new(struct {
+		FileHash        [32]byte
+		Uploader        common.Address
+		Filename        string
+		Size            *big.Int
+		UploadTimestamp *big.Int
+		MerkleRoot      string
+		IsActive        bool
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.FileHash = *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+	outstruct.Uploader = *abi.ConvertType(out[1], new(common.Address)).(*common.Address)
+	outstruct.Filename = *abi.ConvertType(out[2], new(string)).(*string)
+	outstruct.Size = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+	outstruct.UploadTimestamp = *abi.ConvertType(out[4], new(*big.Int)).(**big.Int)
+	outstruct.MerkleRoot = *abi.ConvertType(out[5], new(string)).(*string)
+	outstruct.IsActive = *abi.ConvertType(out[6], new(bool)).(*bool)
+
+	return *outstruct, err
+
+}
+
+// Files is a free data retrieval call binding the contract method 0x98c9adff.
+//
+// Solidity: function files(bytes32 ) view returns(bytes32 fileHash, address uploader, string filename, uint256 size, uint256 uploadTimestamp, string merkleRoot, bool isActive)
+func (_FileStorageContract *FileStorageContractSession) Files(arg0 [32]byte) (struct {
+	FileHash        [32]byte
+	Uploader        common.Address
+	Filename        string
+	Size            *big.Int
+	UploadTimestamp *big.Int
+	MerkleRoot      string
+	IsActive        bool
+}, error) {
+	return _FileStorageContract.Contract.Files(&_FileStorageContract.CallOpts, arg0)
+}
+
+// Files is a free data retrieval call binding the contract method 0x98c9adff.
+//
+// Solidity: function files(bytes32 ) view returns(bytes32 fileHash, address uploader, string filename, uint256 size, uint256 uploadTimestamp, string merkleRoot, bool isActive)
+func (_FileStorageContract *FileStorageContractCallerSession) Files(arg0 [32]byte) (struct {
+	FileHash        [32]byte
+	Uploader        common.Address
+	Filename        string
+	Size            *big.Int
+	UploadTimestamp *big.Int
+	MerkleRoot      string
+	IsActive        bool
+}, error) {
+	return _FileStorageContract.Contract.Files(&_FileStorageContract.CallOpts, arg0)
+}
+
+// GetFileMetadata is a free data retrieval call binding the contract method 0x65f35371.
+//
+// Solidity: function getFileMetadata(bytes32 fileHash) view returns(bytes32, address, string, uint256, uint256, string, bool)
+func (_FileStorageContract *FileStorageContractCaller) GetFileMetadata(opts *bind.CallOpts, fileHash [32]byte) ([32]byte, common.Address, string, *big.Int, *big.Int, string, bool, error) {
+	var out []interface{}
+	err := _FileStorageContract.contract.Call(opts, &out, "getFileMetadata", fileHash)
+
+	if err != nil {
+		return *new([32]byte), *new(common.Address), *new(string), *new(*big.Int), *new(*big.Int), *new(string), *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+	out1 := *abi.ConvertType(out[1], new(common.Address)).(*common.Address)
+	out2 := *abi.ConvertType(out[2], new(string)).(*string)
+	out3 := *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+	out4 := *abi.ConvertType(out[4], new(*big.Int)).(**big.Int)
+	out5 := *abi.ConvertType(out[5], new(string)).(*string)
+	out6 := *abi.ConvertType(out[6], new(bool)).(*bool)
+
+	return out0, out1, out2, out3, out4, out5, out6, err
+
+}
+
+// GetFileMetadata is a free data retrieval call binding the contract method 0x65f35371.
+//
+// Solidity: function getFileMetadata(bytes32 fileHash) view returns(bytes32, address, string, uint256, uint256, string, bool)
+func (_FileStorageContract *FileStorageContractSession) GetFileMetadata(fileHash [32]byte) ([32]byte, common.Address, string, *big.Int, *big.Int, string, bool, error) {
+	return _FileStorageContract.Contract.GetFileMetadata(&_FileStorageContract.CallOpts, fileHash)
+}
+
+// GetFileMetadata is a free data retrieval call binding the contract method 0x65f35371.
+//
+// Solidity: function getFileMetadata(bytes32 fileHash) view returns(bytes32, address, string, uint256, uint256, string, bool)
+func (_FileStorageContract *FileStorageContractCallerSession) GetFileMetadata(fileHash [32]byte) ([32]byte, common.Address, string, *big.Int, *big.Int, string, bool, error) {
+	return _FileStorageContract.Contract.GetFileMetadata(&_FileStorageContract.CallOpts, fileHash)
+}
+
+// GetFileUsers is a free data retrieval call binding the contract method 0x3b8f4908.
+//
+// Solidity: function getFileUsers(bytes32 fileHash) view returns(address[])
+func (_FileStorageContract *FileStorageContractCaller) GetFileUsers(opts *bind.CallOpts, fileHash [32]byte) ([]common.Address, error) {
+	var out []interface{}
+	err := _FileStorageContract.contract.Call(opts, &out, "getFileUsers", fileHash)
+
+	if err != nil {
+		return *new([]common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+
+	return out0, err
+
+}
+
+// GetFileUsers is a free data retrieval call binding the contract method 0x3b8f4908.
+//
+// Solidity: function getFileUsers(bytes32 fileHash) view returns(address[])
+func (_FileStorageContract *FileStorageContractSession) GetFileUsers(fileHash [32]byte) ([]common.Address, error) {
+	return _FileStorageContract.Contract.GetFileUsers(&_FileStorageContract.CallOpts, fileHash)
+}
+
+// GetFileUsers is a free data retrieval call binding the contract method 0x3b8f4908.
+//
+// Solidity: function getFileUsers(bytes32 fileHash) view returns(address[])
+func (_FileStorageContract *FileStorageContractCallerSession) GetFileUsers(fileHash [32]byte) ([]common.Address, error) {
+	return _FileStorageContract.Contract.GetFileUsers(&_FileStorageContract.CallOpts, fileHash)
+}
+
+// GetProof is a free data retrieval call binding the contract method 0x1b80bb3a.
+//
+// Solidity: function getProof(bytes32 fileHash) view returns(bytes32, bytes32[], uint256[], bool, uint256)
+func (_FileStorageContract *FileStorageContractCaller) GetProof(opts *bind.CallOpts, fileHash [32]byte) ([32]byte, [][32]byte, []*big.Int, bool, *big.Int, error) {
+	var out []interface{}
+	err := _FileStorageContract.contract.Call(opts, &out, "getProof", fileHash)
+
+	if err != nil {
+		return *new([32]byte), *new([][32]byte), *new([]*big.Int), *new(bool), *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+	out1 := *abi.ConvertType(out[1], new([][32]byte)).(*[][32]byte)
+	out2 := *abi.ConvertType(out[2], new([]*big.Int)).(*[]*big.Int)
+	out3 := *abi.ConvertType(out[3], new(bool)).(*bool)
+	out4 := *abi.ConvertType(out[4], new(*big.Int)).(**big.Int)
+
+	return out0, out1, out2, out3, out4, err
+
+}
+
+// GetProof is a free data retrieval call binding the contract method 0x1b80bb3a.
+//
+// Solidity: function getProof(bytes32 fileHash) view returns(bytes32, bytes32[], uint256[], bool, uint256)
+func (_FileStorageContract *FileStorageContractSession) GetProof(fileHash [32]byte) ([32]byte, [][32]byte, []*big.Int, bool, *big.Int, error) {
+	return _FileStorageContract.Contract.GetProof(&_FileStorageContract.CallOpts, fileHash)
+}
+
+// GetProof is a free data retrieval call binding the contract method 0x1b80bb3a.
+//
+// Solidity: function getProof(bytes32 fileHash) view returns(bytes32, bytes32[], uint256[], bool, uint256)
+func (_FileStorageContract *FileStorageContractCallerSession) GetProof(fileHash [32]byte) ([32]byte, [][32]byte, []*big.Int, bool, *big.Int, error) {
+	return _FileStorageContract.Contract.GetProof(&_FileStorageContract.CallOpts, fileHash)
+}
+
+// GetTotalFiles is a free data retrieval call binding the contract method 0x766e0d33.
+//
+// Solidity: function getTotalFiles() view returns(uint256)
+func (_FileStorageContract *FileStorageContractCaller) GetTotalFiles(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _FileStorageContract.contract.Call(opts, &out, "getTotalFiles")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetTotalFiles is a free data retrieval call binding the contract method 0x766e0d33.
+//
+// Solidity: function getTotalFiles() view returns(uint256)
+func (_FileStorageContract *FileStorageContractSession) GetTotalFiles() (*big.Int, error) {
+	return _FileStorageContract.Contract.GetTotalFiles(&_FileStorageContract.CallOpts)
+}
+
+// GetTotalFiles is a free data retrieval call binding the contract method 0x766e0d33.
+//
+// Solidity: function getTotalFiles() view returns(uint256)
+func (_FileStorageContract *FileStorageContractCallerSession) GetTotalFiles() (*big.Int, error) {
+	return _FileStorageContract.Contract.GetTotalFiles(&_FileStorageContract.CallOpts)
+}
+
+// GetUserFiles is a free data retrieval call binding the contract method 0x1fc37922.
+//
+// Solidity: function getUserFiles(address user) view returns(bytes32[])
+func (_FileStorageContract *FileStorageContractCaller) GetUserFiles(opts *bind.CallOpts, user common.Address) ([][32]byte, error) {
+	var out []interface{}
+	err := _FileStorageContract.contract.Call(opts, &out, "getUserFiles", user)
+
+	if err != nil {
+		return *new([][32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([][32]byte)).(*[][32]byte)
+
+	return out0, err
+
+}
+
+// GetUserFiles is a free data retrieval call binding the contract method 0x1fc37922.
+//
+// Solidity: function getUserFiles(address user) view returns(bytes32[])
+func (_FileStorageContract *FileStorageContractSession) GetUserFiles(user common.Address) ([][32]byte, error) {
+	return _FileStorageContract.Contract.GetUserFiles(&_FileStorageContract.CallOpts, user)
+}
+
+// GetUserFiles is a free data retrieval call binding the contract method 0x1fc37922.
+//
+// Solidity: function getUserFiles(address user) view returns(bytes32[])
+func (_FileStorageContract *FileStorageContractCallerSession) GetUserFiles(user common.Address) ([][32]byte, error) {
+	return _FileStorageContract.Contract.GetUserFiles(&_FileStorageContract.CallOpts, user)
+}
+
+// IsUserAuthorized is a free data retrieval call binding the contract method 0xcee4dc8c.
+//
+// Solidity: function isUserAuthorized(bytes32 fileHash, address user) view returns(bool)
+func (_FileStorageContract *FileStorageContractCaller) IsUserAuthorized(opts *bind.CallOpts, fileHash [32]byte, user common.Address) (bool, error) {
+	var out []interface{}
+	err := _FileStorageContract.contract.Call(opts, &out, "isUserAuthorized", fileHash, user)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsUserAuthorized is a free data retrieval call binding the contract method 0xcee4dc8c.
+//
+// Solidity: function isUserAuthorized(bytes32 fileHash, address user) view returns(bool)
+func (_FileStorageContract *FileStorageContractSession) IsUserAuthorized(fileHash [32]byte, user common.Address) (bool, error) {
+	return _FileStorageContract.Contract.IsUserAuthorized(&_FileStorageContract.CallOpts, fileHash, user)
+}
+
+// IsUserAuthorized is a free data retrieval call binding the contract method 0xcee4dc8c.
+//
+// Solidity: function isUserAuthorized(bytes32 fileHash, address user) view returns(bool)
+func (_FileStorageContract *FileStorageContractCallerSession) IsUserAuthorized(fileHash [32]byte, user common.Address) (bool, error) {
+	return _FileStorageContract.Contract.IsUserAuthorized(&_FileStorageContract.CallOpts, fileHash, user)
+}
+
+// MaxFileSize is a free data retrieval call binding the contract method 0x1376d017.
+//
+// Solidity: function maxFileSize() view returns(uint256)
+func (_FileStorageContract *FileStorageContractCaller) MaxFileSize(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _FileStorageContract.contract.Call(opts, &out, "maxFileSize")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MaxFileSize is a free data retrieval call binding the contract method 0x1376d017.
+//
+// Solidity: function maxFileSize() view returns(uint256)
+func (_FileStorageContract *FileStorageContractSession) MaxFileSize() (*big.Int, error) {
+	return _FileStorageContract.Contract.MaxFileSize(&_FileStorageContract.CallOpts)
+}
+
+// MaxFileSize is a free data retrieval call binding the contract method 0x1376d017.
+//
+// Solidity: function maxFileSize() view returns(uint256)
+func (_FileStorageContract *FileStorageContractCallerSession) MaxFileSize() (*big.Int, error) {
+	return _FileStorageContract.Contract.MaxFileSize(&_FileStorageContract.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_FileStorageContract *FileStorageContractCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _FileStorageContract.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_FileStorageContract *FileStorageContractSession) Owner() (common.Address, error) {
+	return _FileStorageContract.Contract.Owner(&_FileStorageContract.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_FileStorageContract *FileStorageContractCallerSession) Owner() (common.Address, error) {
+	return _FileStorageContract.Contract.Owner(&_FileStorageContract.CallOpts)
+}
+
+// Proofs is a free data retrieval call binding the contract method 0x444d95b0.
+//
+// Solidity: function proofs(bytes32 ) view returns(bytes32 merkleRoot, bool isValid, uint256 verificationTimestamp)
+func (_FileStorageContract *FileStorageContractCaller) Proofs(opts *bind.CallOpts, arg0 [32]byte) (struct {
+	MerkleRoot            [32]byte
+	IsValid               bool
+	VerificationTimestamp *big.Int
+}, error) {
+	var out []interface{}
+	err := _FileStorageContract.contract.Call(opts, &out, "proofs", arg0)
+
+	outstruct := new(struct {
+		MerkleRoot            [32]byte
+		IsValid               bool
+		VerificationTimestamp *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.MerkleRoot = *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+	outstruct.IsValid = *abi.ConvertType(out[1], new(bool)).(*bool)
+	outstruct.VerificationTimestamp = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// Proofs is a free data retrieval call binding the contract method 0x444d95b0.
+//
+// Solidity: function proofs(bytes32 ) view returns(bytes32 merkleRoot, bool isValid, uint256 verificationTimestamp)
+func (_FileStorageContract *FileStorageContractSession) Proofs(arg0 [32]byte) (struct {
+	MerkleRoot            [32]byte
+	IsValid               bool
+	VerificationTimestamp *big.Int
+}, error) {
+	return _FileStorageContract.Contract.Proofs(&_FileStorageContract.CallOpts, arg0)
+}
+
+// Proofs is a free data retrieval call binding the contract method 0x444d95b0.
+//
+// Solidity: function proofs(bytes32 ) view returns(bytes32 merkleRoot, bool isValid, uint256 verificationTimestamp)
+func (_FileStorageContract *FileStorageContractCallerSession) Proofs(arg0 [32]byte) (struct {
+	MerkleRoot            [32]byte
+	IsValid               bool
+	VerificationTimestamp *big.Int
+}, error) {
+	return _FileStorageContract.Contract.Proofs(&_FileStorageContract.CallOpts, arg0)
+}
+
+// PublicUploadEnabled is a free data retrieval call binding the contract method 0xe23527f0.
+//
+// Solidity: function publicUploadEnabled() view returns(bool)
+func (_FileStorageContract *FileStorageContractCaller) PublicUploadEnabled(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _FileStorageContract.contract.Call(opts, &out, "publicUploadEnabled")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// PublicUploadEnabled is a free data retrieval call binding the contract method 0xe23527f0.
+//
+// Solidity: function publicUploadEnabled() view returns(bool)
+func (_FileStorageContract *FileStorageContractSession) PublicUploadEnabled() (bool, error) {
+	return _FileStorageContract.Contract.PublicUploadEnabled(&_FileStorageContract.CallOpts)
+}
+
+// PublicUploadEnabled is a free data retrieval call binding the contract method 0xe23527f0.
+//
+// Solidity: function publicUploadEnabled() view returns(bool)
+func (_FileStorageContract *FileStorageContractCallerSession) PublicUploadEnabled() (bool, error) {
+	return _FileStorageContract.Contract.PublicUploadEnabled(&_FileStorageContract.CallOpts)
+}
+
+// StorageFee is a free data retrieval call binding the contract method 0x773f0b18.
+//
+// Solidity: function storageFee() view returns(uint256)
+func (_FileStorageContract *FileStorageContractCaller) StorageFee(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _FileStorageContract.contract.Call(opts, &out, "storageFee")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// StorageFee is a free data retrieval call binding the contract method 0x773f0b18.
+//
+// Solidity: function storageFee() view returns(uint256)
+func (_FileStorageContract *FileStorageContractSession) StorageFee() (*big.Int, error) {
+	return _FileStorageContract.Contract.StorageFee(&_FileStorageContract.CallOpts)
+}
+
+// StorageFee is a free data retrieval call binding the contract method 0x773f0b18.
+//
+// Solidity: function storageFee() view returns(uint256)
+func (_FileStorageContract *FileStorageContractCallerSession) StorageFee() (*big.Int, error) {
+	return _FileStorageContract.Contract.StorageFee(&_FileStorageContract.CallOpts)
+}
+
+// UserFiles is a free data retrieval call binding the contract method 0x718f38a6.
+//
+// Solidity: function userFiles(address , uint256 ) view returns(bytes32)
+func (_FileStorageContract *FileStorageContractCaller) UserFiles(opts *bind.CallOpts, arg0 common.Address, arg1 *big.Int) ([32]byte, error) {
+	var out []interface{}
+	err := _FileStorageContract.contract.Call(opts, &out, "userFiles", arg0, arg1)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// UserFiles is a free data retrieval call binding the contract method 0x718f38a6.
+//
+// Solidity: function userFiles(address , uint256 ) view returns(bytes32)
+func (_FileStorageContract *FileStorageContractSession) UserFiles(arg0 common.Address, arg1 *big.Int) ([32]byte, error) {
+	return _FileStorageContract.Contract.UserFiles(&_FileStorageContract.CallOpts, arg0, arg1)
+}
+
+// UserFiles is a free data retrieval call binding the contract method 0x718f38a6.
+//
+// Solidity: function userFiles(address , uint256 ) view returns(bytes32)
+func (_FileStorageContract *FileStorageContractCallerSession) UserFiles(arg0 common.Address, arg1 *big.Int) ([32]byte, error) {
+	return _FileStorageContract.Contract.UserFiles(&_FileStorageContract.CallOpts, arg0, arg1)
+}
+
+// AuthorizeUser is a paid mutator transaction binding the contract method 0x9103edbf.
+//
+// Solidity: function authorizeUser(bytes32 fileHash, address user) returns()
+func (_FileStorageContract *FileStorageContractTransactor) AuthorizeUser(opts *bind.TransactOpts, fileHash [32]byte, user common.Address) (*types.Transaction, error) {
+	return _FileStorageContract.contract.Transact(opts, "authorizeUser", fileHash, user)
+}
+
+// AuthorizeUser is a paid mutator transaction binding the contract method 0x9103edbf.
+//
+// Solidity: function authorizeUser(bytes32 fileHash, address user) returns()
+func (_FileStorageContract *FileStorageContractSession) AuthorizeUser(fileHash [32]byte, user common.Address) (*types.Transaction, error) {
+	return _FileStorageContract.Contract.AuthorizeUser(&_FileStorageContract.TransactOpts, fileHash, user)
+}
+
+// AuthorizeUser is a paid mutator transaction binding the contract method 0x9103edbf.
+//
+// Solidity: function authorizeUser(bytes32 fileHash, address user) returns()
+func (_FileStorageContract *FileStorageContractTransactorSession) AuthorizeUser(fileHash [32]byte, user common.Address) (*types.Transaction, error) {
+	return _FileStorageContract.Contract.AuthorizeUser(&_FileStorageContract.TransactOpts, fileHash, user)
+}
+
+// DeleteFile is a paid mutator transaction binding the contract method 0x6ab799f1.
+//
+// Solidity: function deleteFile(bytes32 fileHash) returns()
+func (_FileStorageContract *FileStorageContractTransactor) DeleteFile(opts *bind.TransactOpts, fileHash [32]byte) (*types.Transaction, error) {
+	return _FileStorageContract.contract.Transact(opts, "deleteFile", fileHash)
+}
+
+// DeleteFile is a paid mutator transaction binding the contract method 0x6ab799f1.
+//
+// Solidity: function deleteFile(bytes32 fileHash) returns()
+func (_FileStorageContract *FileStorageContractSession) DeleteFile(fileHash [32]byte) (*types.Transaction, error) {
+	return _FileStorageContract.Contract.DeleteFile(&_FileStorageContract.TransactOpts, fileHash)
+}
+
+// DeleteFile is a paid mutator transaction binding the contract method 0x6ab799f1.
+//
+// Solidity: function deleteFile(bytes32 fileHash) returns()
+func (_FileStorageContract *FileStorageContractTransactorSession) DeleteFile(fileHash [32]byte) (*types.Transaction, error) {
+	return _FileStorageContract.Contract.DeleteFile(&_FileStorageContract.TransactOpts, fileHash)
+}
+
+// DownloadFile is a paid mutator transaction binding the contract method 0xa30e992c.
+//
+// Solidity: function downloadFile(bytes32 fileHash) returns()
+func (_FileStorageContract *FileStorageContractTransactor) DownloadFile(opts *bind.TransactOpts, fileHash [32]byte) (*types.Transaction, error) {
+	return _FileStorageContract.contract.Transact(opts, "downloadFile", fileHash)
+}
+
+// DownloadFile is a paid mutator transaction binding the contract method 0xa30e992c.
+//
+// Solidity: function downloadFile(bytes32 fileHash) returns()
+func (_FileStorageContract *FileStorageContractSession) DownloadFile(fileHash [32]byte) (*types.Transaction, error) {
+	return _FileStorageContract.Contract.DownloadFile(&_FileStorageContract.TransactOpts, fileHash)
+}
+
+// DownloadFile is a paid mutator transaction binding the contract method 0xa30e992c.
+//
+// Solidity: function downloadFile(bytes32 fileHash) returns()
+func (_FileStorageContract *FileStorageContractTransactorSession) DownloadFile(fileHash [32]byte) (*types.Transaction, error) {
+	return _FileStorageContract.Contract.DownloadFile(&_FileStorageContract.TransactOpts, fileHash)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_FileStorageContract *FileStorageContractTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FileStorageContract.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_FileStorageContract *FileStorageContractSession) RenounceOwnership() (*types.Transaction, error) {
+	return _FileStorageContract.Contract.RenounceOwnership(&_FileStorageContract.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_FileStorageContract *FileStorageContractTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _FileStorageContract.Contract.RenounceOwnership(&_FileStorageContract.TransactOpts)
+}
+
+// RevokeUser is a paid mutator transaction binding the contract method 0xbe5b9b60.
+//
+// Solidity: function revokeUser(bytes32 fileHash, address user) returns()
+func (_FileStorageContract *FileStorageContractTransactor) RevokeUser(opts *bind.TransactOpts, fileHash [32]byte, user common.Address) (*types.Transaction, error) {
+	return _FileStorageContract.contract.Transact(opts, "revokeUser", fileHash, user)
+}
+
+// RevokeUser is a paid mutator transaction binding the contract method 0xbe5b9b60.
+//
+// Solidity: function revokeUser(bytes32 fileHash, address user) returns()
+func (_FileStorageContract *FileStorageContractSession) RevokeUser(fileHash [32]byte, user common.Address) (*types.Transaction, error) {
+	return _FileStorageContract.Contract.RevokeUser(&_FileStorageContract.TransactOpts, fileHash, user)
+}
+
+// RevokeUser is a paid mutator transaction binding the contract method 0xbe5b9b60.
+//
+// Solidity: function revokeUser(bytes32 fileHash, address user) returns()
+func (_FileStorageContract *FileStorageContractTransactorSession) RevokeUser(fileHash [32]byte, user common.Address) (*types.Transaction, error) {
+	return _FileStorageContract.Contract.RevokeUser(&_FileStorageContract.TransactOpts, fileHash, user)
+}
+
+// SetAuthorizedDownloader is a paid mutator transaction binding the contract method 0x436bc49c.
+//
+// Solidity: function setAuthorizedDownloader(address downloader, bool authorized) returns()
+func (_FileStorageContract *FileStorageContractTransactor) SetAuthorizedDownloader(opts *bind.TransactOpts, downloader common.Address, authorized bool) (*types.Transaction, error) {
+	return _FileStorageContract.contract.Transact(opts, "setAuthorizedDownloader", downloader, authorized)
+}
+
+// SetAuthorizedDownloader is a paid mutator transaction binding the contract method 0x436bc49c.
+//
+// Solidity: function setAuthorizedDownloader(address downloader, bool authorized) returns()
+func (_FileStorageContract *FileStorageContractSession) SetAuthorizedDownloader(downloader common.Address, authorized bool) (*types.Transaction, error) {
+	return _FileStorageContract.Contract.SetAuthorizedDownloader(&_FileStorageContract.TransactOpts, downloader, authorized)
+}
+
+// SetAuthorizedDownloader is a paid mutator transaction binding the contract method 0x436bc49c.
+//
+// Solidity: function setAuthorizedDownloader(address downloader, bool authorized) returns()
+func (_FileStorageContract *FileStorageContractTransactorSession) SetAuthorizedDownloader(downloader common.Address, authorized bool) (*types.Transaction, error) {
+	return _FileStorageContract.Contract.SetAuthorizedDownloader(&_FileStorageContract.TransactOpts, downloader, authorized)
+}
+
+// SetAuthorizedUploader is a paid mutator transaction binding the contract method 0xd881ae87.
+//
+// Solidity: function setAuthorizedUploader(address uploader, bool authorized) returns()
+func (_FileStorageContract *FileStorageContractTransactor) SetAuthorizedUploader(opts *bind.TransactOpts, uploader common.Address, authorized bool) (*types.Transaction, error) {
+	return _FileStorageContract.contract.Transact(opts, "setAuthorizedUploader", uploader, authorized)
+}
+
+// SetAuthorizedUploader is a paid mutator transaction binding the contract method 0xd881ae87.
+//
+// Solidity: function setAuthorizedUploader(address uploader, bool authorized) returns()
+func (_FileStorageContract *FileStorageContractSession) SetAuthorizedUploader(uploader common.Address, authorized bool) (*types.Transaction, error) {
+	return _FileStorageContract.Contract.SetAuthorizedUploader(&_FileStorageContract.TransactOpts, uploader, authorized)
+}
+
+// SetAuthorizedUploader is a paid mutator transaction binding the contract method 0xd881ae87.
+//
+// Solidity: function setAuthorizedUploader(address uploader, bool authorized) returns()
+func (_FileStorageContract *FileStorageContractTransactorSession) SetAuthorizedUploader(uploader common.Address, authorized bool) (*types.Transaction, error) {
+	return _FileStorageContract.Contract.SetAuthorizedUploader(&_FileStorageContract.TransactOpts, uploader, authorized)
+}
+
+// SetMaxFileSize is a paid mutator transaction binding the contract method 0x9ad64f93.
+//
+// Solidity: function setMaxFileSize(uint256 newMaxSize) returns()
+func (_FileStorageContract *FileStorageContractTransactor) SetMaxFileSize(opts *bind.TransactOpts, newMaxSize *big.Int) (*types.Transaction, error) {
+	return _FileStorageContract.contract.Transact(opts, "setMaxFileSize", newMaxSize)
+}
+
+// SetMaxFileSize is a paid mutator transaction binding the contract method 0x9ad64f93.
+//
+// Solidity: function setMaxFileSize(uint256 newMaxSize) returns()
+func (_FileStorageContract *FileStorageContractSession) SetMaxFileSize(newMaxSize *big.Int) (*types.Transaction, error) {
+	return _FileStorageContract.Contract.SetMaxFileSize(&_FileStorageContract.TransactOpts, newMaxSize)
+}
+
+// SetMaxFileSize is a paid mutator transaction binding the contract method 0x9ad64f93.
+//
+// Solidity: function setMaxFileSize(uint256 newMaxSize) returns()
+func (_FileStorageContract *FileStorageContractTransactorSession) SetMaxFileSize(newMaxSize *big.Int) (*types.Transaction, error) {
+	return _FileStorageContract.Contract.SetMaxFileSize(&_FileStorageContract.TransactOpts, newMaxSize)
+}
+
+// SetPublicUploadEnabled is a paid mutator transaction binding the contract method 0x15391a58.
+//
+// Solidity: function setPublicUploadEnabled(bool enabled) returns()
+func (_FileStorageContract *FileStorageContractTransactor) SetPublicUploadEnabled(opts *bind.TransactOpts, enabled bool) (*types.Transaction, error) {
+	return _FileStorageContract.contract.Transact(opts, "setPublicUploadEnabled", enabled)
+}
+
+// SetPublicUploadEnabled is a paid mutator transaction binding the contract method 0x15391a58.
+//
+// Solidity: function setPublicUploadEnabled(bool enabled) returns()
+func (_FileStorageContract *FileStorageContractSession) SetPublicUploadEnabled(enabled bool) (*types.Transaction, error) {
+	return _FileStorageContract.Contract.SetPublicUploadEnabled(&_FileStorageContract.TransactOpts, enabled)
+}
+
+// SetPublicUploadEnabled is a paid mutator transaction binding the contract method 0x15391a58.
+//
+// Solidity: function setPublicUploadEnabled(bool enabled) returns()
+func (_FileStorageContract *FileStorageContractTransactorSession) SetPublicUploadEnabled(enabled bool) (*types.Transaction, error) {
+	return _FileStorageContract.Contract.SetPublicUploadEnabled(&_FileStorageContract.TransactOpts, enabled)
+}
+
+// SetStorageFee is a paid mutator transaction binding the contract method 0xdfa7ff34.
+//
+// Solidity: function setStorageFee(uint256 newFee) returns()
+func (_FileStorageContract *FileStorageContractTransactor) SetStorageFee(opts *bind.TransactOpts, newFee *big.Int) (*types.Transaction, error) {
+	return _FileStorageContract.contract.Transact(opts, "setStorageFee", newFee)
+}
+
+// SetStorageFee is a paid mutator transaction binding the contract method 0xdfa7ff34.
+//
+// Solidity: function setStorageFee(uint256 newFee) returns()
+func (_FileStorageContract *FileStorageContractSession) SetStorageFee(newFee *big.Int) (*types.Transaction, error) {
+	return _FileStorageContract.Contract.SetStorageFee(&_FileStorageContract.TransactOpts, newFee)
+}
+
+// SetStorageFee is a paid mutator transaction binding the contract method 0xdfa7ff34.
+//
+// Solidity: function setStorageFee(uint256 newFee) returns()
+func (_FileStorageContract *FileStorageContractTransactorSession) SetStorageFee(newFee *big.Int) (*types.Transaction, error) {
+	return _FileStorageContract.Contract.SetStorageFee(&_FileStorageContract.TransactOpts, newFee)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_FileStorageContract *FileStorageContractTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _FileStorageContract.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_FileStorageContract *FileStorageContractSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _FileStorageContract.Contract.TransferOwnership(&_FileStorageContract.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_FileStorageContract *FileStorageContractTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _FileStorageContract.Contract.TransferOwnership(&_FileStorageContract.TransactOpts, newOwner)
+}
+
+// UploadFile is a paid mutator transaction binding the contract method 0x67233ed8.
+//
+// Solidity: function uploadFile(bytes32 fileHash, string filename, uint256 size, string merkleRoot) payable returns()
+func (_FileStorageContract *FileStorageContractTransactor) UploadFile(opts *bind.TransactOpts, fileHash [32]byte, filename string, size *big.Int, merkleRoot string) (*types.Transaction, error) {
+	return _FileStorageContract.contract.Transact(opts, "uploadFile", fileHash, filename, size, merkleRoot)
+}
+
+// UploadFile is a paid mutator transaction binding the contract method 0x67233ed8.
+//
+// Solidity: function uploadFile(bytes32 fileHash, string filename, uint256 size, string merkleRoot) payable returns()
+func (_FileStorageContract *FileStorageContractSession) UploadFile(fileHash [32]byte, filename string, size *big.Int, merkleRoot string) (*types.Transaction, error) {
+	return _FileStorageContract.Contract.UploadFile(&_FileStorageContract.TransactOpts, fileHash, filename, size, merkleRoot)
+}
+
+// UploadFile is a paid mutator transaction binding the contract method 0x67233ed8.
+//
+// Solidity: function uploadFile(bytes32 fileHash, string filename, uint256 size, string merkleRoot) payable returns()
+func (_FileStorageContract *FileStorageContractTransactorSession) UploadFile(fileHash [32]byte, filename string, size *big.Int, merkleRoot string) (*types.Transaction, error) {
+	return _FileStorageContract.Contract.UploadFile(&_FileStorageContract.TransactOpts, fileHash, filename, size, merkleRoot)
+}
+
+// VerifyProof is a paid mutator transaction binding the contract method 0xf9ec5315.
+//
+// Solidity: function verifyProof(bytes32 fileHash, bytes32 merkleRoot, bytes32[] proof, uint256[] indices) returns(bool)
+func (_FileStorageContract *FileStorageContractTransactor) VerifyProof(opts *bind.TransactOpts, fileHash [32]byte, merkleRoot [32]byte, proof [][32]byte, indices []*big.Int) (*types.Transaction, error) {
+	return _FileStorageContract.contract.Transact(opts, "verifyProof", fileHash, merkleRoot, proof, indices)
+}
+
+// VerifyProof is a paid mutator transaction binding the contract method 0xf9ec5315.
+//
+// Solidity: function verifyProof(bytes32 fileHash, bytes32 merkleRoot, bytes32[] proof, uint256[] indices) returns(bool)
+func (_FileStorageContract *FileStorageContractSession) VerifyProof(fileHash [32]byte, merkleRoot [32]byte, proof [][32]byte, indices []*big.Int) (*types.Transaction, error) {
+	return _FileStorageContract.Contract.VerifyProof(&_FileStorageContract.TransactOpts, fileHash, merkleRoot, proof, indices)
+}
+
+// VerifyProof is a paid mutator transaction binding the contract method 0xf9ec5315.
+//
+// Solidity: function verifyProof(bytes32 fileHash, bytes32 merkleRoot, bytes32[] proof, uint256[] indices) returns(bool)
+func (_FileStorageContract *FileStorageContractTransactorSession) VerifyProof(fileHash [32]byte, merkleRoot [32]byte, proof [][32]byte, indices []*big.Int) (*types.Transaction, error) {
+	return _FileStorageContract.Contract.VerifyProof(&_FileStorageContract.TransactOpts, fileHash, merkleRoot, proof, indices)
+}
+
+// WithdrawFees is a paid mutator transaction binding the contract method 0x476343ee.
+//
+// Solidity: function withdrawFees() returns()
+func (_FileStorageContract *FileStorageContractTransactor) WithdrawFees(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FileStorageContract.contract.Transact(opts, "withdrawFees")
+}
+
+// WithdrawFees is a paid mutator transaction binding the contract method 0x476343ee.
+//
+// Solidity: function withdrawFees() returns()
+func (_FileStorageContract *FileStorageContractSession) WithdrawFees() (*types.Transaction, error) {
+	return _FileStorageContract.Contract.WithdrawFees(&_FileStorageContract.TransactOpts)
+}
+
+// WithdrawFees is a paid mutator transaction binding the contract method 0x476343ee.
+//
+// Solidity: function withdrawFees() returns()
+func (_FileStorageContract *FileStorageContractTransactorSession) WithdrawFees() (*types.Transaction, error) {
+	return _FileStorageContract.Contract.WithdrawFees(&_FileStorageContract.TransactOpts)
+}
+
+// FileStorageContractFileDeletedIterator is returned from FilterFileDeleted and is used to iterate over the raw logs and unpacked data for FileDeleted events raised by the FileStorageContract contract.
+type FileStorageContractFileDeletedIterator struct {
+	Event *FileStorageContractFileDeleted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *FileStorageContractFileDeletedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(FileStorageContractFileDeleted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(FileStorageContractFileDeleted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *FileStorageContractFileDeletedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *FileStorageContractFileDeletedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// FileStorageContractFileDeleted represents a FileDeleted event raised by the FileStorageContract contract.
+type FileStorageContractFileDeleted struct {
+	FileHash  [32]byte
+	Deleter   common.Address
+	Timestamp *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterFileDeleted is a free log retrieval operation binding the contract event 0x44784d2638eb6c2b2ef3dace8a8d89a892a4d4aedf4719f97d1864e303fbda94.
+//
+// Solidity: event FileDeleted(bytes32 indexed fileHash, address indexed deleter, uint256 timestamp)
+func (_FileStorageContract *FileStorageContractFilterer) FilterFileDeleted(opts *bind.FilterOpts, fileHash [][32]byte, deleter []common.Address) (*FileStorageContractFileDeletedIterator, error) {
+
+	var fileHashRule []interface{}
+	for _, fileHashItem := range fileHash {
+		fileHashRule = append(fileHashRule, fileHashItem)
+	}
+	var deleterRule []interface{}
+	for _, deleterItem := range deleter {
+		deleterRule = append(deleterRule, deleterItem)
+	}
+
+	logs, sub, err := _FileStorageContract.contract.FilterLogs(opts, "FileDeleted", fileHashRule, deleterRule)
+	if err != nil {
+		return nil, err
+	}
+	return &FileStorageContractFileDeletedIterator{contract: _FileStorageContract.contract, event: "FileDeleted", logs: logs, sub: sub}, nil
+}
+
+// WatchFileDeleted is a free log subscription operation binding the contract event 0x44784d2638eb6c2b2ef3dace8a8d89a892a4d4aedf4719f97d1864e303fbda94.
+//
+// Solidity: event FileDeleted(bytes32 indexed fileHash, address indexed deleter, uint256 timestamp)
+func (_FileStorageContract *FileStorageContractFilterer) WatchFileDeleted(opts *bind.WatchOpts, sink chan<- *FileStorageContractFileDeleted, fileHash [][32]byte, deleter []common.Address) (event.Subscription, error) {
+
+	var fileHashRule []interface{}
+	for _, fileHashItem := range fileHash {
+		fileHashRule = append(fileHashRule, fileHashItem)
+	}
+	var deleterRule []interface{}
+	for _, deleterItem := range deleter {
+		deleterRule = append(deleterRule, deleterItem)
+	}
+
+	logs, sub, err := _FileStorageContract.contract.WatchLogs(opts, "FileDeleted", fileHashRule, deleterRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(FileStorageContractFileDeleted)
+				if err := _FileStorageContract.contract.UnpackLog(event, "FileDeleted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseFileDeleted is a log parse operation binding the contract event 0x44784d2638eb6c2b2ef3dace8a8d89a892a4d4aedf4719f97d1864e303fbda94.
+//
+// Solidity: event FileDeleted(bytes32 indexed fileHash, address indexed deleter, uint256 timestamp)
+func (_FileStorageContract *FileStorageContractFilterer) ParseFileDeleted(log types.Log) (*FileStorageContractFileDeleted, error) {
+	event := new(FileStorageContractFileDeleted)
+	if err := _FileStorageContract.contract.UnpackLog(event, "FileDeleted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// FileStorageContractFileDownloadedIterator is returned from FilterFileDownloaded and is used to iterate over the raw logs and unpacked data for FileDownloaded events raised by the FileStorageContract contract.
+type FileStorageContractFileDownloadedIterator struct {
+	Event *FileStorageContractFileDownloaded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *FileStorageContractFileDownloadedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(FileStorageContractFileDownloaded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(FileStorageContractFileDownloaded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *FileStorageContractFileDownloadedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *FileStorageContractFileDownloadedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// FileStorageContractFileDownloaded represents a FileDownloaded event raised by the FileStorageContract contract.
+type FileStorageContractFileDownloaded struct {
+	FileHash   [32]byte
+	Downloader common.Address
+	Timestamp  *big.Int
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterFileDownloaded is a free log retrieval operation binding the contract event 0x134689260d977c8820d39f35ab2a85b84776f032a8d64b2a65feaaff5490ac0f.
+//
+// Solidity: event FileDownloaded(bytes32 indexed fileHash, address indexed downloader, uint256 timestamp)
+func (_FileStorageContract *FileStorageContractFilterer) FilterFileDownloaded(opts *bind.FilterOpts, fileHash [][32]byte, downloader []common.Address) (*FileStorageContractFileDownloadedIterator, error) {
+
+	var fileHashRule []interface{}
+	for _, fileHashItem := range fileHash {
+		fileHashRule = append(fileHashRule, fileHashItem)
+	}
+	var downloaderRule []interface{}
+	for _, downloaderItem := range downloader {
+		downloaderRule = append(downloaderRule, downloaderItem)
+	}
+
+	logs, sub, err := _FileStorageContract.contract.FilterLogs(opts, "FileDownloaded", fileHashRule, downloaderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &FileStorageContractFileDownloadedIterator{contract: _FileStorageContract.contract, event: "FileDownloaded", logs: logs, sub: sub}, nil
+}
+
+// WatchFileDownloaded is a free log subscription operation binding the contract event 0x134689260d977c8820d39f35ab2a85b84776f032a8d64b2a65feaaff5490ac0f.
+//
+// Solidity: event FileDownloaded(bytes32 indexed fileHash, address indexed downloader, uint256 timestamp)
+func (_FileStorageContract *FileStorageContractFilterer) WatchFileDownloaded(opts *bind.WatchOpts, sink chan<- *FileStorageContractFileDownloaded, fileHash [][32]byte, downloader []common.Address) (event.Subscription, error) {
+
+	var fileHashRule []interface{}
+	for _, fileHashItem := range fileHash {
+		fileHashRule = append(fileHashRule, fileHashItem)
+	}
+	var downloaderRule []interface{}
+	for _, downloaderItem := range downloader {
+		downloaderRule = append(downloaderRule, downloaderItem)
+	}
+
+	logs, sub, err := _FileStorageContract.contract.WatchLogs(opts, "FileDownloaded", fileHashRule, downloaderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(FileStorageContractFileDownloaded)
+				if err := _FileStorageContract.contract.UnpackLog(event, "FileDownloaded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseFileDownloaded is a log parse operation binding the contract event 0x134689260d977c8820d39f35ab2a85b84776f032a8d64b2a65feaaff5490ac0f.
+//
+// Solidity: event FileDownloaded(bytes32 indexed fileHash, address indexed downloader, uint256 timestamp)
+func (_FileStorageContract *FileStorageContractFilterer) ParseFileDownloaded(log types.Log) (*FileStorageContractFileDownloaded, error) {
+	event := new(FileStorageContractFileDownloaded)
+	if err := _FileStorageContract.contract.UnpackLog(event, "FileDownloaded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// FileStorageContractFileUploadedIterator is returned from FilterFileUploaded and is used to iterate over the raw logs and unpacked data for FileUploaded events raised by the FileStorageContract contract.
+type FileStorageContractFileUploadedIterator struct {
+	Event *FileStorageContractFileUploaded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *FileStorageContractFileUploadedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(FileStorageContractFileUploaded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(FileStorageContractFileUploaded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *FileStorageContractFileUploadedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *FileStorageContractFileUploadedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// FileStorageContractFileUploaded represents a FileUploaded event raised by the FileStorageContract contract.
+type FileStorageContractFileUploaded struct {
+	FileHash   [32]byte
+	Uploader   common.Address
+	Filename   string
+	Size       *big.Int
+	Timestamp  *big.Int
+	MerkleRoot string
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterFileUploaded is a free log retrieval operation binding the contract event 0x4fd65ab323e60be3274cd82bbed2685014bf4cc64c0c30e1fc979f683080d54e.
+//
+// Solidity: event FileUploaded(bytes32 indexed fileHash, address indexed uploader, string filename, uint256 size, uint256 timestamp, string merkleRoot)
+func (_FileStorageContract *FileStorageContractFilterer) FilterFileUploaded(opts *bind.FilterOpts, fileHash [][32]byte, uploader []common.Address) (*FileStorageContractFileUploadedIterator, error) {
+
+	var fileHashRule []interface{}
+	for _, fileHashItem := range fileHash {
+		fileHashRule = append(fileHashRule, fileHashItem)
+	}
+	var uploaderRule []interface{}
+	for _, uploaderItem := range uploader {
+		uploaderRule = append(uploaderRule, uploaderItem)
+	}
+
+	logs, sub, err := _FileStorageContract.contract.FilterLogs(opts, "FileUploaded", fileHashRule, uploaderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &FileStorageContractFileUploadedIterator{contract: _FileStorageContract.contract, event: "FileUploaded", logs: logs, sub: sub}, nil
+}
+
+// WatchFileUploaded is a free log subscription operation binding the contract event 0x4fd65ab323e60be3274cd82bbed2685014bf4cc64c0c30e1fc979f683080d54e.
+//
+// Solidity: event FileUploaded(bytes32 indexed fileHash, address indexed uploader, string filename, uint256 size, uint256 timestamp, string merkleRoot)
+func (_FileStorageContract *FileStorageContractFilterer) WatchFileUploaded(opts *bind.WatchOpts, sink chan<- *FileStorageContractFileUploaded, fileHash [][32]byte, uploader []common.Address) (event.Subscription, error) {
+
+	var fileHashRule []interface{}
+	for _, fileHashItem := range fileHash {
+		fileHashRule = append(fileHashRule, fileHashItem)
+	}
+	var uploaderRule []interface{}
+	for _, uploaderItem := range uploader {
+		uploaderRule = append(uploaderRule, uploaderItem)
+	}
+
+	logs, sub, err := _FileStorageContract.contract.WatchLogs(opts, "FileUploaded", fileHashRule, uploaderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(FileStorageContractFileUploaded)
+				if err := _FileStorageContract.contract.UnpackLog(event, "FileUploaded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseFileUploaded is a log parse operation binding the contract event 0x4fd65ab323e60be3274cd82bbed2685014bf4cc64c0c30e1fc979f683080d54e.
+//
+// Solidity: event FileUploaded(bytes32 indexed fileHash, address indexed uploader, string filename, uint256 size, uint256 timestamp, string merkleRoot)
+func (_FileStorageContract *FileStorageContractFilterer) ParseFileUploaded(log types.Log) (*FileStorageContractFileUploaded, error) {
+	event := new(FileStorageContractFileUploaded)
+	if err := _FileStorageContract.contract.UnpackLog(event, "FileUploaded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// FileStorageContractOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the FileStorageContract contract.
+type FileStorageContractOwnershipTransferredIterator struct {
+	Event *FileStorageContractOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *FileStorageContractOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(FileStorageContractOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(FileStorageContractOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *FileStorageContractOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *FileStorageContractOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// FileStorageContractOwnershipTransferred represents a OwnershipTransferred event raised by the FileStorageContract contract.
+type FileStorageContractOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_FileStorageContract *FileStorageContractFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*FileStorageContractOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _FileStorageContract.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &FileStorageContractOwnershipTransferredIterator{contract: _FileStorageContract.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_FileStorageContract *FileStorageContractFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *FileStorageContractOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _FileStorageContract.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(FileStorageContractOwnershipTransferred)
+				if err := _FileStorageContract.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_FileStorageContract *FileStorageContractFilterer) ParseOwnershipTransferred(log types.Log) (*FileStorageContractOwnershipTransferred, error) {
+	event := new(FileStorageContractOwnershipTransferred)
+	if err := _FileStorageContract.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// FileStorageContractProofVerifiedIterator is returned from FilterProofVerified and is used to iterate over the raw logs and unpacked data for ProofVerified events raised by the FileStorageContract contract.
+type FileStorageContractProofVerifiedIterator struct {
+	Event *FileStorageContractProofVerified // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *FileStorageContractProofVerifiedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(FileStorageContractProofVerified)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(FileStorageContractProofVerified)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *FileStorageContractProofVerifiedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *FileStorageContractProofVerifiedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// FileStorageContractProofVerified represents a ProofVerified event raised by the FileStorageContract contract.
+type FileStorageContractProofVerified struct {
+	FileHash  [32]byte
+	Verifier  common.Address
+	IsValid   bool
+	Timestamp *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterProofVerified is a free log retrieval operation binding the contract event 0xd70445ae79bfa82bd8c52e90ca89940dff8279c6c39f91265be2f4ae43e4cae2.
+//
+// Solidity: event ProofVerified(bytes32 indexed fileHash, address indexed verifier, bool isValid, uint256 timestamp)
+func (_FileStorageContract *FileStorageContractFilterer) FilterProofVerified(opts *bind.FilterOpts, fileHash [][32]byte, verifier []common.Address) (*FileStorageContractProofVerifiedIterator, error) {
+
+	var fileHashRule []interface{}
+	for _, fileHashItem := range fileHash {
+		fileHashRule = append(fileHashRule, fileHashItem)
+	}
+	var verifierRule []interface{}
+	for _, verifierItem := range verifier {
+		verifierRule = append(verifierRule, verifierItem)
+	}
+
+	logs, sub, err := _FileStorageContract.contract.FilterLogs(opts, "ProofVerified", fileHashRule, verifierRule)
+	if err != nil {
+		return nil, err
+	}
+	return &FileStorageContractProofVerifiedIterator{contract: _FileStorageContract.contract, event: "ProofVerified", logs: logs, sub: sub}, nil
+}
+
+// WatchProofVerified is a free log subscription operation binding the contract event 0xd70445ae79bfa82bd8c52e90ca89940dff8279c6c39f91265be2f4ae43e4cae2.
+//
+// Solidity: event ProofVerified(bytes32 indexed fileHash, address indexed verifier, bool isValid, uint256 timestamp)
+func (_FileStorageContract *FileStorageContractFilterer) WatchProofVerified(opts *bind.WatchOpts, sink chan<- *FileStorageContractProofVerified, fileHash [][32]byte, verifier []common.Address) (event.Subscription, error) {
+
+	var fileHashRule []interface{}
+	for _, fileHashItem := range fileHash {
+		fileHashRule = append(fileHashRule, fileHashItem)
+	}
+	var verifierRule []interface{}
+	for _, verifierItem := range verifier {
+		verifierRule = append(verifierRule, verifierItem)
+	}
+
+	logs, sub, err := _FileStorageContract.contract.WatchLogs(opts, "ProofVerified", fileHashRule, verifierRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(FileStorageContractProofVerified)
+				if err := _FileStorageContract.contract.UnpackLog(event, "ProofVerified", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseProofVerified is a log parse operation binding the contract event 0xd70445ae79bfa82bd8c52e90ca89940dff8279c6c39f91265be2f4ae43e4cae2.
+//
+// Solidity: event ProofVerified(bytes32 indexed fileHash, address indexed verifier, bool isValid, uint256 timestamp)
+func (_FileStorageContract *FileStorageContractFilterer) ParseProofVerified(log types.Log) (*FileStorageContractProofVerified, error) {
+	event := new(FileStorageContractProofVerified)
+	if err := _FileStorageContract.contract.UnpackLog(event, "ProofVerified", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
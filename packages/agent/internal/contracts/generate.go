@@ -0,0 +1,20 @@
+package contracts
+
+// Bindings are generated from the Hardhat artifacts in /contracts. Run
+// `npx hardhat compile` there first, then `go generate ./internal/contracts`.
+
+//go:generate sh -c "jq -c .abi ../../../../contracts/artifacts/src/NebulaVault.sol/NebulaVault.json > build/NebulaVault.abi"
+//go:generate sh -c "jq -r .bytecode ../../../../contracts/artifacts/src/NebulaVault.sol/NebulaVault.json | sed 's/^0x//' > build/NebulaVault.bin"
+//go:generate abigen --abi build/NebulaVault.abi --bin build/NebulaVault.bin --pkg contracts --type NebulaVaultContract --out nebulavault.go
+
+//go:generate sh -c "jq -c .abi ../../../../contracts/artifacts/src/ProofVerification.sol/ProofVerification.json > build/ProofVerification.abi"
+//go:generate sh -c "jq -r .bytecode ../../../../contracts/artifacts/src/ProofVerification.sol/ProofVerification.json | sed 's/^0x//' > build/ProofVerification.bin"
+//go:generate abigen --abi build/ProofVerification.abi --bin build/ProofVerification.bin --pkg contracts --type ProofVerificationContract --out proofverification.go
+
+//go:generate sh -c "jq -c .abi ../../../../contracts/artifacts/src/AccessControl.sol/NebulaVaultAccessControl.json > build/AccessControl.abi"
+//go:generate sh -c "jq -r .bytecode ../../../../contracts/artifacts/src/AccessControl.sol/NebulaVaultAccessControl.json | sed 's/^0x//' > build/AccessControl.bin"
+//go:generate abigen --abi build/AccessControl.abi --bin build/AccessControl.bin --pkg contracts --type AccessControlContract --out accesscontrol.go
+
+//go:generate sh -c "jq -c .abi ../../../../contracts/artifacts/src/FileStorage.sol/FileStorage.json > build/FileStorage.abi"
+//go:generate sh -c "jq -r .bytecode ../../../../contracts/artifacts/src/FileStorage.sol/FileStorage.json | sed 's/^0x//' > build/FileStorage.bin"
+//go:generate abigen --abi build/FileStorage.abi --bin build/FileStorage.bin --pkg contracts --type FileStorageContract --out filestorage.go
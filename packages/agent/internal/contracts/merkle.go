@@ -0,0 +1,86 @@
+package contracts
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// MerkleProof is an inclusion proof in the layout ProofVerification checks:
+// each step hashes the running node with Proof[i] using keccak256, with the
+// sibling on the left when Indices[i] is 0 and on the right otherwise
+type MerkleProof struct {
+	Root    common.Hash
+	Leaf    common.Hash
+	Proof   []common.Hash
+	Indices []uint64
+}
+
+// BuildMerkleProof builds a keccak256 Merkle tree over leaves and returns the
+// proof for the leaf at index. A node without a sibling is paired with
+// itself, so even a single leaf yields the non-empty proof the contract
+// requires.
+func BuildMerkleProof(leaves []common.Hash, index int) (*MerkleProof, error) {
+	if len(leaves) == 0 {
+		return nil, fmt.Errorf("cannot build a proof without leaves")
+	}
+	if index < 0 || index >= len(leaves) {
+		return nil, fmt.Errorf("leaf index %d out of range for %d leaves", index, len(leaves))
+	}
+
+	result := &MerkleProof{Leaf: leaves[index]}
+	level := append([]common.Hash(nil), leaves...)
+	for {
+		if len(level)%2 == 1 {
+			level = append(level, level[len(level)-1])
+		}
+
+		if index%2 == 0 {
+			result.Proof = append(result.Proof, level[index+1])
+			result.Indices = append(result.Indices, 1)
+		} else {
+			result.Proof = append(result.Proof, level[index-1])
+			result.Indices = append(result.Indices, 0)
+		}
+
+		next := make([]common.Hash, len(level)/2)
+		for i := range next {
+			next[i] = crypto.Keccak256Hash(level[2*i].Bytes(), level[2*i+1].Bytes())
+		}
+		level = next
+		index /= 2
+
+		if len(level) == 1 {
+			result.Root = level[0]
+			return result, nil
+		}
+	}
+}
+
+// Verify recomputes the root from the proof the way ProofVerification does
+func (p *MerkleProof) Verify() bool {
+	if len(p.Proof) != len(p.Indices) {
+		return false
+	}
+
+	computed := p.Leaf
+	for i, sibling := range p.Proof {
+		if p.Indices[i] == 0 {
+			computed = crypto.Keccak256Hash(sibling.Bytes(), computed.Bytes())
+		} else {
+			computed = crypto.Keccak256Hash(computed.Bytes(), sibling.Bytes())
+		}
+	}
+	return computed == p.Root
+}
+
+// Apply fills the proof fields of an upload request
+func (p *MerkleProof) Apply(req *FileUploadRequest) {
+	req.LeafHash = p.Leaf.Hex()
+	req.Proof = make([]string, len(p.Proof))
+	for i, sibling := range p.Proof {
+		req.Proof[i] = sibling.Hex()
+	}
+	req.Indices = append([]uint64(nil), p.Indices...)
+}
@@ -0,0 +1,93 @@
+package contracts
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func testLeaves(n int) []common.Hash {
+	leaves := make([]common.Hash, n)
+	for i := range leaves {
+		leaves[i] = crypto.Keccak256Hash([]byte{byte(i)})
+	}
+	return leaves
+}
+
+func TestBuildMerkleProof(t *testing.T) {
+	for _, n := range []int{1, 2, 3, 5, 8} {
+		leaves := testLeaves(n)
+		var root common.Hash
+		for i := range leaves {
+			proof, err := BuildMerkleProof(leaves, i)
+			if err != nil {
+				t.Fatalf("Failed to build proof for leaf %d of %d: %v", i, n, err)
+			}
+			if len(proof.Proof) == 0 {
+				t.Errorf("Expected a non-empty proof for leaf %d of %d", i, n)
+			}
+			if !proof.Verify() {
+				t.Errorf("Expected proof for leaf %d of %d to verify", i, n)
+			}
+			if i > 0 && proof.Root != root {
+				t.Errorf("Expected every proof of %d leaves to share a root", n)
+			}
+			root = proof.Root
+		}
+	}
+
+	proof, err := BuildMerkleProof(testLeaves(4), 1)
+	if err != nil {
+		t.Fatalf("Failed to build proof: %v", err)
+	}
+	proof.Leaf = common.HexToHash("0xbad")
+	if proof.Verify() {
+		t.Error("Expected proof for a different leaf not to verify")
+	}
+
+	if _, err := BuildMerkleProof(nil, 0); err == nil {
+		t.Error("Expected an error without leaves")
+	}
+	if _, err := BuildMerkleProof(testLeaves(2), 2); err == nil {
+		t.Error("Expected an error for an out of range leaf")
+	}
+}
+
+func TestBuildMerkleProof_VerifiesOnChain(t *testing.T) {
+	h := newTestHarness(t)
+
+	deployer, err := bind.NewKeyedTransactorWithChainID(h.owner, big.NewInt(simulatedChainID))
+	if err != nil {
+		t.Fatalf("Failed to create deployer: %v", err)
+	}
+	_, _, pv, err := DeployProofVerificationContract(deployer, h.backend.Client())
+	if err != nil {
+		t.Fatalf("Failed to deploy ProofVerification: %v", err)
+	}
+	h.backend.Commit()
+
+	proof, err := BuildMerkleProof(testLeaves(5), 3)
+	if err != nil {
+		t.Fatalf("Failed to build proof: %v", err)
+	}
+
+	siblings := make([][32]byte, len(proof.Proof))
+	indices := make([]*big.Int, len(proof.Indices))
+	for i := range proof.Proof {
+		siblings[i] = proof.Proof[i]
+		indices[i] = new(big.Int).SetUint64(proof.Indices[i])
+	}
+
+	// verifyProof changes state, so call it without sending a transaction
+	raw := &ProofVerificationContractCallerRaw{Contract: &pv.ProofVerificationContractCaller}
+	var out []interface{}
+	if err := raw.Call(nil, &out, "verifyProof", common.HexToHash("0x01"), proof.Root, siblings, indices, proof.Leaf); err != nil {
+		t.Fatalf("Failed to call verifyProof: %v", err)
+	}
+	if valid, _ := out[0].(bool); !valid {
+		t.Error("Expected the contract to accept the proof")
+	}
+}
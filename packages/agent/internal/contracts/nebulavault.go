@@ -0,0 +1,2079 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package contracts
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// NebulaVaultContractMetaData contains all meta data concerning the NebulaVaultContract contract.
+var NebulaVaultContractMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"fileStorage\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"accessControl\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"proofVerification\",\"type\":\"address\"}],\"name\":\"ContractsDeployed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"fileHash\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"uploader\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"filename\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"size\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"proofSubmitted\",\"type\":\"bool\"}],\"name\":\"FileUploadedWithVerification\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"Paused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"oldContract\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newContract\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"contractType\",\"type\":\"string\"}],\"name\":\"SystemUpgraded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"Unpaused\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"accessControl\",\"outputs\":[{\"internalType\":\"contractNebulaVaultAccessControl\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"fileHash\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"}],\"name\":\"authorizeUser\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"fileHash\",\"type\":\"bytes32\"}],\"name\":\"deleteFile\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"fileHash\",\"type\":\"bytes32\"}],\"name\":\"downloadFile\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"emergencyWithdraw\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"fileStorage\",\"outputs\":[{\"internalType\":\"contractFileStorage\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"fileHash\",\"type\":\"bytes32\"}],\"name\":\"getFileMetadata\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getSystemStats\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"totalUsers\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"totalFiles\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"totalVerified\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"totalUploadsCount\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"totalDownloadsCount\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"}],\"name\":\"getUserProfile\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"}],\"name\":\"getUserStats\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"uploads\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"downloads\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"storageUsed\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"storageQuota\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"fileHash\",\"type\":\"bytes32\"}],\"name\":\"getVerificationResult\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"},{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"fileHash\",\"type\":\"bytes32\"}],\"name\":\"isFileVerified\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"}],\"name\":\"isUserActive\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"pause\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"paused\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"proofVerification\",\"outputs\":[{\"internalType\":\"contractProofVerification\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"username\",\"type\":\"string\"}],\"name\":\"registerUser\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"fileHash\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"}],\"name\":\"revokeUser\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalDownloads\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalUploads\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalVerifiedFiles\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"unpause\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newAccessControl\",\"type\":\"address\"}],\"name\":\"upgradeAccessControl\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newFileStorage\",\"type\":\"address\"}],\"name\":\"upgradeFileStorage\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newProofVerification\",\"type\":\"address\"}],\"name\":\"upgradeProofVerification\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"fileHash\",\"type\":\"bytes32\"},{\"internalType\":\"string\",\"name\":\"filename\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"size\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"merkleRoot\",\"type\":\"string\"}],\"name\":\"uploadFile\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"fileHash\",\"type\":\"bytes32\"},{\"internalType\":\"string\",\"name\":\"filename\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"size\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"merkleRoot\",\"type\":\"string\"},{\"internalType\":\"bytes32[]\",\"name\":\"proof\",\"type\":\"bytes32[]\"},{\"internalType\":\"uint256[]\",\"name\":\"indices\",\"type\":\"uint256[]\"},{\"internalType\":\"bytes32\",\"name\":\"leafHash\",\"type\":\"bytes32\"}],\"name\":\"uploadFileWithVerification\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"userDownloadCounts\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"userUploadCounts\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"name\":\"verifiedUploads\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"fileHash\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"merkleRoot\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32[]\",\"name\":\"proof\",\"type\":\"bytes32[]\"},{\"internalType\":\"uint256[]\",\"name\":\"indices\",\"type\":\"uint256[]\"},{\"internalType\":\"bytes32\",\"name\":\"leafHash\",\"type\":\"bytes32\"}],\"name\":\"verifyFileProof\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"stateMutability\":\"payable\",\"type\":\"receive\"}]",
+	Bin: "0x60806040523480156200001157600080fd5b506200001d33620002f2565b600180556002805460ff19169055604051620000399062000342565b604051809103906000f08015801562000056573d6000803e3d6000fd5b50600260016101000a8154816001600160a01b0302191690836001600160a01b031602179055506040516200008b9062000350565b604051809103906000f080158015620000a8573d6000803e3d6000fd5b50600380546001600160a01b0319166001600160a01b0392909216919091179055604051620000d7906200035e565b604051809103906000f080158015620000f4573d6000803e3d6000fd5b50600480546001600160a01b0319166001600160a01b0392831617815560025460405163f2fde38b60e01b8152309281019290925261010090049091169063f2fde38b90602401600060405180830381600087803b1580156200015657600080fd5b505af11580156200016b573d6000803e3d6000fd5b505060035460408051631d6c8e3f60e21b815290516001600160a01b039092169350632f2ff15d925083916375b238fc916004808201926020929091908290030181865afa158015620001c2573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190620001e891906200036c565b6040516001600160e01b031960e084901b1681526004810191909152306024820152604401600060405180830381600087803b1580156200022857600080fd5b505af11580156200023d573d6000803e3d6000fd5b50506004805460405163f2fde38b60e01b815230928101929092526001600160a01b0316925063f2fde38b9150602401600060405180830381600087803b1580156200028857600080fd5b505af11580156200029d573d6000803e3d6000fd5b50506004546003546002546040516001600160a01b03938416955091831693506101009004909116907f64ff5f080a9fbac5fd2b92ee5d223ab47abf2bc09caad8eefb67cdf7f7a1796c90600090a462000386565b600080546001600160a01b038381166001600160a01b0319831681178455604051919092169283917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e09190a35050565b6121b380620025fb83390190565b611fa080620047ae83390190565b6117a9806200674e83390190565b6000602082840312156200037f57600080fd5b5051919050565b61226580620003966000396000f3fe6080604052600436106101fd5760003560e01c8063715018a61161010d578063a30e992c116100a0578063d1ce59a71161006f578063d1ce59a714610629578063d6e62a1c14610666578063db2e21bc14610686578063f2fde38b1461069b578063fdbcefdf146106bb57600080fd5b8063a30e992c146105ae578063b5d350d0146105ce578063be5b9b60146105e4578063d06a7fa11461060457600080fd5b80638da5cb5b116100dc5780638da5cb5b1461051e5780639103edbf1461053c578063987ee1561461055c5780639ee20b151461058e57600080fd5b8063715018a614610494578063738ee5e6146104a95780637943a193146104d95780638456cb591461050957600080fd5b80633f4ba83a1161019057806365f353711161015f57806365f35371146103f857806367233ed81461042b5780636ab799f11461043e5780636d5a0bca1461045e578063704f1b941461047457600080fd5b80633f4ba83a1461037557806344c62d9f1461038a5780634e43603a146103a05780635c975abb146103e057600080fd5b80632d84ed13116101cc5780632d84ed13146102ab57806332911c21146102cb57806333ea2255146102eb57806336beb6971461032657600080fd5b80630452cb601461020957806307a210be1461023e57806313007d55146102535780631494f7f11461028b57600080fd5b3661020457005b600080fd5b34801561021557600080fd5b50610229610224366004611a8d565b6106e8565b60405190151581526020015b60405180910390f35b61025161024c366004611b8a565b610851565b005b34801561025f57600080fd5b50600354610273906001600160a01b031681565b6040516001600160a01b039091168152602001610235565b34801561029757600080fd5b506102516102a6366004611c69565b610a79565b3480156102b757600080fd5b506102516102c6366004611c69565b610b08565b3480156102d757600080fd5b50600454610273906001600160a01b031681565b3480156102f757600080fd5b50610318610306366004611c69565b60076020526000908152604090205481565b604051908152602001610235565b34801561033257600080fd5b50610346610341366004611c8d565b610b89565b604051610235959493929190948552602085019390935290151560408401526060830152608082015260a00190565b34801561038157600080fd5b50610251610c18565b34801561039657600080fd5b5061031860095481565b3480156103ac57600080fd5b506103c06103bb366004611c69565b610c2a565b604080519485526020850193909352918301526060820152608001610235565b3480156103ec57600080fd5b5060025460ff16610229565b34801561040457600080fd5b50610418610413366004611c8d565b610cc8565b6040516102359796959493929190611cf6565b610251610439366004611d55565b610d63565b34801561044a57600080fd5b50610251610459366004611c8d565b610f21565b34801561046a57600080fd5b5061031860085481565b34801561048057600080fd5b5061025161048f366004611dcc565b611050565b3480156104a057600080fd5b506102516110b5565b3480156104b557600080fd5b506102296104c4366004611c8d565b60009081526005602052604090205460ff1690565b3480156104e557600080fd5b506102296104f4366004611c8d565b60056020526000908152604090205460ff1681565b34801561051557600080fd5b506102516110c7565b34801561052a57600080fd5b506000546001600160a01b0316610273565b34801561054857600080fd5b50610251610557366004611e09565b6110d7565b34801561056857600080fd5b5061057c610577366004611c69565b6111d0565b60405161023596959493929190611e39565b34801561059a57600080fd5b506102296105a9366004611c69565b611264565b3480156105ba57600080fd5b506102516105c9366004611c8d565b6112d9565b3480156105da57600080fd5b50610318600a5481565b3480156105f057600080fd5b506102516105ff366004611e09565b611451565b34801561061057600080fd5b506002546102739061010090046001600160a01b031681565b34801561063557600080fd5b5061063e611518565b604080519586526020860194909452928401919091526060830152608082015260a001610235565b34801561067257600080fd5b50610251610681366004611c69565b611628565b34801561069257600080fd5b506102516116a5565b3480156106a757600080fd5b506102516106b6366004611c69565b61172f565b3480156106c757600080fd5b506103186106d6366004611c69565b60066020526000908152604090205481565b600354604051639ee20b1560e01b81523360048201526000916001600160a01b031690639ee20b1590602401602060405180830381865afa158015610731573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906107559190611e8c565b61077a5760405162461bcd60e51b815260040161077190611ea7565b60405180910390fd5b600480546040516314d0b37560e31b81526000926001600160a01b039092169163a6859ba8916107b4918b918b918b918b918b9101611ede565b6020604051808303816000875af11580156107d3573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906107f79190611e8c565b9050808015610815575060008781526005602052604090205460ff16155b15610847576000878152600560205260408120805460ff19166001179055600a80549161084183611f89565b91905055505b9695505050505050565b600354604051639ee20b1560e01b81523360048201526001600160a01b0390911690639ee20b1590602401602060405180830381865afa158015610899573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906108bd9190611e8c565b6108d95760405162461bcd60e51b815260040161077190611ea7565b6108e16117a5565b6108e96117eb565b600254604051630ce467db60e31b81526101009091046001600160a01b0316906367233ed8903490610925908b908b908b908b90600401611fa2565b6000604051808303818588803b15801561093e57600080fd5b505af1158015610952573d6000803e3d6000fd5b50506004546040516001600160a01b03909116935063e95c28c392508a915061097f908890602001611fd3565b604051602081830303815290604052805190602001208686866040518663ffffffff1660e01b81526004016109b8959493929190611ede565b600060405180830381600087803b1580156109d257600080fd5b505af11580156109e6573d6000803e3d6000fd5b5050336000908152600660205260408120805493509150610a0683611f89565b909155505060088054906000610a1b83611f89565b9190505550336001600160a01b0316877fce3f1950f96e51ff8d0977b5884bbb34419e4231b478f45bef943f95478b9a8988886001604051610a5f93929190611fef565b60405180910390a3610a7060018055565b50505050505050565b610a81611844565b600280546001600160a01b03838116610100818102610100600160a81b031985161790945560405193909204169182907f604ad002a40ff5c7889d79b0d608bb9a3deca65b1ad03844689a7ef3f63ec4a190610afc906020808252600b908201526a46696c6553746f7261676560a81b604082015260600190565b60405180910390a35050565b610b10611844565b600480546001600160a01b038381166001600160a01b0319831681179093556040519116919082907f604ad002a40ff5c7889d79b0d608bb9a3deca65b1ad03844689a7ef3f63ec4a190610afc90602080825260119082015270283937b7b32b32b934b334b1b0ba34b7b760791b604082015260600190565b600480546040516336beb69760e01b815260009283928392839283926001600160a01b0316916336beb69791610bc5918a910190815260200190565b60a060405180830381865afa158015610be2573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610c069190612019565b939a9299509097509550909350915050565b610c20611844565b610c2861189e565b565b6001600160a01b03818116600081815260066020908152604080832054600790925280832054600354915163274f348d60e11b815260048101959095529194919383929190911690634e9e691a90602401606060405180830381865afa158015610c98573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610cbc9190612061565b50949693955092915050565b6002546040516365f3537160e01b8152600481018390526000918291606091839182918491839161010090046001600160a01b0316906365f3537190602401600060405180830381865afa158015610d24573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f19168201604052610d4c91908101906120d4565b959e949d50929b5090995097509550909350915050565b600354604051639ee20b1560e01b81523360048201526001600160a01b0390911690639ee20b1590602401602060405180830381865afa158015610dab573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610dcf9190611e8c565b610deb5760405162461bcd60e51b815260040161077190611ea7565b610df36117a5565b610dfb6117eb565b600254604051630ce467db60e31b81526101009091046001600160a01b0316906367233ed8903490610e37908890889088908890600401611fa2565b6000604051808303818588803b158015610e5057600080fd5b505af1158015610e64573d6000803e3d6000fd5b5050506000868152600560205260408120805460ff19166001179055600a805493509150610e9183611f89565b9091555050336000908152600660205260408120805491610eb183611f89565b909155505060088054906000610ec683611f89565b9190505550336001600160a01b0316847fce3f1950f96e51ff8d0977b5884bbb34419e4231b478f45bef943f95478b9a8985856000604051610f0a93929190611fef565b60405180910390a3610f1b60018055565b50505050565b600354604051639ee20b1560e01b81523360048201526001600160a01b0390911690639ee20b1590602401602060405180830381865afa158015610f69573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610f8d9190611e8c565b610fa95760405162461bcd60e51b815260040161077190611ea7565b600254604051636ab799f160e01b8152600481018390526101009091046001600160a01b031690636ab799f190602401600060405180830381600087803b158015610ff357600080fd5b505af1158015611007573d6000803e3d6000fd5b50505060008281526005602052604090205460ff1615905061104d576000818152600560205260408120805460ff19169055600a80549161104783612179565b91905055505b50565b600354604051631c13c6e560e21b81526001600160a01b039091169063704f1b9490611080908490600401612190565b600060405180830381600087803b15801561109a57600080fd5b505af11580156110ae573d6000803e3d6000fd5b5050505050565b6110bd611844565b610c2860006118f0565b6110cf611844565b610c28611940565b600354604051639ee20b1560e01b81523360048201526001600160a01b0390911690639ee20b1590602401602060405180830381865afa15801561111f573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906111439190611e8c565b61115f5760405162461bcd60e51b815260040161077190611ea7565b600254604051639103edbf60e01b8152600481018490526001600160a01b03838116602483015261010090920490911690639103edbf906044015b600060405180830381600087803b1580156111b457600080fd5b505af11580156111c8573d6000803e3d6000fd5b505050505050565b600354604051634c3f70ab60e11b81526001600160a01b03838116600483015260609260009283928392839283929091169063987ee15690602401600060405180830381865afa158015611228573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f1916820160405261125091908101906121a3565b949c939b5091995097509550909350915050565b600354604051639ee20b1560e01b81526001600160a01b0383811660048301526000921690639ee20b1590602401602060405180830381865afa1580156112af573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906112d39190611e8c565b92915050565b600354604051639ee20b1560e01b81523360048201526001600160a01b0390911690639ee20b1590602401602060405180830381865afa158015611321573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906113459190611e8c565b6113615760405162461bcd60e51b815260040161077190611ea7565b600081815260056020526040902054819060ff166113b55760405162461bcd60e51b8152602060048201526011602482015270119a5b19481b9bdd081d995c9a599a5959607a1b6044820152606401610771565b6002546040516328c3a64b60e21b8152600481018490526101009091046001600160a01b03169063a30e992c90602401600060405180830381600087803b1580156113ff57600080fd5b505af1158015611413573d6000803e3d6000fd5b505033600090815260076020526040812080549350915061143383611f89565b90915550506009805490600061144883611f89565b91905055505050565b600354604051639ee20b1560e01b81523360048201526001600160a01b0390911690639ee20b1590602401602060405180830381865afa158015611499573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906114bd9190611e8c565b6114d95760405162461bcd60e51b815260040161077190611ea7565b6002546040516305f2dcdb60e51b8152600481018490526001600160a01b0383811660248301526101009092049091169063be5b9b609060440161119a565b6000806000806000600360009054906101000a90046001600160a01b03166001600160a01b0316639be572f66040518163ffffffff1660e01b8152600401602060405180830381865afa158015611573573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906115979190612216565b9450600260019054906101000a90046001600160a01b03166001600160a01b031663766e0d336040518163ffffffff1660e01b8152600401602060405180830381865afa1580156115ec573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906116109190612216565b9350600a549250600854915060095490509091929394565b611630611844565b600380546001600160a01b038381166001600160a01b0319831681179093556040519116919082907f604ad002a40ff5c7889d79b0d608bb9a3deca65b1ad03844689a7ef3f63ec4a190610afc906020808252600d908201526c1058d8d95cdcd0dbdb9d1c9bdb609a1b604082015260600190565b6116ad611844565b47806116f25760405162461bcd60e51b81526020600482015260146024820152734e6f2066756e647320746f20776974686472617760601b6044820152606401610771565b600080546040516001600160a01b039091169183156108fc02918491818181858888f1935050505015801561172b573d6000803e3d6000fd5b5050565b611737611844565b6001600160a01b03811661179c5760405162461bcd60e51b815260206004820152602660248201527f4f776e61626c653a206e6577206f776e657220697320746865207a65726f206160448201526564647265737360d01b6064820152608401610771565b61104d816118f0565b60025460ff1615610c285760405162461bcd60e51b815260206004820152601060248201526f14185d5cd8589b194e881c185d5cd95960821b6044820152606401610771565b60026001540361183d5760405162461bcd60e51b815260206004820152601f60248201527f5265656e7472616e637947756172643a207265656e7472616e742063616c6c006044820152606401610771565b6002600155565b6000546001600160a01b03163314610c285760405162461bcd60e51b815260206004820181905260248201527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e65726044820152606401610771565b6118a661197d565b6002805460ff191690557f5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa335b6040516001600160a01b03909116815260200160405180910390a1565b600080546001600160a01b038381166001600160a01b0319831681178455604051919092169283917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e09190a35050565b6119486117a5565b6002805460ff191660011790557f62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a2586118d33390565b60025460ff16610c285760405162461bcd60e51b815260206004820152601460248201527314185d5cd8589b194e881b9bdd081c185d5cd95960621b6044820152606401610771565b634e487b7160e01b600052604160045260246000fd5b604051601f8201601f1916810167ffffffffffffffff81118282101715611a0557611a056119c6565b604052919050565b600082601f830112611a1e57600080fd5b8135602067ffffffffffffffff821115611a3a57611a3a6119c6565b8160051b611a498282016119dc565b9283528481018201928281019087851115611a6357600080fd5b83870192505b84831015611a8257823582529183019190830190611a69565b979650505050505050565b600080600080600060a08688031215611aa557600080fd5b8535945060208601359350604086013567ffffffffffffffff80821115611acb57600080fd5b611ad789838a01611a0d565b94506060880135915080821115611aed57600080fd5b50611afa88828901611a0d565b95989497509295608001359392505050565b600067ffffffffffffffff821115611b2657611b266119c6565b50601f01601f191660200190565b600082601f830112611b4557600080fd5b8135611b58611b5382611b0c565b6119dc565b818152846020838601011115611b6d57600080fd5b816020850160208301376000918101602001919091529392505050565b600080600080600080600060e0888a031215611ba557600080fd5b87359650602088013567ffffffffffffffff80821115611bc457600080fd5b611bd08b838c01611b34565b975060408a0135965060608a0135915080821115611bed57600080fd5b611bf98b838c01611b34565b955060808a0135915080821115611c0f57600080fd5b611c1b8b838c01611a0d565b945060a08a0135915080821115611c3157600080fd5b50611c3e8a828b01611a0d565b92505060c0880135905092959891949750929550565b6001600160a01b038116811461104d57600080fd5b600060208284031215611c7b57600080fd5b8135611c8681611c54565b9392505050565b600060208284031215611c9f57600080fd5b5035919050565b60005b83811015611cc1578181015183820152602001611ca9565b50506000910152565b60008151808452611ce2816020860160208601611ca6565b601f01601f19169290920160200192915050565b8781526001600160a01b038716602082015260e060408201819052600090611d2090830188611cca565b86606084015285608084015282810360a0840152611d3e8186611cca565b91505082151560c083015298975050505050505050565b60008060008060808587031215611d6b57600080fd5b84359350602085013567ffffffffffffffff80821115611d8a57600080fd5b611d9688838901611b34565b9450604087013593506060870135915080821115611db357600080fd5b50611dc087828801611b34565b91505092959194509250565b600060208284031215611dde57600080fd5b813567ffffffffffffffff811115611df557600080fd5b611e0184828501611b34565b949350505050565b60008060408385031215611e1c57600080fd5b823591506020830135611e2e81611c54565b809150509250929050565b60c081526000611e4c60c0830189611cca565b602083019790975250604081019490945260608401929092526080830152151560a090910152919050565b80518015158114611e8757600080fd5b919050565b600060208284031215611e9e57600080fd5b611c8682611e77565b6020808252601f908201527f55736572206e6f742072656769737465726564206f7220696e61637469766500604082015260600190565b600060a082018783526020878185015260a0604085015281875180845260c086019150828901935060005b81811015611f2557845183529383019391830191600101611f09565b50508481036060860152865180825290820192508187019060005b81811015611f5c57825185529383019391830191600101611f40565b505050506080929092019290925295945050505050565b634e487b7160e01b600052601160045260246000fd5b600060018201611f9b57611f9b611f73565b5060010190565b848152608060208201526000611fbb6080830186611cca565b8460408401528281036060840152611a828185611cca565b60008251611fe5818460208701611ca6565b9190910192915050565b6060815260006120026060830186611cca565b602083019490945250901515604090910152919050565b600080600080600060a0868803121561203157600080fd5b855194506020860151935061204860408701611e77565b6060870151608090970151959894975095949392505050565b60008060006060848603121561207657600080fd5b8351925060208401519150604084015190509250925092565b600082601f8301126120a057600080fd5b81516120ae611b5382611b0c565b8181528460208386010111156120c357600080fd5b611e01826020830160208701611ca6565b600080600080600080600060e0888a0312156120ef57600080fd5b87519650602088015161210181611c54565b604089015190965067ffffffffffffffff8082111561211f57600080fd5b61212b8b838c0161208f565b965060608a0151955060808a0151945060a08a015191508082111561214f57600080fd5b5061215c8a828b0161208f565b92505061216b60c08901611e77565b905092959891949750929550565b60008161218857612188611f73565b506000190190565b602081526000611c866020830184611cca565b60008060008060008060c087890312156121bc57600080fd5b865167ffffffffffffffff8111156121d357600080fd5b6121df89828a0161208f565b9650506020870151945060408701519350606087015192506080870151915061220a60a08801611e77565b90509295509295509295565b60006020828403121561222857600080fd5b505191905056fea2646970667358221220ed87f60528634055ef01070fa8b32700022049df6089fdeb1591082d5daa37f264736f6c634300081300336080604052630640000060095566038d7ea4c68000600a55600b805460ff1916600117905534801561003057600080fd5b5061003a33610075565b6001808055336000908152600760209081526040808320805460ff1990811686179091556008909252909120805490911690911790556100c5565b600080546001600160a01b038381166001600160a01b0319831681178455604051919092169283917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e09190a35050565b6120df806100d46000396000f3fe6080604052600436106101cd5760003560e01c8063766e0d33116100f7578063be5b9b6011610095578063dfa7ff3411610064578063dfa7ff34146105cf578063e23527f0146105ef578063f2fde38b14610609578063f9ec53151461062957600080fd5b8063be5b9b6014610515578063cc7ac3b614610535578063cee4dc8c14610565578063d881ae87146105af57600080fd5b80639103edbf116100d15780639103edbf1461049557806398c9adff146104b55780639ad64f93146104d5578063a30e992c146104f557600080fd5b8063766e0d331461044c578063773f0b18146104615780638da5cb5b1461047757600080fd5b8063436bc49c1161016f57806367233ed81161013e57806367233ed8146103e45780636ab799f1146103f7578063715018a614610417578063718f38a61461042c57600080fd5b8063436bc49c14610320578063444d95b014610340578063476343ee1461039c57806365f35371146103b157600080fd5b80631b80bb3a116101ab5780631b80bb3a146102555780631fc379221461028657806320033f0e146102b35780633b8f4908146102f357600080fd5b80631376d017146101d257806315391a58146101fb57806317f4a5211461021d575b600080fd5b3480156101de57600080fd5b506101e860095481565b6040519081526020015b60405180910390f35b34801561020757600080fd5b5061021b610216366004611911565b610649565b005b34801561022957600080fd5b5061023d610238366004611933565b610664565b6040516001600160a01b0390911681526020016101f2565b34801561026157600080fd5b50610275610270366004611955565b61069c565b6040516101f29594939291906119a9565b34801561029257600080fd5b506102a66102a1366004611a25565b6107ca565b6040516101f29190611a40565b3480156102bf57600080fd5b506102e36102ce366004611a25565b60086020526000908152604090205460ff1681565b60405190151581526020016101f2565b3480156102ff57600080fd5b5061031361030e366004611955565b610836565b6040516101f29190611a53565b34801561032c57600080fd5b5061021b61033b366004611aa0565b6108a1565b34801561034c57600080fd5b5061038161035b366004611955565b600460208190526000918252604090912080546003820154919092015460ff9091169083565b604080519384529115156020840152908201526060016101f2565b3480156103a857600080fd5b5061021b6108d4565b3480156103bd57600080fd5b506103d16103cc366004611955565b61095d565b6040516101f29796959493929190611b19565b61021b6103f2366004611c2f565b610b20565b34801561040357600080fd5b5061021b610412366004611955565b610dbf565b34801561042357600080fd5b5061021b610f2e565b34801561043857600080fd5b506101e8610447366004611ca6565b610f42565b34801561045857600080fd5b506101e8610f73565b34801561046d57600080fd5b506101e8600a5481565b34801561048357600080fd5b506000546001600160a01b031661023d565b3480156104a157600080fd5b5061021b6104b0366004611cd0565b610f83565b3480156104c157600080fd5b506103d16104d0366004611955565b61113c565b3480156104e157600080fd5b5061021b6104f0366004611955565b611294565b34801561050157600080fd5b5061021b610510366004611955565b6112a1565b34801561052157600080fd5b5061021b610530366004611cd0565b6114ad565b34801561054157600080fd5b506102e3610550366004611a25565b60076020526000908152604090205460ff1681565b34801561057157600080fd5b506102e3610580366004611cd0565b60008281526003602090815260408083206001600160a01b038516845260070190915290205460ff1692915050565b3480156105bb57600080fd5b5061021b6105ca366004611aa0565b6115a8565b3480156105db57600080fd5b5061021b6105ea366004611955565b6115db565b3480156105fb57600080fd5b50600b546102e39060ff1681565b34801561061557600080fd5b5061021b610624366004611a25565b6115e8565b34801561063557600080fd5b506102e3610644366004611d82565b611661565b610651611799565b600b805460ff1916911515919091179055565b6006602052816000526040600020818154811061068057600080fd5b6000918252602090912001546001600160a01b03169150829050565b60008181526003602052604081206001015460609081908390819086906001600160a01b03166106e75760405162461bcd60e51b81526004016106de90611e44565b60405180910390fd5b6000878152600460208181526040928390208054600382015493820154600183018054875181870281018701909852808852939692959094600288019460ff9092169391869183018282801561075c57602002820191906000526020600020905b815481526020019060010190808311610748575b50505050509350828054806020026020016040519081016040528092919081815260200182805480156107ae57602002820191906000526020600020905b81548152602001906001019080831161079a575b5050505050925096509650965096509650505091939590929450565b6001600160a01b03811660009081526005602090815260409182902080548351818402810184019094528084526060939283018282801561082a57602002820191906000526020600020905b815481526020019060010190808311610816575b50505050509050919050565b60008181526006602090815260409182902080548351818402810184019094528084526060939283018282801561082a57602002820191906000526020600020905b81546001600160a01b031681526001909101906020018083116108785750505050509050919050565b6108a9611799565b6001600160a01b03919091166000908152600860205260409020805460ff1916911515919091179055565b6108dc611799565b47806109205760405162461bcd60e51b81526020600482015260136024820152724e6f206665657320746f20776974686472617760681b60448201526064016106de565b600080546040516001600160a01b039091169183156108fc02918491818181858888f19350505050158015610959573d6000803e3d6000fd5b5050565b6000818152600360205260408120600101548190606090829081908390829088906001600160a01b03166109a35760405162461bcd60e51b81526004016106de90611e44565b6000898152600360208190526040909120805460018201549282015460048301546006840154600285018054959694956001600160a01b039095169490939291600588019160ff9091169085906109f990611e71565b80601f0160208091040260200160405190810160405280929190818152602001828054610a2590611e71565b8015610a725780601f10610a4757610100808354040283529160200191610a72565b820191906000526020600020905b815481529060010190602001808311610a5557829003601f168201915b50505050509450818054610a8590611e71565b80601f0160208091040260200160405190810160405280929190818152602001828054610ab190611e71565b8015610afe5780601f10610ad357610100808354040283529160200191610afe565b820191906000526020600020905b815481529060010190602001808311610ae157829003601f168201915b5050505050915098509850985098509850985098505050919395979092949650565b3360009081526007602052604090205460ff1680610b405750600b5460ff165b610b8c5760405162461bcd60e51b815260206004820152601e60248201527f4e6f7420617574686f72697a656420746f2075706c6f61642066696c6573000060448201526064016106de565b610b946117f3565b6000848152600360205260409020600101546001600160a01b031615610bf25760405162461bcd60e51b815260206004820152601360248201527246696c6520616c72656164792065786973747360681b60448201526064016106de565b600954821115610c4e5760405162461bcd60e51b815260206004820152602160248201527f46696c652073697a652065786365656473206d6178696d756d20616c6c6f77656044820152601960fa1b60648201526084016106de565b600a54341015610ca05760405162461bcd60e51b815260206004820152601860248201527f496e73756666696369656e742073746f7261676520666565000000000000000060448201526064016106de565b610cae600280546001019055565b60008481526003602052604090208481556001810180546001600160a01b0319163317905560028101610ce18582611efa565b506003810183905542600482015560058101610cfd8382611efa565b5060068181018054600160ff1991821681179092553360008181526007860160209081526040808320805490951686179094556005815283822080548087018255908352818320018b90558a82529485528281208054948501815581529390932090910180546001600160a01b031916831790555186907f4fd65ab323e60be3274cd82bbed2685014bf4cc64c0c30e1fc979f683080d54e90610da7908890889042908990611fba565b60405180910390a350610db960018055565b50505050565b60008181526003602052604090206001015481906001600160a01b0316610df85760405162461bcd60e51b81526004016106de90611e44565b600082815260036020526040902060060154829060ff16610e505760405162461bcd60e51b815260206004820152601260248201527146696c65206973206e6f742061637469766560701b60448201526064016106de565b600083815260036020526040902060018101546001600160a01b0316331480610e8357506000546001600160a01b031633145b610ee45760405162461bcd60e51b815260206004820152602c60248201527f4f6e6c792066696c65206f776e6572206f7220636f6e7472616374206f776e6560448201526b722063616e2064656c65746560a01b60648201526084016106de565b60068101805460ff19169055604051428152339085907f44784d2638eb6c2b2ef3dace8a8d89a892a4d4aedf4719f97d1864e303fbda94906020015b60405180910390a350505050565b610f36611799565b610f40600061184c565b565b60056020528160005260406000208181548110610f5e57600080fd5b90600052602060002001600091509150505481565b6000610f7e60025490565b905090565b60008281526003602052604090206001015482906001600160a01b0316610fbc5760405162461bcd60e51b81526004016106de90611e44565b600083815260036020526040902060018101546001600160a01b0316331480610fef57506000546001600160a01b031633145b6110595760405162461bcd60e51b815260206004820152603560248201527f4f6e6c792066696c65206f776e6572206f7220636f6e7472616374206f776e65604482015274722063616e20617574686f72697a6520757365727360581b60648201526084016106de565b6001600160a01b03831660009081526007820160205260408120805460ff19166001179055805b6000868152600660205260409020548110156110f757600086815260066020526040902080546001600160a01b0387169190839081106110c2576110c2611ff6565b6000918252602090912001546001600160a01b0316036110e557600191506110f7565b806110ef8161200c565b915050611080565b50806111355760008581526006602090815260408220805460018101825590835291200180546001600160a01b0319166001600160a01b0386161790555b5050505050565b60036020526000908152604090208054600182015460028301805492936001600160a01b039092169261116e90611e71565b80601f016020809104026020016040519081016040528092919081815260200182805461119a90611e71565b80156111e75780601f106111bc576101008083540402835291602001916111e7565b820191906000526020600020905b8154815290600101906020018083116111ca57829003601f168201915b50505050509080600301549080600401549080600501805461120890611e71565b80601f016020809104026020016040519081016040528092919081815260200182805461123490611e71565b80156112815780601f1061125657610100808354040283529160200191611281565b820191906000526020600020905b81548152906001019060200180831161126457829003601f168201915b5050506006909301549192505060ff1687565b61129c611799565b600955565b60008181526003602052604090206001015481906001600160a01b03166112da5760405162461bcd60e51b81526004016106de90611e44565b600082815260036020526040902060060154829060ff166113325760405162461bcd60e51b815260206004820152601260248201527146696c65206973206e6f742061637469766560701b60448201526064016106de565b3360009081526008602052604090205460ff168061138257506000805260036020527f3617319a054d772f909f7c479a2cebe5066e836a939412e32403c99029b92f00546001600160a01b031615155b6113ce5760405162461bcd60e51b815260206004820181905260248201527f4e6f7420617574686f72697a656420746f20646f776e6c6f61642066696c657360448201526064016106de565b6000838152600360209081526040808320338452600781019092529091205460ff1680611407575060018101546001600160a01b031633145b8061142157503360009081526008602052604090205460ff165b6114795760405162461bcd60e51b8152602060048201526024808201527f4e6f7420617574686f72697a656420746f20646f776e6c6f616420746869732060448201526366696c6560e01b60648201526084016106de565b604051428152339085907f134689260d977c8820d39f35ab2a85b84776f032a8d64b2a65feaaff5490ac0f90602001610f20565b60008281526003602052604090206001015482906001600160a01b03166114e65760405162461bcd60e51b81526004016106de90611e44565b600083815260036020526040902060018101546001600160a01b031633148061151957506000546001600160a01b031633145b6115805760405162461bcd60e51b815260206004820152603260248201527f4f6e6c792066696c65206f776e6572206f7220636f6e7472616374206f776e65604482015271722063616e207265766f6b6520757365727360701b60648201526084016106de565b6001600160a01b03909216600090815260079092016020525060409020805460ff1916905550565b6115b0611799565b6001600160a01b03919091166000908152600760205260409020805460ff1916911515919091179055565b6115e3611799565b600a55565b6115f0611799565b6001600160a01b0381166116555760405162461bcd60e51b815260206004820152602660248201527f4f776e61626c653a206e6577206f776e657220697320746865207a65726f206160448201526564647265737360d01b60648201526084016106de565b61165e8161184c565b50565b60008481526003602052604081206001015485906001600160a01b031661169a5760405162461bcd60e51b81526004016106de90611e44565b600086815260036020908152604080832090519092916116bf91600585019101612033565b60408051808303601f1901815282825280516020918201208184018b9052825180850383018152938301835283519382019390932060008c815260048352929092208a8155895192909314935061171d9160018401918a019061189c565b508551611733906002830190602089019061189c565b5060038101805460ff1916831515908117909155426004830181905560408051928352602083019190915233918b917fd70445ae79bfa82bd8c52e90ca89940dff8279c6c39f91265be2f4ae43e4cae2910160405180910390a350979650505050505050565b6000546001600160a01b03163314610f405760405162461bcd60e51b815260206004820181905260248201527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e657260448201526064016106de565b6002600154036118455760405162461bcd60e51b815260206004820152601f60248201527f5265656e7472616e637947756172643a207265656e7472616e742063616c6c0060448201526064016106de565b6002600155565b600080546001600160a01b038381166001600160a01b0319831681178455604051919092169283917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e09190a35050565b8280548282559060005260206000209081019282156118d7579160200282015b828111156118d75782518255916020019190600101906118bc565b506118e39291506118e7565b5090565b5b808211156118e357600081556001016118e8565b8035801515811461190c57600080fd5b919050565b60006020828403121561192357600080fd5b61192c826118fc565b9392505050565b6000806040838503121561194657600080fd5b50508035926020909101359150565b60006020828403121561196757600080fd5b5035919050565b600081518084526020808501945080840160005b8381101561199e57815187529582019590820190600101611982565b509495945050505050565b8581526000602060a0818401526119c360a084018861196e565b838103604085015286518082528288019183019060005b818110156119f6578351835292840192918401916001016119da565b50509515156060850152505050608001529392505050565b80356001600160a01b038116811461190c57600080fd5b600060208284031215611a3757600080fd5b61192c82611a0e565b60208152600061192c602083018461196e565b6020808252825182820181905260009190848201906040850190845b81811015611a945783516001600160a01b031683529284019291840191600101611a6f565b50909695505050505050565b60008060408385031215611ab357600080fd5b611abc83611a0e565b9150611aca602084016118fc565b90509250929050565b6000815180845260005b81811015611af957602081850181015186830182015201611add565b506000602082860101526020601f19601f83011685010191505092915050565b8781526001600160a01b038716602082015260e060408201819052600090611b4390830188611ad3565b86606084015285608084015282810360a0840152611b618186611ad3565b91505082151560c083015298975050505050505050565b634e487b7160e01b600052604160045260246000fd5b604051601f8201601f1916810167ffffffffffffffff81118282101715611bb757611bb7611b78565b604052919050565b600082601f830112611bd057600080fd5b813567ffffffffffffffff811115611bea57611bea611b78565b611bfd601f8201601f1916602001611b8e565b818152846020838601011115611c1257600080fd5b816020850160208301376000918101602001919091529392505050565b60008060008060808587031215611c4557600080fd5b84359350602085013567ffffffffffffffff80821115611c6457600080fd5b611c7088838901611bbf565b9450604087013593506060870135915080821115611c8d57600080fd5b50611c9a87828801611bbf565b91505092959194509250565b60008060408385031215611cb957600080fd5b611cc283611a0e565b946020939093013593505050565b60008060408385031215611ce357600080fd5b82359150611aca60208401611a0e565b600067ffffffffffffffff821115611d0d57611d0d611b78565b5060051b60200190565b600082601f830112611d2857600080fd5b81356020611d3d611d3883611cf3565b611b8e565b82815260059290921b84018101918181019086841115611d5c57600080fd5b8286015b84811015611d775780358352918301918301611d60565b509695505050505050565b60008060008060808587031215611d9857600080fd5b843593506020808601359350604086013567ffffffffffffffff80821115611dbf57600080fd5b818801915088601f830112611dd357600080fd5b8135611de1611d3882611cf3565b81815260059190911b8301840190848101908b831115611e0057600080fd5b938501935b82851015611e1e57843582529385019390850190611e05565b965050506060880135925080831115611e3657600080fd5b5050611c9a87828801611d17565b602080825260139082015272119a5b1948191bd95cc81b9bdd08195e1a5cdd606a1b604082015260600190565b600181811c90821680611e8557607f821691505b602082108103611ea557634e487b7160e01b600052602260045260246000fd5b50919050565b601f821115611ef557600081815260208120601f850160051c81016020861015611ed25750805b601f850160051c820191505b81811015611ef157828155600101611ede565b5050505b505050565b815167ffffffffffffffff811115611f1457611f14611b78565b611f2881611f228454611e71565b84611eab565b602080601f831160018114611f5d5760008415611f455750858301515b600019600386901b1c1916600185901b178555611ef1565b600085815260208120601f198616915b82811015611f8c57888601518255948401946001909101908401611f6d565b5085821015611faa5787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b608081526000611fcd6080830187611ad3565b8560208401528460408401528281036060840152611feb8185611ad3565b979650505050505050565b634e487b7160e01b600052603260045260246000fd5b60006001820161202c57634e487b7160e01b600052601160045260246000fd5b5060010190565b600080835461204181611e71565b60018281168015612059576001811461206e5761209d565b60ff198416875282151583028701945061209d565b8760005260208060002060005b858110156120945781548a82015290840190820161207b565b50505082870194505b5092969550505050505056fea26469706673582212209a241b11f483ff4ed0919d783b9fa588fddff8c772488686d150bb4554e7f6ea64736f6c63430008130033608060405263400000006006556419000000006007553480156200002257600080fd5b50600180556200003460003362000116565b620000607fa49807205ce4d355092ef5a8a18f56e8913cf4a201fbe287825b095693c217753362000116565b6200008c7f922390b27f65d828a1f7695b2eaae33fd0be87496792afddba1d25446a8fcb913362000116565b620000b87fa3e884b9717ba66c8dd31f7abb98c86660643264f5743d122de83f0cad5c96553362000116565b620000e47f0ce23c3e399818cfee81a7ab0880f714e53d7672b08df0fa62f2843416e1ea093362000116565b620001107f71f3d55856e4058ed06ee057d79ada615f65cdf5f9ee88181b914225088f834f3362000116565b620001b7565b6000828152602081815260408083206001600160a01b038516845290915290205460ff16620001b3576000828152602081815260408083206001600160a01b03851684529091529020805460ff19166001179055620001723390565b6001600160a01b0316816001600160a01b0316837f2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d60405160405180910390a45b5050565b611dd980620001c76000396000f3fe608060405234801561001057600080fd5b506004361061023d5760003560e01c8063704f1b941161013b578063bff1f9e1116100b8578063d547741f1161007c578063d547741f14610565578063e7705db614610578578063e98e44481461059f578063f5593607146104cd578063f825f143146105b257600080fd5b8063bff1f9e114610503578063c60dd0a71461050c578063c8ea8eed1461051f578063cddc32a414610532578063ce43c0321461054557600080fd5b8063987ee156116100ff578063987ee156146104ba5780639be572f6146104cd5780639ee20b15146104d5578063a217fddf146104e8578063b0619e85146104f057600080fd5b8063704f1b941461044f57806375b238fc14610462578063797669c9146104775780637daf37121461049e57806391d14854146104a757600080fd5b80632f2ff15d116101c95780634985e85c1161018d5780634985e85c146103a75780634e9e691a146103d2578063604c101d1461040057806361cc0766146104275780636b515fa71461043c57600080fd5b80632f2ff15d14610340578063332d56d71461035357806336568abe146103785780633b424f091461038b578063478656e51461039e57600080fd5b80631b0e1ffa116102105780631b0e1ffa146102ce5780631e153acb146102d65780631fbeef27146102e9578063248a9ca3146102fc5780632daa7cec1461032d57600080fd5b806301ffc9a714610242578063039d4cae1461026a5780630e50cee51461027f578063163f7522146102a2575b600080fd5b610255610250366004611825565b6105e6565b60405190151581526020015b60405180910390f35b61027d61027836600461186b565b61061d565b005b61025561028d366004611895565b60046020526000908152604090205460ff1681565b6102556102b0366004611895565b6001600160a01b031660009081526004602052604090205460ff1690565b61027d610685565b61027d6102e4366004611895565b6106d5565b61027d6102f73660046118b0565b610803565b61031f61030a3660046118b0565b60009081526020819052604090206001015490565b604051908152602001610261565b61027d61033b36600461186b565b610821565b61027d61034e3660046118c9565b610916565b610366610361366004611895565b610940565b60405161026196959493929190611945565b61027d6103863660046118c9565b610a00565b61027d610399366004611983565b610a7e565b61031f60075481565b6103ba6103b53660046119de565b610b08565b6040516001600160a01b039091168152602001610261565b6103e56103e0366004611895565b610b39565b60408051938452602084019290925290820152606001610261565b61031f7fa3e884b9717ba66c8dd31f7abb98c86660643264f5743d122de83f0cad5c965581565b61031f600080516020611d6483398151915281565b61027d61044a3660046118b0565b610bbd565b61027d61045d3660046119de565b610bdb565b61031f600080516020611d8483398151915281565b61031f7f71f3d55856e4058ed06ee057d79ada615f65cdf5f9ee88181b914225088f834f81565b61031f60065481565b6102556104b53660046118c9565b610e8e565b6103666104c8366004611895565b610eb7565b60055461031f565b6102556104e3366004611895565b610fde565b61031f600081565b6102556104fe36600461186b565b611024565b61031f60055481565b61027d61051a36600461186b565b61108c565b61027d61052d36600461186b565b61116f565b61027d610540366004611895565b611206565b610558610553366004611895565b611320565b6040516102619190611a8f565b61027d6105733660046118c9565b611403565b61031f7f0ce23c3e399818cfee81a7ab0880f714e53d7672b08df0fa62f2843416e1ea0981565b61027d6105ad36600461186b565b611428565b6103ba6105c03660046119de565b80516020818301810180516003825292820191909301209152546001600160a01b031681565b60006001600160e01b03198216637965db0b60e01b148061061757506301ffc9a760e01b6001600160e01b03198316145b92915050565b600080516020611d84833981519152610635816114c8565b61063f82846114d5565b60405182815233906001600160a01b038516907f52baf7d6d37ffa8e8c78a88bd162825565c66d8b8fcb356693cd4704c0d09109906020015b60405180910390a3505050565b3360009081526004602052604090205460ff166106bd5760405162461bcd60e51b81526004016106b490611aa2565b60405180910390fd5b33600090815260026020819052604090912042910155565b7f71f3d55856e4058ed06ee057d79ada615f65cdf5f9ee88181b914225088f834f6106ff816114c8565b6001600160a01b03821660009081526004602052604090205460ff166107375760405162461bcd60e51b81526004016106b490611aa2565b6001600160a01b03821660009081526002602052604090206005015460ff161561079c5760405162461bcd60e51b8152602060048201526016602482015275155cd95c88185b1c9958591e481cdd5cdc195b99195960521b60448201526064016106b4565b6001600160a01b03821660008181526002602052604090819020600501805460ff19166001179055513391907ff7760221cf53f8188ca0c1ddef184060c3cd7d0f8cfa168caa43a1c88f7da26a906107f79042815260200190565b60405180910390a35050565b600080516020611d8483398151915261081b816114c8565b50600655565b600080516020611d84833981519152610839816114c8565b6001600160a01b03831660009081526004602052604090205460ff166108715760405162461bcd60e51b81526004016106b490611aa2565b6007548211156108c35760405162461bcd60e51b815260206004820152601d60248201527f51756f74612065786365656473206d6178696d756d20616c6c6f77656400000060448201526064016106b4565b6001600160a01b03831660008181526002602052604090819020600301849055513391907f2349967681dfd5781e7d2990653e9f851fce73efd2702f52b551b2969a7d8a9b906106789086815260200190565b600082815260208190526040902060010154610931816114c8565b61093b838361153a565b505050565b60026020526000908152604090208054819061095b90611acf565b80601f016020809104026020016040519081016040528092919081815260200182805461098790611acf565b80156109d45780601f106109a9576101008083540402835291602001916109d4565b820191906000526020600020905b8154815290600101906020018083116109b757829003601f168201915b505050600184015460028501546003860154600487015460059097015495969295919450925060ff1686565b6001600160a01b0381163314610a705760405162461bcd60e51b815260206004820152602f60248201527f416363657373436f6e74726f6c3a2063616e206f6e6c792072656e6f756e636560448201526e103937b632b9903337b91039b2b63360891b60648201526084016106b4565b610a7a82826114d5565b5050565b600080516020611d84833981519152610a96816114c8565b6001600160a01b03841660009081526004602052604090205460ff16610ace5760405162461bcd60e51b81526004016106b490611aa2565b506001600160a01b039290921660009081526002602090815260408083209383526006909301905220805460ff1916911515919091179055565b6000600382604051610b1a9190611b09565b908152604051908190036020019020546001600160a01b031692915050565b6001600160a01b0381166000908152600460205260408120548190819060ff16610b755760405162461bcd60e51b81526004016106b490611aa2565b6001600160a01b0384166000908152600260205260409020600381015460048201549094509250828411610baa576000610bb4565b610bb48385611b3b565b93959294505050565b600080516020611d84833981519152610bd5816114c8565b50600755565b80600381511015610c235760405162461bcd60e51b8152602060048201526012602482015271155cd95c9b985b59481d1bdbc81cda1bdc9d60721b60448201526064016106b4565b602081511115610c695760405162461bcd60e51b8152602060048201526011602482015270557365726e616d6520746f6f206c6f6e6760781b60448201526064016106b4565b60006001600160a01b0316600382604051610c849190611b09565b908152604051908190036020019020546001600160a01b031614610ce35760405162461bcd60e51b81526020600482015260166024820152752ab9b2b93730b6b29030b63932b0b23c903a30b5b2b760511b60448201526064016106b4565b610ceb6115be565b3360009081526004602052604090205460ff1615610d4b5760405162461bcd60e51b815260206004820152601760248201527f5573657220616c7265616479207265676973746572656400000000000000000060448201526064016106b4565b33600090815260026020526040902080610d658482611b9c565b50426001820181905560028201556006546003808301919091556000600483015560058201805460ff19169055604051339190610da3908690611b09565b908152604080516020928190038301902080546001600160a01b0319166001600160a01b0394909416939093179092553360009081526004909152908120805460ff191660011790556005805491610dfa83611c5c565b9190505550610e17600080516020611d648339815191523361153a565b610e417fa3e884b9717ba66c8dd31f7abb98c86660643264f5743d122de83f0cad5c96553361153a565b336001600160a01b03167f89105a1c6a3c2fbd471255c66a31ccab604af5697f67d7e2e9a0028c5e4dbd918442604051610e7c929190611c75565b60405180910390a250610a7a60018055565b6000918252602082815260408084206001600160a01b0393909316845291905290205460ff1690565b6001600160a01b03811660009081526004602052604081205460609190819081908190819060ff16610efb5760405162461bcd60e51b81526004016106b490611aa2565b6001600160a01b0387166000908152600260208190526040909120600181015491810154600382015460048301546005840154845494958695909493929160ff16908690610f4890611acf565b80601f0160208091040260200160405190810160405280929190818152602001828054610f7490611acf565b8015610fc15780601f10610f9657610100808354040283529160200191610fc1565b820191906000526020600020905b815481529060010190602001808311610fa457829003601f168201915b505050505095509650965096509650965096505091939550919395565b6001600160a01b03811660009081526004602052604081205460ff1680156106175750506001600160a01b031660009081526002602052604090206005015460ff161590565b6001600160a01b03821660009081526004602052604081205460ff1661105c5760405162461bcd60e51b81526004016106b490611aa2565b506001600160a01b0391909116600090815260026020908152604080832093835260069093019052205460ff1690565b600080516020611d648339815191526110a4816114c8565b6001600160a01b03831660009081526004602052604090205460ff166110dc5760405162461bcd60e51b81526004016106b490611aa2565b6001600160a01b038316600090815260026020526040902060038101546004820154611109908590611c97565b11156111505760405162461bcd60e51b815260206004820152601660248201527514dd1bdc9859d9481c5d5bdd1848195e18d95959195960521b60448201526064016106b4565b828160040160008282546111649190611c97565b909155505050505050565b600080516020611d84833981519152611187816114c8565b6001600160a01b03831660009081526004602052604090205460ff166111bf5760405162461bcd60e51b81526004016106b490611aa2565b6111c9828461153a565b60405182815233906001600160a01b038516907fc872def599bcebf2e8a06f60f9b0bb89c9f470341c992898f1e23b9b372e6ca690602001610678565b7f71f3d55856e4058ed06ee057d79ada615f65cdf5f9ee88181b914225088f834f611230816114c8565b6001600160a01b03821660009081526004602052604090205460ff166112685760405162461bcd60e51b81526004016106b490611aa2565b6001600160a01b03821660009081526002602052604090206005015460ff166112c85760405162461bcd60e51b8152602060048201526012602482015271155cd95c881b9bdd081cdd5cdc195b99195960721b60448201526064016106b4565b6001600160a01b03821660008181526002602052604090819020600501805460ff19169055513391907f5c3cbcec29ca81b0c591e9481e017bc106603d1df4653b35f75165348c80c728906107f79042815260200190565b6001600160a01b03811660009081526004602052604090205460609060ff1661135b5760405162461bcd60e51b81526004016106b490611aa2565b6001600160a01b0382166000908152600260205260409020805461137e90611acf565b80601f01602080910402602001604051908101604052809291908181526020018280546113aa90611acf565b80156113f75780601f106113cc576101008083540402835291602001916113f7565b820191906000526020600020905b8154815290600101906020018083116113da57829003601f168201915b50505050509050919050565b60008281526020819052604090206001015461141e816114c8565b61093b83836114d5565b600080516020611d64833981519152611440816114c8565b6001600160a01b03831660009081526004602052604090205460ff166114785760405162461bcd60e51b81526004016106b490611aa2565b6001600160a01b0383166000908152600260205260409020600481015483116114ba57828160040160008282546114af9190611b3b565b909155506114c29050565b600060048201555b50505050565b6114d28133611617565b50565b6114df8282610e8e565b15610a7a576000828152602081815260408083206001600160a01b0385168085529252808320805460ff1916905551339285917ff6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b9190a45050565b6115448282610e8e565b610a7a576000828152602081815260408083206001600160a01b03851684529091529020805460ff1916600117905561157a3390565b6001600160a01b0316816001600160a01b0316837f2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d60405160405180910390a45050565b6002600154036116105760405162461bcd60e51b815260206004820152601f60248201527f5265656e7472616e637947756172643a207265656e7472616e742063616c6c0060448201526064016106b4565b6002600155565b6116218282610e8e565b610a7a5761162e81611670565b611639836020611682565b60405160200161164a929190611caa565b60408051601f198184030181529082905262461bcd60e51b82526106b491600401611a8f565b60606106176001600160a01b03831660145b60606000611691836002611d1f565b61169c906002611c97565b67ffffffffffffffff8111156116b4576116b46119c8565b6040519080825280601f01601f1916602001820160405280156116de576020820181803683370190505b509050600360fc1b816000815181106116f9576116f9611d36565b60200101906001600160f81b031916908160001a905350600f60fb1b8160018151811061172857611728611d36565b60200101906001600160f81b031916908160001a905350600061174c846002611d1f565b611757906001611c97565b90505b60018111156117cf576f181899199a1a9b1b9c1cb0b131b232b360811b85600f166010811061178b5761178b611d36565b1a60f81b8282815181106117a1576117a1611d36565b60200101906001600160f81b031916908160001a90535060049490941c936117c881611d4c565b905061175a565b50831561181e5760405162461bcd60e51b815260206004820181905260248201527f537472696e67733a20686578206c656e67746820696e73756666696369656e7460448201526064016106b4565b9392505050565b60006020828403121561183757600080fd5b81356001600160e01b03198116811461181e57600080fd5b80356001600160a01b038116811461186657600080fd5b919050565b6000806040838503121561187e57600080fd5b6118878361184f565b946020939093013593505050565b6000602082840312156118a757600080fd5b61181e8261184f565b6000602082840312156118c257600080fd5b5035919050565b600080604083850312156118dc57600080fd5b823591506118ec6020840161184f565b90509250929050565b60005b838110156119105781810151838201526020016118f8565b50506000910152565b600081518084526119318160208601602086016118f5565b601f01601f19169290920160200192915050565b60c08152600061195860c0830189611919565b602083019790975250604081019490945260608401929092526080830152151560a090910152919050565b60008060006060848603121561199857600080fd5b6119a18461184f565b925060208401359150604084013580151581146119bd57600080fd5b809150509250925092565b634e487b7160e01b600052604160045260246000fd5b6000602082840312156119f057600080fd5b813567ffffffffffffffff80821115611a0857600080fd5b818401915084601f830112611a1c57600080fd5b813581811115611a2e57611a2e6119c8565b604051601f8201601f19908116603f01168101908382118183101715611a5657611a566119c8565b81604052828152876020848701011115611a6f57600080fd5b826020860160208301376000928101602001929092525095945050505050565b60208152600061181e6020830184611919565b602080825260139082015272155cd95c881b9bdd081c9959da5cdd195c9959606a1b604082015260600190565b600181811c90821680611ae357607f821691505b602082108103611b0357634e487b7160e01b600052602260045260246000fd5b50919050565b60008251611b1b8184602087016118f5565b9190910192915050565b634e487b7160e01b600052601160045260246000fd5b8181038181111561061757610617611b25565b601f82111561093b57600081815260208120601f850160051c81016020861015611b755750805b601f850160051c820191505b81811015611b9457828155600101611b81565b505050505050565b815167ffffffffffffffff811115611bb657611bb66119c8565b611bca81611bc48454611acf565b84611b4e565b602080601f831160018114611bff5760008415611be75750858301515b600019600386901b1c1916600185901b178555611b94565b600085815260208120601f198616915b82811015611c2e57888601518255948401946001909101908401611c0f565b5085821015611c4c5787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b600060018201611c6e57611c6e611b25565b5060010190565b604081526000611c886040830185611919565b90508260208301529392505050565b8082018082111561061757610617611b25565b7f416363657373436f6e74726f6c3a206163636f756e7420000000000000000000815260008351611ce28160178501602088016118f5565b7001034b99036b4b9b9b4b733903937b6329607d1b6017918401918201528351611d138160288401602088016118f5565b01602801949350505050565b808202811582820484141761061757610617611b25565b634e487b7160e01b600052603260045260246000fd5b600081611d5b57611d5b611b25565b50600019019056fe922390b27f65d828a1f7695b2eaae33fd0be87496792afddba1d25446a8fcb91a49807205ce4d355092ef5a8a18f56e8913cf4a201fbe287825b095693c21775a26469706673582212206b71c629ccacc406f486651c2ea8b772498a9affd054ef3171d0b8332db5c16a64736f6c634300081300336080604052600360065566038d7ea4c680006007556103e860085534801561002657600080fd5b5061003033610039565b60018055610089565b600080546001600160a01b038381166001600160a01b0319831681178455604051919092169283917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e09190a35050565b611711806100986000396000f3fe608060405234801561001057600080fd5b50600436106101425760003560e01c8063738ee5e6116100b85780638da5cb5b1161007c5780638da5cb5b14610387578063a6859ba8146103a2578063c7b8981c146103b5578063c98da654146103bd578063e95c28c3146103c6578063f2fde38b146103d957600080fd5b8063738ee5e6146102b457806377e2a07a146102e7578063782184cf146102fa5780637e5d70a01461031d57806382bab3eb1461035e57600080fd5b806336beb6971161010a57806336beb697146101c457806338cc32c4146102345780636df1409f146102475780636e902e3114610286578063715018a6146102995780637166a9eb146102a157600080fd5b80630666fa0514610147578063082fa3741461015c578063167feb09146101785780631aea9fd1146101815780632e36b050146101a1575b600080fd5b61015a6101553660046111d5565b6103ec565b005b61016560075481565b6040519081526020015b60405180910390f35b61016560065481565b61016561018f3660046111ee565b60046020526000908152604090205481565b6101b46101af3660046111d5565b610458565b60405161016f949392919061121e565b6102056101d23660046111d5565b600090815260026020819052604090912080546001820154928201546003830154600490930154919460ff909116929190565b60405161016f959493929190948552602085019390935290151560408401526060830152608082015260a00190565b61015a6102423660046111d5565b610530565b6102716102553660046111d5565b6003602081905260009182526040909120600281015491015482565b6040805192835260208301919091520161016f565b61015a6102943660046111d5565b6105c3565b61015a6105d0565b61015a6102af366004611479565b6105e4565b6102d76102c23660046111d5565b60009081526005602052604090205460ff1690565b604051901515815260200161016f565b61015a6102f536600461154b565b610704565b6102d76103083660046111d5565b60056020526000908152604090205460ff1681565b61020561032b3660046111d5565b60026020819052600091825260409091208054600182015492820154600383015460049093015491939260ff9091169185565b61016561036c3660046111ee565b6001600160a01b031660009081526004602052604090205490565b6000546040516001600160a01b03909116815260200161016f565b6102d76103b0366004611580565b610752565b61015a610a60565b61016560085481565b61015a6103d4366004611580565b610aec565b61015a6103e73660046111ee565b610cda565b6103f4610d53565b600081116104535760405162461bcd60e51b815260206004820152602160248201527f4d6178206c656e677468206d7573742062652067726561746572207468616e206044820152600360fc1b60648201526084015b60405180910390fd5b600855565b600081815260036020818152604080842060028101549381015481548351818602810186019094528084526060968796909586958594600186019490928691908301828280156104c757602002820191906000526020600020905b8154815260200190600101908083116104b3575b505050505093508280548060200260200160405190810160405280929190818152602001828054801561051957602002820191906000526020600020905b815481526020019060010190808311610505575b505050505092509450945094509450509193509193565b610538610d53565b600081116105885760405162461bcd60e51b815260206004820181905260248201527f5468726573686f6c64206d7573742062652067726561746572207468616e2030604482015260640161044a565b60068190556040518181527f92f96a03bef2d117f6cb166c330c75b2d08091142a78ab5d19e3bff63fa6c5b59060200160405180910390a150565b6105cb610d53565b600755565b6105d8610d53565b6105e26000610dad565b565b835185511480156105f6575082518551145b8015610603575081518551145b8015610610575080518551145b6106545760405162461bcd60e51b8152602060048201526015602482015274082e4e4c2f240d8cadccee8d040dad2e6dac2e8c6d605b1b604482015260640161044a565b60005b85518110156106fc576106ea868281518110610675576106756115ff565b602002602001015186838151811061068f5761068f6115ff565b60200260200101518684815181106106a9576106a96115ff565b60200260200101518685815181106106c3576106c36115ff565b60200260200101518686815181106106dd576106dd6115ff565b6020026020010151610dfd565b806106f48161162b565b915050610657565b505050505050565b61070c610d53565b6000828152600560209081526040808320805494151560ff1995861681179091556002928390529220938455830180549092161790554260048201556001600390910155565b60008383600082511161079f5760405162461bcd60e51b815260206004820152601560248201527450726f6f662063616e6e6f7420626520656d70747960581b604482015260640161044a565b60008151116107ea5760405162461bcd60e51b8152602060048201526017602482015276496e64696365732063616e6e6f7420626520656d70747960481b604482015260640161044a565b805182511461080b5760405162461bcd60e51b815260040161044a90611644565b6008548251111561084f5760405162461bcd60e51b815260206004820152600e60248201526d50726f6f6620746f6f206c6f6e6760901b604482015260640161044a565b60008881526005602052604090205460ff16156108a65760405162461bcd60e51b8152602060048201526015602482015274119a5b1948185b1c9958591e481d995c9a599a5959605a1b604482015260640161044a565b60006108b488888888610fb3565b60008a81526002602052604090208054919250906108dd57898155600181018990554260048201555b33600090815260058201602052604090205460ff161561093f5760405162461bcd60e51b815260206004820181905260248201527f416c726561647920766572696669656420627920746869732061646472657373604482015260640161044a565b3360009081526005820160205260408120805460ff191660011790556003820180549161096b8361162b565b9190505550811561099b573360009081526004602052604081208054916109918361162b565b91905055506109d1565b33600090815260046020526040902054156109d1573360009081526004602052604081208054916109cb83611685565b91905055505b600654816003015410610a135760028101805483151560ff19918216811790925560008c815260056020526040902080549091169091179055610a138a6110a0565b60408051831515815242602082015233918b918d917f8a0ad150496891d984a0aabb6db8e35c32bfbd4a47acd2e8e4c44a5a6fad5481910160405180910390a45098975050505050505050565b610a68610d53565b4780610aaf5760405162461bcd60e51b81526020600482015260166024820152754e6f207265776172647320746f20776974686472617760501b604482015260640161044a565b600080546040516001600160a01b039091169183156108fc02918491818181858888f19350505050158015610ae8573d6000803e3d6000fd5b5050565b82826000825111610b375760405162461bcd60e51b815260206004820152601560248201527450726f6f662063616e6e6f7420626520656d70747960581b604482015260640161044a565b6000815111610b825760405162461bcd60e51b8152602060048201526017602482015276496e64696365732063616e6e6f7420626520656d70747960481b604482015260640161044a565b8051825114610ba35760405162461bcd60e51b815260040161044a90611644565b60085482511115610be75760405162461bcd60e51b815260206004820152600e60248201526d50726f6f6620746f6f206c6f6e6760901b604482015260640161044a565b610bef61111c565b60008781526005602052604090205460ff1615610c465760405162461bcd60e51b8152602060048201526015602482015274119a5b1948185b1c9958591e481d995c9a599a5959605a1b604482015260640161044a565b600087815260036020908152604090912086519091610c69918391890190611175565b508451610c7f9060018301906020880190611175565b506002810184905560038101879055604051428152339088908a907fbf6d5a75fbb0f09b654f2e10afef706c173808adbf06a5f41ac03ac15bfa62529060200160405180910390a450610cd160018055565b50505050505050565b610ce2610d53565b6001600160a01b038116610d475760405162461bcd60e51b815260206004820152602660248201527f4f776e61626c653a206e6577206f776e657220697320746865207a65726f206160448201526564647265737360d01b606482015260840161044a565b610d5081610dad565b50565b6000546001600160a01b031633146105e25760405162461bcd60e51b815260206004820181905260248201527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e6572604482015260640161044a565b600080546001600160a01b038381166001600160a01b0319831681178455604051919092169283917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e09190a35050565b6000610e0b85858585610fb3565b6000878152600260205260409020805491925090610e3457868155600181018690554260048201555b33600090815260058201602052604090205460ff1615610e965760405162461bcd60e51b815260206004820181905260248201527f416c726561647920766572696669656420627920746869732061646472657373604482015260640161044a565b3360009081526005820160205260408120805460ff1916600117905560038201805491610ec28361162b565b91905055508115610ef257336000908152600460205260408120805491610ee88361162b565b9190505550610f28565b3360009081526004602052604090205415610f2857336000908152600460205260408120805491610f2283611685565b91905055505b600654816003015410610f6a5760028101805483151560ff199182168117909255600089815260056020526040902080549091169091179055610f6a876110a0565b604080518315158152426020820152339188918a917f8a0ad150496891d984a0aabb6db8e35c32bfbd4a47acd2e8e4c44a5a6fad5481910160405180910390a450505050505050565b600081815b855181101561109457848181518110610fd357610fd36115ff565b602002602001015160000361103457858181518110610ff457610ff46115ff565b602002602001015182604051602001611017929190918252602082015260400190565b604051602081830303815290604052805190602001209150611082565b81868281518110611047576110476115ff565b6020026020010151604051602001611069929190918252602082015260400190565b6040516020818303038152906040528051906020012091505b8061108c8161162b565b915050610fb8565b50909414949350505050565b600081815260026020526040812060038101546007549192916110c3919061169c565b905060008260030154826110d791906116b9565b90507f92f96a03bef2d117f6cb166c330c75b2d08091142a78ab5d19e3bff63fa6c5b5836003015460405161110e91815260200190565b60405180910390a150505050565b60026001540361116e5760405162461bcd60e51b815260206004820152601f60248201527f5265656e7472616e637947756172643a207265656e7472616e742063616c6c00604482015260640161044a565b6002600155565b8280548282559060005260206000209081019282156111b0579160200282015b828111156111b0578251825591602001919060010190611195565b506111bc9291506111c0565b5090565b5b808211156111bc57600081556001016111c1565b6000602082840312156111e757600080fd5b5035919050565b60006020828403121561120057600080fd5b81356001600160a01b038116811461121757600080fd5b9392505050565b6080808252855190820181905260009060209060a0840190828901845b828110156112575781518452928401929084019060010161123b565b5050508381038285015286518082528783019183019060005b8181101561128c57835183529284019291840191600101611270565b505060408501969096525050506060015292915050565b634e487b7160e01b600052604160045260246000fd5b604051601f8201601f1916810167ffffffffffffffff811182821017156112e2576112e26112a3565b604052919050565b600067ffffffffffffffff821115611304576113046112a3565b5060051b60200190565b600082601f83011261131f57600080fd5b8135602061133461132f836112ea565b6112b9565b82815260059290921b8401810191818101908684111561135357600080fd5b8286015b8481101561136e5780358352918301918301611357565b509695505050505050565b600082601f83011261138a57600080fd5b8135602061139a61132f836112ea565b82815260059290921b840181019181810190868411156113b957600080fd5b8286015b8481101561136e57803567ffffffffffffffff8111156113dd5760008081fd5b6113eb8986838b010161130e565b8452509183019183016113bd565b600082601f83011261140a57600080fd5b8135602061141a61132f836112ea565b82815260059290921b8401810191818101908684111561143957600080fd5b8286015b8481101561136e57803567ffffffffffffffff81111561145d5760008081fd5b61146b8986838b010161130e565b84525091830191830161143d565b600080600080600060a0868803121561149157600080fd5b853567ffffffffffffffff808211156114a957600080fd5b6114b589838a0161130e565b965060208801359150808211156114cb57600080fd5b6114d789838a0161130e565b955060408801359150808211156114ed57600080fd5b6114f989838a01611379565b9450606088013591508082111561150f57600080fd5b61151b89838a016113f9565b9350608088013591508082111561153157600080fd5b5061153e8882890161130e565b9150509295509295909350565b6000806040838503121561155e57600080fd5b823591506020830135801515811461157557600080fd5b809150509250929050565b600080600080600060a0868803121561159857600080fd5b8535945060208601359350604086013567ffffffffffffffff808211156115be57600080fd5b6115ca89838a0161130e565b945060608801359150808211156115e057600080fd5b506115ed8882890161130e565b95989497509295608001359392505050565b634e487b7160e01b600052603260045260246000fd5b634e487b7160e01b600052601160045260246000fd5b60006001820161163d5761163d611615565b5060010190565b60208082526021908201527f50726f6f6620616e6420696e6469636573206c656e677468206d69736d6174636040820152600d60fb1b606082015260800190565b60008161169457611694611615565b506000190190565b80820281158282048414176116b3576116b3611615565b92915050565b6000826116d657634e487b7160e01b600052601260045260246000fd5b50049056fea2646970667358221220b6698805337b0c977c22e28056c9a09edeee26006bb1569a8bf6aeb397f6125a64736f6c63430008130033",
+}
+
+// NebulaVaultContractABI is the input ABI used to generate the binding from.
+// Deprecated: Use NebulaVaultContractMetaData.ABI instead.
+var NebulaVaultContractABI = NebulaVaultContractMetaData.ABI
+
+// NebulaVaultContractBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use NebulaVaultContractMetaData.Bin instead.
+var NebulaVaultContractBin = NebulaVaultContractMetaData.Bin
+
+// DeployNebulaVaultContract deploys a new Ethereum contract, binding an instance of NebulaVaultContract to it.
+func DeployNebulaVaultContract(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *NebulaVaultContract, error) {
+	parsed, err := NebulaVaultContractMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(NebulaVaultContractBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &NebulaVaultContract{NebulaVaultContractCaller: NebulaVaultContractCaller{contract: contract}, NebulaVaultContractTransactor: NebulaVaultContractTransactor{contract: contract}, NebulaVaultContractFilterer: NebulaVaultContractFilterer{contract: contract}}, nil
+}
+
+// NebulaVaultContract is an auto generated Go binding around an Ethereum contract.
+type NebulaVaultContract struct {
+	NebulaVaultContractCaller     // Read-only binding to the contract
+	NebulaVaultContractTransactor // Write-only binding to the contract
+	NebulaVaultContractFilterer   // Log filterer for contract events
+}
+
+// NebulaVaultContractCaller is an auto generated read-only Go binding around an Ethereum contract.
+type NebulaVaultContractCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// NebulaVaultContractTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type NebulaVaultContractTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// NebulaVaultContractFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type NebulaVaultContractFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// NebulaVaultContractSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type NebulaVaultContractSession struct {
+	Contract     *NebulaVaultContract // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts        // Call options to use throughout this session
+	TransactOpts bind.TransactOpts    // Transaction auth options to use throughout this session
+}
+
+// NebulaVaultContractCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type NebulaVaultContractCallerSession struct {
+	Contract *NebulaVaultContractCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts              // Call options to use throughout this session
+}
+
+// NebulaVaultContractTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type NebulaVaultContractTransactorSession struct {
+	Contract     *NebulaVaultContractTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts              // Transaction auth options to use throughout this session
+}
+
+// NebulaVaultContractRaw is an auto generated low-level Go binding around an Ethereum contract.
+type NebulaVaultContractRaw struct {
+	Contract *NebulaVaultContract // Generic contract binding to access the raw methods on
+}
+
+// NebulaVaultContractCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type NebulaVaultContractCallerRaw struct {
+	Contract *NebulaVaultContractCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// NebulaVaultContractTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type NebulaVaultContractTransactorRaw struct {
+	Contract *NebulaVaultContractTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewNebulaVaultContract creates a new instance of NebulaVaultContract, bound to a specific deployed contract.
+func NewNebulaVaultContract(address common.Address, backend bind.ContractBackend) (*NebulaVaultContract, error) {
+	contract, err := bindNebulaVaultContract(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &NebulaVaultContract{NebulaVaultContractCaller: NebulaVaultContractCaller{contract: contract}, NebulaVaultContractTransactor: NebulaVaultContractTransactor{contract: contract}, NebulaVaultContractFilterer: NebulaVaultContractFilterer{contract: contract}}, nil
+}
+
+// NewNebulaVaultContractCaller creates a new read-only instance of NebulaVaultContract, bound to a specific deployed contract.
+func NewNebulaVaultContractCaller(address common.Address, caller bind.ContractCaller) (*NebulaVaultContractCaller, error) {
+	contract, err := bindNebulaVaultContract(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &NebulaVaultContractCaller{contract: contract}, nil
+}
+
+// NewNebulaVaultContractTransactor creates a new write-only instance of NebulaVaultContract, bound to a specific deployed contract.
+func NewNebulaVaultContractTransactor(address common.Address, transactor bind.ContractTransactor) (*NebulaVaultContractTransactor, error) {
+	contract, err := bindNebulaVaultContract(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &NebulaVaultContractTransactor{contract: contract}, nil
+}
+
+// NewNebulaVaultContractFilterer creates a new log filterer instance of NebulaVaultContract, bound to a specific deployed contract.
+func NewNebulaVaultContractFilterer(address common.Address, filterer bind.ContractFilterer) (*NebulaVaultContractFilterer, error) {
+	contract, err := bindNebulaVaultContract(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &NebulaVaultContractFilterer{contract: contract}, nil
+}
+
+// bindNebulaVaultContract binds a generic wrapper to an already deployed contract.
+func bindNebulaVaultContract(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := NebulaVaultContractMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_NebulaVaultContract *NebulaVaultContractRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _NebulaVaultContract.Contract.NebulaVaultContractCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_NebulaVaultContract *NebulaVaultContractRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _NebulaVaultContract.Contract.NebulaVaultContractTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_NebulaVaultContract *NebulaVaultContractRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _NebulaVaultContract.Contract.NebulaVaultContractTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_NebulaVaultContract *NebulaVaultContractCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _NebulaVaultContract.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_NebulaVaultContract *NebulaVaultContractTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _NebulaVaultContract.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_NebulaVaultContract *NebulaVaultContractTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _NebulaVaultContract.Contract.contract.Transact(opts, method, params...)
+}
+
+// AccessControl is a free data retrieval call binding the contract method 0x13007d55.
+//
+// Solidity: function accessControl() view returns(address)
+func (_NebulaVaultContract *NebulaVaultContractCaller) AccessControl(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _NebulaVaultContract.contract.Call(opts, &out, "accessControl")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// AccessControl is a free data retrieval call binding the contract method 0x13007d55.
+//
+// Solidity: function accessControl() view returns(address)
+func (_NebulaVaultContract *NebulaVaultContractSession) AccessControl() (common.Address, error) {
+	return _NebulaVaultContract.Contract.AccessControl(&_NebulaVaultContract.CallOpts)
+}
+
+// AccessControl is a free data retrieval call binding the contract method 0x13007d55.
+//
+// Solidity: function accessControl() view returns(address)
+func (_NebulaVaultContract *NebulaVaultContractCallerSession) AccessControl() (common.Address, error) {
+	return _NebulaVaultContract.Contract.AccessControl(&_NebulaVaultContract.CallOpts)
+}
+
+// FileStorage is a free data retrieval call binding the contract method 0xd06a7fa1.
+//
+// Solidity: function fileStorage() view returns(address)
+func (_NebulaVaultContract *NebulaVaultContractCaller) FileStorage(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _NebulaVaultContract.contract.Call(opts, &out, "fileStorage")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// FileStorage is a free data retrieval call binding the contract method 0xd06a7fa1.
+//
+// Solidity: function fileStorage() view returns(address)
+func (_NebulaVaultContract *NebulaVaultContractSession) FileStorage() (common.Address, error) {
+	return _NebulaVaultContract.Contract.FileStorage(&_NebulaVaultContract.CallOpts)
+}
+
+// FileStorage is a free data retrieval call binding the contract method 0xd06a7fa1.
+//
+// Solidity: function fileStorage() view returns(address)
+func (_NebulaVaultContract *NebulaVaultContractCallerSession) FileStorage() (common.Address, error) {
+	return _NebulaVaultContract.Contract.FileStorage(&_NebulaVaultContract.CallOpts)
+}
+
+// GetFileMetadata is a free data retrieval call binding the contract method 0x65f35371.
+//
+// Solidity: function getFileMetadata(bytes32 fileHash) view returns(bytes32, address, string, uint256, uint256, string, bool)
+func (_NebulaVaultContract *NebulaVaultContractCaller) GetFileMetadata(opts *bind.CallOpts, fileHash [32]byte) ([32]byte, common.Address, string, *big.Int, *big.Int, string, bool, error) {
+	var out []interface{}
+	err := _NebulaVaultContract.contract.Call(opts, &out, "getFileMetadata", fileHash)
+
+	if err != nil {
+		return *new([32]byte), *new(common.Address), *new(string), *new(*big.Int), *new(*big.Int), *new(string), *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+	out1 := *abi.ConvertType(out[1], new(common.Address)).(*common.Address)
+	out2 := *abi.ConvertType(out[2], new(string)).(*string)
+	out3 := *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+	out4 := *abi.ConvertType(out[4], new(*big.Int)).(**big.Int)
+	out5 := *abi.ConvertType(out[5], new(string)).(*string)
+	out6 := *abi.ConvertType(out[6], new(bool)).(*bool)
+
+	return out0, out1, out2, out3, out4, out5, out6, err
+
+}
+
+// GetFileMetadata is a free data retrieval call binding the contract method 0x65f35371.
+//
+// Solidity: function getFileMetadata(bytes32 fileHash) view returns(bytes32, address, string, uint256, uint256, string, bool)
+func (_NebulaVaultContract *NebulaVaultContractSession) GetFileMetadata(fileHash [32]byte) ([32]byte, common.Address, string, *big.Int, *big.Int, string, bool, error) {
+	return _NebulaVaultContract.Contract.GetFileMetadata(&_NebulaVaultContract.CallOpts, fileHash)
+}
+
+// GetFileMetadata is a free data retrieval call binding the contract method 0x65f35371.
+//
+// Solidity: function getFileMetadata(bytes32 fileHash) view returns(bytes32, address, string, uint256, uint256, string, bool)
+func (_NebulaVaultContract *NebulaVaultContractCallerSession) GetFileMetadata(fileHash [32]byte) ([32]byte, common.Address, string, *big.Int, *big.Int, string, bool, error) {
+	return _NebulaVaultContract.Contract.GetFileMetadata(&_NebulaVaultContract.CallOpts, fileHash)
+}
+
+// GetSystemStats is a free data retrieval call binding the contract method 0xd1ce59a7.
+//
+// Solidity: function getSystemStats() view returns(uint256 totalUsers, uint256 totalFiles, uint256 totalVerified, uint256 totalUploadsCount, uint256 totalDownloadsCount)
+func (_NebulaVaultContract *NebulaVaultContractCaller) GetSystemStats(opts *bind.CallOpts) (struct {
+	TotalUsers          *big.Int
+	TotalFiles          *big.Int
+	TotalVerified       *big.Int
+	TotalUploadsCount   *big.Int
+	TotalDownloadsCount *big.Int
+}, error) {
+	var out []interface{}
+	err := _NebulaVaultContract.contract.Call(opts, &out, "getSystemStats")
+
+	outstruct := new(struct {
+		TotalUsers          *big.Int
+		TotalFiles          *big.Int
+		TotalVerified       *big.Int
+		TotalUploadsCount   *big.Int
+		TotalDownloadsCount *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.TotalUsers = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.TotalFiles = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.TotalVerified = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	outstruct.TotalUploadsCount = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+	outstruct.TotalDownloadsCount = *abi.ConvertType(out[4], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// GetSystemStats is a free data retrieval call binding the contract method 0xd1ce59a7.
+//
+// Solidity: function getSystemStats() view returns(uint256 totalUsers, uint256 totalFiles, uint256 totalVerified, uint256 totalUploadsCount, uint256 totalDownloadsCount)
+func (_NebulaVaultContract *NebulaVaultContractSession) GetSystemStats() (struct {
+	TotalUsers          *big.Int
+	TotalFiles          *big.Int
+	TotalVerified       *big.Int
+	TotalUploadsCount   *big.Int
+	TotalDownloadsCount *big.Int
+}, error) {
+	return _NebulaVaultContract.Contract.GetSystemStats(&_NebulaVaultContract.CallOpts)
+}
+
+// GetSystemStats is a free data retrieval call binding the contract method 0xd1ce59a7.
+//
+// Solidity: function getSystemStats() view returns(uint256 totalUsers, uint256 totalFiles, uint256 totalVerified, uint256 totalUploadsCount, uint256 totalDownloadsCount)
+func (_NebulaVaultContract *NebulaVaultContractCallerSession) GetSystemStats() (struct {
+	TotalUsers          *big.Int
+	TotalFiles          *big.Int
+	TotalVerified       *big.Int
+	TotalUploadsCount   *big.Int
+	TotalDownloadsCount *big.Int
+}, error) {
+	return _NebulaVaultContract.Contract.GetSystemStats(&_NebulaVaultContract.CallOpts)
+}
+
+// GetUserProfile is a free data retrieval call binding the contract method 0x987ee156.
+//
+// Solidity: function getUserProfile(address user) view returns(string, uint256, uint256, uint256, uint256, bool)
+func (_NebulaVaultContract *NebulaVaultContractCaller) GetUserProfile(opts *bind.CallOpts, user common.Address) (string, *big.Int, *big.Int, *big.Int, *big.Int, bool, error) {
+	var out []interface{}
+	err := _NebulaVaultContract.contract.Call(opts, &out, "getUserProfile", user)
+
+	if err != nil {
+		return *new(string), *new(*big.Int), *new(*big.Int), *new(*big.Int), *new(*big.Int), *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+	out1 := *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	out2 := *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	out3 := *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+	out4 := *abi.ConvertType(out[4], new(*big.Int)).(**big.Int)
+	out5 := *abi.ConvertType(out[5], new(bool)).(*bool)
+
+	return out0, out1, out2, out3, out4, out5, err
+
+}
+
+// GetUserProfile is a free data retrieval call binding the contract method 0x987ee156.
+//
+// Solidity: function getUserProfile(address user) view returns(string, uint256, uint256, uint256, uint256, bool)
+func (_NebulaVaultContract *NebulaVaultContractSession) GetUserProfile(user common.Address) (string, *big.Int, *big.Int, *big.Int, *big.Int, bool, error) {
+	return _NebulaVaultContract.Contract.GetUserProfile(&_NebulaVaultContract.CallOpts, user)
+}
+
+// GetUserProfile is a free data retrieval call binding the contract method 0x987ee156.
+//
+// Solidity: function getUserProfile(address user) view returns(string, uint256, uint256, uint256, uint256, bool)
+func (_NebulaVaultContract *NebulaVaultContractCallerSession) GetUserProfile(user common.Address) (string, *big.Int, *big.Int, *big.Int, *big.Int, bool, error) {
+	return _NebulaVaultContract.Contract.GetUserProfile(&_NebulaVaultContract.CallOpts, user)
+}
+
+// GetUserStats is a free data retrieval call binding the contract method 0x4e43603a.
+//
+// Solidity: function getUserStats(address user) view returns(uint256 uploads, uint256 downloads, uint256 storageUsed, uint256 storageQuota)
+func (_NebulaVaultContract *NebulaVaultContractCaller) GetUserStats(opts *bind.CallOpts, user common.Address) (struct {
+	Uploads      *big.Int
+	Downloads    *big.Int
+	StorageUsed  *big.Int
+	StorageQuota *big.Int
+}, error) {
+	var out []interface{}
+	err := _NebulaVaultContract.contract.Call(opts, &out, "getUserStats", user)
+
+	outstruct := new(struct {
+		Uploads      *big.Int
+		Downloads    *big.Int
+		StorageUsed  *big.Int
+		StorageQuota *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Uploads = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.Downloads = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.StorageUsed = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	outstruct.StorageQuota = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// GetUserStats is a free data retrieval call binding the contract method 0x4e43603a.
+//
+// Solidity: function getUserStats(address user) view returns(uint256 uploads, uint256 downloads, uint256 storageUsed, uint256 storageQuota)
+func (_NebulaVaultContract *NebulaVaultContractSession) GetUserStats(user common.Address) (struct {
+	Uploads      *big.Int
+	Downloads    *big.Int
+	StorageUsed  *big.Int
+	StorageQuota *big.Int
+}, error) {
+	return _NebulaVaultContract.Contract.GetUserStats(&_NebulaVaultContract.CallOpts, user)
+}
+
+// GetUserStats is a free data retrieval call binding the contract method 0x4e43603a.
+//
+// Solidity: function getUserStats(address user) view returns(uint256 uploads, uint256 downloads, uint256 storageUsed, uint256 storageQuota)
+func (_NebulaVaultContract *NebulaVaultContractCallerSession) GetUserStats(user common.Address) (struct {
+	Uploads      *big.Int
+	Downloads    *big.Int
+	StorageUsed  *big.Int
+	StorageQuota *big.Int
+}, error) {
+	return _NebulaVaultContract.Contract.GetUserStats(&_NebulaVaultContract.CallOpts, user)
+}
+
+// GetVerificationResult is a free data retrieval call binding the contract method 0x36beb697.
+//
+// Solidity: function getVerificationResult(bytes32 fileHash) view returns(bytes32, bytes32, bool, uint256, uint256)
+func (_NebulaVaultContract *NebulaVaultContractCaller) GetVerificationResult(opts *bind.CallOpts, fileHash [32]byte) ([32]byte, [32]byte, bool, *big.Int, *big.Int, error) {
+	var out []interface{}
+	err := _NebulaVaultContract.contract.Call(opts, &out, "getVerificationResult", fileHash)
+
+	if err != nil {
+		return *new([32]byte), *new([32]byte), *new(bool), *new(*big.Int), *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+	out1 := *abi.ConvertType(out[1], new([32]byte)).(*[32]byte)
+	out2 := *abi.ConvertType(out[2], new(bool)).(*bool)
+	out3 := *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+	out4 := *abi.ConvertType(out[4], new(*big.Int)).(**big.Int)
+
+	return out0, out1, out2, out3, out4, err
+
+}
+
+// GetVerificationResult is a free data retrieval call binding the contract method 0x36beb697.
+//
+// Solidity: function getVerificationResult(bytes32 fileHash) view returns(bytes32, bytes32, bool, uint256, uint256)
+func (_NebulaVaultContract *NebulaVaultContractSession) GetVerificationResult(fileHash [32]byte) ([32]byte, [32]byte, bool, *big.Int, *big.Int, error) {
+	return _NebulaVaultContract.Contract.GetVerificationResult(&_NebulaVaultContract.CallOpts, fileHash)
+}
+
+// GetVerificationResult is a free data retrieval call binding the contract method 0x36beb697.
+//
+// Solidity: function getVerificationResult(bytes32 fileHash) view returns(bytes32, bytes32, bool, uint256, uint256)
+func (_NebulaVaultContract *NebulaVaultContractCallerSession) GetVerificationResult(fileHash [32]byte) ([32]byte, [32]byte, bool, *big.Int, *big.Int, error) {
+	return _NebulaVaultContract.Contract.GetVerificationResult(&_NebulaVaultContract.CallOpts, fileHash)
+}
+
+// IsFileVerified is a free data retrieval call binding the contract method 0x738ee5e6.
+//
+// Solidity: function isFileVerified(bytes32 fileHash) view returns(bool)
+func (_NebulaVaultContract *NebulaVaultContractCaller) IsFileVerified(opts *bind.CallOpts, fileHash [32]byte) (bool, error) {
+	var out []interface{}
+	err := _NebulaVaultContract.contract.Call(opts, &out, "isFileVerified", fileHash)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsFileVerified is a free data retrieval call binding the contract method 0x738ee5e6.
+//
+// Solidity: function isFileVerified(bytes32 fileHash) view returns(bool)
+func (_NebulaVaultContract *NebulaVaultContractSession) IsFileVerified(fileHash [32]byte) (bool, error) {
+	return _NebulaVaultContract.Contract.IsFileVerified(&_NebulaVaultContract.CallOpts, fileHash)
+}
+
+// IsFileVerified is a free data retrieval call binding the contract method 0x738ee5e6.
+//
+// Solidity: function isFileVerified(bytes32 fileHash) view returns(bool)
+func (_NebulaVaultContract *NebulaVaultContractCallerSession) IsFileVerified(fileHash [32]byte) (bool, error) {
+	return _NebulaVaultContract.Contract.IsFileVerified(&_NebulaVaultContract.CallOpts, fileHash)
+}
+
+// IsUserActive is a free data retrieval call binding the contract method 0x9ee20b15.
+//
+// Solidity: function isUserActive(address user) view returns(bool)
+func (_NebulaVaultContract *NebulaVaultContractCaller) IsUserActive(opts *bind.CallOpts, user common.Address) (bool, error) {
+	var out []interface{}
+	err := _NebulaVaultContract.contract.Call(opts, &out, "isUserActive", user)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsUserActive is a free data retrieval call binding the contract method 0x9ee20b15.
+//
+// Solidity: function isUserActive(address user) view returns(bool)
+func (_NebulaVaultContract *NebulaVaultContractSession) IsUserActive(user common.Address) (bool, error) {
+	return _NebulaVaultContract.Contract.IsUserActive(&_NebulaVaultContract.CallOpts, user)
+}
+
+// IsUserActive is a free data retrieval call binding the contract method 0x9ee20b15.
+//
+// Solidity: function isUserActive(address user) view returns(bool)
+func (_NebulaVaultContract *NebulaVaultContractCallerSession) IsUserActive(user common.Address) (bool, error) {
+	return _NebulaVaultContract.Contract.IsUserActive(&_NebulaVaultContract.CallOpts, user)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_NebulaVaultContract *NebulaVaultContractCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _NebulaVaultContract.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_NebulaVaultContract *NebulaVaultContractSession) Owner() (common.Address, error) {
+	return _NebulaVaultContract.Contract.Owner(&_NebulaVaultContract.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_NebulaVaultContract *NebulaVaultContractCallerSession) Owner() (common.Address, error) {
+	return _NebulaVaultContract.Contract.Owner(&_NebulaVaultContract.CallOpts)
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_NebulaVaultContract *NebulaVaultContractCaller) Paused(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _NebulaVaultContract.contract.Call(opts, &out, "paused")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_NebulaVaultContract *NebulaVaultContractSession) Paused() (bool, error) {
+	return _NebulaVaultContract.Contract.Paused(&_NebulaVaultContract.CallOpts)
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_NebulaVaultContract *NebulaVaultContractCallerSession) Paused() (bool, error) {
+	return _NebulaVaultContract.Contract.Paused(&_NebulaVaultContract.CallOpts)
+}
+
+// ProofVerification is a free data retrieval call binding the contract method 0x32911c21.
+//
+// Solidity: function proofVerification() view returns(address)
+func (_NebulaVaultContract *NebulaVaultContractCaller) ProofVerification(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _NebulaVaultContract.contract.Call(opts, &out, "proofVerification")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// ProofVerification is a free data retrieval call binding the contract method 0x32911c21.
+//
+// Solidity: function proofVerification() view returns(address)
+func (_NebulaVaultContract *NebulaVaultContractSession) ProofVerification() (common.Address, error) {
+	return _NebulaVaultContract.Contract.ProofVerification(&_NebulaVaultContract.CallOpts)
+}
+
+// ProofVerification is a free data retrieval call binding the contract method 0x32911c21.
+//
+// Solidity: function proofVerification() view returns(address)
+func (_NebulaVaultContract *NebulaVaultContractCallerSession) ProofVerification() (common.Address, error) {
+	return _NebulaVaultContract.Contract.ProofVerification(&_NebulaVaultContract.CallOpts)
+}
+
+// TotalDownloads is a free data retrieval call binding the contract method 0x44c62d9f.
+//
+// Solidity: function totalDownloads() view returns(uint256)
+func (_NebulaVaultContract *NebulaVaultContractCaller) TotalDownloads(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _NebulaVaultContract.contract.Call(opts, &out, "totalDownloads")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalDownloads is a free data retrieval call binding the contract method 0x44c62d9f.
+//
+// Solidity: function totalDownloads() view returns(uint256)
+func (_NebulaVaultContract *NebulaVaultContractSession) TotalDownloads() (*big.Int, error) {
+	return _NebulaVaultContract.Contract.TotalDownloads(&_NebulaVaultContract.CallOpts)
+}
+
+// TotalDownloads is a free data retrieval call binding the contract method 0x44c62d9f.
+//
+// Solidity: function totalDownloads() view returns(uint256)
+func (_NebulaVaultContract *NebulaVaultContractCallerSession) TotalDownloads() (*big.Int, error) {
+	return _NebulaVaultContract.Contract.TotalDownloads(&_NebulaVaultContract.CallOpts)
+}
+
+// TotalUploads is a free data retrieval call binding the contract method 0x6d5a0bca.
+//
+// Solidity: function totalUploads() view returns(uint256)
+func (_NebulaVaultContract *NebulaVaultContractCaller) TotalUploads(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _NebulaVaultContract.contract.Call(opts, &out, "totalUploads")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalUploads is a free data retrieval call binding the contract method 0x6d5a0bca.
+//
+// Solidity: function totalUploads() view returns(uint256)
+func (_NebulaVaultContract *NebulaVaultContractSession) TotalUploads() (*big.Int, error) {
+	return _NebulaVaultContract.Contract.TotalUploads(&_NebulaVaultContract.CallOpts)
+}
+
+// TotalUploads is a free data retrieval call binding the contract method 0x6d5a0bca.
+//
+// Solidity: function totalUploads() view returns(uint256)
+func (_NebulaVaultContract *NebulaVaultContractCallerSession) TotalUploads() (*big.Int, error) {
+	return _NebulaVaultContract.Contract.TotalUploads(&_NebulaVaultContract.CallOpts)
+}
+
+// TotalVerifiedFiles is a free data retrieval call binding the contract method 0xb5d350d0.
+//
+// Solidity: function totalVerifiedFiles() view returns(uint256)
+func (_NebulaVaultContract *NebulaVaultContractCaller) TotalVerifiedFiles(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _NebulaVaultContract.contract.Call(opts, &out, "totalVerifiedFiles")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalVerifiedFiles is a free data retrieval call binding the contract method 0xb5d350d0.
+//
+// Solidity: function totalVerifiedFiles() view returns(uint256)
+func (_NebulaVaultContract *NebulaVaultContractSession) TotalVerifiedFiles() (*big.Int, error) {
+	return _NebulaVaultContract.Contract.TotalVerifiedFiles(&_NebulaVaultContract.CallOpts)
+}
+
+// TotalVerifiedFiles is a free data retrieval call binding the contract method 0xb5d350d0.
+//
+// Solidity: function totalVerifiedFiles() view returns(uint256)
+func (_NebulaVaultContract *NebulaVaultContractCallerSession) TotalVerifiedFiles() (*big.Int, error) {
+	return _NebulaVaultContract.Contract.TotalVerifiedFiles(&_NebulaVaultContract.CallOpts)
+}
+
+// UserDownloadCounts is a free data retrieval call binding the contract method 0x33ea2255.
+//
+// Solidity: function userDownloadCounts(address ) view returns(uint256)
+func (_NebulaVaultContract *NebulaVaultContractCaller) UserDownloadCounts(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _NebulaVaultContract.contract.Call(opts, &out, "userDownloadCounts", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UserDownloadCounts is a free data retrieval call binding the contract method 0x33ea2255.
+//
+// Solidity: function userDownloadCounts(address ) view returns(uint256)
+func (_NebulaVaultContract *NebulaVaultContractSession) UserDownloadCounts(arg0 common.Address) (*big.Int, error) {
+	return _NebulaVaultContract.Contract.UserDownloadCounts(&_NebulaVaultContract.CallOpts, arg0)
+}
+
+// UserDownloadCounts is a free data retrieval call binding the contract method 0x33ea2255.
+//
+// Solidity: function userDownloadCounts(address ) view returns(uint256)
+func (_NebulaVaultContract *NebulaVaultContractCallerSession) UserDownloadCounts(arg0 common.Address) (*big.Int, error) {
+	return _NebulaVaultContract.Contract.UserDownloadCounts(&_NebulaVaultContract.CallOpts, arg0)
+}
+
+// UserUploadCounts is a free data retrieval call binding the contract method 0xfdbcefdf.
+//
+// Solidity: function userUploadCounts(address ) view returns(uint256)
+func (_NebulaVaultContract *NebulaVaultContractCaller) UserUploadCounts(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _NebulaVaultContract.contract.Call(opts, &out, "userUploadCounts", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UserUploadCounts is a free data retrieval call binding the contract method 0xfdbcefdf.
+//
+// Solidity: function userUploadCounts(address ) view returns(uint256)
+func (_NebulaVaultContract *NebulaVaultContractSession) UserUploadCounts(arg0 common.Address) (*big.Int, error) {
+	return _NebulaVaultContract.Contract.UserUploadCounts(&_NebulaVaultContract.CallOpts, arg0)
+}
+
+// UserUploadCounts is a free data retrieval call binding the contract method 0xfdbcefdf.
+//
+// Solidity: function userUploadCounts(address ) view returns(uint256)
+func (_NebulaVaultContract *NebulaVaultContractCallerSession) UserUploadCounts(arg0 common.Address) (*big.Int, error) {
+	return _NebulaVaultContract.Contract.UserUploadCounts(&_NebulaVaultContract.CallOpts, arg0)
+}
+
+// VerifiedUploads is a free data retrieval call binding the contract method 0x7943a193.
+//
+// Solidity: function verifiedUploads(bytes32 ) view returns(bool)
+func (_NebulaVaultContract *NebulaVaultContractCaller) VerifiedUploads(opts *bind.CallOpts, arg0 [32]byte) (bool, error) {
+	var out []interface{}
+	err := _NebulaVaultContract.contract.Call(opts, &out, "verifiedUploads", arg0)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// VerifiedUploads is a free data retrieval call binding the contract method 0x7943a193.
+//
+// Solidity: function verifiedUploads(bytes32 ) view returns(bool)
+func (_NebulaVaultContract *NebulaVaultContractSession) VerifiedUploads(arg0 [32]byte) (bool, error) {
+	return _NebulaVaultContract.Contract.VerifiedUploads(&_NebulaVaultContract.CallOpts, arg0)
+}
+
+// VerifiedUploads is a free data retrieval call binding the contract method 0x7943a193.
+//
+// Solidity: function verifiedUploads(bytes32 ) view returns(bool)
+func (_NebulaVaultContract *NebulaVaultContractCallerSession) VerifiedUploads(arg0 [32]byte) (bool, error) {
+	return _NebulaVaultContract.Contract.VerifiedUploads(&_NebulaVaultContract.CallOpts, arg0)
+}
+
+// AuthorizeUser is a paid mutator transaction binding the contract method 0x9103edbf.
+//
+// Solidity: function authorizeUser(bytes32 fileHash, address user) returns()
+func (_NebulaVaultContract *NebulaVaultContractTransactor) AuthorizeUser(opts *bind.TransactOpts, fileHash [32]byte, user common.Address) (*types.Transaction, error) {
+	return _NebulaVaultContract.contract.Transact(opts, "authorizeUser", fileHash, user)
+}
+
+// AuthorizeUser is a paid mutator transaction binding the contract method 0x9103edbf.
+//
+// Solidity: function authorizeUser(bytes32 fileHash, address user) returns()
+func (_NebulaVaultContract *NebulaVaultContractSession) AuthorizeUser(fileHash [32]byte, user common.Address) (*types.Transaction, error) {
+	return _NebulaVaultContract.Contract.AuthorizeUser(&_NebulaVaultContract.TransactOpts, fileHash, user)
+}
+
+// AuthorizeUser is a paid mutator transaction binding the contract method 0x9103edbf.
+//
+// Solidity: function authorizeUser(bytes32 fileHash, address user) returns()
+func (_NebulaVaultContract *NebulaVaultContractTransactorSession) AuthorizeUser(fileHash [32]byte, user common.Address) (*types.Transaction, error) {
+	return _NebulaVaultContract.Contract.AuthorizeUser(&_NebulaVaultContract.TransactOpts, fileHash, user)
+}
+
+// DeleteFile is a paid mutator transaction binding the contract method 0x6ab799f1.
+//
+// Solidity: function deleteFile(bytes32 fileHash) returns()
+func (_NebulaVaultContract *NebulaVaultContractTransactor) DeleteFile(opts *bind.TransactOpts, fileHash [32]byte) (*types.Transaction, error) {
+	return _NebulaVaultContract.contract.Transact(opts, "deleteFile", fileHash)
+}
+
+// DeleteFile is a paid mutator transaction binding the contract method 0x6ab799f1.
+//
+// Solidity: function deleteFile(bytes32 fileHash) returns()
+func (_NebulaVaultContract *NebulaVaultContractSession) DeleteFile(fileHash [32]byte) (*types.Transaction, error) {
+	return _NebulaVaultContract.Contract.DeleteFile(&_NebulaVaultContract.TransactOpts, fileHash)
+}
+
+// DeleteFile is a paid mutator transaction binding the contract method 0x6ab799f1.
+//
+// Solidity: function deleteFile(bytes32 fileHash) returns()
+func (_NebulaVaultContract *NebulaVaultContractTransactorSession) DeleteFile(fileHash [32]byte) (*types.Transaction, error) {
+	return _NebulaVaultContract.Contract.DeleteFile(&_NebulaVaultContract.TransactOpts, fileHash)
+}
+
+// DownloadFile is a paid mutator transaction binding the contract method 0xa30e992c.
+//
+// Solidity: function downloadFile(bytes32 fileHash) returns()
+func (_NebulaVaultContract *NebulaVaultContractTransactor) DownloadFile(opts *bind.TransactOpts, fileHash [32]byte) (*types.Transaction, error) {
+	return _NebulaVaultContract.contract.Transact(opts, "downloadFile", fileHash)
+}
+
+// DownloadFile is a paid mutator transaction binding the contract method 0xa30e992c.
+//
+// Solidity: function downloadFile(bytes32 fileHash) returns()
+func (_NebulaVaultContract *NebulaVaultContractSession) DownloadFile(fileHash [32]byte) (*types.Transaction, error) {
+	return _NebulaVaultContract.Contract.DownloadFile(&_NebulaVaultContract.TransactOpts, fileHash)
+}
+
+// DownloadFile is a paid mutator transaction binding the contract method 0xa30e992c.
+//
+// Solidity: function downloadFile(bytes32 fileHash) returns()
+func (_NebulaVaultContract *NebulaVaultContractTransactorSession) DownloadFile(fileHash [32]byte) (*types.Transaction, error) {
+	return _NebulaVaultContract.Contract.DownloadFile(&_NebulaVaultContract.TransactOpts, fileHash)
+}
+
+// EmergencyWithdraw is a paid mutator transaction binding the contract method 0xdb2e21bc.
+//
+// Solidity: function emergencyWithdraw() returns()
+func (_NebulaVaultContract *NebulaVaultContractTransactor) EmergencyWithdraw(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _NebulaVaultContract.contract.Transact(opts, "emergencyWithdraw")
+}
+
+// EmergencyWithdraw is a paid mutator transaction binding the contract method 0xdb2e21bc.
+//
+// Solidity: function emergencyWithdraw() returns()
+func (_NebulaVaultContract *NebulaVaultContractSession) EmergencyWithdraw() (*types.Transaction, error) {
+	return _NebulaVaultContract.Contract.EmergencyWithdraw(&_NebulaVaultContract.TransactOpts)
+}
+
+// EmergencyWithdraw is a paid mutator transaction binding the contract method 0xdb2e21bc.
+//
+// Solidity: function emergencyWithdraw() returns()
+func (_NebulaVaultContract *NebulaVaultContractTransactorSession) EmergencyWithdraw() (*types.Transaction, error) {
+	return _NebulaVaultContract.Contract.EmergencyWithdraw(&_NebulaVaultContract.TransactOpts)
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_NebulaVaultContract *NebulaVaultContractTransactor) Pause(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _NebulaVaultContract.contract.Transact(opts, "pause")
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_NebulaVaultContract *NebulaVaultContractSession) Pause() (*types.Transaction, error) {
+	return _NebulaVaultContract.Contract.Pause(&_NebulaVaultContract.TransactOpts)
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_NebulaVaultContract *NebulaVaultContractTransactorSession) Pause() (*types.Transaction, error) {
+	return _NebulaVaultContract.Contract.Pause(&_NebulaVaultContract.TransactOpts)
+}
+
+// RegisterUser is a paid mutator transaction binding the contract method 0x704f1b94.
+//
+// Solidity: function registerUser(string username) returns()
+func (_NebulaVaultContract *NebulaVaultContractTransactor) RegisterUser(opts *bind.TransactOpts, username string) (*types.Transaction, error) {
+	return _NebulaVaultContract.contract.Transact(opts, "registerUser", username)
+}
+
+// RegisterUser is a paid mutator transaction binding the contract method 0x704f1b94.
+//
+// Solidity: function registerUser(string username) returns()
+func (_NebulaVaultContract *NebulaVaultContractSession) RegisterUser(username string) (*types.Transaction, error) {
+	return _NebulaVaultContract.Contract.RegisterUser(&_NebulaVaultContract.TransactOpts, username)
+}
+
+// RegisterUser is a paid mutator transaction binding the contract method 0x704f1b94.
+//
+// Solidity: function registerUser(string username) returns()
+func (_NebulaVaultContract *NebulaVaultContractTransactorSession) RegisterUser(username string) (*types.Transaction, error) {
+	return _NebulaVaultContract.Contract.RegisterUser(&_NebulaVaultContract.TransactOpts, username)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_NebulaVaultContract *NebulaVaultContractTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _NebulaVaultContract.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_NebulaVaultContract *NebulaVaultContractSession) RenounceOwnership() (*types.Transaction, error) {
+	return _NebulaVaultContract.Contract.RenounceOwnership(&_NebulaVaultContract.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_NebulaVaultContract *NebulaVaultContractTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _NebulaVaultContract.Contract.RenounceOwnership(&_NebulaVaultContract.TransactOpts)
+}
+
+// RevokeUser is a paid mutator transaction binding the contract method 0xbe5b9b60.
+//
+// Solidity: function revokeUser(bytes32 fileHash, address user) returns()
+func (_NebulaVaultContract *NebulaVaultContractTransactor) RevokeUser(opts *bind.TransactOpts, fileHash [32]byte, user common.Address) (*types.Transaction, error) {
+	return _NebulaVaultContract.contract.Transact(opts, "revokeUser", fileHash, user)
+}
+
+// RevokeUser is a paid mutator transaction binding the contract method 0xbe5b9b60.
+//
+// Solidity: function revokeUser(bytes32 fileHash, address user) returns()
+func (_NebulaVaultContract *NebulaVaultContractSession) RevokeUser(fileHash [32]byte, user common.Address) (*types.Transaction, error) {
+	return _NebulaVaultContract.Contract.RevokeUser(&_NebulaVaultContract.TransactOpts, fileHash, user)
+}
+
+// RevokeUser is a paid mutator transaction binding the contract method 0xbe5b9b60.
+//
+// Solidity: function revokeUser(bytes32 fileHash, address user) returns()
+func (_NebulaVaultContract *NebulaVaultContractTransactorSession) RevokeUser(fileHash [32]byte, user common.Address) (*types.Transaction, error) {
+	return _NebulaVaultContract.Contract.RevokeUser(&_NebulaVaultContract.TransactOpts, fileHash, user)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_NebulaVaultContract *NebulaVaultContractTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _NebulaVaultContract.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_NebulaVaultContract *NebulaVaultContractSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _NebulaVaultContract.Contract.TransferOwnership(&_NebulaVaultContract.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_NebulaVaultContract *NebulaVaultContractTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _NebulaVaultContract.Contract.TransferOwnership(&_NebulaVaultContract.TransactOpts, newOwner)
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_NebulaVaultContract *NebulaVaultContractTransactor) Unpause(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _NebulaVaultContract.contract.Transact(opts, "unpause")
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_NebulaVaultContract *NebulaVaultContractSession) Unpause() (*types.Transaction, error) {
+	return _NebulaVaultContract.Contract.Unpause(&_NebulaVaultContract.TransactOpts)
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_NebulaVaultContract *NebulaVaultContractTransactorSession) Unpause() (*types.Transaction, error) {
+	return _NebulaVaultContract.Contract.Unpause(&_NebulaVaultContract.TransactOpts)
+}
+
+// UpgradeAccessControl is a paid mutator transaction binding the contract method 0xd6e62a1c.
+//
+// Solidity: function upgradeAccessControl(address newAccessControl) returns()
+func (_NebulaVaultContract *NebulaVaultContractTransactor) UpgradeAccessControl(opts *bind.TransactOpts, newAccessControl common.Address) (*types.Transaction, error) {
+	return _NebulaVaultContract.contract.Transact(opts, "upgradeAccessControl", newAccessControl)
+}
+
+// UpgradeAccessControl is a paid mutator transaction binding the contract method 0xd6e62a1c.
+//
+// Solidity: function upgradeAccessControl(address newAccessControl) returns()
+func (_NebulaVaultContract *NebulaVaultContractSession) UpgradeAccessControl(newAccessControl common.Address) (*types.Transaction, error) {
+	return _NebulaVaultContract.Contract.UpgradeAccessControl(&_NebulaVaultContract.TransactOpts, newAccessControl)
+}
+
+// UpgradeAccessControl is a paid mutator transaction binding the contract method 0xd6e62a1c.
+//
+// Solidity: function upgradeAccessControl(address newAccessControl) returns()
+func (_NebulaVaultContract *NebulaVaultContractTransactorSession) UpgradeAccessControl(newAccessControl common.Address) (*types.Transaction, error) {
+	return _NebulaVaultContract.Contract.UpgradeAccessControl(&_NebulaVaultContract.TransactOpts, newAccessControl)
+}
+
+// UpgradeFileStorage is a paid mutator transaction binding the contract method 0x1494f7f1.
+//
+// Solidity: function upgradeFileStorage(address newFileStorage) returns()
+func (_NebulaVaultContract *NebulaVaultContractTransactor) UpgradeFileStorage(opts *bind.TransactOpts, newFileStorage common.Address) (*types.Transaction, error) {
+	return _NebulaVaultContract.contract.Transact(opts, "upgradeFileStorage", newFileStorage)
+}
+
+// UpgradeFileStorage is a paid mutator transaction binding the contract method 0x1494f7f1.
+//
+// Solidity: function upgradeFileStorage(address newFileStorage) returns()
+func (_NebulaVaultContract *NebulaVaultContractSession) UpgradeFileStorage(newFileStorage common.Address) (*types.Transaction, error) {
+	return _NebulaVaultContract.Contract.UpgradeFileStorage(&_NebulaVaultContract.TransactOpts, newFileStorage)
+}
+
+// UpgradeFileStorage is a paid mutator transaction binding the contract method 0x1494f7f1.
+//
+// Solidity: function upgradeFileStorage(address newFileStorage) returns()
+func (_NebulaVaultContract *NebulaVaultContractTransactorSession) UpgradeFileStorage(newFileStorage common.Address) (*types.Transaction, error) {
+	return _NebulaVaultContract.Contract.UpgradeFileStorage(&_NebulaVaultContract.TransactOpts, newFileStorage)
+}
+
+// UpgradeProofVerification is a paid mutator transaction binding the contract method 0x2d84ed13.
+//
+// Solidity: function upgradeProofVerification(address newProofVerification) returns()
+func (_NebulaVaultContract *NebulaVaultContractTransactor) UpgradeProofVerification(opts *bind.TransactOpts, newProofVerification common.Address) (*types.Transaction, error) {
+	return _NebulaVaultContract.contract.Transact(opts, "upgradeProofVerification", newProofVerification)
+}
+
+// UpgradeProofVerification is a paid mutator transaction binding the contract method 0x2d84ed13.
+//
+// Solidity: function upgradeProofVerification(address newProofVerification) returns()
+func (_NebulaVaultContract *NebulaVaultContractSession) UpgradeProofVerification(newProofVerification common.Address) (*types.Transaction, error) {
+	return _NebulaVaultContract.Contract.UpgradeProofVerification(&_NebulaVaultContract.TransactOpts, newProofVerification)
+}
+
+// UpgradeProofVerification is a paid mutator transaction binding the contract method 0x2d84ed13.
+//
+// Solidity: function upgradeProofVerification(address newProofVerification) returns()
+func (_NebulaVaultContract *NebulaVaultContractTransactorSession) UpgradeProofVerification(newProofVerification common.Address) (*types.Transaction, error) {
+	return _NebulaVaultContract.Contract.UpgradeProofVerification(&_NebulaVaultContract.TransactOpts, newProofVerification)
+}
+
+// UploadFile is a paid mutator transaction binding the contract method 0x67233ed8.
+//
+// Solidity: function uploadFile(bytes32 fileHash, string filename, uint256 size, string merkleRoot) payable returns()
+func (_NebulaVaultContract *NebulaVaultContractTransactor) UploadFile(opts *bind.TransactOpts, fileHash [32]byte, filename string, size *big.Int, merkleRoot string) (*types.Transaction, error) {
+	return _NebulaVaultContract.contract.Transact(opts, "uploadFile", fileHash, filename, size, merkleRoot)
+}
+
+// UploadFile is a paid mutator transaction binding the contract method 0x67233ed8.
+//
+// Solidity: function uploadFile(bytes32 fileHash, string filename, uint256 size, string merkleRoot) payable returns()
+func (_NebulaVaultContract *NebulaVaultContractSession) UploadFile(fileHash [32]byte, filename string, size *big.Int, merkleRoot string) (*types.Transaction, error) {
+	return _NebulaVaultContract.Contract.UploadFile(&_NebulaVaultContract.TransactOpts, fileHash, filename, size, merkleRoot)
+}
+
+// UploadFile is a paid mutator transaction binding the contract method 0x67233ed8.
+//
+// Solidity: function uploadFile(bytes32 fileHash, string filename, uint256 size, string merkleRoot) payable returns()
+func (_NebulaVaultContract *NebulaVaultContractTransactorSession) UploadFile(fileHash [32]byte, filename string, size *big.Int, merkleRoot string) (*types.Transaction, error) {
+	return _NebulaVaultContract.Contract.UploadFile(&_NebulaVaultContract.TransactOpts, fileHash, filename, size, merkleRoot)
+}
+
+// UploadFileWithVerification is a paid mutator transaction binding the contract method 0x07a210be.
+//
+// Solidity: function uploadFileWithVerification(bytes32 fileHash, string filename, uint256 size, string merkleRoot, bytes32[] proof, uint256[] indices, bytes32 leafHash) payable returns()
+func (_NebulaVaultContract *NebulaVaultContractTransactor) UploadFileWithVerification(opts *bind.TransactOpts, fileHash [32]byte, filename string, size *big.Int, merkleRoot string, proof [][32]byte, indices []*big.Int, leafHash [32]byte) (*types.Transaction, error) {
+	return _NebulaVaultContract.contract.Transact(opts, "uploadFileWithVerification", fileHash, filename, size, merkleRoot, proof, indices, leafHash)
+}
+
+// UploadFileWithVerification is a paid mutator transaction binding the contract method 0x07a210be.
+//
+// Solidity: function uploadFileWithVerification(bytes32 fileHash, string filename, uint256 size, string merkleRoot, bytes32[] proof, uint256[] indices, bytes32 leafHash) payable returns()
+func (_NebulaVaultContract *NebulaVaultContractSession) UploadFileWithVerification(fileHash [32]byte, filename string, size *big.Int, merkleRoot string, proof [][32]byte, indices []*big.Int, leafHash [32]byte) (*types.Transaction, error) {
+	return _NebulaVaultContract.Contract.UploadFileWithVerification(&_NebulaVaultContract.TransactOpts, fileHash, filename, size, merkleRoot, proof, indices, leafHash)
+}
+
+// UploadFileWithVerification is a paid mutator transaction binding the contract method 0x07a210be.
+//
+// Solidity: function uploadFileWithVerification(bytes32 fileHash, string filename, uint256 size, string merkleRoot, bytes32[] proof, uint256[] indices, bytes32 leafHash) payable returns()
+func (_NebulaVaultContract *NebulaVaultContractTransactorSession) UploadFileWithVerification(fileHash [32]byte, filename string, size *big.Int, merkleRoot string, proof [][32]byte, indices []*big.Int, leafHash [32]byte) (*types.Transaction, error) {
+	return _NebulaVaultContract.Contract.UploadFileWithVerification(&_NebulaVaultContract.TransactOpts, fileHash, filename, size, merkleRoot, proof, indices, leafHash)
+}
+
+// VerifyFileProof is a paid mutator transaction binding the contract method 0x0452cb60.
+//
+// Solidity: function verifyFileProof(bytes32 fileHash, bytes32 merkleRoot, bytes32[] proof, uint256[] indices, bytes32 leafHash) returns(bool)
+func (_NebulaVaultContract *NebulaVaultContractTransactor) VerifyFileProof(opts *bind.TransactOpts, fileHash [32]byte, merkleRoot [32]byte, proof [][32]byte, indices []*big.Int, leafHash [32]byte) (*types.Transaction, error) {
+	return _NebulaVaultContract.contract.Transact(opts, "verifyFileProof", fileHash, merkleRoot, proof, indices, leafHash)
+}
+
+// VerifyFileProof is a paid mutator transaction binding the contract method 0x0452cb60.
+//
+// Solidity: function verifyFileProof(bytes32 fileHash, bytes32 merkleRoot, bytes32[] proof, uint256[] indices, bytes32 leafHash) returns(bool)
+func (_NebulaVaultContract *NebulaVaultContractSession) VerifyFileProof(fileHash [32]byte, merkleRoot [32]byte, proof [][32]byte, indices []*big.Int, leafHash [32]byte) (*types.Transaction, error) {
+	return _NebulaVaultContract.Contract.VerifyFileProof(&_NebulaVaultContract.TransactOpts, fileHash, merkleRoot, proof, indices, leafHash)
+}
+
+// VerifyFileProof is a paid mutator transaction binding the contract method 0x0452cb60.
+//
+// Solidity: function verifyFileProof(bytes32 fileHash, bytes32 merkleRoot, bytes32[] proof, uint256[] indices, bytes32 leafHash) returns(bool)
+func (_NebulaVaultContract *NebulaVaultContractTransactorSession) VerifyFileProof(fileHash [32]byte, merkleRoot [32]byte, proof [][32]byte, indices []*big.Int, leafHash [32]byte) (*types.Transaction, error) {
+	return _NebulaVaultContract.Contract.VerifyFileProof(&_NebulaVaultContract.TransactOpts, fileHash, merkleRoot, proof, indices, leafHash)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_NebulaVaultContract *NebulaVaultContractTransactor) Receive(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _NebulaVaultContract.contract.RawTransact(opts, nil) // calldata is disallowed for receive function
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_NebulaVaultContract *NebulaVaultContractSession) Receive() (*types.Transaction, error) {
+	return _NebulaVaultContract.Contract.Receive(&_NebulaVaultContract.TransactOpts)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_NebulaVaultContract *NebulaVaultContractTransactorSession) Receive() (*types.Transaction, error) {
+	return _NebulaVaultContract.Contract.Receive(&_NebulaVaultContract.TransactOpts)
+}
+
+// NebulaVaultContractContractsDeployedIterator is returned from FilterContractsDeployed and is used to iterate over the raw logs and unpacked data for ContractsDeployed events raised by the NebulaVaultContract contract.
+type NebulaVaultContractContractsDeployedIterator struct {
+	Event *NebulaVaultContractContractsDeployed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *NebulaVaultContractContractsDeployedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(NebulaVaultContractContractsDeployed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(NebulaVaultContractContractsDeployed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *NebulaVaultContractContractsDeployedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *NebulaVaultContractContractsDeployedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// NebulaVaultContractContractsDeployed represents a ContractsDeployed event raised by the NebulaVaultContract contract.
+type NebulaVaultContractContractsDeployed struct {
+	FileStorage       common.Address
+	AccessControl     common.Address
+	ProofVerification common.Address
+	Raw               types.Log // Blockchain specific contextual infos
+}
+
+// FilterContractsDeployed is a free log retrieval operation binding the contract event 0x64ff5f080a9fbac5fd2b92ee5d223ab47abf2bc09caad8eefb67cdf7f7a1796c.
+//
+// Solidity: event ContractsDeployed(address indexed fileStorage, address indexed accessControl, address indexed proofVerification)
+func (_NebulaVaultContract *NebulaVaultContractFilterer) FilterContractsDeployed(opts *bind.FilterOpts, fileStorage []common.Address, accessControl []common.Address, proofVerification []common.Address) (*NebulaVaultContractContractsDeployedIterator, error) {
+
+	var fileStorageRule []interface{}
+	for _, fileStorageItem := range fileStorage {
+		fileStorageRule = append(fileStorageRule, fileStorageItem)
+	}
+	var accessControlRule []interface{}
+	for _, accessControlItem := range accessControl {
+		accessControlRule = append(accessControlRule, accessControlItem)
+	}
+	var proofVerificationRule []interface{}
+	for _, proofVerificationItem := range proofVerification {
+		proofVerificationRule = append(proofVerificationRule, proofVerificationItem)
+	}
+
+	logs, sub, err := _NebulaVaultContract.contract.FilterLogs(opts, "ContractsDeployed", fileStorageRule, accessControlRule, proofVerificationRule)
+	if err != nil {
+		return nil, err
+	}
+	return &NebulaVaultContractContractsDeployedIterator{contract: _NebulaVaultContract.contract, event: "ContractsDeployed", logs: logs, sub: sub}, nil
+}
+
+// WatchContractsDeployed is a free log subscription operation binding the contract event 0x64ff5f080a9fbac5fd2b92ee5d223ab47abf2bc09caad8eefb67cdf7f7a1796c.
+//
+// Solidity: event ContractsDeployed(address indexed fileStorage, address indexed accessControl, address indexed proofVerification)
+func (_NebulaVaultContract *NebulaVaultContractFilterer) WatchContractsDeployed(opts *bind.WatchOpts, sink chan<- *NebulaVaultContractContractsDeployed, fileStorage []common.Address, accessControl []common.Address, proofVerification []common.Address) (event.Subscription, error) {
+
+	var fileStorageRule []interface{}
+	for _, fileStorageItem := range fileStorage {
+		fileStorageRule = append(fileStorageRule, fileStorageItem)
+	}
+	var accessControlRule []interface{}
+	for _, accessControlItem := range accessControl {
+		accessControlRule = append(accessControlRule, accessControlItem)
+	}
+	var proofVerificationRule []interface{}
+	for _, proofVerificationItem := range proofVerification {
+		proofVerificationRule = append(proofVerificationRule, proofVerificationItem)
+	}
+
+	logs, sub, err := _NebulaVaultContract.contract.WatchLogs(opts, "ContractsDeployed", fileStorageRule, accessControlRule, proofVerificationRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(NebulaVaultContractContractsDeployed)
+				if err := _NebulaVaultContract.contract.UnpackLog(event, "ContractsDeployed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseContractsDeployed is a log parse operation binding the contract event 0x64ff5f080a9fbac5fd2b92ee5d223ab47abf2bc09caad8eefb67cdf7f7a1796c.
+//
+// Solidity: event ContractsDeployed(address indexed fileStorage, address indexed accessControl, address indexed proofVerification)
+func (_NebulaVaultContract *NebulaVaultContractFilterer) ParseContractsDeployed(log types.Log) (*NebulaVaultContractContractsDeployed, error) {
+	event := new(NebulaVaultContractContractsDeployed)
+	if err := _NebulaVaultContract.contract.UnpackLog(event, "ContractsDeployed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// NebulaVaultContractFileUploadedWithVerificationIterator is returned from FilterFileUploadedWithVerification and is used to iterate over the raw logs and unpacked data for FileUploadedWithVerification events raised by the NebulaVaultContract contract.
+type NebulaVaultContractFileUploadedWithVerificationIterator struct {
+	Event *NebulaVaultContractFileUploadedWithVerification // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *NebulaVaultContractFileUploadedWithVerificationIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(NebulaVaultContractFileUploadedWithVerification)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(NebulaVaultContractFileUploadedWithVerification)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *NebulaVaultContractFileUploadedWithVerificationIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *NebulaVaultContractFileUploadedWithVerificationIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// NebulaVaultContractFileUploadedWithVerification represents a FileUploadedWithVerification event raised by the NebulaVaultContract contract.
+type NebulaVaultContractFileUploadedWithVerification struct {
+	FileHash       [32]byte
+	Uploader       common.Address
+	Filename       string
+	Size           *big.Int
+	ProofSubmitted bool
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterFileUploadedWithVerification is a free log retrieval operation binding the contract event 0xce3f1950f96e51ff8d0977b5884bbb34419e4231b478f45bef943f95478b9a89.
+//
+// Solidity: event FileUploadedWithVerification(bytes32 indexed fileHash, address indexed uploader, string filename, uint256 size, bool proofSubmitted)
+func (_NebulaVaultContract *NebulaVaultContractFilterer) FilterFileUploadedWithVerification(opts *bind.FilterOpts, fileHash [][32]byte, uploader []common.Address) (*NebulaVaultContractFileUploadedWithVerificationIterator, error) {
+
+	var fileHashRule []interface{}
+	for _, fileHashItem := range fileHash {
+		fileHashRule = append(fileHashRule, fileHashItem)
+	}
+	var uploaderRule []interface{}
+	for _, uploaderItem := range uploader {
+		uploaderRule = append(uploaderRule, uploaderItem)
+	}
+
+	logs, sub, err := _NebulaVaultContract.contract.FilterLogs(opts, "FileUploadedWithVerification", fileHashRule, uploaderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &NebulaVaultContractFileUploadedWithVerificationIterator{contract: _NebulaVaultContract.contract, event: "FileUploadedWithVerification", logs: logs, sub: sub}, nil
+}
+
+// WatchFileUploadedWithVerification is a free log subscription operation binding the contract event 0xce3f1950f96e51ff8d0977b5884bbb34419e4231b478f45bef943f95478b9a89.
+//
+// Solidity: event FileUploadedWithVerification(bytes32 indexed fileHash, address indexed uploader, string filename, uint256 size, bool proofSubmitted)
+func (_NebulaVaultContract *NebulaVaultContractFilterer) WatchFileUploadedWithVerification(opts *bind.WatchOpts, sink chan<- *NebulaVaultContractFileUploadedWithVerification, fileHash [][32]byte, uploader []common.Address) (event.Subscription, error) {
+
+	var fileHashRule []interface{}
+	for _, fileHashItem := range fileHash {
+		fileHashRule = append(fileHashRule, fileHashItem)
+	}
+	var uploaderRule []interface{}
+	for _, uploaderItem := range uploader {
+		uploaderRule = append(uploaderRule, uploaderItem)
+	}
+
+	logs, sub, err := _NebulaVaultContract.contract.WatchLogs(opts, "FileUploadedWithVerification", fileHashRule, uploaderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(NebulaVaultContractFileUploadedWithVerification)
+				if err := _NebulaVaultContract.contract.UnpackLog(event, "FileUploadedWithVerification", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseFileUploadedWithVerification is a log parse operation binding the contract event 0xce3f1950f96e51ff8d0977b5884bbb34419e4231b478f45bef943f95478b9a89.
+//
+// Solidity: event FileUploadedWithVerification(bytes32 indexed fileHash, address indexed uploader, string filename, uint256 size, bool proofSubmitted)
+func (_NebulaVaultContract *NebulaVaultContractFilterer) ParseFileUploadedWithVerification(log types.Log) (*NebulaVaultContractFileUploadedWithVerification, error) {
+	event := new(NebulaVaultContractFileUploadedWithVerification)
+	if err := _NebulaVaultContract.contract.UnpackLog(event, "FileUploadedWithVerification", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// NebulaVaultContractOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the NebulaVaultContract contract.
+type NebulaVaultContractOwnershipTransferredIterator struct {
+	Event *NebulaVaultContractOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *NebulaVaultContractOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(NebulaVaultContractOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(NebulaVaultContractOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *NebulaVaultContractOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *NebulaVaultContractOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// NebulaVaultContractOwnershipTransferred represents a OwnershipTransferred event raised by the NebulaVaultContract contract.
+type NebulaVaultContractOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_NebulaVaultContract *NebulaVaultContractFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*NebulaVaultContractOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _NebulaVaultContract.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &NebulaVaultContractOwnershipTransferredIterator{contract: _NebulaVaultContract.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_NebulaVaultContract *NebulaVaultContractFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *NebulaVaultContractOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _NebulaVaultContract.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(NebulaVaultContractOwnershipTransferred)
+				if err := _NebulaVaultContract.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_NebulaVaultContract *NebulaVaultContractFilterer) ParseOwnershipTransferred(log types.Log) (*NebulaVaultContractOwnershipTransferred, error) {
+	event := new(NebulaVaultContractOwnershipTransferred)
+	if err := _NebulaVaultContract.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// NebulaVaultContractPausedIterator is returned from FilterPaused and is used to iterate over the raw logs and unpacked data for Paused events raised by the NebulaVaultContract contract.
+type NebulaVaultContractPausedIterator struct {
+	Event *NebulaVaultContractPaused // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *NebulaVaultContractPausedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(NebulaVaultContractPaused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(NebulaVaultContractPaused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *NebulaVaultContractPausedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *NebulaVaultContractPausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// NebulaVaultContractPaused represents a Paused event raised by the NebulaVaultContract contract.
+type NebulaVaultContractPaused struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterPaused is a free log retrieval operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
+//
+// Solidity: event Paused(address account)
+func (_NebulaVaultContract *NebulaVaultContractFilterer) FilterPaused(opts *bind.FilterOpts) (*NebulaVaultContractPausedIterator, error) {
+
+	logs, sub, err := _NebulaVaultContract.contract.FilterLogs(opts, "Paused")
+	if err != nil {
+		return nil, err
+	}
+	return &NebulaVaultContractPausedIterator{contract: _NebulaVaultContract.contract, event: "Paused", logs: logs, sub: sub}, nil
+}
+
+// WatchPaused is a free log subscription operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
+//
+// Solidity: event Paused(address account)
+func (_NebulaVaultContract *NebulaVaultContractFilterer) WatchPaused(opts *bind.WatchOpts, sink chan<- *NebulaVaultContractPaused) (event.Subscription, error) {
+
+	logs, sub, err := _NebulaVaultContract.contract.WatchLogs(opts, "Paused")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(NebulaVaultContractPaused)
+				if err := _NebulaVaultContract.contract.UnpackLog(event, "Paused", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePaused is a log parse operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
+//
+// Solidity: event Paused(address account)
+func (_NebulaVaultContract *NebulaVaultContractFilterer) ParsePaused(log types.Log) (*NebulaVaultContractPaused, error) {
+	event := new(NebulaVaultContractPaused)
+	if err := _NebulaVaultContract.contract.UnpackLog(event, "Paused", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// NebulaVaultContractSystemUpgradedIterator is returned from FilterSystemUpgraded and is used to iterate over the raw logs and unpacked data for SystemUpgraded events raised by the NebulaVaultContract contract.
+type NebulaVaultContractSystemUpgradedIterator struct {
+	Event *NebulaVaultContractSystemUpgraded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *NebulaVaultContractSystemUpgradedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(NebulaVaultContractSystemUpgraded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(NebulaVaultContractSystemUpgraded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *NebulaVaultContractSystemUpgradedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *NebulaVaultContractSystemUpgradedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// NebulaVaultContractSystemUpgraded represents a SystemUpgraded event raised by the NebulaVaultContract contract.
+type NebulaVaultContractSystemUpgraded struct {
+	OldContract  common.Address
+	NewContract  common.Address
+	ContractType string
+	Raw          types.Log // Blockchain specific contextual infos
+}
+
+// FilterSystemUpgraded is a free log retrieval operation binding the contract event 0x604ad002a40ff5c7889d79b0d608bb9a3deca65b1ad03844689a7ef3f63ec4a1.
+//
+// Solidity: event SystemUpgraded(address indexed oldContract, address indexed newContract, string contractType)
+func (_NebulaVaultContract *NebulaVaultContractFilterer) FilterSystemUpgraded(opts *bind.FilterOpts, oldContract []common.Address, newContract []common.Address) (*NebulaVaultContractSystemUpgradedIterator, error) {
+
+	var oldContractRule []interface{}
+	for _, oldContractItem := range oldContract {
+		oldContractRule = append(oldContractRule, oldContractItem)
+	}
+	var newContractRule []interface{}
+	for _, newContractItem := range newContract {
+		newContractRule = append(newContractRule, newContractItem)
+	}
+
+	logs, sub, err := _NebulaVaultContract.contract.FilterLogs(opts, "SystemUpgraded", oldContractRule, newContractRule)
+	if err != nil {
+		return nil, err
+	}
+	return &NebulaVaultContractSystemUpgradedIterator{contract: _NebulaVaultContract.contract, event: "SystemUpgraded", logs: logs, sub: sub}, nil
+}
+
+// WatchSystemUpgraded is a free log subscription operation binding the contract event 0x604ad002a40ff5c7889d79b0d608bb9a3deca65b1ad03844689a7ef3f63ec4a1.
+//
+// Solidity: event SystemUpgraded(address indexed oldContract, address indexed newContract, string contractType)
+func (_NebulaVaultContract *NebulaVaultContractFilterer) WatchSystemUpgraded(opts *bind.WatchOpts, sink chan<- *NebulaVaultContractSystemUpgraded, oldContract []common.Address, newContract []common.Address) (event.Subscription, error) {
+
+	var oldContractRule []interface{}
+	for _, oldContractItem := range oldContract {
+		oldContractRule = append(oldContractRule, oldContractItem)
+	}
+	var newContractRule []interface{}
+	for _, newContractItem := range newContract {
+		newContractRule = append(newContractRule, newContractItem)
+	}
+
+	logs, sub, err := _NebulaVaultContract.contract.WatchLogs(opts, "SystemUpgraded", oldContractRule, newContractRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(NebulaVaultContractSystemUpgraded)
+				if err := _NebulaVaultContract.contract.UnpackLog(event, "SystemUpgraded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSystemUpgraded is a log parse operation binding the contract event 0x604ad002a40ff5c7889d79b0d608bb9a3deca65b1ad03844689a7ef3f63ec4a1.
+//
+// Solidity: event SystemUpgraded(address indexed oldContract, address indexed newContract, string contractType)
+func (_NebulaVaultContract *NebulaVaultContractFilterer) ParseSystemUpgraded(log types.Log) (*NebulaVaultContractSystemUpgraded, error) {
+	event := new(NebulaVaultContractSystemUpgraded)
+	if err := _NebulaVaultContract.contract.UnpackLog(event, "SystemUpgraded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// NebulaVaultContractUnpausedIterator is returned from FilterUnpaused and is used to iterate over the raw logs and unpacked data for Unpaused events raised by the NebulaVaultContract contract.
+type NebulaVaultContractUnpausedIterator struct {
+	Event *NebulaVaultContractUnpaused // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *NebulaVaultContractUnpausedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(NebulaVaultContractUnpaused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(NebulaVaultContractUnpaused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *NebulaVaultContractUnpausedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *NebulaVaultContractUnpausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// NebulaVaultContractUnpaused represents a Unpaused event raised by the NebulaVaultContract contract.
+type NebulaVaultContractUnpaused struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterUnpaused is a free log retrieval operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
+//
+// Solidity: event Unpaused(address account)
+func (_NebulaVaultContract *NebulaVaultContractFilterer) FilterUnpaused(opts *bind.FilterOpts) (*NebulaVaultContractUnpausedIterator, error) {
+
+	logs, sub, err := _NebulaVaultContract.contract.FilterLogs(opts, "Unpaused")
+	if err != nil {
+		return nil, err
+	}
+	return &NebulaVaultContractUnpausedIterator{contract: _NebulaVaultContract.contract, event: "Unpaused", logs: logs, sub: sub}, nil
+}
+
+// WatchUnpaused is a free log subscription operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
+//
+// Solidity: event Unpaused(address account)
+func (_NebulaVaultContract *NebulaVaultContractFilterer) WatchUnpaused(opts *bind.WatchOpts, sink chan<- *NebulaVaultContractUnpaused) (event.Subscription, error) {
+
+	logs, sub, err := _NebulaVaultContract.contract.WatchLogs(opts, "Unpaused")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(NebulaVaultContractUnpaused)
+				if err := _NebulaVaultContract.contract.UnpackLog(event, "Unpaused", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUnpaused is a log parse operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
+//
+// Solidity: event Unpaused(address account)
+func (_NebulaVaultContract *NebulaVaultContractFilterer) ParseUnpaused(log types.Log) (*NebulaVaultContractUnpaused, error) {
+	event := new(NebulaVaultContractUnpaused)
+	if err := _NebulaVaultContract.contract.UnpackLog(event, "Unpaused", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
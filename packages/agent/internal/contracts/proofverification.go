@@ -0,0 +1,1449 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package contracts
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// ProofVerificationContractMetaData contains all meta data concerning the ProofVerificationContract contract.
+var ProofVerificationContractMetaData = &bind.MetaData{
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"fileHash\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"merkleRoot\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"submitter\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"timestamp\",\"type\":\"uint256\"}],\"name\":\"ProofSubmitted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"fileHash\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"merkleRoot\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"verifier\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"isValid\",\"type\":\"bool\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"timestamp\",\"type\":\"uint256\"}],\"name\":\"ProofVerified\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"newThreshold\",\"type\":\"uint256\"}],\"name\":\"VerificationThresholdUpdated\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"bytes32[]\",\"name\":\"fileHashes\",\"type\":\"bytes32[]\"},{\"internalType\":\"bytes32[]\",\"name\":\"merkleRoots\",\"type\":\"bytes32[]\"},{\"internalType\":\"bytes32[][]\",\"name\":\"proofs\",\"type\":\"bytes32[][]\"},{\"internalType\":\"uint256[][]\",\"name\":\"indicesArray\",\"type\":\"uint256[][]\"},{\"internalType\":\"bytes32[]\",\"name\":\"leafHashes\",\"type\":\"bytes32[]\"}],\"name\":\"batchVerifyProofs\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"fileHash\",\"type\":\"bytes32\"},{\"internalType\":\"bool\",\"name\":\"isValid\",\"type\":\"bool\"}],\"name\":\"emergencyVerify\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"fileHash\",\"type\":\"bytes32\"}],\"name\":\"getMerkleProof\",\"outputs\":[{\"internalType\":\"bytes32[]\",\"name\":\"\",\"type\":\"bytes32[]\"},{\"internalType\":\"uint256[]\",\"name\":\"\",\"type\":\"uint256[]\"},{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"fileHash\",\"type\":\"bytes32\"}],\"name\":\"getVerificationResult\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"},{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"verifier\",\"type\":\"address\"}],\"name\":\"getVerifierReputation\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"fileHash\",\"type\":\"bytes32\"}],\"name\":\"isFileVerified\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"maxProofLength\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"name\":\"merkleProofs\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"leafHash\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"rootHash\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"newMaxLength\",\"type\":\"uint256\"}],\"name\":\"setMaxProofLength\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"newReward\",\"type\":\"uint256\"}],\"name\":\"setVerificationReward\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"newThreshold\",\"type\":\"uint256\"}],\"name\":\"setVerificationThreshold\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"fileHash\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"merkleRoot\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32[]\",\"name\":\"proof\",\"type\":\"bytes32[]\"},{\"internalType\":\"uint256[]\",\"name\":\"indices\",\"type\":\"uint256[]\"},{\"internalType\":\"bytes32\",\"name\":\"leafHash\",\"type\":\"bytes32\"}],\"name\":\"submitProof\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"name\":\"verificationResults\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"fileHash\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"merkleRoot\",\"type\":\"bytes32\"},{\"internalType\":\"bool\",\"name\":\"isValid\",\"type\":\"bool\"},{\"internalType\":\"uint256\",\"name\":\"verificationCount\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"timestamp\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"verificationReward\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"verificationThreshold\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"name\":\"verifiedFiles\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"verifierReputation\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"fileHash\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"merkleRoot\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32[]\",\"name\":\"proof\",\"type\":\"bytes32[]\"},{\"internalType\":\"uint256[]\",\"name\":\"indices\",\"type\":\"uint256[]\"},{\"internalType\":\"bytes32\",\"name\":\"leafHash\",\"type\":\"bytes32\"}],\"name\":\"verifyProof\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"withdrawRewards\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	Bin: "0x6080604052600360065566038d7ea4c680006007556103e860085534801561002657600080fd5b5061003033610039565b60018055610089565b600080546001600160a01b038381166001600160a01b0319831681178455604051919092169283917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e09190a35050565b611711806100986000396000f3fe608060405234801561001057600080fd5b50600436106101425760003560e01c8063738ee5e6116100b85780638da5cb5b1161007c5780638da5cb5b14610387578063a6859ba8146103a2578063c7b8981c146103b5578063c98da654146103bd578063e95c28c3146103c6578063f2fde38b146103d957600080fd5b8063738ee5e6146102b457806377e2a07a146102e7578063782184cf146102fa5780637e5d70a01461031d57806382bab3eb1461035e57600080fd5b806336beb6971161010a57806336beb697146101c457806338cc32c4146102345780636df1409f146102475780636e902e3114610286578063715018a6146102995780637166a9eb146102a157600080fd5b80630666fa0514610147578063082fa3741461015c578063167feb09146101785780631aea9fd1146101815780632e36b050146101a1575b600080fd5b61015a6101553660046111d5565b6103ec565b005b61016560075481565b6040519081526020015b60405180910390f35b61016560065481565b61016561018f3660046111ee565b60046020526000908152604090205481565b6101b46101af3660046111d5565b610458565b60405161016f949392919061121e565b6102056101d23660046111d5565b600090815260026020819052604090912080546001820154928201546003830154600490930154919460ff909116929190565b60405161016f959493929190948552602085019390935290151560408401526060830152608082015260a00190565b61015a6102423660046111d5565b610530565b6102716102553660046111d5565b6003602081905260009182526040909120600281015491015482565b6040805192835260208301919091520161016f565b61015a6102943660046111d5565b6105c3565b61015a6105d0565b61015a6102af366004611479565b6105e4565b6102d76102c23660046111d5565b60009081526005602052604090205460ff1690565b604051901515815260200161016f565b61015a6102f536600461154b565b610704565b6102d76103083660046111d5565b60056020526000908152604090205460ff1681565b61020561032b3660046111d5565b60026020819052600091825260409091208054600182015492820154600383015460049093015491939260ff9091169185565b61016561036c3660046111ee565b6001600160a01b031660009081526004602052604090205490565b6000546040516001600160a01b03909116815260200161016f565b6102d76103b0366004611580565b610752565b61015a610a60565b61016560085481565b61015a6103d4366004611580565b610aec565b61015a6103e73660046111ee565b610cda565b6103f4610d53565b600081116104535760405162461bcd60e51b815260206004820152602160248201527f4d6178206c656e677468206d7573742062652067726561746572207468616e206044820152600360fc1b60648201526084015b60405180910390fd5b600855565b600081815260036020818152604080842060028101549381015481548351818602810186019094528084526060968796909586958594600186019490928691908301828280156104c757602002820191906000526020600020905b8154815260200190600101908083116104b3575b505050505093508280548060200260200160405190810160405280929190818152602001828054801561051957602002820191906000526020600020905b815481526020019060010190808311610505575b505050505092509450945094509450509193509193565b610538610d53565b600081116105885760405162461bcd60e51b815260206004820181905260248201527f5468726573686f6c64206d7573742062652067726561746572207468616e2030604482015260640161044a565b60068190556040518181527f92f96a03bef2d117f6cb166c330c75b2d08091142a78ab5d19e3bff63fa6c5b59060200160405180910390a150565b6105cb610d53565b600755565b6105d8610d53565b6105e26000610dad565b565b835185511480156105f6575082518551145b8015610603575081518551145b8015610610575080518551145b6106545760405162461bcd60e51b8152602060048201526015602482015274082e4e4c2f240d8cadccee8d040dad2e6dac2e8c6d605b1b604482015260640161044a565b60005b85518110156106fc576106ea868281518110610675576106756115ff565b602002602001015186838151811061068f5761068f6115ff565b60200260200101518684815181106106a9576106a96115ff565b60200260200101518685815181106106c3576106c36115ff565b60200260200101518686815181106106dd576106dd6115ff565b6020026020010151610dfd565b806106f48161162b565b915050610657565b505050505050565b61070c610d53565b6000828152600560209081526040808320805494151560ff1995861681179091556002928390529220938455830180549092161790554260048201556001600390910155565b60008383600082511161079f5760405162461bcd60e51b815260206004820152601560248201527450726f6f662063616e6e6f7420626520656d70747960581b604482015260640161044a565b60008151116107ea5760405162461bcd60e51b8152602060048201526017602482015276496e64696365732063616e6e6f7420626520656d70747960481b604482015260640161044a565b805182511461080b5760405162461bcd60e51b815260040161044a90611644565b6008548251111561084f5760405162461bcd60e51b815260206004820152600e60248201526d50726f6f6620746f6f206c6f6e6760901b604482015260640161044a565b60008881526005602052604090205460ff16156108a65760405162461bcd60e51b8152602060048201526015602482015274119a5b1948185b1c9958591e481d995c9a599a5959605a1b604482015260640161044a565b60006108b488888888610fb3565b60008a81526002602052604090208054919250906108dd57898155600181018990554260048201555b33600090815260058201602052604090205460ff161561093f5760405162461bcd60e51b815260206004820181905260248201527f416c726561647920766572696669656420627920746869732061646472657373604482015260640161044a565b3360009081526005820160205260408120805460ff191660011790556003820180549161096b8361162b565b9190505550811561099b573360009081526004602052604081208054916109918361162b565b91905055506109d1565b33600090815260046020526040902054156109d1573360009081526004602052604081208054916109cb83611685565b91905055505b600654816003015410610a135760028101805483151560ff19918216811790925560008c815260056020526040902080549091169091179055610a138a6110a0565b60408051831515815242602082015233918b918d917f8a0ad150496891d984a0aabb6db8e35c32bfbd4a47acd2e8e4c44a5a6fad5481910160405180910390a45098975050505050505050565b610a68610d53565b4780610aaf5760405162461bcd60e51b81526020600482015260166024820152754e6f207265776172647320746f20776974686472617760501b604482015260640161044a565b600080546040516001600160a01b039091169183156108fc02918491818181858888f19350505050158015610ae8573d6000803e3d6000fd5b5050565b82826000825111610b375760405162461bcd60e51b815260206004820152601560248201527450726f6f662063616e6e6f7420626520656d70747960581b604482015260640161044a565b6000815111610b825760405162461bcd60e51b8152602060048201526017602482015276496e64696365732063616e6e6f7420626520656d70747960481b604482015260640161044a565b8051825114610ba35760405162461bcd60e51b815260040161044a90611644565b60085482511115610be75760405162461bcd60e51b815260206004820152600e60248201526d50726f6f6620746f6f206c6f6e6760901b604482015260640161044a565b610bef61111c565b60008781526005602052604090205460ff1615610c465760405162461bcd60e51b8152602060048201526015602482015274119a5b1948185b1c9958591e481d995c9a599a5959605a1b604482015260640161044a565b600087815260036020908152604090912086519091610c69918391890190611175565b508451610c7f9060018301906020880190611175565b506002810184905560038101879055604051428152339088908a907fbf6d5a75fbb0f09b654f2e10afef706c173808adbf06a5f41ac03ac15bfa62529060200160405180910390a450610cd160018055565b50505050505050565b610ce2610d53565b6001600160a01b038116610d475760405162461bcd60e51b815260206004820152602660248201527f4f776e61626c653a206e6577206f776e657220697320746865207a65726f206160448201526564647265737360d01b606482015260840161044a565b610d5081610dad565b50565b6000546001600160a01b031633146105e25760405162461bcd60e51b815260206004820181905260248201527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e6572604482015260640161044a565b600080546001600160a01b038381166001600160a01b0319831681178455604051919092169283917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e09190a35050565b6000610e0b85858585610fb3565b6000878152600260205260409020805491925090610e3457868155600181018690554260048201555b33600090815260058201602052604090205460ff1615610e965760405162461bcd60e51b815260206004820181905260248201527f416c726561647920766572696669656420627920746869732061646472657373604482015260640161044a565b3360009081526005820160205260408120805460ff1916600117905560038201805491610ec28361162b565b91905055508115610ef257336000908152600460205260408120805491610ee88361162b565b9190505550610f28565b3360009081526004602052604090205415610f2857336000908152600460205260408120805491610f2283611685565b91905055505b600654816003015410610f6a5760028101805483151560ff199182168117909255600089815260056020526040902080549091169091179055610f6a876110a0565b604080518315158152426020820152339188918a917f8a0ad150496891d984a0aabb6db8e35c32bfbd4a47acd2e8e4c44a5a6fad5481910160405180910390a450505050505050565b600081815b855181101561109457848181518110610fd357610fd36115ff565b602002602001015160000361103457858181518110610ff457610ff46115ff565b602002602001015182604051602001611017929190918252602082015260400190565b604051602081830303815290604052805190602001209150611082565b81868281518110611047576110476115ff565b6020026020010151604051602001611069929190918252602082015260400190565b6040516020818303038152906040528051906020012091505b8061108c8161162b565b915050610fb8565b50909414949350505050565b600081815260026020526040812060038101546007549192916110c3919061169c565b905060008260030154826110d791906116b9565b90507f92f96a03bef2d117f6cb166c330c75b2d08091142a78ab5d19e3bff63fa6c5b5836003015460405161110e91815260200190565b60405180910390a150505050565b60026001540361116e5760405162461bcd60e51b815260206004820152601f60248201527f5265656e7472616e637947756172643a207265656e7472616e742063616c6c00604482015260640161044a565b6002600155565b8280548282559060005260206000209081019282156111b0579160200282015b828111156111b0578251825591602001919060010190611195565b506111bc9291506111c0565b5090565b5b808211156111bc57600081556001016111c1565b6000602082840312156111e757600080fd5b5035919050565b60006020828403121561120057600080fd5b81356001600160a01b038116811461121757600080fd5b9392505050565b6080808252855190820181905260009060209060a0840190828901845b828110156112575781518452928401929084019060010161123b565b5050508381038285015286518082528783019183019060005b8181101561128c57835183529284019291840191600101611270565b505060408501969096525050506060015292915050565b634e487b7160e01b600052604160045260246000fd5b604051601f8201601f1916810167ffffffffffffffff811182821017156112e2576112e26112a3565b604052919050565b600067ffffffffffffffff821115611304576113046112a3565b5060051b60200190565b600082601f83011261131f57600080fd5b8135602061133461132f836112ea565b6112b9565b82815260059290921b8401810191818101908684111561135357600080fd5b8286015b8481101561136e5780358352918301918301611357565b509695505050505050565b600082601f83011261138a57600080fd5b8135602061139a61132f836112ea565b82815260059290921b840181019181810190868411156113b957600080fd5b8286015b8481101561136e57803567ffffffffffffffff8111156113dd5760008081fd5b6113eb8986838b010161130e565b8452509183019183016113bd565b600082601f83011261140a57600080fd5b8135602061141a61132f836112ea565b82815260059290921b8401810191818101908684111561143957600080fd5b8286015b8481101561136e57803567ffffffffffffffff81111561145d5760008081fd5b61146b8986838b010161130e565b84525091830191830161143d565b600080600080600060a0868803121561149157600080fd5b853567ffffffffffffffff808211156114a957600080fd5b6114b589838a0161130e565b965060208801359150808211156114cb57600080fd5b6114d789838a0161130e565b955060408801359150808211156114ed57600080fd5b6114f989838a01611379565b9450606088013591508082111561150f57600080fd5b61151b89838a016113f9565b9350608088013591508082111561153157600080fd5b5061153e8882890161130e565b9150509295509295909350565b6000806040838503121561155e57600080fd5b823591506020830135801515811461157557600080fd5b809150509250929050565b600080600080600060a0868803121561159857600080fd5b8535945060208601359350604086013567ffffffffffffffff808211156115be57600080fd5b6115ca89838a0161130e565b945060608801359150808211156115e057600080fd5b506115ed8882890161130e565b95989497509295608001359392505050565b634e487b7160e01b600052603260045260246000fd5b634e487b7160e01b600052601160045260246000fd5b60006001820161163d5761163d611615565b5060010190565b60208082526021908201527f50726f6f6620616e6420696e6469636573206c656e677468206d69736d6174636040820152600d60fb1b606082015260800190565b60008161169457611694611615565b506000190190565b80820281158282048414176116b3576116b3611615565b92915050565b6000826116d657634e487b7160e01b600052601260045260246000fd5b50049056fea2646970667358221220b6698805337b0c977c22e28056c9a09edeee26006bb1569a8bf6aeb397f6125a64736f6c63430008130033",
+}
+
+// ProofVerificationContractABI is the input ABI used to generate the binding from.
+// Deprecated: Use ProofVerificationContractMetaData.ABI instead.
+var ProofVerificationContractABI = ProofVerificationContractMetaData.ABI
+
+// ProofVerificationContractBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use ProofVerificationContractMetaData.Bin instead.
+var ProofVerificationContractBin = ProofVerificationContractMetaData.Bin
+
+// DeployProofVerificationContract deploys a new Ethereum contract, binding an instance of ProofVerificationContract to it.
+func DeployProofVerificationContract(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *ProofVerificationContract, error) {
+	parsed, err := ProofVerificationContractMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(ProofVerificationContractBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &ProofVerificationContract{ProofVerificationContractCaller: ProofVerificationContractCaller{contract: contract}, ProofVerificationContractTransactor: ProofVerificationContractTransactor{contract: contract}, ProofVerificationContractFilterer: ProofVerificationContractFilterer{contract: contract}}, nil
+}
+
+// ProofVerificationContract is an auto generated Go binding around an Ethereum contract.
+type ProofVerificationContract struct {
+	ProofVerificationContractCaller     // Read-only binding to the contract
+	ProofVerificationContractTransactor // Write-only binding to the contract
+	ProofVerificationContractFilterer   // Log filterer for contract events
+}
+
+// ProofVerificationContractCaller is an auto generated read-only Go binding around an Ethereum contract.
+type ProofVerificationContractCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ProofVerificationContractTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type ProofVerificationContractTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ProofVerificationContractFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ProofVerificationContractFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ProofVerificationContractSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ProofVerificationContractSession struct {
+	Contract     *ProofVerificationContract // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts              // Call options to use throughout this session
+	TransactOpts bind.TransactOpts          // Transaction auth options to use throughout this session
+}
+
+// ProofVerificationContractCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ProofVerificationContractCallerSession struct {
+	Contract *ProofVerificationContractCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                    // Call options to use throughout this session
+}
+
+// ProofVerificationContractTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ProofVerificationContractTransactorSession struct {
+	Contract     *ProofVerificationContractTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                    // Transaction auth options to use throughout this session
+}
+
+// ProofVerificationContractRaw is an auto generated low-level Go binding around an Ethereum contract.
+type ProofVerificationContractRaw struct {
+	Contract *ProofVerificationContract // Generic contract binding to access the raw methods on
+}
+
+// ProofVerificationContractCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ProofVerificationContractCallerRaw struct {
+	Contract *ProofVerificationContractCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// ProofVerificationContractTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ProofVerificationContractTransactorRaw struct {
+	Contract *ProofVerificationContractTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewProofVerificationContract creates a new instance of ProofVerificationContract, bound to a specific deployed contract.
+func NewProofVerificationContract(address common.Address, backend bind.ContractBackend) (*ProofVerificationContract, error) {
+	contract, err := bindProofVerificationContract(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &ProofVerificationContract{ProofVerificationContractCaller: ProofVerificationContractCaller{contract: contract}, ProofVerificationContractTransactor: ProofVerificationContractTransactor{contract: contract}, ProofVerificationContractFilterer: ProofVerificationContractFilterer{contract: contract}}, nil
+}
+
+// NewProofVerificationContractCaller creates a new read-only instance of ProofVerificationContract, bound to a specific deployed contract.
+func NewProofVerificationContractCaller(address common.Address, caller bind.ContractCaller) (*ProofVerificationContractCaller, error) {
+	contract, err := bindProofVerificationContract(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ProofVerificationContractCaller{contract: contract}, nil
+}
+
+// NewProofVerificationContractTransactor creates a new write-only instance of ProofVerificationContract, bound to a specific deployed contract.
+func NewProofVerificationContractTransactor(address common.Address, transactor bind.ContractTransactor) (*ProofVerificationContractTransactor, error) {
+	contract, err := bindProofVerificationContract(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ProofVerificationContractTransactor{contract: contract}, nil
+}
+
+// NewProofVerificationContractFilterer creates a new log filterer instance of ProofVerificationContract, bound to a specific deployed contract.
+func NewProofVerificationContractFilterer(address common.Address, filterer bind.ContractFilterer) (*ProofVerificationContractFilterer, error) {
+	contract, err := bindProofVerificationContract(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ProofVerificationContractFilterer{contract: contract}, nil
+}
+
+// bindProofVerificationContract binds a generic wrapper to an already deployed contract.
+func bindProofVerificationContract(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := ProofVerificationContractMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ProofVerificationContract *ProofVerificationContractRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ProofVerificationContract.Contract.ProofVerificationContractCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ProofVerificationContract *ProofVerificationContractRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ProofVerificationContract.Contract.ProofVerificationContractTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ProofVerificationContract *ProofVerificationContractRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ProofVerificationContract.Contract.ProofVerificationContractTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ProofVerificationContract *ProofVerificationContractCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ProofVerificationContract.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ProofVerificationContract *ProofVerificationContractTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ProofVerificationContract.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ProofVerificationContract *ProofVerificationContractTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ProofVerificationContract.Contract.contract.Transact(opts, method, params...)
+}
+
+// GetMerkleProof is a free data retrieval call binding the contract method 0x2e36b050.
+//
+// Solidity: function getMerkleProof(bytes32 fileHash) view returns(bytes32[], uint256[], bytes32, bytes32)
+func (_ProofVerificationContract *ProofVerificationContractCaller) GetMerkleProof(opts *bind.CallOpts, fileHash [32]byte) ([][32]byte, []*big.Int, [32]byte, [32]byte, error) {
+	var out []interface{}
+	err := _ProofVerificationContract.contract.Call(opts, &out, "getMerkleProof", fileHash)
+
+	if err != nil {
+		return *new([][32]byte), *new([]*big.Int), *new([32]byte), *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([][32]byte)).(*[][32]byte)
+	out1 := *abi.ConvertType(out[1], new([]*big.Int)).(*[]*big.Int)
+	out2 := *abi.ConvertType(out[2], new([32]byte)).(*[32]byte)
+	out3 := *abi.ConvertType(out[3], new([32]byte)).(*[32]byte)
+
+	return out0, out1, out2, out3, err
+
+}
+
+// GetMerkleProof is a free data retrieval call binding the contract method 0x2e36b050.
+//
+// Solidity: function getMerkleProof(bytes32 fileHash) view returns(bytes32[], uint256[], bytes32, bytes32)
+func (_ProofVerificationContract *ProofVerificationContractSession) GetMerkleProof(fileHash [32]byte) ([][32]byte, []*big.Int, [32]byte, [32]byte, error) {
+	return _ProofVerificationContract.Contract.GetMerkleProof(&_ProofVerificationContract.CallOpts, fileHash)
+}
+
+// GetMerkleProof is a free data retrieval call binding the contract method 0x2e36b050.
+//
+// Solidity: function getMerkleProof(bytes32 fileHash) view returns(bytes32[], uint256[], bytes32, bytes32)
+func (_ProofVerificationContract *ProofVerificationContractCallerSession) GetMerkleProof(fileHash [32]byte) ([][32]byte, []*big.Int, [32]byte, [32]byte, error) {
+	return _ProofVerificationContract.Contract.GetMerkleProof(&_ProofVerificationContract.CallOpts, fileHash)
+}
+
+// GetVerificationResult is a free data retrieval call binding the contract method 0x36beb697.
+//
+// Solidity: function getVerificationResult(bytes32 fileHash) view returns(bytes32, bytes32, bool, uint256, uint256)
+func (_ProofVerificationContract *ProofVerificationContractCaller) GetVerificationResult(opts *bind.CallOpts, fileHash [32]byte) ([32]byte, [32]byte, bool, *big.Int, *big.Int, error) {
+	var out []interface{}
+	err := _ProofVerificationContract.contract.Call(opts, &out, "getVerificationResult", fileHash)
+
+	if err != nil {
+		return *new([32]byte), *new([32]byte), *new(bool), *new(*big.Int), *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+	out1 := *abi.ConvertType(out[1], new([32]byte)).(*[32]byte)
+	out2 := *abi.ConvertType(out[2], new(bool)).(*bool)
+	out3 := *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+	out4 := *abi.ConvertType(out[4], new(*big.Int)).(**big.Int)
+
+	return out0, out1, out2, out3, out4, err
+
+}
+
+// GetVerificationResult is a free data retrieval call binding the contract method 0x36beb697.
+//
+// Solidity: function getVerificationResult(bytes32 fileHash) view returns(bytes32, bytes32, bool, uint256, uint256)
+func (_ProofVerificationContract *ProofVerificationContractSession) GetVerificationResult(fileHash [32]byte) ([32]byte, [32]byte, bool, *big.Int, *big.Int, error) {
+	return _ProofVerificationContract.Contract.GetVerificationResult(&_ProofVerificationContract.CallOpts, fileHash)
+}
+
+// GetVerificationResult is a free data retrieval call binding the contract method 0x36beb697.
+//
+// Solidity: function getVerificationResult(bytes32 fileHash) view returns(bytes32, bytes32, bool, uint256, uint256)
+func (_ProofVerificationContract *ProofVerificationContractCallerSession) GetVerificationResult(fileHash [32]byte) ([32]byte, [32]byte, bool, *big.Int, *big.Int, error) {
+	return _ProofVerificationContract.Contract.GetVerificationResult(&_ProofVerificationContract.CallOpts, fileHash)
+}
+
+// GetVerifierReputation is a free data retrieval call binding the contract method 0x82bab3eb.
+//
+// Solidity: function getVerifierReputation(address verifier) view returns(uint256)
+func (_ProofVerificationContract *ProofVerificationContractCaller) GetVerifierReputation(opts *bind.CallOpts, verifier common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _ProofVerificationContract.contract.Call(opts, &out, "getVerifierReputation", verifier)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetVerifierReputation is a free data retrieval call binding the contract method 0x82bab3eb.
+//
+// Solidity: function getVerifierReputation(address verifier) view returns(uint256)
+func (_ProofVerificationContract *ProofVerificationContractSession) GetVerifierReputation(verifier common.Address) (*big.Int, error) {
+	return _ProofVerificationContract.Contract.GetVerifierReputation(&_ProofVerificationContract.CallOpts, verifier)
+}
+
+// GetVerifierReputation is a free data retrieval call binding the contract method 0x82bab3eb.
+//
+// Solidity: function getVerifierReputation(address verifier) view returns(uint256)
+func (_ProofVerificationContract *ProofVerificationContractCallerSession) GetVerifierReputation(verifier common.Address) (*big.Int, error) {
+	return _ProofVerificationContract.Contract.GetVerifierReputation(&_ProofVerificationContract.CallOpts, verifier)
+}
+
+// IsFileVerified is a free data retrieval call binding the contract method 0x738ee5e6.
+//
+// Solidity: function isFileVerified(bytes32 fileHash) view returns(bool)
+func (_ProofVerificationContract *ProofVerificationContractCaller) IsFileVerified(opts *bind.CallOpts, fileHash [32]byte) (bool, error) {
+	var out []interface{}
+	err := _ProofVerificationContract.contract.Call(opts, &out, "isFileVerified", fileHash)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsFileVerified is a free data retrieval call binding the contract method 0x738ee5e6.
+//
+// Solidity: function isFileVerified(bytes32 fileHash) view returns(bool)
+func (_ProofVerificationContract *ProofVerificationContractSession) IsFileVerified(fileHash [32]byte) (bool, error) {
+	return _ProofVerificationContract.Contract.IsFileVerified(&_ProofVerificationContract.CallOpts, fileHash)
+}
+
+// IsFileVerified is a free data retrieval call binding the contract method 0x738ee5e6.
+//
+// Solidity: function isFileVerified(bytes32 fileHash) view returns(bool)
+func (_ProofVerificationContract *ProofVerificationContractCallerSession) IsFileVerified(fileHash [32]byte) (bool, error) {
+	return _ProofVerificationContract.Contract.IsFileVerified(&_ProofVerificationContract.CallOpts, fileHash)
+}
+
+// MaxProofLength is a free data retrieval call binding the contract method 0xc98da654.
+//
+// Solidity: function maxProofLength() view returns(uint256)
+func (_ProofVerificationContract *ProofVerificationContractCaller) MaxProofLength(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ProofVerificationContract.contract.Call(opts, &out, "maxProofLength")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MaxProofLength is a free data retrieval call binding the contract method 0xc98da654.
+//
+// Solidity: function maxProofLength() view returns(uint256)
+func (_ProofVerificationContract *ProofVerificationContractSession) MaxProofLength() (*big.Int, error) {
+	return _ProofVerificationContract.Contract.MaxProofLength(&_ProofVerificationContract.CallOpts)
+}
+
+// MaxProofLength is a free data retrieval call binding the contract method 0xc98da654.
+//
+// Solidity: function maxProofLength() view returns(uint256)
+func (_ProofVerificationContract *ProofVerificationContractCallerSession) MaxProofLength() (*big.Int, error) {
+	return _ProofVerificationContract.Contract.MaxProofLength(&_ProofVerificationContract.CallOpts)
+}
+
+// MerkleProofs is a free data retrieval call binding the contract method 0x6df1409f.
+//
+// Solidity: function merkleProofs(bytes32 ) view returns(bytes32 leafHash, bytes32 rootHash)
+func (_ProofVerificationContract *ProofVerificationContractCaller) MerkleProofs(opts *bind.CallOpts, arg0 [32]byte) (struct {
+	LeafHash [32]byte
+	RootHash [32]byte
+}, error) {
+	var out []interface{}
+	err := _ProofVerificationContract.contract.Call(opts, &out, "merkleProofs", arg0)
+
+	outstruct := new(struct {
+		LeafHash [32]byte
+		RootHash [32]byte
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.LeafHash = *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+	outstruct.RootHash = *abi.ConvertType(out[1], new([32]byte)).(*[32]byte)
+
+	return *outstruct, err
+
+}
+
+// MerkleProofs is a free data retrieval call binding the contract method 0x6df1409f.
+//
+// Solidity: function merkleProofs(bytes32 ) view returns(bytes32 leafHash, bytes32 rootHash)
+func (_ProofVerificationContract *ProofVerificationContractSession) MerkleProofs(arg0 [32]byte) (struct {
+	LeafHash [32]byte
+	RootHash [32]byte
+}, error) {
+	return _ProofVerificationContract.Contract.MerkleProofs(&_ProofVerificationContract.CallOpts, arg0)
+}
+
+// MerkleProofs is a free data retrieval call binding the contract method 0x6df1409f.
+//
+// Solidity: function merkleProofs(bytes32 ) view returns(bytes32 leafHash, bytes32 rootHash)
+func (_ProofVerificationContract *ProofVerificationContractCallerSession) MerkleProofs(arg0 [32]byte) (struct {
+	LeafHash [32]byte
+	RootHash [32]byte
+}, error) {
+	return _ProofVerificationContract.Contract.MerkleProofs(&_ProofVerificationContract.CallOpts, arg0)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_ProofVerificationContract *ProofVerificationContractCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ProofVerificationContract.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_ProofVerificationContract *ProofVerificationContractSession) Owner() (common.Address, error) {
+	return _ProofVerificationContract.Contract.Owner(&_ProofVerificationContract.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_ProofVerificationContract *ProofVerificationContractCallerSession) Owner() (common.Address, error) {
+	return _ProofVerificationContract.Contract.Owner(&_ProofVerificationContract.CallOpts)
+}
+
+// VerificationResults is a free data retrieval call binding the contract method 0x7e5d70a0.
+//
+// Solidity: function verificationResults(bytes32 ) view returns(bytes32 fileHash, bytes32 merkleRoot, bool isValid, uint256 verificationCount, uint256 timestamp)
+func (_ProofVerificationContract *ProofVerificationContractCaller) VerificationResults(opts *bind.CallOpts, arg0 [32]byte) (struct {
+	FileHash          [32]byte
+	MerkleRoot        [32]byte
+	IsValid           bool
+	VerificationCount *big.Int
+	Timestamp         *big.Int
+}, error) {
+	var out []interface{}
+	err := _ProofVerificationContract.contract.Call(opts, &out, "verificationResults", arg0)
+
+	outstruct := new(struct {
+		FileHash          [32]byte
+		MerkleRoot        [32]byte
+		IsValid           bool
+		VerificationCount *big.Int
+		Timestamp         *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.FileHash = *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+	outstruct.MerkleRoot = *abi.ConvertType(out[1], new([32]byte)).(*[32]byte)
+	outstruct.IsValid = *abi.ConvertType(out[2], new(bool)).(*bool)
+	outstruct.VerificationCount = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+	outstruct.Timestamp = *abi.ConvertType(out[4], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// VerificationResults is a free data retrieval call binding the contract method 0x7e5d70a0.
+//
+// Solidity: function verificationResults(bytes32 ) view returns(bytes32 fileHash, bytes32 merkleRoot, bool isValid, uint256 verificationCount, uint256 timestamp)
+func (_ProofVerificationContract *ProofVerificationContractSession) VerificationResults(arg0 [32]byte) (struct {
+	FileHash          [32]byte
+	MerkleRoot        [32]byte
+	IsValid           bool
+	VerificationCount *big.Int
+	Timestamp         *big.Int
+}, error) {
+	return _ProofVerificationContract.Contract.VerificationResults(&_ProofVerificationContract.CallOpts, arg0)
+}
+
+// VerificationResults is a free data retrieval call binding the contract method 0x7e5d70a0.
+//
+// Solidity: function verificationResults(bytes32 ) view returns(bytes32 fileHash, bytes32 merkleRoot, bool isValid, uint256 verificationCount, uint256 timestamp)
+func (_ProofVerificationContract *ProofVerificationContractCallerSession) VerificationResults(arg0 [32]byte) (struct {
+	FileHash          [32]byte
+	MerkleRoot        [32]byte
+	IsValid           bool
+	VerificationCount *big.Int
+	Timestamp         *big.Int
+}, error) {
+	return _ProofVerificationContract.Contract.VerificationResults(&_ProofVerificationContract.CallOpts, arg0)
+}
+
+// VerificationReward is a free data retrieval call binding the contract method 0x082fa374.
+//
+// Solidity: function verificationReward() view returns(uint256)
+func (_ProofVerificationContract *ProofVerificationContractCaller) VerificationReward(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ProofVerificationContract.contract.Call(opts, &out, "verificationReward")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// VerificationReward is a free data retrieval call binding the contract method 0x082fa374.
+//
+// Solidity: function verificationReward() view returns(uint256)
+func (_ProofVerificationContract *ProofVerificationContractSession) VerificationReward() (*big.Int, error) {
+	return _ProofVerificationContract.Contract.VerificationReward(&_ProofVerificationContract.CallOpts)
+}
+
+// VerificationReward is a free data retrieval call binding the contract method 0x082fa374.
+//
+// Solidity: function verificationReward() view returns(uint256)
+func (_ProofVerificationContract *ProofVerificationContractCallerSession) VerificationReward() (*big.Int, error) {
+	return _ProofVerificationContract.Contract.VerificationReward(&_ProofVerificationContract.CallOpts)
+}
+
+// VerificationThreshold is a free data retrieval call binding the contract method 0x167feb09.
+//
+// Solidity: function verificationThreshold() view returns(uint256)
+func (_ProofVerificationContract *ProofVerificationContractCaller) VerificationThreshold(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ProofVerificationContract.contract.Call(opts, &out, "verificationThreshold")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// VerificationThreshold is a free data retrieval call binding the contract method 0x167feb09.
+//
+// Solidity: function verificationThreshold() view returns(uint256)
+func (_ProofVerificationContract *ProofVerificationContractSession) VerificationThreshold() (*big.Int, error) {
+	return _ProofVerificationContract.Contract.VerificationThreshold(&_ProofVerificationContract.CallOpts)
+}
+
+// VerificationThreshold is a free data retrieval call binding the contract method 0x167feb09.
+//
+// Solidity: function verificationThreshold() view returns(uint256)
+func (_ProofVerificationContract *ProofVerificationContractCallerSession) VerificationThreshold() (*big.Int, error) {
+	return _ProofVerificationContract.Contract.VerificationThreshold(&_ProofVerificationContract.CallOpts)
+}
+
+// VerifiedFiles is a free data retrieval call binding the contract method 0x782184cf.
+//
+// Solidity: function verifiedFiles(bytes32 ) view returns(bool)
+func (_ProofVerificationContract *ProofVerificationContractCaller) VerifiedFiles(opts *bind.CallOpts, arg0 [32]byte) (bool, error) {
+	var out []interface{}
+	err := _ProofVerificationContract.contract.Call(opts, &out, "verifiedFiles", arg0)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// VerifiedFiles is a free data retrieval call binding the contract method 0x782184cf.
+//
+// Solidity: function verifiedFiles(bytes32 ) view returns(bool)
+func (_ProofVerificationContract *ProofVerificationContractSession) VerifiedFiles(arg0 [32]byte) (bool, error) {
+	return _ProofVerificationContract.Contract.VerifiedFiles(&_ProofVerificationContract.CallOpts, arg0)
+}
+
+// VerifiedFiles is a free data retrieval call binding the contract method 0x782184cf.
+//
+// Solidity: function verifiedFiles(bytes32 ) view returns(bool)
+func (_ProofVerificationContract *ProofVerificationContractCallerSession) VerifiedFiles(arg0 [32]byte) (bool, error) {
+	return _ProofVerificationContract.Contract.VerifiedFiles(&_ProofVerificationContract.CallOpts, arg0)
+}
+
+// VerifierReputation is a free data retrieval call binding the contract method 0x1aea9fd1.
+//
+// Solidity: function verifierReputation(address ) view returns(uint256)
+func (_ProofVerificationContract *ProofVerificationContractCaller) VerifierReputation(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _ProofVerificationContract.contract.Call(opts, &out, "verifierReputation", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// VerifierReputation is a free data retrieval call binding the contract method 0x1aea9fd1.
+//
+// Solidity: function verifierReputation(address ) view returns(uint256)
+func (_ProofVerificationContract *ProofVerificationContractSession) VerifierReputation(arg0 common.Address) (*big.Int, error) {
+	return _ProofVerificationContract.Contract.VerifierReputation(&_ProofVerificationContract.CallOpts, arg0)
+}
+
+// VerifierReputation is a free data retrieval call binding the contract method 0x1aea9fd1.
+//
+// Solidity: function verifierReputation(address ) view returns(uint256)
+func (_ProofVerificationContract *ProofVerificationContractCallerSession) VerifierReputation(arg0 common.Address) (*big.Int, error) {
+	return _ProofVerificationContract.Contract.VerifierReputation(&_ProofVerificationContract.CallOpts, arg0)
+}
+
+// BatchVerifyProofs is a paid mutator transaction binding the contract method 0x7166a9eb.
+//
+// Solidity: function batchVerifyProofs(bytes32[] fileHashes, bytes32[] merkleRoots, bytes32[][] proofs, uint256[][] indicesArray, bytes32[] leafHashes) returns()
+func (_ProofVerificationContract *ProofVerificationContractTransactor) BatchVerifyProofs(opts *bind.TransactOpts, fileHashes [][32]byte, merkleRoots [][32]byte, proofs [][][32]byte, indicesArray [][]*big.Int, leafHashes [][32]byte) (*types.Transaction, error) {
+	return _ProofVerificationContract.contract.Transact(opts, "batchVerifyProofs", fileHashes, merkleRoots, proofs, indicesArray, leafHashes)
+}
+
+// BatchVerifyProofs is a paid mutator transaction binding the contract method 0x7166a9eb.
+//
+// Solidity: function batchVerifyProofs(bytes32[] fileHashes, bytes32[] merkleRoots, bytes32[][] proofs, uint256[][] indicesArray, bytes32[] leafHashes) returns()
+func (_ProofVerificationContract *ProofVerificationContractSession) BatchVerifyProofs(fileHashes [][32]byte, merkleRoots [][32]byte, proofs [][][32]byte, indicesArray [][]*big.Int, leafHashes [][32]byte) (*types.Transaction, error) {
+	return _ProofVerificationContract.Contract.BatchVerifyProofs(&_ProofVerificationContract.TransactOpts, fileHashes, merkleRoots, proofs, indicesArray, leafHashes)
+}
+
+// BatchVerifyProofs is a paid mutator transaction binding the contract method 0x7166a9eb.
+//
+// Solidity: function batchVerifyProofs(bytes32[] fileHashes, bytes32[] merkleRoots, bytes32[][] proofs, uint256[][] indicesArray, bytes32[] leafHashes) returns()
+func (_ProofVerificationContract *ProofVerificationContractTransactorSession) BatchVerifyProofs(fileHashes [][32]byte, merkleRoots [][32]byte, proofs [][][32]byte, indicesArray [][]*big.Int, leafHashes [][32]byte) (*types.Transaction, error) {
+	return _ProofVerificationContract.Contract.BatchVerifyProofs(&_ProofVerificationContract.TransactOpts, fileHashes, merkleRoots, proofs, indicesArray, leafHashes)
+}
+
+// EmergencyVerify is a paid mutator transaction binding the contract method 0x77e2a07a.
+//
+// Solidity: function emergencyVerify(bytes32 fileHash, bool isValid) returns()
+func (_ProofVerificationContract *ProofVerificationContractTransactor) EmergencyVerify(opts *bind.TransactOpts, fileHash [32]byte, isValid bool) (*types.Transaction, error) {
+	return _ProofVerificationContract.contract.Transact(opts, "emergencyVerify", fileHash, isValid)
+}
+
+// EmergencyVerify is a paid mutator transaction binding the contract method 0x77e2a07a.
+//
+// Solidity: function emergencyVerify(bytes32 fileHash, bool isValid) returns()
+func (_ProofVerificationContract *ProofVerificationContractSession) EmergencyVerify(fileHash [32]byte, isValid bool) (*types.Transaction, error) {
+	return _ProofVerificationContract.Contract.EmergencyVerify(&_ProofVerificationContract.TransactOpts, fileHash, isValid)
+}
+
+// EmergencyVerify is a paid mutator transaction binding the contract method 0x77e2a07a.
+//
+// Solidity: function emergencyVerify(bytes32 fileHash, bool isValid) returns()
+func (_ProofVerificationContract *ProofVerificationContractTransactorSession) EmergencyVerify(fileHash [32]byte, isValid bool) (*types.Transaction, error) {
+	return _ProofVerificationContract.Contract.EmergencyVerify(&_ProofVerificationContract.TransactOpts, fileHash, isValid)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_ProofVerificationContract *ProofVerificationContractTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ProofVerificationContract.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_ProofVerificationContract *ProofVerificationContractSession) RenounceOwnership() (*types.Transaction, error) {
+	return _ProofVerificationContract.Contract.RenounceOwnership(&_ProofVerificationContract.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_ProofVerificationContract *ProofVerificationContractTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _ProofVerificationContract.Contract.RenounceOwnership(&_ProofVerificationContract.TransactOpts)
+}
+
+// SetMaxProofLength is a paid mutator transaction binding the contract method 0x0666fa05.
+//
+// Solidity: function setMaxProofLength(uint256 newMaxLength) returns()
+func (_ProofVerificationContract *ProofVerificationContractTransactor) SetMaxProofLength(opts *bind.TransactOpts, newMaxLength *big.Int) (*types.Transaction, error) {
+	return _ProofVerificationContract.contract.Transact(opts, "setMaxProofLength", newMaxLength)
+}
+
+// SetMaxProofLength is a paid mutator transaction binding the contract method 0x0666fa05.
+//
+// Solidity: function setMaxProofLength(uint256 newMaxLength) returns()
+func (_ProofVerificationContract *ProofVerificationContractSession) SetMaxProofLength(newMaxLength *big.Int) (*types.Transaction, error) {
+	return _ProofVerificationContract.Contract.SetMaxProofLength(&_ProofVerificationContract.TransactOpts, newMaxLength)
+}
+
+// SetMaxProofLength is a paid mutator transaction binding the contract method 0x0666fa05.
+//
+// Solidity: function setMaxProofLength(uint256 newMaxLength) returns()
+func (_ProofVerificationContract *ProofVerificationContractTransactorSession) SetMaxProofLength(newMaxLength *big.Int) (*types.Transaction, error) {
+	return _ProofVerificationContract.Contract.SetMaxProofLength(&_ProofVerificationContract.TransactOpts, newMaxLength)
+}
+
+// SetVerificationReward is a paid mutator transaction binding the contract method 0x6e902e31.
+//
+// Solidity: function setVerificationReward(uint256 newReward) returns()
+func (_ProofVerificationContract *ProofVerificationContractTransactor) SetVerificationReward(opts *bind.TransactOpts, newReward *big.Int) (*types.Transaction, error) {
+	return _ProofVerificationContract.contract.Transact(opts, "setVerificationReward", newReward)
+}
+
+// SetVerificationReward is a paid mutator transaction binding the contract method 0x6e902e31.
+//
+// Solidity: function setVerificationReward(uint256 newReward) returns()
+func (_ProofVerificationContract *ProofVerificationContractSession) SetVerificationReward(newReward *big.Int) (*types.Transaction, error) {
+	return _ProofVerificationContract.Contract.SetVerificationReward(&_ProofVerificationContract.TransactOpts, newReward)
+}
+
+// SetVerificationReward is a paid mutator transaction binding the contract method 0x6e902e31.
+//
+// Solidity: function setVerificationReward(uint256 newReward) returns()
+func (_ProofVerificationContract *ProofVerificationContractTransactorSession) SetVerificationReward(newReward *big.Int) (*types.Transaction, error) {
+	return _ProofVerificationContract.Contract.SetVerificationReward(&_ProofVerificationContract.TransactOpts, newReward)
+}
+
+// SetVerificationThreshold is a paid mutator transaction binding the contract method 0x38cc32c4.
+//
+// Solidity: function setVerificationThreshold(uint256 newThreshold) returns()
+func (_ProofVerificationContract *ProofVerificationContractTransactor) SetVerificationThreshold(opts *bind.TransactOpts, newThreshold *big.Int) (*types.Transaction, error) {
+	return _ProofVerificationContract.contract.Transact(opts, "setVerificationThreshold", newThreshold)
+}
+
+// SetVerificationThreshold is a paid mutator transaction binding the contract method 0x38cc32c4.
+//
+// Solidity: function setVerificationThreshold(uint256 newThreshold) returns()
+func (_ProofVerificationContract *ProofVerificationContractSession) SetVerificationThreshold(newThreshold *big.Int) (*types.Transaction, error) {
+	return _ProofVerificationContract.Contract.SetVerificationThreshold(&_ProofVerificationContract.TransactOpts, newThreshold)
+}
+
+// SetVerificationThreshold is a paid mutator transaction binding the contract method 0x38cc32c4.
+//
+// Solidity: function setVerificationThreshold(uint256 newThreshold) returns()
+func (_ProofVerificationContract *ProofVerificationContractTransactorSession) SetVerificationThreshold(newThreshold *big.Int) (*types.Transaction, error) {
+	return _ProofVerificationContract.Contract.SetVerificationThreshold(&_ProofVerificationContract.TransactOpts, newThreshold)
+}
+
+// SubmitProof is a paid mutator transaction binding the contract method 0xe95c28c3.
+//
+// Solidity: function submitProof(bytes32 fileHash, bytes32 merkleRoot, bytes32[] proof, uint256[] indices, bytes32 leafHash) returns()
+func (_ProofVerificationContract *ProofVerificationContractTransactor) SubmitProof(opts *bind.TransactOpts, fileHash [32]byte, merkleRoot [32]byte, proof [][32]byte, indices []*big.Int, leafHash [32]byte) (*types.Transaction, error) {
+	return _ProofVerificationContract.contract.Transact(opts, "submitProof", fileHash, merkleRoot, proof, indices, leafHash)
+}
+
+// SubmitProof is a paid mutator transaction binding the contract method 0xe95c28c3.
+//
+// Solidity: function submitProof(bytes32 fileHash, bytes32 merkleRoot, bytes32[] proof, uint256[] indices, bytes32 leafHash) returns()
+func (_ProofVerificationContract *ProofVerificationContractSession) SubmitProof(fileHash [32]byte, merkleRoot [32]byte, proof [][32]byte, indices []*big.Int, leafHash [32]byte) (*types.Transaction, error) {
+	return _ProofVerificationContract.Contract.SubmitProof(&_ProofVerificationContract.TransactOpts, fileHash, merkleRoot, proof, indices, leafHash)
+}
+
+// SubmitProof is a paid mutator transaction binding the contract method 0xe95c28c3.
+//
+// Solidity: function submitProof(bytes32 fileHash, bytes32 merkleRoot, bytes32[] proof, uint256[] indices, bytes32 leafHash) returns()
+func (_ProofVerificationContract *ProofVerificationContractTransactorSession) SubmitProof(fileHash [32]byte, merkleRoot [32]byte, proof [][32]byte, indices []*big.Int, leafHash [32]byte) (*types.Transaction, error) {
+	return _ProofVerificationContract.Contract.SubmitProof(&_ProofVerificationContract.TransactOpts, fileHash, merkleRoot, proof, indices, leafHash)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_ProofVerificationContract *ProofVerificationContractTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _ProofVerificationContract.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_ProofVerificationContract *ProofVerificationContractSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _ProofVerificationContract.Contract.TransferOwnership(&_ProofVerificationContract.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_ProofVerificationContract *ProofVerificationContractTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _ProofVerificationContract.Contract.TransferOwnership(&_ProofVerificationContract.TransactOpts, newOwner)
+}
+
+// VerifyProof is a paid mutator transaction binding the contract method 0xa6859ba8.
+//
+// Solidity: function verifyProof(bytes32 fileHash, bytes32 merkleRoot, bytes32[] proof, uint256[] indices, bytes32 leafHash) returns(bool)
+func (_ProofVerificationContract *ProofVerificationContractTransactor) VerifyProof(opts *bind.TransactOpts, fileHash [32]byte, merkleRoot [32]byte, proof [][32]byte, indices []*big.Int, leafHash [32]byte) (*types.Transaction, error) {
+	return _ProofVerificationContract.contract.Transact(opts, "verifyProof", fileHash, merkleRoot, proof, indices, leafHash)
+}
+
+// VerifyProof is a paid mutator transaction binding the contract method 0xa6859ba8.
+//
+// Solidity: function verifyProof(bytes32 fileHash, bytes32 merkleRoot, bytes32[] proof, uint256[] indices, bytes32 leafHash) returns(bool)
+func (_ProofVerificationContract *ProofVerificationContractSession) VerifyProof(fileHash [32]byte, merkleRoot [32]byte, proof [][32]byte, indices []*big.Int, leafHash [32]byte) (*types.Transaction, error) {
+	return _ProofVerificationContract.Contract.VerifyProof(&_ProofVerificationContract.TransactOpts, fileHash, merkleRoot, proof, indices, leafHash)
+}
+
+// VerifyProof is a paid mutator transaction binding the contract method 0xa6859ba8.
+//
+// Solidity: function verifyProof(bytes32 fileHash, bytes32 merkleRoot, bytes32[] proof, uint256[] indices, bytes32 leafHash) returns(bool)
+func (_ProofVerificationContract *ProofVerificationContractTransactorSession) VerifyProof(fileHash [32]byte, merkleRoot [32]byte, proof [][32]byte, indices []*big.Int, leafHash [32]byte) (*types.Transaction, error) {
+	return _ProofVerificationContract.Contract.VerifyProof(&_ProofVerificationContract.TransactOpts, fileHash, merkleRoot, proof, indices, leafHash)
+}
+
+// WithdrawRewards is a paid mutator transaction binding the contract method 0xc7b8981c.
+//
+// Solidity: function withdrawRewards() returns()
+func (_ProofVerificationContract *ProofVerificationContractTransactor) WithdrawRewards(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ProofVerificationContract.contract.Transact(opts, "withdrawRewards")
+}
+
+// WithdrawRewards is a paid mutator transaction binding the contract method 0xc7b8981c.
+//
+// Solidity: function withdrawRewards() returns()
+func (_ProofVerificationContract *ProofVerificationContractSession) WithdrawRewards() (*types.Transaction, error) {
+	return _ProofVerificationContract.Contract.WithdrawRewards(&_ProofVerificationContract.TransactOpts)
+}
+
+// WithdrawRewards is a paid mutator transaction binding the contract method 0xc7b8981c.
+//
+// Solidity: function withdrawRewards() returns()
+func (_ProofVerificationContract *ProofVerificationContractTransactorSession) WithdrawRewards() (*types.Transaction, error) {
+	return _ProofVerificationContract.Contract.WithdrawRewards(&_ProofVerificationContract.TransactOpts)
+}
+
+// ProofVerificationContractOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the ProofVerificationContract contract.
+type ProofVerificationContractOwnershipTransferredIterator struct {
+	Event *ProofVerificationContractOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ProofVerificationContractOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ProofVerificationContractOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ProofVerificationContractOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ProofVerificationContractOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ProofVerificationContractOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ProofVerificationContractOwnershipTransferred represents a OwnershipTransferred event raised by the ProofVerificationContract contract.
+type ProofVerificationContractOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_ProofVerificationContract *ProofVerificationContractFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*ProofVerificationContractOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _ProofVerificationContract.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ProofVerificationContractOwnershipTransferredIterator{contract: _ProofVerificationContract.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_ProofVerificationContract *ProofVerificationContractFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *ProofVerificationContractOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _ProofVerificationContract.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ProofVerificationContractOwnershipTransferred)
+				if err := _ProofVerificationContract.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_ProofVerificationContract *ProofVerificationContractFilterer) ParseOwnershipTransferred(log types.Log) (*ProofVerificationContractOwnershipTransferred, error) {
+	event := new(ProofVerificationContractOwnershipTransferred)
+	if err := _ProofVerificationContract.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ProofVerificationContractProofSubmittedIterator is returned from FilterProofSubmitted and is used to iterate over the raw logs and unpacked data for ProofSubmitted events raised by the ProofVerificationContract contract.
+type ProofVerificationContractProofSubmittedIterator struct {
+	Event *ProofVerificationContractProofSubmitted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ProofVerificationContractProofSubmittedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ProofVerificationContractProofSubmitted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ProofVerificationContractProofSubmitted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ProofVerificationContractProofSubmittedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ProofVerificationContractProofSubmittedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ProofVerificationContractProofSubmitted represents a ProofSubmitted event raised by the ProofVerificationContract contract.
+type ProofVerificationContractProofSubmitted struct {
+	FileHash   [32]byte
+	MerkleRoot [32]byte
+	Submitter  common.Address
+	Timestamp  *big.Int
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterProofSubmitted is a free log retrieval operation binding the contract event 0xbf6d5a75fbb0f09b654f2e10afef706c173808adbf06a5f41ac03ac15bfa6252.
+//
+// Solidity: event ProofSubmitted(bytes32 indexed fileHash, bytes32 indexed merkleRoot, address indexed submitter, uint256 timestamp)
+func (_ProofVerificationContract *ProofVerificationContractFilterer) FilterProofSubmitted(opts *bind.FilterOpts, fileHash [][32]byte, merkleRoot [][32]byte, submitter []common.Address) (*ProofVerificationContractProofSubmittedIterator, error) {
+
+	var fileHashRule []interface{}
+	for _, fileHashItem := range fileHash {
+		fileHashRule = append(fileHashRule, fileHashItem)
+	}
+	var merkleRootRule []interface{}
+	for _, merkleRootItem := range merkleRoot {
+		merkleRootRule = append(merkleRootRule, merkleRootItem)
+	}
+	var submitterRule []interface{}
+	for _, submitterItem := range submitter {
+		submitterRule = append(submitterRule, submitterItem)
+	}
+
+	logs, sub, err := _ProofVerificationContract.contract.FilterLogs(opts, "ProofSubmitted", fileHashRule, merkleRootRule, submitterRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ProofVerificationContractProofSubmittedIterator{contract: _ProofVerificationContract.contract, event: "ProofSubmitted", logs: logs, sub: sub}, nil
+}
+
+// WatchProofSubmitted is a free log subscription operation binding the contract event 0xbf6d5a75fbb0f09b654f2e10afef706c173808adbf06a5f41ac03ac15bfa6252.
+//
+// Solidity: event ProofSubmitted(bytes32 indexed fileHash, bytes32 indexed merkleRoot, address indexed submitter, uint256 timestamp)
+func (_ProofVerificationContract *ProofVerificationContractFilterer) WatchProofSubmitted(opts *bind.WatchOpts, sink chan<- *ProofVerificationContractProofSubmitted, fileHash [][32]byte, merkleRoot [][32]byte, submitter []common.Address) (event.Subscription, error) {
+
+	var fileHashRule []interface{}
+	for _, fileHashItem := range fileHash {
+		fileHashRule = append(fileHashRule, fileHashItem)
+	}
+	var merkleRootRule []interface{}
+	for _, merkleRootItem := range merkleRoot {
+		merkleRootRule = append(merkleRootRule, merkleRootItem)
+	}
+	var submitterRule []interface{}
+	for _, submitterItem := range submitter {
+		submitterRule = append(submitterRule, submitterItem)
+	}
+
+	logs, sub, err := _ProofVerificationContract.contract.WatchLogs(opts, "ProofSubmitted", fileHashRule, merkleRootRule, submitterRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ProofVerificationContractProofSubmitted)
+				if err := _ProofVerificationContract.contract.UnpackLog(event, "ProofSubmitted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseProofSubmitted is a log parse operation binding the contract event 0xbf6d5a75fbb0f09b654f2e10afef706c173808adbf06a5f41ac03ac15bfa6252.
+//
+// Solidity: event ProofSubmitted(bytes32 indexed fileHash, bytes32 indexed merkleRoot, address indexed submitter, uint256 timestamp)
+func (_ProofVerificationContract *ProofVerificationContractFilterer) ParseProofSubmitted(log types.Log) (*ProofVerificationContractProofSubmitted, error) {
+	event := new(ProofVerificationContractProofSubmitted)
+	if err := _ProofVerificationContract.contract.UnpackLog(event, "ProofSubmitted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ProofVerificationContractProofVerifiedIterator is returned from FilterProofVerified and is used to iterate over the raw logs and unpacked data for ProofVerified events raised by the ProofVerificationContract contract.
+type ProofVerificationContractProofVerifiedIterator struct {
+	Event *ProofVerificationContractProofVerified // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ProofVerificationContractProofVerifiedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ProofVerificationContractProofVerified)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ProofVerificationContractProofVerified)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ProofVerificationContractProofVerifiedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ProofVerificationContractProofVerifiedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ProofVerificationContractProofVerified represents a ProofVerified event raised by the ProofVerificationContract contract.
+type ProofVerificationContractProofVerified struct {
+	FileHash   [32]byte
+	MerkleRoot [32]byte
+	Verifier   common.Address
+	IsValid    bool
+	Timestamp  *big.Int
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterProofVerified is a free log retrieval operation binding the contract event 0x8a0ad150496891d984a0aabb6db8e35c32bfbd4a47acd2e8e4c44a5a6fad5481.
+//
+// Solidity: event ProofVerified(bytes32 indexed fileHash, bytes32 indexed merkleRoot, address indexed verifier, bool isValid, uint256 timestamp)
+func (_ProofVerificationContract *ProofVerificationContractFilterer) FilterProofVerified(opts *bind.FilterOpts, fileHash [][32]byte, merkleRoot [][32]byte, verifier []common.Address) (*ProofVerificationContractProofVerifiedIterator, error) {
+
+	var fileHashRule []interface{}
+	for _, fileHashItem := range fileHash {
+		fileHashRule = append(fileHashRule, fileHashItem)
+	}
+	var merkleRootRule []interface{}
+	for _, merkleRootItem := range merkleRoot {
+		merkleRootRule = append(merkleRootRule, merkleRootItem)
+	}
+	var verifierRule []interface{}
+	for _, verifierItem := range verifier {
+		verifierRule = append(verifierRule, verifierItem)
+	}
+
+	logs, sub, err := _ProofVerificationContract.contract.FilterLogs(opts, "ProofVerified", fileHashRule, merkleRootRule, verifierRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ProofVerificationContractProofVerifiedIterator{contract: _ProofVerificationContract.contract, event: "ProofVerified", logs: logs, sub: sub}, nil
+}
+
+// WatchProofVerified is a free log subscription operation binding the contract event 0x8a0ad150496891d984a0aabb6db8e35c32bfbd4a47acd2e8e4c44a5a6fad5481.
+//
+// Solidity: event ProofVerified(bytes32 indexed fileHash, bytes32 indexed merkleRoot, address indexed verifier, bool isValid, uint256 timestamp)
+func (_ProofVerificationContract *ProofVerificationContractFilterer) WatchProofVerified(opts *bind.WatchOpts, sink chan<- *ProofVerificationContractProofVerified, fileHash [][32]byte, merkleRoot [][32]byte, verifier []common.Address) (event.Subscription, error) {
+
+	var fileHashRule []interface{}
+	for _, fileHashItem := range fileHash {
+		fileHashRule = append(fileHashRule, fileHashItem)
+	}
+	var merkleRootRule []interface{}
+	for _, merkleRootItem := range merkleRoot {
+		merkleRootRule = append(merkleRootRule, merkleRootItem)
+	}
+	var verifierRule []interface{}
+	for _, verifierItem := range verifier {
+		verifierRule = append(verifierRule, verifierItem)
+	}
+
+	logs, sub, err := _ProofVerificationContract.contract.WatchLogs(opts, "ProofVerified", fileHashRule, merkleRootRule, verifierRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ProofVerificationContractProofVerified)
+				if err := _ProofVerificationContract.contract.UnpackLog(event, "ProofVerified", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseProofVerified is a log parse operation binding the contract event 0x8a0ad150496891d984a0aabb6db8e35c32bfbd4a47acd2e8e4c44a5a6fad5481.
+//
+// Solidity: event ProofVerified(bytes32 indexed fileHash, bytes32 indexed merkleRoot, address indexed verifier, bool isValid, uint256 timestamp)
+func (_ProofVerificationContract *ProofVerificationContractFilterer) ParseProofVerified(log types.Log) (*ProofVerificationContractProofVerified, error) {
+	event := new(ProofVerificationContractProofVerified)
+	if err := _ProofVerificationContract.contract.UnpackLog(event, "ProofVerified", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ProofVerificationContractVerificationThresholdUpdatedIterator is returned from FilterVerificationThresholdUpdated and is used to iterate over the raw logs and unpacked data for VerificationThresholdUpdated events raised by the ProofVerificationContract contract.
+type ProofVerificationContractVerificationThresholdUpdatedIterator struct {
+	Event *ProofVerificationContractVerificationThresholdUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ProofVerificationContractVerificationThresholdUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ProofVerificationContractVerificationThresholdUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ProofVerificationContractVerificationThresholdUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ProofVerificationContractVerificationThresholdUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ProofVerificationContractVerificationThresholdUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ProofVerificationContractVerificationThresholdUpdated represents a VerificationThresholdUpdated event raised by the ProofVerificationContract contract.
+type ProofVerificationContractVerificationThresholdUpdated struct {
+	NewThreshold *big.Int
+	Raw          types.Log // Blockchain specific contextual infos
+}
+
+// FilterVerificationThresholdUpdated is a free log retrieval operation binding the contract event 0x92f96a03bef2d117f6cb166c330c75b2d08091142a78ab5d19e3bff63fa6c5b5.
+//
+// Solidity: event VerificationThresholdUpdated(uint256 newThreshold)
+func (_ProofVerificationContract *ProofVerificationContractFilterer) FilterVerificationThresholdUpdated(opts *bind.FilterOpts) (*ProofVerificationContractVerificationThresholdUpdatedIterator, error) {
+
+	logs, sub, err := _ProofVerificationContract.contract.FilterLogs(opts, "VerificationThresholdUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return &ProofVerificationContractVerificationThresholdUpdatedIterator{contract: _ProofVerificationContract.contract, event: "VerificationThresholdUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchVerificationThresholdUpdated is a free log subscription operation binding the contract event 0x92f96a03bef2d117f6cb166c330c75b2d08091142a78ab5d19e3bff63fa6c5b5.
+//
+// Solidity: event VerificationThresholdUpdated(uint256 newThreshold)
+func (_ProofVerificationContract *ProofVerificationContractFilterer) WatchVerificationThresholdUpdated(opts *bind.WatchOpts, sink chan<- *ProofVerificationContractVerificationThresholdUpdated) (event.Subscription, error) {
+
+	logs, sub, err := _ProofVerificationContract.contract.WatchLogs(opts, "VerificationThresholdUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ProofVerificationContractVerificationThresholdUpdated)
+				if err := _ProofVerificationContract.contract.UnpackLog(event, "VerificationThresholdUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseVerificationThresholdUpdated is a log parse operation binding the contract event 0x92f96a03bef2d117f6cb166c330c75b2d08091142a78ab5d19e3bff63fa6c5b5.
+//
+// Solidity: event VerificationThresholdUpdated(uint256 newThreshold)
+func (_ProofVerificationContract *ProofVerificationContractFilterer) ParseVerificationThresholdUpdated(log types.Log) (*ProofVerificationContractVerificationThresholdUpdated, error) {
+	event := new(ProofVerificationContractVerificationThresholdUpdated)
+	if err := _ProofVerificationContract.contract.UnpackLog(event, "VerificationThresholdUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,703 @@
+package contracts
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/sirupsen/logrus"
+
+	"nebularvault-agent/internal/metrics"
+)
+
+// ErrTxReplaced is returned when a tracked nonce was consumed by a
+// transaction the manager did not send
+var ErrTxReplaced = errors.New("transaction nonce consumed by another transaction")
+
+// ErrTxNotTracked is returned when waiting on a transaction this manager
+// neither has pending nor has a result for
+var ErrTxNotTracked = errors.New("transaction is not tracked")
+
+// TxManagerConfig controls how broadcast transactions are tracked
+type TxManagerConfig struct {
+	Confirmations  uint64
+	PollInterval   time.Duration
+	StuckTimeout   time.Duration
+	GasBumpPercent int64
+	MaxGasBumps    int
+	StateFile      string
+	Fees           FeeConfig
+}
+
+// PendingTx is a broadcast transaction that has not yet reached the required
+// number of confirmations
+type PendingTx struct {
+	Nonce  uint64        `json:"nonce"`
+	Method string        `json:"method"`
+	Hashes []common.Hash `json:"hashes"`
+	RawTx  hexutil.Bytes `json:"raw_tx"`
+	SentAt time.Time     `json:"sent_at"`
+	Bumps  int           `json:"bumps"`
+}
+
+// Hash returns the hash the transaction was originally sent with
+func (p *PendingTx) Hash() common.Hash {
+	return p.Hashes[0]
+}
+
+// RevertError is returned when a transaction was mined but reverted
+type RevertError struct {
+	TxHash common.Hash
+	Reason string
+}
+
+func (e *RevertError) Error() string {
+	if e.Reason == "" {
+		return fmt.Sprintf("transaction %s reverted", e.TxHash.Hex())
+	}
+	return fmt.Sprintf("transaction %s reverted: %s", e.TxHash.Hex(), e.Reason)
+}
+
+type txResult struct {
+	receipt    *types.Receipt
+	err        error
+	finishedAt time.Time
+}
+
+// TxManager assigns nonces locally, serializes use of the shared transactor
+// and follows each transaction until it is confirmed, replacing it with a
+// higher fee when it gets stuck
+type TxManager struct {
+	backend Backend
+	auth    *bind.TransactOpts
+	config  TxManagerConfig
+	fees    *FeeOracle
+	logger  *logrus.Logger
+
+	// sendMu serializes nonce assignment and use of auth
+	sendMu      sync.Mutex
+	nonce       uint64
+	nonceSynced bool
+
+	// pollMu keeps concurrent waiters from polling the chain at once
+	pollMu sync.Mutex
+
+	mu      sync.Mutex
+	pending map[uint64]*PendingTx
+	results map[common.Hash]txResult
+}
+
+// NewTxManager creates a transaction manager for the account behind auth,
+// restoring any transactions left pending by a previous run
+func NewTxManager(backend Backend, auth *bind.TransactOpts, config TxManagerConfig, logger *logrus.Logger) (*TxManager, error) {
+	if config.Confirmations == 0 {
+		config.Confirmations = 1
+	}
+	if config.PollInterval <= 0 {
+		config.PollInterval = 2 * time.Second
+	}
+	if config.StuckTimeout <= 0 {
+		config.StuckTimeout = 2 * time.Minute
+	}
+	if config.GasBumpPercent < 10 {
+		// Nodes reject replacements that raise fees by less than 10%
+		config.GasBumpPercent = 10
+	}
+	if config.MaxGasBumps <= 0 {
+		config.MaxGasBumps = 5
+	}
+
+	fees, err := NewFeeOracle(backend, config.Fees)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &TxManager{
+		backend: backend,
+		auth:    auth,
+		config:  config,
+		fees:    fees,
+		logger:  logger,
+		pending: make(map[uint64]*PendingTx),
+		results: make(map[common.Hash]txResult),
+	}
+
+	if err := m.load(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// Send assigns the next nonce and runs fn with a private copy of the shared
+// transactor carrying value. The returned transaction is tracked until it
+// is confirmed.
+func (m *TxManager) Send(ctx context.Context, method string, value *big.Int, fn func(*bind.TransactOpts) (*types.Transaction, error)) (*types.Transaction, error) {
+	m.sendMu.Lock()
+	defer m.sendMu.Unlock()
+
+	if !m.nonceSynced {
+		if err := m.syncNonce(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	opts, _, err := m.prepare(ctx, value, fn)
+	if err != nil {
+		return nil, err
+	}
+	opts.Nonce = new(big.Int).SetUint64(m.nonce)
+
+	tx, err := fn(opts)
+	if err != nil && isNonceError(err) {
+		// Something else sent from this account; resync once and retry
+		if syncErr := m.syncNonce(ctx); syncErr != nil {
+			return nil, syncErr
+		}
+		opts.Nonce = new(big.Int).SetUint64(m.nonce)
+		tx, err = fn(opts)
+	}
+	if err != nil {
+		if isNonceError(err) {
+			m.nonceSynced = false
+		}
+		return nil, err
+	}
+	m.nonce++
+
+	raw, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode transaction: %w", err)
+	}
+
+	m.mu.Lock()
+	m.pending[tx.Nonce()] = &PendingTx{
+		Nonce:  tx.Nonce(),
+		Method: method,
+		Hashes: []common.Hash{tx.Hash()},
+		RawTx:  raw,
+		SentAt: time.Now(),
+	}
+	m.persistLocked()
+	metrics.SetPendingTransactions(len(m.pending))
+	m.mu.Unlock()
+	metrics.ObserveTransaction(method, "sent")
+
+	m.logger.WithFields(logrus.Fields{
+		"method": method,
+		"nonce":  tx.Nonce(),
+		"txHash": tx.Hash().Hex(),
+	}).Debug("Transaction sent")
+
+	return tx, nil
+}
+
+// Simulate prices and estimates a transaction the way Send would, without
+// sending it or consuming a nonce
+func (m *TxManager) Simulate(ctx context.Context, method string, value *big.Int, fn func(*bind.TransactOpts) (*types.Transaction, error)) (*CostEstimate, error) {
+	opts, fees, err := m.prepare(ctx, value, fn)
+	if err != nil {
+		return nil, err
+	}
+	opts.NoSend = true
+
+	tx, err := fn(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return fees.Estimate(method, tx), nil
+}
+
+// prepare copies the shared transactor for one call, prices it and pads
+// its gas limit. A fixed gas price or limit from the configuration wins
+// over the oracle.
+func (m *TxManager) prepare(ctx context.Context, value *big.Int, fn func(*bind.TransactOpts) (*types.Transaction, error)) (*bind.TransactOpts, *Fees, error) {
+	opts := *m.auth
+	opts.Context = ctx
+	opts.Value = value
+
+	fees := &Fees{GasPrice: m.auth.GasPrice}
+	if m.auth.GasPrice == nil {
+		suggested, err := m.fees.SuggestFees(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		fees = suggested
+		fees.Apply(&opts)
+	}
+
+	if opts.GasLimit == 0 {
+		dryRun := opts
+		dryRun.NoSend = true
+		tx, err := fn(&dryRun)
+		if err != nil {
+			return nil, nil, err
+		}
+		opts.GasLimit = m.fees.GasLimit(tx.Gas())
+	}
+
+	return &opts, fees, nil
+}
+
+// WaitMined blocks until the transaction originally sent as hash, or its
+// replacement, has the configured number of confirmations. A mined
+// transaction that reverted yields a *RevertError.
+func (m *TxManager) WaitMined(ctx context.Context, hash common.Hash) (*types.Receipt, error) {
+	ticker := time.NewTicker(m.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := m.poll(ctx); err != nil {
+			m.logger.WithError(err).Warn("Failed to poll pending transactions")
+		}
+
+		m.mu.Lock()
+		result, done := m.results[hash]
+		if done {
+			delete(m.results, hash)
+		}
+		tracked := done || m.isPendingLocked(hash)
+		m.mu.Unlock()
+
+		if done {
+			return result.receipt, result.err
+		}
+		if !tracked {
+			return nil, fmt.Errorf("%w: %s", ErrTxNotTracked, hash.Hex())
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Pending returns the transactions still awaiting confirmation, ordered by nonce
+func (m *TxManager) Pending() []PendingTx {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	pending := make([]PendingTx, 0, len(m.pending))
+	for _, tx := range m.pending {
+		pending = append(pending, *tx)
+	}
+	sort.Slice(pending, func(i, j int) bool { return pending[i].Nonce < pending[j].Nonce })
+	return pending
+}
+
+// Start rebroadcasts transactions restored from a previous run and keeps
+// polling pending transactions in the background until ctx is cancelled
+func (m *TxManager) Start(ctx context.Context) {
+	for _, tx := range m.Pending() {
+		var signed types.Transaction
+		if err := signed.UnmarshalBinary(tx.RawTx); err != nil {
+			m.logger.WithError(err).WithField("nonce", tx.Nonce).Warn("Dropping undecodable pending transaction")
+			continue
+		}
+		if err := m.backend.SendTransaction(ctx, &signed); err != nil && !isKnownTxError(err) {
+			m.logger.WithError(err).WithField("txHash", signed.Hash().Hex()).Warn("Failed to rebroadcast pending transaction")
+		}
+	}
+
+	go func() {
+		ticker := time.NewTicker(m.config.PollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := m.poll(ctx); err != nil {
+					m.logger.WithError(err).Warn("Failed to poll pending transactions")
+				}
+			}
+		}
+	}()
+}
+
+// syncNonce resumes from the node's pending nonce, skipping any nonces still
+// held by transactions restored from disk
+func (m *TxManager) syncNonce(ctx context.Context) error {
+	nonce, err := m.backend.PendingNonceAt(ctx, m.auth.From)
+	if err != nil {
+		return fmt.Errorf("failed to get account nonce: %w", err)
+	}
+
+	m.mu.Lock()
+	for n := range m.pending {
+		if n >= nonce {
+			nonce = n + 1
+		}
+	}
+	m.mu.Unlock()
+
+	m.nonce = nonce
+	m.nonceSynced = true
+	return nil
+}
+
+// poll checks every pending transaction for a receipt, finishing the ones
+// with enough confirmations and replacing the ones that look stuck
+func (m *TxManager) poll(ctx context.Context) error {
+	m.pollMu.Lock()
+	defer m.pollMu.Unlock()
+
+	pending := m.Pending()
+	m.pruneResults()
+	if len(pending) == 0 {
+		return nil
+	}
+
+	head, err := m.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get latest header: %w", err)
+	}
+	minedNonce, err := m.backend.NonceAt(ctx, m.auth.From, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get account nonce: %w", err)
+	}
+
+	for i := range pending {
+		tx := &pending[i]
+
+		receipt, err := m.findReceipt(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		if receipt == nil {
+			if tx.Nonce < minedNonce {
+				m.finish(tx, nil, ErrTxReplaced)
+				continue
+			}
+			if time.Since(tx.SentAt) >= m.config.StuckTimeout && tx.Bumps < m.config.MaxGasBumps {
+				if err := m.bump(ctx, tx); err != nil {
+					m.logger.WithError(err).WithField("nonce", tx.Nonce).Warn("Failed to replace stuck transaction")
+				}
+			}
+			continue
+		}
+
+		if head.Number.Uint64() < receipt.BlockNumber.Uint64()+m.config.Confirmations-1 {
+			continue
+		}
+
+		var txErr error
+		if receipt.Status != types.ReceiptStatusSuccessful {
+			txErr = m.revertError(ctx, tx, receipt)
+		}
+		m.finish(tx, receipt, txErr)
+	}
+
+	return nil
+}
+
+// findReceipt looks up a receipt for any of the hashes the nonce was sent
+// with, newest first
+func (m *TxManager) findReceipt(ctx context.Context, tx *PendingTx) (*types.Receipt, error) {
+	for i := len(tx.Hashes) - 1; i >= 0; i-- {
+		receipt, err := m.backend.TransactionReceipt(ctx, tx.Hashes[i])
+		if errors.Is(err, ethereum.NotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get receipt for %s: %w", tx.Hashes[i].Hex(), err)
+		}
+		return receipt, nil
+	}
+	return nil, nil
+}
+
+// bump re-signs a stuck transaction with the same nonce and fees raised by
+// GasBumpPercent, and broadcasts it as a replacement
+func (m *TxManager) bump(ctx context.Context, pending *PendingTx) error {
+	var tx types.Transaction
+	if err := tx.UnmarshalBinary(pending.RawTx); err != nil {
+		return fmt.Errorf("failed to decode pending transaction: %w", err)
+	}
+
+	// Replacements pay at least what the oracle suggests right now, so a
+	// transaction priced before a fee spike catches up in one step
+	suggested := &Fees{}
+	if m.auth.GasPrice == nil {
+		var err error
+		if suggested, err = m.fees.SuggestFees(ctx); err != nil {
+			return err
+		}
+	}
+
+	var replacement types.TxData
+	switch tx.Type() {
+	case types.DynamicFeeTxType:
+		feeCap := maxBig(m.bumpFee(tx.GasFeeCap()), suggested.GasFeeCap)
+		if limit := m.config.Fees.MaxFeePerGas; limit != nil && feeCap.Cmp(limit) > 0 {
+			return fmt.Errorf("replacement fee cap %s exceeds maximum %s", feeCap, limit)
+		}
+		replacement = &types.DynamicFeeTx{
+			ChainID:    tx.ChainId(),
+			Nonce:      tx.Nonce(),
+			GasTipCap:  maxBig(m.bumpFee(tx.GasTipCap()), suggested.GasTipCap),
+			GasFeeCap:  feeCap,
+			Gas:        tx.Gas(),
+			To:         tx.To(),
+			Value:      tx.Value(),
+			Data:       tx.Data(),
+			AccessList: tx.AccessList(),
+		}
+	case types.LegacyTxType:
+		replacement = &types.LegacyTx{
+			Nonce:    tx.Nonce(),
+			GasPrice: maxBig(m.bumpFee(tx.GasPrice()), suggested.GasPrice),
+			Gas:      tx.Gas(),
+			To:       tx.To(),
+			Value:    tx.Value(),
+			Data:     tx.Data(),
+		}
+	default:
+		return fmt.Errorf("cannot replace transaction of type %d", tx.Type())
+	}
+
+	signed, err := m.auth.Signer(m.auth.From, types.NewTx(replacement))
+	if err != nil {
+		return fmt.Errorf("failed to sign replacement: %w", err)
+	}
+	if err := m.backend.SendTransaction(ctx, signed); err != nil {
+		return fmt.Errorf("failed to send replacement: %w", err)
+	}
+
+	raw, err := signed.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("failed to encode replacement: %w", err)
+	}
+
+	m.mu.Lock()
+	if tracked, ok := m.pending[pending.Nonce]; ok {
+		tracked.Hashes = append(tracked.Hashes, signed.Hash())
+		tracked.RawTx = raw
+		tracked.SentAt = time.Now()
+		tracked.Bumps++
+		m.persistLocked()
+	}
+	m.mu.Unlock()
+	metrics.ObserveTransaction(pending.Method, "replaced")
+
+	m.logger.WithFields(logrus.Fields{
+		"method":      pending.Method,
+		"nonce":       pending.Nonce,
+		"txHash":      signed.Hash().Hex(),
+		"replacement": pending.Bumps + 1,
+	}).Warn("Replaced stuck transaction with higher fees")
+
+	return nil
+}
+
+func (m *TxManager) bumpFee(fee *big.Int) *big.Int {
+	bumped := new(big.Int).Mul(fee, big.NewInt(100+m.config.GasBumpPercent))
+	bumped.Div(bumped, big.NewInt(100))
+	return bumped.Add(bumped, common.Big1)
+}
+
+// maxBig returns the larger of a and b, treating nil as absent
+func maxBig(a, b *big.Int) *big.Int {
+	if b == nil || a.Cmp(b) >= 0 {
+		return a
+	}
+	return b
+}
+
+// revertError replays a reverted transaction against the parent block to
+// recover its revert reason
+func (m *TxManager) revertError(ctx context.Context, pending *PendingTx, receipt *types.Receipt) error {
+	revertErr := &RevertError{TxHash: receipt.TxHash}
+
+	var tx types.Transaction
+	if err := tx.UnmarshalBinary(pending.RawTx); err != nil {
+		return revertErr
+	}
+
+	msg := ethereum.CallMsg{
+		From:  m.auth.From,
+		To:    tx.To(),
+		Gas:   tx.Gas(),
+		Value: tx.Value(),
+		Data:  tx.Data(),
+	}
+	parent := new(big.Int).Sub(receipt.BlockNumber, common.Big1)
+	if _, err := m.backend.CallContract(ctx, msg, parent); err != nil {
+		revertErr.Reason, _ = RevertReason(err)
+	}
+
+	return revertErr
+}
+
+func (m *TxManager) finish(tx *PendingTx, receipt *types.Receipt, err error) {
+	m.mu.Lock()
+	delete(m.pending, tx.Nonce)
+	m.results[tx.Hash()] = txResult{receipt: receipt, err: err, finishedAt: time.Now()}
+	m.persistLocked()
+	metrics.SetPendingTransactions(len(m.pending))
+	m.mu.Unlock()
+
+	switch {
+	case receipt == nil:
+		metrics.ObserveTransaction(tx.Method, "dropped")
+	case err != nil:
+		metrics.ObserveTransaction(tx.Method, "reverted")
+	default:
+		metrics.ObserveTransaction(tx.Method, "confirmed")
+	}
+	if receipt != nil {
+		metrics.ObserveGas(tx.Method, receipt.GasUsed, receipt.EffectiveGasPrice)
+	}
+
+	entry := m.logger.WithFields(logrus.Fields{
+		"method": tx.Method,
+		"nonce":  tx.Nonce,
+		"txHash": tx.Hash().Hex(),
+	})
+	if err != nil {
+		entry.WithError(err).Warn("Transaction failed")
+		return
+	}
+	entry.WithField("block", receipt.BlockNumber.Uint64()).Info("Transaction confirmed")
+}
+
+// pruneResults drops results nobody collected, such as those of transactions
+// restored from a previous run
+func (m *TxManager) pruneResults() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for hash, result := range m.results {
+		if time.Since(result.finishedAt) > time.Hour {
+			delete(m.results, hash)
+		}
+	}
+}
+
+func (m *TxManager) isPendingLocked(hash common.Hash) bool {
+	for _, tx := range m.pending {
+		if tx.Hash() == hash {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *TxManager) load() error {
+	if m.config.StateFile == "" {
+		return nil
+	}
+
+	data, err := os.ReadFile(m.config.StateFile)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read pending transactions: %w", err)
+	}
+
+	var pending []*PendingTx
+	if err := json.Unmarshal(data, &pending); err != nil {
+		return fmt.Errorf("failed to parse pending transactions: %w", err)
+	}
+	for _, tx := range pending {
+		if len(tx.Hashes) == 0 {
+			continue
+		}
+		m.pending[tx.Nonce] = tx
+	}
+
+	metrics.SetPendingTransactions(len(m.pending))
+	if len(m.pending) > 0 {
+		m.logger.WithField("count", len(m.pending)).Info("Restored pending transactions")
+	}
+	return nil
+}
+
+// persistLocked writes the pending set to the state file; callers hold mu
+func (m *TxManager) persistLocked() {
+	if m.config.StateFile == "" {
+		return
+	}
+
+	pending := make([]*PendingTx, 0, len(m.pending))
+	for _, tx := range m.pending {
+		pending = append(pending, tx)
+	}
+	sort.Slice(pending, func(i, j int) bool { return pending[i].Nonce < pending[j].Nonce })
+
+	data, err := json.MarshalIndent(pending, "", "  ")
+	if err != nil {
+		m.logger.WithError(err).Error("Failed to encode pending transactions")
+		return
+	}
+
+	if err := os.MkdirAll(filepath.Dir(m.config.StateFile), 0755); err != nil {
+		m.logger.WithError(err).Error("Failed to create pending transaction directory")
+		return
+	}
+	tmp := m.config.StateFile + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		m.logger.WithError(err).Error("Failed to write pending transactions")
+		return
+	}
+	if err := os.Rename(tmp, m.config.StateFile); err != nil {
+		m.logger.WithError(err).Error("Failed to write pending transactions")
+	}
+}
+
+// RevertReason extracts the Solidity revert reason from an error returned by
+// a call, gas estimation or the node
+func RevertReason(err error) (string, bool) {
+	if err == nil {
+		return "", false
+	}
+
+	var dataErr interface{ ErrorData() interface{} }
+	if errors.As(err, &dataErr) {
+		if data, ok := dataErr.ErrorData().(string); ok {
+			if reason, unpackErr := abi.UnpackRevert(common.FromHex(data)); unpackErr == nil {
+				return reason, true
+			}
+		}
+	}
+
+	const prefix = "execution reverted: "
+	if idx := strings.Index(err.Error(), prefix); idx >= 0 {
+		return err.Error()[idx+len(prefix):], true
+	}
+	if strings.Contains(err.Error(), "execution reverted") {
+		return "", true
+	}
+
+	return "", false
+}
+
+func isNonceError(err error) bool {
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "nonce too low") || strings.Contains(msg, "nonce too high")
+}
+
+func isKnownTxError(err error) bool {
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "already known") || strings.Contains(msg, "nonce too low")
+}
@@ -0,0 +1,308 @@
+package contracts
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/sirupsen/logrus"
+)
+
+func (h *testHarness) newTxManager(t *testing.T, config TxManagerConfig) *TxManager {
+	t.Helper()
+
+	auth, err := bind.NewKeyedTransactorWithChainID(h.owner, big.NewInt(simulatedChainID))
+	if err != nil {
+		t.Fatalf("Failed to create transactor: %v", err)
+	}
+	if config.PollInterval == 0 {
+		config.PollInterval = 10 * time.Millisecond
+	}
+
+	m, err := NewTxManager(h.backend.Client(), auth, config, logrus.New())
+	if err != nil {
+		t.Fatalf("Failed to create transaction manager: %v", err)
+	}
+	return m
+}
+
+// deposit sends a plain value transfer to the vault's receive function
+func (h *testHarness) deposit(t *testing.T, m *TxManager) *types.Transaction {
+	t.Helper()
+
+	tx, err := m.Send(context.Background(), "receive", big.NewInt(1), func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return h.vault.Receive(opts)
+	})
+	if err != nil {
+		t.Fatalf("Failed to send transaction: %v", err)
+	}
+	return tx
+}
+
+func waitMined(t *testing.T, m *TxManager, hash common.Hash) (*types.Receipt, error) {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	return m.WaitMined(ctx, hash)
+}
+
+func TestTxManager_ConcurrentNonces(t *testing.T) {
+	h := newTestHarness(t)
+	m := h.newTxManager(t, TxManagerConfig{})
+
+	const count = 8
+	txs := make([]*types.Transaction, count)
+	errs := make([]error, count)
+	var wg sync.WaitGroup
+	for i := 0; i < count; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			txs[i], errs[i] = m.Send(context.Background(), "receive", big.NewInt(1), func(opts *bind.TransactOpts) (*types.Transaction, error) {
+				return h.vault.Receive(opts)
+			})
+		}(i)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			t.Fatalf("Failed to send transaction: %v", err)
+		}
+	}
+	h.backend.Commit()
+
+	nonces := make(map[uint64]bool)
+	for _, tx := range txs {
+		if nonces[tx.Nonce()] {
+			t.Errorf("Nonce %d assigned twice", tx.Nonce())
+		}
+		nonces[tx.Nonce()] = true
+
+		receipt, err := waitMined(t, m, tx.Hash())
+		if err != nil {
+			t.Fatalf("Failed to wait for transaction: %v", err)
+		}
+		if receipt.Status != types.ReceiptStatusSuccessful {
+			t.Errorf("Expected transaction with nonce %d to succeed", tx.Nonce())
+		}
+	}
+
+	if pending := m.Pending(); len(pending) != 0 {
+		t.Errorf("Expected no pending transactions, got %d", len(pending))
+	}
+}
+
+func TestTxManager_WaitsForConfirmations(t *testing.T) {
+	h := newTestHarness(t)
+	m := h.newTxManager(t, TxManagerConfig{Confirmations: 3})
+
+	tx := h.deposit(t, m)
+
+	stop := h.autoCommit()
+	defer stop()
+
+	receipt, err := waitMined(t, m, tx.Hash())
+	if err != nil {
+		t.Fatalf("Failed to wait for transaction: %v", err)
+	}
+
+	head, err := h.backend.Client().BlockNumber(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to get block number: %v", err)
+	}
+	if head < receipt.BlockNumber.Uint64()+2 {
+		t.Errorf("Expected 3 confirmations, mined in %d with head %d", receipt.BlockNumber.Uint64(), head)
+	}
+}
+
+func TestTxManager_DecodesRevertReason(t *testing.T) {
+	h := newTestHarness(t)
+	m := h.newTxManager(t, TxManagerConfig{})
+
+	// A fixed gas limit skips estimation, so the revert only shows up
+	// once the transaction is mined
+	tx, err := m.Send(context.Background(), "uploadFile", big.NewInt(1000000000000000), func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		opts.GasLimit = 500000
+		return h.vault.UploadFile(opts, common.HexToHash("0x01"), "unregistered.txt", big.NewInt(1), "root")
+	})
+	if err != nil {
+		t.Fatalf("Failed to send transaction: %v", err)
+	}
+	h.backend.Commit()
+
+	_, err = waitMined(t, m, tx.Hash())
+	var revertErr *RevertError
+	if !errors.As(err, &revertErr) {
+		t.Fatalf("Expected a RevertError, got %v", err)
+	}
+	if revertErr.Reason != "User not registered or inactive" {
+		t.Errorf("Expected revert reason 'User not registered or inactive', got '%s'", revertErr.Reason)
+	}
+	if revertErr.TxHash != tx.Hash() {
+		t.Errorf("Expected revert for %s, got %s", tx.Hash().Hex(), revertErr.TxHash.Hex())
+	}
+}
+
+func TestTxManager_ReplacesStuckTransaction(t *testing.T) {
+	h := newTestHarness(t)
+	m := h.newTxManager(t, TxManagerConfig{StuckTimeout: time.Nanosecond})
+
+	tx := h.deposit(t, m)
+
+	// Nothing is mined yet, so the first poll treats the transaction as stuck
+	if err := m.poll(context.Background()); err != nil {
+		t.Fatalf("Failed to poll: %v", err)
+	}
+
+	pending := m.Pending()
+	if len(pending) != 1 || len(pending[0].Hashes) != 2 {
+		t.Fatalf("Expected one transaction with a replacement, got %+v", pending)
+	}
+	replacement := pending[0].Hashes[1]
+
+	h.backend.Commit()
+
+	receipt, err := waitMined(t, m, tx.Hash())
+	if err != nil {
+		t.Fatalf("Failed to wait for transaction: %v", err)
+	}
+	if receipt.TxHash != replacement {
+		t.Errorf("Expected replacement %s to be mined, got %s", replacement.Hex(), receipt.TxHash.Hex())
+	}
+
+	mined, _, err := h.backend.Client().TransactionByHash(context.Background(), replacement)
+	if err != nil {
+		t.Fatalf("Failed to get replacement: %v", err)
+	}
+	if mined.GasTipCap().Cmp(tx.GasTipCap()) <= 0 || mined.GasFeeCap().Cmp(tx.GasFeeCap()) <= 0 {
+		t.Error("Expected replacement to pay higher fees")
+	}
+}
+
+func TestTxManager_PersistsPendingTransactions(t *testing.T) {
+	h := newTestHarness(t)
+	stateFile := filepath.Join(t.TempDir(), "pending.json")
+
+	first := h.newTxManager(t, TxManagerConfig{StateFile: stateFile})
+	tx := h.deposit(t, first)
+
+	restarted := h.newTxManager(t, TxManagerConfig{StateFile: stateFile})
+	pending := restarted.Pending()
+	if len(pending) != 1 || pending[0].Hash() != tx.Hash() || pending[0].Method != "receive" {
+		t.Fatalf("Expected the pending deposit to be restored, got %+v", pending)
+	}
+
+	// The restored nonce must not be handed out again
+	next := h.deposit(t, restarted)
+	if next.Nonce() != tx.Nonce()+1 {
+		t.Errorf("Expected nonce %d after restart, got %d", tx.Nonce()+1, next.Nonce())
+	}
+
+	h.backend.Commit()
+
+	if _, err := waitMined(t, restarted, tx.Hash()); err != nil {
+		t.Fatalf("Failed to wait for restored transaction: %v", err)
+	}
+	if _, err := waitMined(t, restarted, next.Hash()); err != nil {
+		t.Fatalf("Failed to wait for transaction: %v", err)
+	}
+
+	again := h.newTxManager(t, TxManagerConfig{StateFile: stateFile})
+	if pending := again.Pending(); len(pending) != 0 {
+		t.Errorf("Expected confirmed transactions to be removed from disk, got %d", len(pending))
+	}
+}
+
+func TestContractClient_WaitsForReceipts(t *testing.T) {
+	h := newTestHarness(t)
+	stop := h.autoCommit()
+	defer stop()
+
+	client, err := NewContractClientWithBackend(&ContractConfig{
+		ChainID:         simulatedChainID,
+		ContractAddress: h.address.Hex(),
+		PrivateKey:      h.client.config.PrivateKey,
+		Timeout:         10 * time.Second,
+		Confirmations:   1,
+		PollInterval:    10 * time.Millisecond,
+	}, h.backend.Client(), logrus.New())
+	if err != nil {
+		t.Fatalf("Failed to create contract client: %v", err)
+	}
+
+	registered, err := client.RegisterUser("alice")
+	if err != nil {
+		t.Fatalf("Failed to register user: %v", err)
+	}
+	if registered.BlockNumber == 0 || registered.GasUsed == 0 {
+		t.Errorf("Expected receipt details in response, got %+v", registered)
+	}
+
+	fileHash := "0x" + strings.Repeat("51", 32)
+	if _, err := client.UploadFileWithVerification(testUpload(fileHash, "wait.txt", 64)); err != nil {
+		t.Fatalf("Failed to upload file with verification: %v", err)
+	}
+
+	// The sibling does not hash to the claimed root, so the contract
+	// records the proof as invalid
+	verify, err := client.VerifyProof(&FileUploadRequest{
+		FileHash:   fileHash,
+		MerkleRoot: "0x" + strings.Repeat("cd", 32),
+		Proof:      []string{"0x" + strings.Repeat("0b", 32)},
+		Indices:    []uint64{1},
+		LeafHash:   "0x" + strings.Repeat("0a", 32),
+	})
+	if err != nil {
+		t.Fatalf("Failed to verify proof: %v", err)
+	}
+	if verify.Valid {
+		t.Error("Expected invalid proof to be reported from the receipt")
+	}
+}
+
+// autoCommit mines a block every few milliseconds until the returned stop
+// function is called, which returns once mining has stopped
+func (h *testHarness) autoCommit() func() {
+	stop, done := make(chan struct{}), make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(20 * time.Millisecond)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				h.backend.Commit()
+			}
+		}
+	}()
+	return func() {
+		close(stop)
+		<-done
+	}
+}
+
+func TestRevertReason(t *testing.T) {
+	reason, ok := RevertReason(errors.New("execution reverted: File already exists"))
+	if !ok || reason != "File already exists" {
+		t.Errorf("Expected 'File already exists', got '%s' (%v)", reason, ok)
+	}
+
+	if _, ok := RevertReason(errors.New("connection refused")); ok {
+		t.Error("Expected non-revert errors not to decode")
+	}
+
+	if !strings.Contains((&RevertError{Reason: "nope"}).Error(), "reverted: nope") {
+		t.Error("Expected revert reason in error message")
+	}
+}
@@ -0,0 +1,125 @@
+package grpcapi
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	vaultv1 "nebularvault-agent/api/vault/v1"
+	"nebularvault-agent/internal/metadata"
+	"nebularvault-agent/internal/storage"
+)
+
+func newFile(record *metadata.FileRecord) *vaultv1.File {
+	file := &vaultv1.File{
+		Id:          record.ID,
+		Filename:    record.Filename,
+		Size:        record.Size,
+		MimeType:    record.MimeType,
+		Hash:        record.Hash,
+		MerkleRoot:  record.MerkleRoot,
+		ChunkHashes: record.ChunkHashes,
+		StorageRefs: record.StorageRefs,
+		UploadedAt:  record.UploadedAt,
+		UserId:      record.UserID,
+		IsPublic:    record.IsPublic,
+	}
+	if a := record.Anchor; a != nil {
+		file.Anchor = &vaultv1.Anchor{
+			Status:      string(a.Status),
+			TxHash:      a.TxHash,
+			BlockNumber: a.BlockNumber,
+			Error:       a.Error,
+			UpdatedAt:   timestamp(a.UpdatedAt),
+		}
+	}
+	if v := record.Verification; v != nil {
+		file.Verification = &vaultv1.Verification{
+			Valid:           v.Valid,
+			MerkleRootValid: v.MerkleRootValid,
+			CheckedAt:       timestamp(v.CheckedAt),
+		}
+		for _, index := range v.MissingChunks {
+			file.Verification.MissingChunks = append(file.Verification.MissingChunks, int32(index))
+		}
+	}
+	return file
+}
+
+// newJob mirrors the REST API's job response
+func newJob(job *metadata.Job) *vaultv1.Job {
+	resp := &vaultv1.Job{
+		Id:          job.ID,
+		Type:        job.Type,
+		Subject:     job.Key,
+		Status:      string(job.Status),
+		Attempts:    int32(job.Attempts),
+		MaxAttempts: int32(job.MaxAttempts),
+		Error:       job.Error,
+		Result:      job.Result,
+		CreatedAt:   timestamp(job.CreatedAt),
+		UpdatedAt:   timestamp(job.UpdatedAt),
+	}
+	if job.Status == metadata.JobQueued && job.Attempts > 0 {
+		resp.RetryAt = timestamp(job.RunAt)
+	}
+	return resp
+}
+
+func newManifest(file *storage.FileMetadata) *vaultv1.FileManifest {
+	manifest := &vaultv1.FileManifest{
+		Id:         file.ID,
+		Filename:   file.Filename,
+		Size:       file.Size,
+		MimeType:   file.MimeType,
+		Hash:       file.Hash,
+		MerkleRoot: file.MerkleRoot,
+		UploadedAt: file.UploadedAt,
+		UserId:     file.UserID,
+		IsPublic:   file.IsPublic,
+	}
+	for _, chunk := range file.Chunks {
+		manifest.Chunks = append(manifest.Chunks, &vaultv1.Chunk{
+			Id:       chunk.ID,
+			Index:    int32(chunk.Index),
+			Data:     chunk.Data,
+			Hash:     chunk.Hash,
+			Size:     chunk.Size,
+			ParentId: chunk.ParentID,
+		})
+	}
+	return manifest
+}
+
+func fromManifest(manifest *vaultv1.FileManifest) *storage.FileMetadata {
+	file := &storage.FileMetadata{
+		ID:         manifest.Id,
+		Filename:   manifest.Filename,
+		Size:       manifest.Size,
+		MimeType:   manifest.MimeType,
+		Hash:       manifest.Hash,
+		MerkleRoot: manifest.MerkleRoot,
+		UploadedAt: manifest.UploadedAt,
+		UserID:     manifest.UserId,
+		IsPublic:   manifest.IsPublic,
+	}
+	for _, chunk := range manifest.Chunks {
+		file.Chunks = append(file.Chunks, storage.FileChunk{
+			ID:       chunk.Id,
+			Index:    int(chunk.Index),
+			Data:     chunk.Data,
+			Hash:     chunk.Hash,
+			Size:     chunk.Size,
+			ParentID: chunk.ParentId,
+		})
+	}
+	return file
+}
+
+// timestamp leaves unset times unset
+func timestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
@@ -0,0 +1,357 @@
+package grpcapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	vaultv1 "nebularvault-agent/api/vault/v1"
+	"nebularvault-agent/internal/apikey"
+	"nebularvault-agent/internal/auth"
+	"nebularvault-agent/internal/jobs"
+	metastore "nebularvault-agent/internal/metadata"
+	"nebularvault-agent/internal/middleware"
+	"nebularvault-agent/internal/pipeline"
+	"nebularvault-agent/internal/policy"
+	"nebularvault-agent/internal/storage"
+	"nebularvault-agent/internal/zerog"
+)
+
+// testService is VaultService served in memory behind the auth
+// interceptors, with API keys with read-write and read-only scopes
+type testService struct {
+	client        vaultv1.VaultServiceClient
+	writer        string
+	reader        string
+	authenticator *auth.Authenticator
+	store         *metastore.Store
+}
+
+func newTestService(t *testing.T) *testService {
+	t.Helper()
+	dir := t.TempDir()
+
+	store, err := metastore.Open(filepath.Join(dir, "metadata"))
+	if err != nil {
+		t.Fatalf("Failed to open metadata store: %v", err)
+	}
+	zeroGClient, err := zerog.NewZeroGClient(&zerog.ZeroGConfig{}, logrus.New())
+	if err != nil {
+		t.Fatalf("Failed to create 0G client: %v", err)
+	}
+	storageManager := storage.NewStorageManager(dir, filepath.Join(dir, "temp"), 16)
+	queue := jobs.NewQueue(store, jobs.Config{}, logrus.New())
+	p := pipeline.New(storageManager, zeroGClient, store, nil, queue, pipeline.Config{
+		SpoolDir: filepath.Join(dir, "spool"),
+	}, logrus.New())
+
+	authenticator, err := auth.NewAuthenticator(auth.Config{Domain: "vault.example", ChainID: 1})
+	if err != nil {
+		t.Fatalf("Failed to create authenticator: %v", err)
+	}
+	keys, err := apikey.Open(filepath.Join(dir, "api-keys.json"))
+	if err != nil {
+		t.Fatalf("Failed to open key store: %v", err)
+	}
+	_, writer, err := keys.Create("writer", []string{apikey.ScopeFilesRead, apikey.ScopeFilesWrite}, 0)
+	if err != nil {
+		t.Fatalf("Failed to create key: %v", err)
+	}
+	_, reader, err := keys.Create("reader", []string{apikey.ScopeFilesRead}, 0)
+	if err != nil {
+		t.Fatalf("Failed to create key: %v", err)
+	}
+
+	logUnary, logStream := middleware.GRPCLogger(logrus.New())
+	authUnary, authStream := middleware.GRPCAuthenticate(authenticator, keys, MethodScopes)
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(logUnary, authUnary),
+		grpc.ChainStreamInterceptor(logStream, authStream),
+	)
+	engine := policy.NewEngine(store, nil, policy.Config{}, logrus.New())
+	vaultv1.RegisterVaultServiceServer(server, NewServer(storageManager, zeroGClient, store, p, queue, engine, nil, Config{MaxFileSize: 1024}, logrus.New()))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	if err := queue.Start(ctx); err != nil {
+		t.Fatalf("Failed to start job queue: %v", err)
+	}
+	listener := bufconn.Listen(1 << 20)
+	go server.Serve(listener)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("Failed to dial: %v", err)
+	}
+	t.Cleanup(func() {
+		conn.Close()
+		server.Stop()
+		cancel()
+		queue.Wait()
+		store.Close()
+	})
+	return &testService{
+		client:        vaultv1.NewVaultServiceClient(conn),
+		writer:        writer,
+		reader:        reader,
+		authenticator: authenticator,
+		store:         store,
+	}
+}
+
+func withKey(key string) context.Context {
+	return metadata.AppendToOutgoingContext(context.Background(), "x-api-key", key)
+}
+
+// upload streams content in small pieces after the file info
+func upload(ctx context.Context, client vaultv1.VaultServiceClient, info *vaultv1.UploadInfo, content []byte) (*vaultv1.Job, error) {
+	stream, err := client.Upload(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := stream.Send(&vaultv1.UploadRequest{Data: &vaultv1.UploadRequest_Info{Info: info}}); err != nil {
+		return nil, err
+	}
+	for len(content) > 0 {
+		n := min(len(content), 10)
+		if err := stream.Send(&vaultv1.UploadRequest{Data: &vaultv1.UploadRequest_Content{Content: content[:n]}}); err != nil && err != io.EOF {
+			return nil, err
+		}
+		content = content[n:]
+	}
+	return stream.CloseAndRecv()
+}
+
+func TestVaultService_UploadDownload(t *testing.T) {
+	svc := newTestService(t)
+	client, writer := svc.client, svc.writer
+	ctx := withKey(writer)
+	content := []byte(strings.Repeat("nebular vault ", 3))
+
+	job, err := upload(ctx, client, &vaultv1.UploadInfo{
+		Filename: "notes.txt",
+		Size:     int64(len(content)),
+		Wait:     durationpb.New(10 * time.Second),
+	}, content)
+	if err != nil {
+		t.Fatalf("Failed to upload: %v", err)
+	}
+	if job.Type != pipeline.JobUpload || job.Status != string(metastore.JobSucceeded) {
+		t.Fatalf("Expected the upload job to succeed, got %+v", job)
+	}
+	var result pipeline.UploadResult
+	if err := json.Unmarshal(job.Result, &result); err != nil {
+		t.Fatalf("Failed to decode job result: %v", err)
+	}
+
+	got, err := client.GetJob(ctx, &vaultv1.GetJobRequest{Id: job.Id})
+	if err != nil || got.Id != job.Id {
+		t.Fatalf("Failed to get job: %v", err)
+	}
+
+	file, err := client.GetFileMetadata(ctx, &vaultv1.GetFileMetadataRequest{Hash: "0x" + result.Hash})
+	if err != nil {
+		t.Fatalf("Failed to get metadata: %v", err)
+	}
+	if file.Filename != "notes.txt" || file.Size != int64(len(content)) || len(file.StorageRefs) != 3 {
+		t.Errorf("Unexpected file record %+v", file)
+	}
+
+	list, err := client.ListFiles(ctx, &vaultv1.ListFilesRequest{})
+	if err != nil || len(list.Files) != 1 || list.Files[0].Hash != result.Hash {
+		t.Fatalf("Expected the upload to be listed, got %v, %v", list, err)
+	}
+
+	proof, err := client.GetProof(ctx, &vaultv1.GetProofRequest{Hash: file.StorageRefs[0]})
+	if err != nil || proof.Proof == "" {
+		t.Fatalf("Failed to get proof: %v", err)
+	}
+
+	stream, err := client.Download(ctx, &vaultv1.DownloadRequest{Hash: result.Hash})
+	if err != nil {
+		t.Fatalf("Failed to download: %v", err)
+	}
+	first, err := stream.Recv()
+	if err != nil || first.GetInfo().GetHash() != result.Hash {
+		t.Fatalf("Expected the file record first, got %v, %v", first, err)
+	}
+	var downloaded bytes.Buffer
+	for {
+		msg, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Failed to receive content: %v", err)
+		}
+		downloaded.Write(msg.GetContent())
+	}
+	// The 0G client is a stand-in that answers each root with a placeholder
+	// naming it
+	for _, root := range file.StorageRefs {
+		if !strings.Contains(downloaded.String(), root) {
+			t.Errorf("Expected chunk %s in the download, got %q", root, downloaded.String())
+		}
+	}
+
+	_, err = client.GetFileMetadata(ctx, &vaultv1.GetFileMetadataRequest{Hash: strings.Repeat("ab", 32)})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Expected an unknown file to be NotFound, got %v", err)
+	}
+}
+
+func TestVaultService_RejectsCallers(t *testing.T) {
+	svc := newTestService(t)
+	client, writer, reader := svc.client, svc.writer, svc.reader
+
+	_, err := client.ListFiles(context.Background(), &vaultv1.ListFilesRequest{})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Errorf("Expected a call without credentials to be Unauthenticated, got %v", err)
+	}
+	_, err = client.ListFiles(withKey(reader+"x"), &vaultv1.ListFilesRequest{})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Errorf("Expected a bad key to be Unauthenticated, got %v", err)
+	}
+	bearer := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+reader)
+	if _, err := client.ListFiles(bearer, &vaultv1.ListFilesRequest{}); err != nil {
+		t.Errorf("Expected a key as a bearer token to be accepted, got %v", err)
+	}
+
+	_, err = upload(withKey(reader), client, &vaultv1.UploadInfo{Filename: "a.txt"}, []byte("hello"))
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Expected a read-only key to be refused uploads, got %v", err)
+	}
+	_, err = client.ChunkFile(withKey(writer), &vaultv1.ChunkFileRequest{FilePath: "/etc/hosts"})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Expected storage calls to need the admin scope, got %v", err)
+	}
+
+	_, err = upload(withKey(writer), client, &vaultv1.UploadInfo{Filename: "a.txt", Size: 3}, []byte("hello"))
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected content longer than declared to be refused, got %v", err)
+	}
+	_, err = upload(withKey(writer), client, &vaultv1.UploadInfo{Filename: "a.txt"}, bytes.Repeat([]byte("x"), 2048))
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("Expected content over the size limit to be refused, got %v", err)
+	}
+
+	stream, err := client.Upload(withKey(writer))
+	if err == nil {
+		stream.Send(&vaultv1.UploadRequest{Data: &vaultv1.UploadRequest_Content{Content: []byte("hello")}})
+		_, err = stream.CloseAndRecv()
+	}
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected an upload without file info to be refused, got %v", err)
+	}
+
+	if _, err := upload(withKey(writer), client, &vaultv1.UploadInfo{Filename: "a.txt"}, []byte("hello")); err != nil {
+		t.Errorf("Expected an upload without a declared size to be accepted, got %v", err)
+	}
+}
+
+// TestVaultService_WalletReadsByStorageRef checks that a signed-in wallet
+// can download and prove its own file's chunks by their 0G roots
+func TestVaultService_WalletReadsByStorageRef(t *testing.T) {
+	svc := newTestService(t)
+	owner, ownerToken := signIn(t, svc.authenticator)
+	_, otherToken := signIn(t, svc.authenticator)
+
+	root := "0x" + strings.Repeat("5a", 32)
+	if err := svc.store.PutFile(&metastore.FileRecord{
+		ID:          "file-1",
+		Hash:        strings.Repeat("55", 32),
+		UserID:      owner,
+		ChunkHashes: []string{strings.Repeat("01", 32)},
+		StorageRefs: []string{root},
+	}); err != nil {
+		t.Fatalf("Failed to store file: %v", err)
+	}
+
+	download := func(token string) error {
+		ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+token)
+		stream, err := svc.client.Download(ctx, &vaultv1.DownloadRequest{Hash: root})
+		if err != nil {
+			return err
+		}
+		for {
+			if _, err := stream.Recv(); err != nil {
+				if err == io.EOF {
+					return nil
+				}
+				return err
+			}
+		}
+	}
+	prove := func(token string) error {
+		ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+token)
+		_, err := svc.client.GetProof(ctx, &vaultv1.GetProofRequest{Hash: root})
+		return err
+	}
+
+	if err := download(ownerToken); err != nil {
+		t.Errorf("Expected the owner to download a chunk by its root, got %v", err)
+	}
+	if err := prove(ownerToken); err != nil {
+		t.Errorf("Expected the owner to prove a chunk by its root, got %v", err)
+	}
+	if err := download(otherToken); status.Code(err) != codes.NotFound {
+		t.Errorf("Expected another wallet's download to be NotFound, got %v", err)
+	}
+	if err := prove(otherToken); status.Code(err) != codes.NotFound {
+		t.Errorf("Expected another wallet's proof to be NotFound, got %v", err)
+	}
+}
+
+// signIn signs in a new wallet and returns its address and session token
+func signIn(t *testing.T, authenticator *auth.Authenticator) (string, string) {
+	t.Helper()
+
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	nonce, err := authenticator.Nonce()
+	if err != nil {
+		t.Fatalf("Failed to issue nonce: %v", err)
+	}
+
+	text := (&auth.Message{
+		Domain:   "vault.example",
+		Address:  crypto.PubkeyToAddress(key.PublicKey),
+		URI:      "https://vault.example",
+		Version:  "1",
+		ChainID:  1,
+		Nonce:    nonce,
+		IssuedAt: time.Now(),
+	}).String()
+	sig, err := crypto.Sign(accounts.TextHash([]byte(text)), key)
+	if err != nil {
+		t.Fatalf("Failed to sign message: %v", err)
+	}
+
+	session, err := authenticator.SignIn(text, hexutil.Encode(sig))
+	if err != nil {
+		t.Fatalf("Failed to sign in: %v", err)
+	}
+	return session.Address, session.Token
+}
@@ -0,0 +1,143 @@
+package grpcapi
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	vaultv1 "nebularvault-agent/api/vault/v1"
+	"nebularvault-agent/internal/apikey"
+	"nebularvault-agent/internal/jobs"
+	"nebularvault-agent/internal/logging"
+	"nebularvault-agent/internal/metadata"
+	"nebularvault-agent/internal/middleware"
+	"nebularvault-agent/internal/pipeline"
+	"nebularvault-agent/internal/policy"
+	"nebularvault-agent/internal/quota"
+	"nebularvault-agent/internal/storage"
+	"nebularvault-agent/internal/zerog"
+)
+
+// MethodScopes is the scope each VaultService method needs, matching the
+// REST routes it mirrors
+var MethodScopes = map[string]string{
+	vaultv1.VaultService_Upload_FullMethodName:          apikey.ScopeFilesWrite,
+	vaultv1.VaultService_Download_FullMethodName:        apikey.ScopeFilesRead,
+	vaultv1.VaultService_GetFileMetadata_FullMethodName: apikey.ScopeFilesRead,
+	vaultv1.VaultService_ListFiles_FullMethodName:       apikey.ScopeFilesRead,
+	vaultv1.VaultService_GetProof_FullMethodName:        apikey.ScopeFilesRead,
+	vaultv1.VaultService_GetJob_FullMethodName:          apikey.ScopeFilesRead,
+	vaultv1.VaultService_ChunkFile_FullMethodName:       apikey.ScopeAdmin,
+	vaultv1.VaultService_ReconstructFile_FullMethodName: apikey.ScopeAdmin,
+}
+
+const (
+	// maxJobWait caps how long a call may wait for a job, as the REST API does
+	maxJobWait = 25 * time.Second
+
+	// contentMessageSize is how much file content each stream message carries
+	contentMessageSize = 256 * 1024
+)
+
+// Config limits what callers may send
+type Config struct {
+	MaxFileSize int64
+}
+
+// Server implements VaultService over the same storage manager, 0G client,
+// pipeline and metadata store as the REST handlers
+type Server struct {
+	vaultv1.UnimplementedVaultServiceServer
+
+	storage  *storage.StorageManager
+	zeroG    *zerog.ZeroGClient
+	store    *metadata.Store
+	pipeline *pipeline.Pipeline
+	queue    *jobs.Queue
+	engine   *policy.Engine
+	tracker  *quota.Tracker
+	config   Config
+	logger   *logrus.Logger
+}
+
+// NewServer creates the gRPC service. engine and tracker may be nil, as
+// when auth is disabled.
+func NewServer(storageManager *storage.StorageManager, zeroGClient *zerog.ZeroGClient, store *metadata.Store, p *pipeline.Pipeline, queue *jobs.Queue, engine *policy.Engine, tracker *quota.Tracker, config Config, logger *logrus.Logger) *Server {
+	return &Server{
+		storage:  storageManager,
+		zeroG:    zeroGClient,
+		store:    store,
+		pipeline: p,
+		queue:    queue,
+		engine:   engine,
+		tracker:  tracker,
+		config:   config,
+		logger:   logger,
+	}
+}
+
+// callerAddress returns the signed-in wallet making a call, if any
+func callerAddress(ctx context.Context) string {
+	caller, ok := middleware.CallerFromContext(ctx)
+	if !ok {
+		return ""
+	}
+	return caller.Address
+}
+
+// authorizeFile checks that the signed-in wallet may read a file, as the
+// REST handlers do. Files the caller may not see are reported as missing.
+func (s *Server) authorizeFile(ctx context.Context, hash string) error {
+	return s.authorize(ctx, hash, (*policy.Engine).Authorize)
+}
+
+// authorizeRef is authorizeFile for calls that also take a 0G root, which
+// is resolved to the files stored under it
+func (s *Server) authorizeRef(ctx context.Context, ref string) error {
+	return s.authorize(ctx, ref, (*policy.Engine).AuthorizeRef)
+}
+
+func (s *Server) authorize(ctx context.Context, ref string, decide func(*policy.Engine, string, string) (policy.Decision, error)) error {
+	address := callerAddress(ctx)
+	if address == "" || s.engine == nil {
+		return nil
+	}
+
+	decision, err := decide(s.engine, address, ref)
+	if err == metadata.ErrNotFound {
+		return status.Error(codes.NotFound, "File not found")
+	}
+	if err != nil {
+		return s.fail(ctx, codes.Unavailable, "Failed to check file permissions", err)
+	}
+
+	switch decision.Reason {
+	case policy.ReasonSuspended:
+		return status.Error(codes.PermissionDenied, "Account is suspended")
+	case policy.ReasonNotGranted:
+		return status.Error(codes.NotFound, "File not found")
+	}
+	return nil
+}
+
+// fail logs err and returns message to the caller without it
+func (s *Server) fail(ctx context.Context, code codes.Code, message string, err error) error {
+	logging.Entry(ctx, s.logger).Errorf("%s: %v", message, err)
+	return status.Error(code, message)
+}
+
+// waitDuration reads an optional wait, capped at maxJobWait
+func waitDuration(wait *durationpb.Duration) (time.Duration, error) {
+	d := wait.AsDuration()
+	if d < 0 {
+		return 0, status.Error(codes.InvalidArgument, "wait must not be negative")
+	}
+	if d > maxJobWait {
+		d = maxJobWait
+	}
+	return d, nil
+}
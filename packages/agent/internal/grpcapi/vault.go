@@ -0,0 +1,336 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	vaultv1 "nebularvault-agent/api/vault/v1"
+	"nebularvault-agent/internal/metadata"
+	"nebularvault-agent/internal/metrics"
+	"nebularvault-agent/internal/pipeline"
+	"nebularvault-agent/internal/quota"
+)
+
+// Upload spools a streamed file and queues it for upload, answering with
+// the job once it is queued or, when asked to wait, once it finishes
+func (s *Server) Upload(stream vaultv1.VaultService_UploadServer) error {
+	ctx := stream.Context()
+
+	first, err := stream.Recv()
+	if err != nil && err != io.EOF {
+		return err
+	}
+	info := first.GetInfo()
+	if info == nil {
+		return status.Error(codes.InvalidArgument, "The first message must carry the file info")
+	}
+	if info.Size < 0 {
+		return status.Error(codes.InvalidArgument, "size must not be negative")
+	}
+	if s.config.MaxFileSize > 0 && info.Size > s.config.MaxFileSize {
+		return status.Errorf(codes.ResourceExhausted, "File exceeds the %d byte limit", s.config.MaxFileSize)
+	}
+	wait, err := waitDuration(info.Wait)
+	if err != nil {
+		return err
+	}
+
+	// Hold quota for the upload before its content is read
+	reservation, err := s.reserveQuota(ctx, info.Size)
+	if err != nil {
+		return err
+	}
+	queued := false
+	defer func() {
+		if !queued {
+			reservation.Release()
+		}
+	}()
+
+	path, err := s.pipeline.SpoolPath(info.Filename)
+	if err != nil {
+		return s.fail(ctx, codes.Internal, "Failed to save uploaded file", err)
+	}
+	if err := s.receive(stream, path, info.Size); err != nil {
+		os.RemoveAll(filepath.Dir(path))
+		return err
+	}
+
+	// Uploads belong to the signed-in wallet
+	owner := callerAddress(ctx)
+	job, err := s.pipeline.EnqueueUpload(pipeline.UploadRequest{
+		Path:     path,
+		Filename: info.Filename,
+		UserID:   owner,
+	}, owner)
+	if err != nil {
+		os.RemoveAll(filepath.Dir(path))
+		return s.fail(ctx, codes.Internal, "Failed to queue upload", err)
+	}
+	queued = true
+	go s.holdQuota(job.ID, reservation)
+
+	if wait > 0 {
+		waitCtx, cancel := context.WithTimeout(ctx, wait)
+		waited, err := s.queue.WaitJob(waitCtx, job.ID)
+		cancel()
+		if err == nil {
+			job = waited
+		}
+	}
+	return stream.SendAndClose(newJob(job))
+}
+
+// receive writes the streamed content to path, enforcing the size limit and
+// the declared size
+func (s *Server) receive(stream vaultv1.VaultService_UploadServer, path string, declared int64) error {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return s.fail(stream.Context(), codes.Internal, "Failed to save uploaded file", err)
+	}
+	defer file.Close()
+
+	var written int64
+	for {
+		msg, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if msg.GetInfo() != nil {
+			return status.Error(codes.InvalidArgument, "File info may only be sent once")
+		}
+		content := msg.GetContent()
+
+		written += int64(len(content))
+		if s.config.MaxFileSize > 0 && written > s.config.MaxFileSize {
+			return status.Errorf(codes.ResourceExhausted, "File exceeds the %d byte limit", s.config.MaxFileSize)
+		}
+		if declared > 0 && written > declared {
+			return status.Errorf(codes.InvalidArgument, "Received more than the declared %d bytes", declared)
+		}
+		if _, err := file.Write(content); err != nil {
+			return s.fail(stream.Context(), codes.Internal, "Failed to save uploaded file", err)
+		}
+	}
+
+	if declared > 0 && written != declared {
+		return status.Errorf(codes.InvalidArgument, "Received %d of the declared %d bytes", written, declared)
+	}
+	if err := file.Close(); err != nil {
+		return s.fail(stream.Context(), codes.Internal, "Failed to save uploaded file", err)
+	}
+	return nil
+}
+
+// reserveQuota holds storage quota for a signed-in wallet's upload
+func (s *Server) reserveQuota(ctx context.Context, size int64) (*quota.Reservation, error) {
+	address := callerAddress(ctx)
+	if address == "" || s.tracker == nil {
+		return nil, nil
+	}
+	if size <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "size is required to check the storage quota")
+	}
+
+	reservation, usage, err := s.tracker.Reserve(address, uint64(size))
+	switch err {
+	case nil:
+		return reservation, nil
+	case quota.ErrExceeded:
+		return nil, status.Errorf(codes.ResourceExhausted, "Storage quota exceeded: upload needs %d bytes, %d available", size, usage.Available)
+	case quota.ErrNotRegistered:
+		return nil, status.Error(codes.PermissionDenied, "Register on chain to get a storage quota")
+	case quota.ErrSuspended:
+		return nil, status.Error(codes.PermissionDenied, "Account is suspended")
+	}
+	return nil, s.fail(ctx, codes.Unavailable, "Failed to check storage quota", err)
+}
+
+// holdQuota keeps an upload's quota reserved until its job finishes
+func (s *Server) holdQuota(id string, reservation *quota.Reservation) {
+	if reservation == nil {
+		return
+	}
+	job, err := s.queue.WaitJob(context.Background(), id)
+	if err == nil && job.Status == metadata.JobSucceeded {
+		reservation.Commit()
+		return
+	}
+	reservation.Release()
+}
+
+// Download streams a stored file's chunks from 0G Storage in order,
+// preceded by its record. Any other hash is fetched as one 0G root.
+func (s *Server) Download(req *vaultv1.DownloadRequest, stream vaultv1.VaultService_DownloadServer) error {
+	ctx := stream.Context()
+	if req.Hash == "" {
+		return status.Error(codes.InvalidArgument, "hash is required")
+	}
+	if err := s.authorizeRef(ctx, req.Hash); err != nil {
+		return err
+	}
+
+	roots := []string{req.Hash}
+	record, err := s.store.GetFile(req.Hash)
+	switch {
+	case err == nil:
+		if len(record.StorageRefs) < len(record.ChunkHashes) {
+			return status.Error(codes.FailedPrecondition, "File is not fully stored in 0G Storage; repair it first")
+		}
+		roots = record.StorageRefs
+		if err := stream.Send(&vaultv1.DownloadResponse{Data: &vaultv1.DownloadResponse_Info{Info: newFile(record)}}); err != nil {
+			return err
+		}
+	case err != metadata.ErrNotFound:
+		return s.fail(ctx, codes.Internal, "Failed to get file metadata", err)
+	}
+
+	sent := 0
+	for i, root := range roots {
+		if root == "" {
+			return status.Errorf(codes.FailedPrecondition, "Chunk %d is not stored in 0G Storage; repair the file first", i)
+		}
+		downloadResp, err := s.zeroG.Download(ctx, root)
+		if err != nil {
+			if ctx.Err() != nil {
+				return status.FromContextError(ctx.Err()).Err()
+			}
+			return s.fail(ctx, codes.Unavailable, "Failed to download from 0G Storage", err)
+		}
+		for data := downloadResp.Data; len(data) > 0; {
+			n := min(len(data), contentMessageSize)
+			if err := stream.Send(&vaultv1.DownloadResponse{Data: &vaultv1.DownloadResponse_Content{Content: data[:n]}}); err != nil {
+				return err
+			}
+			data = data[n:]
+		}
+		sent += len(downloadResp.Data)
+	}
+	metrics.ObserveDownload(sent)
+	return nil
+}
+
+// GetFileMetadata returns a stored file's record
+func (s *Server) GetFileMetadata(ctx context.Context, req *vaultv1.GetFileMetadataRequest) (*vaultv1.File, error) {
+	if req.Hash == "" {
+		return nil, status.Error(codes.InvalidArgument, "hash is required")
+	}
+	if err := s.authorizeFile(ctx, req.Hash); err != nil {
+		return nil, err
+	}
+
+	record, err := s.store.GetFile(req.Hash)
+	if err == metadata.ErrNotFound {
+		return nil, status.Error(codes.NotFound, "File not found")
+	}
+	if err != nil {
+		return nil, s.fail(ctx, codes.Internal, "Failed to get file metadata", err)
+	}
+	return newFile(record), nil
+}
+
+// ListFiles returns the signed-in wallet's files, or every file for API
+// keys and unauthenticated callers, newest first
+func (s *Server) ListFiles(ctx context.Context, req *vaultv1.ListFilesRequest) (*vaultv1.ListFilesResponse, error) {
+	owner := callerAddress(ctx)
+	records, err := s.store.ListFiles(func(record *metadata.FileRecord) bool {
+		return owner == "" || strings.EqualFold(record.UserID, owner)
+	})
+	if err != nil {
+		return nil, s.fail(ctx, codes.Internal, "Failed to list files", err)
+	}
+
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].UploadedAt > records[j].UploadedAt
+	})
+	resp := &vaultv1.ListFilesResponse{Files: make([]*vaultv1.File, 0, len(records))}
+	for _, record := range records {
+		resp.Files = append(resp.Files, newFile(record))
+	}
+	return resp, nil
+}
+
+// GetProof returns the 0G Storage proof for a hash
+func (s *Server) GetProof(ctx context.Context, req *vaultv1.GetProofRequest) (*vaultv1.Proof, error) {
+	if req.Hash == "" {
+		return nil, status.Error(codes.InvalidArgument, "hash is required")
+	}
+	if err := s.authorizeRef(ctx, req.Hash); err != nil {
+		return nil, err
+	}
+
+	proofResp, err := s.zeroG.GetProof(ctx, req.Hash)
+	if err != nil {
+		return nil, s.fail(ctx, codes.Unavailable, "Failed to get proof from 0G Storage", err)
+	}
+	return &vaultv1.Proof{Hash: req.Hash, Proof: proofResp.Proof}, nil
+}
+
+// GetJob returns a job the caller may see, optionally waiting for it to
+// finish
+func (s *Server) GetJob(ctx context.Context, req *vaultv1.GetJobRequest) (*vaultv1.Job, error) {
+	wait, err := waitDuration(req.Wait)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := s.queue.Get(req.Id)
+	owner := callerAddress(ctx)
+	if err == metadata.ErrNotFound || (err == nil && owner != "" && !strings.EqualFold(job.Owner, owner)) {
+		return nil, status.Error(codes.NotFound, "Job not found")
+	}
+	if err != nil {
+		return nil, s.fail(ctx, codes.Internal, "Failed to get job", err)
+	}
+
+	if wait > 0 && !job.Done() {
+		waitCtx, cancel := context.WithTimeout(ctx, wait)
+		job, err = s.queue.WaitJob(waitCtx, job.ID)
+		cancel()
+		if err != nil {
+			return nil, s.fail(ctx, codes.Internal, "Failed to get job", err)
+		}
+	}
+	return newJob(job), nil
+}
+
+// ChunkFile splits a file on the agent's disk into chunks
+func (s *Server) ChunkFile(ctx context.Context, req *vaultv1.ChunkFileRequest) (*vaultv1.FileManifest, error) {
+	if req.FilePath == "" {
+		return nil, status.Error(codes.InvalidArgument, "file_path is required")
+	}
+
+	file, err := s.storage.ChunkFile(ctx, req.FilePath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, status.Error(codes.NotFound, "File not found")
+	}
+	if err != nil {
+		return nil, s.fail(ctx, codes.Internal, "Failed to chunk file", err)
+	}
+	return newManifest(file), nil
+}
+
+// ReconstructFile reassembles chunked content to a path on the agent's disk
+func (s *Server) ReconstructFile(ctx context.Context, req *vaultv1.ReconstructFileRequest) (*vaultv1.ReconstructFileResponse, error) {
+	if req.Manifest == nil || req.OutputPath == "" {
+		return nil, status.Error(codes.InvalidArgument, "manifest and output_path are required")
+	}
+
+	file := fromManifest(req.Manifest)
+	if err := s.storage.ReconstructFile(ctx, file, req.OutputPath); err != nil {
+		return nil, s.fail(ctx, codes.Internal, "Failed to reconstruct file", err)
+	}
+	return &vaultv1.ReconstructFileResponse{FileId: file.ID, OutputPath: req.OutputPath}, nil
+}
@@ -0,0 +1,168 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"nebularvault-agent/internal/apierror"
+	"nebularvault-agent/internal/auth"
+	"nebularvault-agent/internal/metadata"
+	"nebularvault-agent/internal/middleware"
+	"nebularvault-agent/internal/policy"
+)
+
+// nonceResponse carries a nonce for a sign-in message
+type nonceResponse struct {
+	Nonce string `json:"nonce"`
+}
+
+// signInRequest is a signed Sign-In with Ethereum message
+type signInRequest struct {
+	Message   string `json:"message" binding:"required"`
+	Signature string `json:"signature" binding:"required"`
+}
+
+// sessionResponse describes the caller: a signed-in wallet's address, an
+// API key, or neither when auth is disabled
+type sessionResponse struct {
+	Address string          `json:"address,omitempty"`
+	APIKey  *apiKeyResponse `json:"api_key,omitempty"`
+}
+
+// apiKeyResponse is an API key as its holder sees it, without its hash
+type apiKeyResponse struct {
+	ID     string   `json:"id"`
+	Name   string   `json:"name"`
+	Scopes []string `json:"scopes"`
+}
+
+// GetNonce issues a nonce to embed in a Sign-In with Ethereum message
+func GetNonce(authenticator *auth.Authenticator) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		nonce, err := authenticator.Nonce()
+		if err != nil {
+			fail(c, err, "Failed to issue nonce")
+			return
+		}
+
+		c.JSON(http.StatusOK, APIResponse{
+			Success: true,
+			Data:    nonceResponse{Nonce: nonce},
+			Message: "Nonce issued successfully",
+		})
+	}
+}
+
+// SignIn exchanges a signed SIWE message for a session token
+func SignIn(authenticator *auth.Authenticator) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var request signInRequest
+		if err := c.ShouldBindJSON(&request); err != nil {
+			reject(c, apierror.InvalidRequest, "Invalid request body")
+			return
+		}
+
+		session, err := authenticator.SignIn(request.Message, request.Signature)
+		if err != nil {
+			code := apierror.Unauthorized
+			if errors.Is(err, auth.ErrInvalidMessage) {
+				code = apierror.InvalidRequest
+			}
+			requestLog(c).Debugf("Sign-in rejected: %v", err)
+			reject(c, code, err.Error())
+			return
+		}
+
+		c.JSON(http.StatusOK, APIResponse{
+			Success: true,
+			Data:    session,
+			Message: "Signed in successfully",
+		})
+	}
+}
+
+// GetSession describes the caller: the wallet it is signed in as, or the
+// API key it presented
+func GetSession(c *gin.Context) {
+	var data sessionResponse
+	if address, ok := middleware.UserAddress(c); ok {
+		data.Address = address
+	}
+	if key, ok := middleware.APIKey(c); ok {
+		data.APIKey = &apiKeyResponse{
+			ID:     key.ID,
+			Name:   key.Name,
+			Scopes: key.Scopes,
+		}
+	}
+
+	c.JSON(http.StatusOK, APIResponse{
+		Success: true,
+		Data:    data,
+		Message: "Session retrieved successfully",
+	})
+}
+
+// ListFiles lists the files uploaded by the caller
+func ListFiles(store *metadata.Store) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		owner, _ := middleware.UserAddress(c)
+		files, err := store.ListFiles(func(record *metadata.FileRecord) bool {
+			return owner == "" || strings.EqualFold(record.UserID, owner)
+		})
+		if err != nil {
+			fail(c, err, "Failed to list files")
+			return
+		}
+
+		c.JSON(http.StatusOK, APIResponse{
+			Success: true,
+			Data:    files,
+			Message: "Files retrieved successfully",
+		})
+	}
+}
+
+// authorizeFile checks that the signed-in wallet may read a file and
+// responds if it may not. Files the caller may not see are reported as
+// missing. API keys and unauthenticated requests, as when auth is disabled,
+// are only subject to their scopes.
+func authorizeFile(c *gin.Context, engine *policy.Engine, hash string) bool {
+	return authorize(c, engine, hash, (*policy.Engine).Authorize)
+}
+
+// authorizeRef is authorizeFile for routes that also take a 0G root, which
+// is resolved to the files stored under it
+func authorizeRef(c *gin.Context, engine *policy.Engine, ref string) bool {
+	return authorize(c, engine, ref, (*policy.Engine).AuthorizeRef)
+}
+
+func authorize(c *gin.Context, engine *policy.Engine, ref string, decide func(*policy.Engine, string, string) (policy.Decision, error)) bool {
+	address, ok := middleware.UserAddress(c)
+	if !ok || engine == nil {
+		return true
+	}
+
+	decision, err := decide(engine, address, ref)
+	if err == metadata.ErrNotFound {
+		reject(c, apierror.FileNotFound, "File not found")
+		return false
+	}
+	if err != nil {
+		fail(c, err, "Failed to check file permissions")
+		return false
+	}
+
+	switch decision.Reason {
+	case policy.ReasonSuspended:
+		reject(c, apierror.Forbidden, "Account is suspended")
+		return false
+	case policy.ReasonNotGranted:
+		reject(c, apierror.FileNotFound, "File not found")
+		return false
+	}
+	return true
+}
@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"nebularvault-agent/internal/apierror"
+	"nebularvault-agent/internal/indexer"
+	"nebularvault-agent/internal/metadata"
+)
+
+// ListChainFiles lists indexed on-chain files, filtered by uploader and a
+// filename search
+func ListChainFiles(store *metadata.Store) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		includeDeleted, _ := strconv.ParseBool(c.Query("include_deleted"))
+		files, err := store.ListChainFiles(metadata.FileQuery{
+			Uploader:       c.Query("uploader"),
+			Search:         c.Query("q"),
+			IncludeDeleted: includeDeleted,
+		})
+		if err != nil {
+			fail(c, err, "Failed to list files")
+			return
+		}
+
+		c.JSON(http.StatusOK, APIResponse{
+			Success: true,
+			Data:    files,
+			Message: "Files retrieved successfully",
+		})
+	}
+}
+
+// GetChainFile returns a file's indexed on-chain state
+func GetChainFile(store *metadata.Store) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		file, err := store.ChainFile(c.Param("hash"))
+		if err == metadata.ErrNotFound {
+			reject(c, apierror.FileNotFound, "File not found on chain")
+			return
+		}
+		if err != nil {
+			fail(c, err, "Failed to get file")
+			return
+		}
+
+		c.JSON(http.StatusOK, APIResponse{
+			Success: true,
+			Data:    file,
+			Message: "File retrieved successfully",
+		})
+	}
+}
+
+// GetFileEvents returns the audit trail of a file
+func GetFileEvents(store *metadata.Store) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		events, err := store.FileEvents(c.Param("hash"))
+		if err != nil {
+			fail(c, err, "Failed to get file events")
+			return
+		}
+
+		c.JSON(http.StatusOK, APIResponse{
+			Success: true,
+			Data:    events,
+			Message: "File events retrieved successfully",
+		})
+	}
+}
+
+// GetUserEvents returns the events involving an account
+func GetUserEvents(store *metadata.Store) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		events, err := store.UserEvents(c.Param("address"))
+		if err != nil {
+			fail(c, err, "Failed to get user events")
+			return
+		}
+
+		c.JSON(http.StatusOK, APIResponse{
+			Success: true,
+			Data:    events,
+			Message: "User events retrieved successfully",
+		})
+	}
+}
+
+// GetIndexerStatus reports how far the event indexer has synced
+func GetIndexerStatus(ix *indexer.Indexer) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.JSON(http.StatusOK, APIResponse{
+			Success: true,
+			Data:    ix.Status(),
+			Message: "Indexer status retrieved successfully",
+		})
+	}
+}
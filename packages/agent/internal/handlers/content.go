@@ -0,0 +1,145 @@
+package handlers
+
+import (
+	"fmt"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"nebularvault-agent/internal/apierror"
+	"nebularvault-agent/internal/metadata"
+	"nebularvault-agent/internal/metrics"
+	"nebularvault-agent/internal/pipeline"
+	"nebularvault-agent/internal/policy"
+)
+
+// DownloadContent streams a stored file's content, reassembled from its
+// chunks. A single "Range: bytes=" range is honoured for files whose chunk
+// size is recorded. Content is served as stored; clients verify it against
+// the file's chunk hashes.
+func DownloadContent(store *metadata.Store, p *pipeline.Pipeline, engine *policy.Engine) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		hash := c.Param("hash")
+		if !authorizeFile(c, engine, hash) {
+			return
+		}
+
+		record, err := store.GetFile(hash)
+		if err == metadata.ErrNotFound {
+			reject(c, apierror.FileNotFound, "File not found")
+			return
+		}
+		if err != nil {
+			fail(c, err, "Failed to get file metadata")
+			return
+		}
+
+		status := http.StatusOK
+		start, end := int64(0), record.Size
+		if header := c.GetHeader("Range"); header != "" && record.ChunkSize > 0 {
+			var ok bool
+			start, end, ok = parseRange(header, record.Size)
+			if !ok {
+				c.Header("Content-Range", fmt.Sprintf("bytes */%d", record.Size))
+				reject(c, apierror.RangeNotSatisfiable, "Range not satisfiable")
+				return
+			}
+			status = http.StatusPartialContent
+			c.Header("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, end-1, record.Size))
+		}
+
+		// Fetch the first chunk before answering so a failure can still be
+		// reported; later failures cut the response short
+		first, chunkStart := 0, int64(0)
+		if record.ChunkSize > 0 {
+			first = int(start / record.ChunkSize)
+			chunkStart = int64(first) * record.ChunkSize
+		}
+		var data []byte
+		if start < end {
+			data, err = p.ReadChunk(c.Request.Context(), record, first)
+			if err != nil {
+				fail(c, err, "Failed to read file content")
+				return
+			}
+		}
+
+		contentType := record.MimeType
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+		if record.ChunkSize > 0 {
+			c.Header("Accept-Ranges", "bytes")
+		}
+		c.Header("ETag", strconv.Quote(record.Hash))
+		c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": record.Filename}))
+		c.Header("Content-Length", strconv.FormatInt(end-start, 10))
+		c.Header("Content-Type", contentType)
+		c.Status(status)
+
+		sent := 0
+		for index := first; start < end; index++ {
+			if index > first {
+				if data, err = p.ReadChunk(c.Request.Context(), record, index); err != nil {
+					requestLog(c).Errorf("Failed to read file content: %v", err)
+					break
+				}
+			}
+			next := chunkStart + int64(len(data))
+			if record.ChunkSize > 0 {
+				next = min(chunkStart+record.ChunkSize, record.Size)
+				if int64(len(data)) < next-chunkStart {
+					requestLog(c).Errorf("Chunk %d of file %s is shorter than recorded", index, record.Hash)
+					break
+				}
+			}
+			lo, hi := start-chunkStart, min(end, next)-chunkStart
+			n, err := c.Writer.Write(data[lo:hi])
+			sent += n
+			if err != nil {
+				break
+			}
+			start, chunkStart = chunkStart+hi, next
+		}
+		metrics.ObserveDownload(sent)
+	}
+}
+
+// parseRange reads a single "bytes=" range over size bytes as the half-open
+// interval [start, end)
+func parseRange(header string, size int64) (int64, int64, bool) {
+	spec, ok := strings.CutPrefix(header, "bytes=")
+	if !ok || strings.Contains(spec, ",") {
+		return 0, 0, false
+	}
+	from, to, ok := strings.Cut(strings.TrimSpace(spec), "-")
+	if !ok {
+		return 0, 0, false
+	}
+
+	if from == "" {
+		// A suffix: the last n bytes
+		n, err := strconv.ParseInt(to, 10, 64)
+		if err != nil || n <= 0 {
+			return 0, 0, false
+		}
+		return max(size-n, 0), size, size > 0
+	}
+
+	start, err := strconv.ParseInt(from, 10, 64)
+	if err != nil || start < 0 || start >= size {
+		return 0, 0, false
+	}
+	end := size
+	if to != "" {
+		last, err := strconv.ParseInt(to, 10, 64)
+		if err != nil || last < start {
+			return 0, 0, false
+		}
+		end = min(last+1, size)
+	}
+	return start, end, true
+}
@@ -0,0 +1,144 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"nebularvault-agent/internal/apierror"
+	"nebularvault-agent/internal/jobs"
+	"nebularvault-agent/internal/metadata"
+)
+
+// eventHeartbeat is how often an idle event stream sends a comment so
+// proxies keep it open; each write also pushes the write deadline past the
+// next one, as streams outlive the server's write timeout
+const eventHeartbeat = 15 * time.Second
+
+// JobEvents streams a job's progress as Server-Sent Events: a "job" event
+// with its current state, the progress reported so far, then each event as
+// it happens, named by stage. A job whose result names an anchor job, as
+// uploads and repairs do, is followed by that job's events through to the
+// transaction's confirmation. The stream ends with a "done" event.
+func JobEvents(queue *jobs.Queue) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		job, err := queue.Get(c.Param("id"))
+		if err == metadata.ErrNotFound || (err == nil && !ownsJob(c, job)) {
+			reject(c, apierror.NotFound, "Job not found")
+			return
+		}
+		if err != nil {
+			fail(c, err, "Failed to get job")
+			return
+		}
+
+		c.Header("Content-Type", "text/event-stream")
+		c.Header("Cache-Control", "no-cache")
+		c.Header("Connection", "keep-alive")
+		c.Header("X-Accel-Buffering", "no")
+		c.Status(http.StatusOK)
+
+		stream := &eventStream{c: c, rc: http.NewResponseController(c.Writer)}
+		final, ok := stream.follow(queue, job.ID)
+		if !ok {
+			return
+		}
+		if anchorJobID := anchorJobOf(final); anchorJobID != "" {
+			if _, ok := stream.follow(queue, anchorJobID); !ok {
+				return
+			}
+		}
+		stream.send("done", newJobResponse(final))
+	}
+}
+
+type eventStream struct {
+	c  *gin.Context
+	rc *http.ResponseController
+}
+
+// follow streams a job's events until it finishes, returning its final
+// state, or false once the client goes away
+func (s *eventStream) follow(queue *jobs.Queue, id string) (*metadata.Job, bool) {
+	// Subscribe before reading the job so no change falls in between
+	history, updates, unsubscribe := queue.Events(id)
+	defer unsubscribe()
+
+	job, err := queue.Get(id)
+	if err != nil {
+		requestLog(s.c).Errorf("Failed to get job: %v", err)
+		return nil, false
+	}
+	if !s.send("job", newJobResponse(job)) {
+		return nil, false
+	}
+	for _, event := range history {
+		if !s.send(event.Stage, event) {
+			return nil, false
+		}
+	}
+	if job.Done() {
+		return job, true
+	}
+
+	heartbeat := time.NewTicker(eventHeartbeat)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-s.c.Request.Context().Done():
+			return nil, false
+		case <-heartbeat.C:
+			if !s.write(func() { s.c.Writer.WriteString(": keepalive\n\n") }) {
+				return nil, false
+			}
+		case event := <-updates:
+			if !s.send(event.Stage, event) {
+				return nil, false
+			}
+			if event.Stage != jobs.StageStatus {
+				continue
+			}
+			status, _ := event.Data["status"].(metadata.JobStatus)
+			if status != metadata.JobSucceeded && status != metadata.JobFailed {
+				continue
+			}
+			if job, err = queue.Get(id); err != nil {
+				requestLog(s.c).Errorf("Failed to get job: %v", err)
+				return nil, false
+			}
+			return job, true
+		}
+	}
+}
+
+func (s *eventStream) send(name string, data interface{}) bool {
+	return s.write(func() { s.c.SSEvent(name, data) })
+}
+
+// write extends the write deadline, writes and flushes, reporting whether
+// the client is still there
+func (s *eventStream) write(fn func()) bool {
+	// Writers that cannot set deadlines have no write timeout to outlive
+	_ = s.rc.SetWriteDeadline(time.Now().Add(2 * eventHeartbeat))
+	fn()
+	if err := s.rc.Flush(); err != nil {
+		return false
+	}
+	return s.c.Request.Context().Err() == nil
+}
+
+// anchorJobOf returns the anchor job a finished job handed off to
+func anchorJobOf(job *metadata.Job) string {
+	if job.Status != metadata.JobSucceeded || len(job.Result) == 0 {
+		return ""
+	}
+	var result struct {
+		AnchorJobID string `json:"anchor_job_id"`
+	}
+	if err := json.Unmarshal(job.Result, &result); err != nil {
+		return ""
+	}
+	return result.AnchorJobID
+}
@@ -0,0 +1,143 @@
+package handlers
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	"nebularvault-agent/internal/jobs"
+	"nebularvault-agent/internal/metadata"
+	"nebularvault-agent/internal/progress"
+)
+
+type sseEvent struct {
+	name string
+	data string
+}
+
+// readEvents reads a Server-Sent Events stream until it ends
+func readEvents(t *testing.T, url string) []sseEvent {
+	t.Helper()
+
+	resp, err := http.Get(url)
+	if err != nil {
+		t.Fatalf("Failed to open event stream: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || resp.Header.Get("Content-Type") != "text/event-stream" {
+		t.Fatalf("Expected an event stream, got %d %s", resp.StatusCode, resp.Header.Get("Content-Type"))
+	}
+
+	var events []sseEvent
+	var current sseEvent
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case strings.HasPrefix(line, "event:"):
+			current.name = strings.TrimPrefix(line, "event:")
+		case strings.HasPrefix(line, "data:"):
+			current.data = strings.TrimPrefix(line, "data:")
+		case line == "" && current.name != "":
+			events = append(events, current)
+			current = sseEvent{}
+		}
+	}
+	return events
+}
+
+func TestJobEvents_FollowsUploadThroughAnchoring(t *testing.T) {
+	store, err := metadata.Open(filepath.Join(t.TempDir(), "metadata"))
+	if err != nil {
+		t.Fatalf("Failed to open metadata store: %v", err)
+	}
+	defer store.Close()
+
+	queue := jobs.NewQueue(store, jobs.Config{}, logrus.New())
+	release := make(chan struct{})
+	queue.Register("upload", jobs.Policy{}, func(ctx context.Context, job *metadata.Job) (interface{}, error) {
+		<-release
+		progress.Report(ctx, progress.MerkleRoot, map[string]interface{}{"merkle_root": "0xroot"})
+		for i := 1; i <= 2; i++ {
+			progress.Report(ctx, progress.ChunkUploaded, map[string]interface{}{"uploaded": i, "chunks": 2})
+		}
+		anchorJob, err := queue.Enqueue("anchor", "abc", "", "abc")
+		if err != nil {
+			return nil, err
+		}
+		return map[string]string{"hash": "abc", "anchor_job_id": anchorJob.ID}, nil
+	})
+	queue.Register("anchor", jobs.Policy{}, func(ctx context.Context, job *metadata.Job) (interface{}, error) {
+		progress.Report(ctx, progress.TxSubmitted, map[string]interface{}{"tx_hash": "0xtx"})
+		progress.Report(ctx, progress.TxConfirmed, map[string]interface{}{"tx_hash": "0xtx", "confirmations": 1})
+		return nil, nil
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	if err := queue.Start(ctx); err != nil {
+		t.Fatalf("Failed to start job queue: %v", err)
+	}
+	defer func() {
+		cancel()
+		queue.Wait()
+	}()
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/api/v1/jobs/:id/events", JobEvents(queue))
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	job, err := queue.Enqueue("upload", "", "", nil)
+	if err != nil {
+		t.Fatalf("Failed to enqueue job: %v", err)
+	}
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		close(release)
+	}()
+
+	events := readEvents(t, server.URL+"/api/v1/jobs/"+job.ID+"/events")
+	var names []string
+	for _, event := range events {
+		if event.name == jobs.StageStatus {
+			var status jobs.Event
+			if err := json.Unmarshal([]byte(event.data), &status); err != nil {
+				t.Fatalf("Failed to decode status event: %v", err)
+			}
+			names = append(names, status.Type+":"+status.Data["status"].(string))
+			continue
+		}
+		names = append(names, event.name)
+	}
+
+	got := strings.Join(names, ",")
+	want := "job,upload:queued,upload:running,merkle_root,chunk_uploaded,chunk_uploaded,upload:succeeded," +
+		"job,anchor:queued,anchor:running,tx_submitted,tx_confirmed,anchor:succeeded,done"
+	if got != want {
+		t.Errorf("Expected events\n%s\ngot\n%s", want, got)
+	}
+
+	// A finished job replays its progress and ends straight away
+	events = readEvents(t, server.URL+"/api/v1/jobs/"+job.ID+"/events")
+	if last := events[len(events)-1]; last.name != "done" || !strings.Contains(last.data, `"status":"succeeded"`) {
+		t.Errorf("Expected the replay to end with the finished job, got %+v", last)
+	}
+
+	resp, err := http.Get(server.URL + "/api/v1/jobs/missing/events")
+	if err != nil {
+		t.Fatalf("Failed to request events: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected 404 for an unknown job, got %d", resp.StatusCode)
+	}
+}
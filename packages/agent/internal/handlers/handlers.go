@@ -6,10 +6,18 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/sirupsen/logrus"
 
+	"nebularvault-agent/internal/anchor"
+	"nebularvault-agent/internal/apierror"
+	"nebularvault-agent/internal/logging"
+	"nebularvault-agent/internal/metadata"
+	"nebularvault-agent/internal/metrics"
+	"nebularvault-agent/internal/middleware"
+	"nebularvault-agent/internal/policy"
 	"nebularvault-agent/internal/storage"
 	"nebularvault-agent/internal/zerog"
 )
 
+// APIResponse is the envelope every JSON response is sent in
 type APIResponse struct {
 	Success bool        `json:"success"`
 	Data    interface{} `json:"data,omitempty"`
@@ -17,175 +25,188 @@ type APIResponse struct {
 	Message string      `json:"message,omitempty"`
 }
 
-func HealthCheck(c *gin.Context) {
-	c.JSON(http.StatusOK, APIResponse{
-		Success: true,
-		Data: map[string]interface{}{
-			"status":    "healthy",
-			"service":   "nebularvault-agent",
-			"version":   "1.0.0",
-			"timestamp": "2024-01-01T00:00:00Z",
-		},
-		Message: "NebularVault Agent is running 🚀",
-	})
+// requestLog returns the logger tagged with the request c serves
+func requestLog(c *gin.Context) *logrus.Entry {
+	return logging.Entry(c.Request.Context(), logrus.StandardLogger())
 }
 
-func UploadFile(storageManager *storage.StorageManager, zeroGClient *zerog.ZeroGClient) gin.HandlerFunc {
+// reject aborts the request with a problem for code
+func reject(c *gin.Context, code apierror.Code, message string) {
+	middleware.Problem(c, apierror.New(code, message))
+}
+
+// fail aborts the request with a problem for err. A typed error is reported
+// with its own code and message; anything else is an internal error
+// described by message. Server-side failures are logged.
+func fail(c *gin.Context, err error, message string) {
+	typed := apierror.From(err)
+	if typed.Code == apierror.Internal {
+		typed = apierror.Wrap(err, apierror.Internal, message)
+	}
+	if typed.Code.Status() >= http.StatusInternalServerError {
+		requestLog(c).Errorf("%s: %v", message, err)
+	}
+	middleware.Problem(c, typed)
+}
+
+// downloadResponse is a file's content, fetched whole from 0G Storage
+type downloadResponse struct {
+	Hash string `json:"hash"`
+	Data []byte `json:"data"`
+	Size int    `json:"size"`
+}
+
+// anchorRetryResponse is a file's anchor state and the job retrying it
+type anchorRetryResponse struct {
+	Anchor *metadata.Anchor `json:"anchor"`
+	Job    *jobResponse     `json:"job"`
+}
+
+// proofResponse is a hash's 0G Storage proof
+type proofResponse struct {
+	Hash  string `json:"hash"`
+	Proof string `json:"proof"`
+}
+
+// chunkFileRequest names a server-side file to split into chunks
+type chunkFileRequest struct {
+	FilePath string `json:"file_path" binding:"required"`
+}
+
+// reconstructFileRequest names where to reassemble a chunked file
+type reconstructFileRequest struct {
+	Metadata   *storage.FileMetadata `json:"metadata" binding:"required"`
+	OutputPath string                `json:"output_path" binding:"required"`
+}
+
+// reconstructFileResponse reports where a file was reassembled
+type reconstructFileResponse struct {
+	OutputPath string `json:"output_path"`
+	FileID     string `json:"file_id"`
+}
+
+// integrityResponse is the outcome of a file integrity check
+type integrityResponse struct {
+	Hash     string `json:"hash"`
+	IsValid  bool   `json:"is_valid"`
+	Verified bool   `json:"verified"`
+}
+
+func DownloadFile(storageManager *storage.StorageManager, zeroGClient *zerog.ZeroGClient, engine *policy.Engine) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		file, err := c.FormFile("file")
-		if err != nil {
-			logrus.Errorf("Failed to get uploaded file: %v", err)
-			c.JSON(http.StatusBadRequest, APIResponse{
-				Success: false,
-				Error:   "No file uploaded",
-			})
+		hash := c.Param("hash")
+		if hash == "" {
+			reject(c, apierror.InvalidHash, "Hash parameter is required")
 			return
 		}
-
-		// Save uploaded file temporarily
-		tempPath := "/tmp/" + file.Filename
-		if err := c.SaveUploadedFile(file, tempPath); err != nil {
-			logrus.Errorf("Failed to save uploaded file: %v", err)
-			c.JSON(http.StatusInternalServerError, APIResponse{
-				Success: false,
-				Error:   "Failed to save uploaded file",
-			})
+		if !authorizeRef(c, engine, hash) {
 			return
 		}
 
-		// Chunk the file
-		metadata, err := storageManager.ChunkFile(tempPath)
+		// Download from 0G Storage
+		downloadResp, err := zeroGClient.Download(c.Request.Context(), hash)
 		if err != nil {
-			logrus.Errorf("Failed to chunk file: %v", err)
-			c.JSON(http.StatusInternalServerError, APIResponse{
-				Success: false,
-				Error:   "Failed to chunk file",
-			})
+			fail(c, err, "Failed to download from 0G Storage")
 			return
 		}
-
-		// Upload chunks to 0G Storage
-		var uploadedChunks []string
-		for _, chunk := range metadata.Chunks {
-			uploadResp, err := zeroGClient.Upload(chunk.Data, map[string]interface{}{
-				"chunk_id":   chunk.ID,
-				"parent_id":  chunk.ParentID,
-				"index":      chunk.Index,
-				"size":       chunk.Size,
-			})
-			if err != nil {
-				logrus.Errorf("Failed to upload chunk %s: %v", chunk.ID, err)
-				c.JSON(http.StatusInternalServerError, APIResponse{
-					Success: false,
-					Error:   "Failed to upload chunk to 0G Storage",
-				})
-				return
-			}
-			uploadedChunks = append(uploadedChunks, uploadResp.Hash)
-		}
+		metrics.ObserveDownload(len(downloadResp.Data))
 
 		c.JSON(http.StatusOK, APIResponse{
 			Success: true,
-			Data: map[string]interface{}{
-				"file_id":      metadata.ID,
-				"filename":     metadata.Filename,
-				"size":         metadata.Size,
-				"merkle_root":  metadata.MerkleRoot,
-				"chunks":       uploadedChunks,
-				"uploaded_at":  metadata.UploadedAt,
+			Data: downloadResponse{
+				Hash: hash,
+				Data: downloadResp.Data,
+				Size: len(downloadResp.Data),
 			},
-			Message: "File uploaded successfully to 0G Storage",
+			Message: "File downloaded successfully from 0G Storage",
 		})
 	}
 }
 
-func DownloadFile(storageManager *storage.StorageManager, zeroGClient *zerog.ZeroGClient) gin.HandlerFunc {
+func GetFileMetadata(store *metadata.Store, engine *policy.Engine) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		hash := c.Param("hash")
 		if hash == "" {
-			c.JSON(http.StatusBadRequest, APIResponse{
-				Success: false,
-				Error:   "Hash parameter is required",
-			})
+			reject(c, apierror.InvalidHash, "Hash parameter is required")
+			return
+		}
+		if !authorizeFile(c, engine, hash) {
 			return
 		}
 
-		// Download from 0G Storage
-		downloadResp, err := zeroGClient.Download(hash)
+		record, err := store.GetFile(hash)
+		if err == metadata.ErrNotFound {
+			reject(c, apierror.FileNotFound, "File not found")
+			return
+		}
 		if err != nil {
-			logrus.Errorf("Failed to download from 0G Storage: %v", err)
-			c.JSON(http.StatusInternalServerError, APIResponse{
-				Success: false,
-				Error:   "Failed to download from 0G Storage",
-			})
+			fail(c, err, "Failed to get file metadata")
 			return
 		}
 
 		c.JSON(http.StatusOK, APIResponse{
 			Success: true,
-			Data: map[string]interface{}{
-				"hash": hash,
-				"data": downloadResp.Data,
-				"size": len(downloadResp.Data),
-			},
-			Message: "File downloaded successfully from 0G Storage",
+			Data:    record,
+			Message: "File metadata retrieved successfully",
 		})
 	}
 }
 
-func GetFileMetadata(storageManager *storage.StorageManager) gin.HandlerFunc {
+// RetryAnchor queues a file whose anchoring failed for another attempt
+func RetryAnchor(anchorer *anchor.Anchorer, engine *policy.Engine) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		hash := c.Param("hash")
-		if hash == "" {
-			c.JSON(http.StatusBadRequest, APIResponse{
-				Success: false,
-				Error:   "Hash parameter is required",
-			})
+		if !requireOwner(c, engine, c.Param("hash")) {
 			return
 		}
 
-		// In a real implementation, this would query the database
-		// For now, return a mock response
-		c.JSON(http.StatusOK, APIResponse{
+		record, job, err := anchorer.Retry(c.Param("hash"))
+		if err == metadata.ErrNotFound {
+			reject(c, apierror.FileNotFound, "File not found")
+			return
+		}
+		if err == anchor.ErrAnchored {
+			middleware.ProblemWithData(c, apierror.New(apierror.Conflict, "File is already anchored"), record.Anchor)
+			return
+		}
+		if err != nil {
+			fail(c, err, "Failed to retry anchor")
+			return
+		}
+
+		c.Header("Location", "/api/v1/jobs/"+job.ID)
+		c.JSON(http.StatusAccepted, APIResponse{
 			Success: true,
-			Data: map[string]interface{}{
-				"hash":         hash,
-				"filename":     "example.txt",
-				"size":         1024,
-				"merkle_root":  "abc123...",
-				"uploaded_at":  "2024-01-01T00:00:00Z",
+			Data: anchorRetryResponse{
+				Anchor: record.Anchor,
+				Job:    newJobResponse(job),
 			},
-			Message: "File metadata retrieved successfully",
+			Message: "File queued for anchoring",
 		})
 	}
 }
 
-func GetProof(zeroGClient *zerog.ZeroGClient) gin.HandlerFunc {
+func GetProof(zeroGClient *zerog.ZeroGClient, engine *policy.Engine) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		hash := c.Param("hash")
 		if hash == "" {
-			c.JSON(http.StatusBadRequest, APIResponse{
-				Success: false,
-				Error:   "Hash parameter is required",
-			})
+			reject(c, apierror.InvalidHash, "Hash parameter is required")
+			return
+		}
+		if !authorizeRef(c, engine, hash) {
 			return
 		}
 
-		proofResp, err := zeroGClient.GetProof(hash)
+		proofResp, err := zeroGClient.GetProof(c.Request.Context(), hash)
 		if err != nil {
-			logrus.Errorf("Failed to get proof from 0G Storage: %v", err)
-			c.JSON(http.StatusInternalServerError, APIResponse{
-				Success: false,
-				Error:   "Failed to get proof from 0G Storage",
-			})
+			fail(c, err, "Failed to get proof from 0G Storage")
 			return
 		}
 
 		c.JSON(http.StatusOK, APIResponse{
 			Success: true,
-			Data: map[string]interface{}{
-				"hash":  hash,
-				"proof": proofResp.Proof,
+			Data: proofResponse{
+				Hash:  hash,
+				Proof: proofResp.Proof,
 			},
 			Message: "Proof retrieved successfully",
 		})
@@ -194,25 +215,16 @@ func GetProof(zeroGClient *zerog.ZeroGClient) gin.HandlerFunc {
 
 func ChunkFile(storageManager *storage.StorageManager) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		var request struct {
-			FilePath string `json:"file_path" binding:"required"`
-		}
+		var request chunkFileRequest
 
 		if err := c.ShouldBindJSON(&request); err != nil {
-			c.JSON(http.StatusBadRequest, APIResponse{
-				Success: false,
-				Error:   "Invalid request body",
-			})
+			reject(c, apierror.InvalidRequest, "Invalid request body")
 			return
 		}
 
-		metadata, err := storageManager.ChunkFile(request.FilePath)
+		metadata, err := storageManager.ChunkFile(c.Request.Context(), request.FilePath)
 		if err != nil {
-			logrus.Errorf("Failed to chunk file: %v", err)
-			c.JSON(http.StatusInternalServerError, APIResponse{
-				Success: false,
-				Error:   "Failed to chunk file",
-			})
+			fail(c, err, "Failed to chunk file")
 			return
 		}
 
@@ -226,34 +238,24 @@ func ChunkFile(storageManager *storage.StorageManager) gin.HandlerFunc {
 
 func ReconstructFile(storageManager *storage.StorageManager) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		var request struct {
-			Metadata   *storage.FileMetadata `json:"metadata" binding:"required"`
-			OutputPath string                `json:"output_path" binding:"required"`
-		}
+		var request reconstructFileRequest
 
 		if err := c.ShouldBindJSON(&request); err != nil {
-			c.JSON(http.StatusBadRequest, APIResponse{
-				Success: false,
-				Error:   "Invalid request body",
-			})
+			reject(c, apierror.InvalidRequest, "Invalid request body")
 			return
 		}
 
-		err := storageManager.ReconstructFile(request.Metadata, request.OutputPath)
+		err := storageManager.ReconstructFile(c.Request.Context(), request.Metadata, request.OutputPath)
 		if err != nil {
-			logrus.Errorf("Failed to reconstruct file: %v", err)
-			c.JSON(http.StatusInternalServerError, APIResponse{
-				Success: false,
-				Error:   "Failed to reconstruct file",
-			})
+			fail(c, err, "Failed to reconstruct file")
 			return
 		}
 
 		c.JSON(http.StatusOK, APIResponse{
 			Success: true,
-			Data: map[string]interface{}{
-				"output_path": request.OutputPath,
-				"file_id":     request.Metadata.ID,
+			Data: reconstructFileResponse{
+				OutputPath: request.OutputPath,
+				FileID:     request.Metadata.ID,
 			},
 			Message: "File reconstructed successfully",
 		})
@@ -264,10 +266,7 @@ func VerifyFileIntegrity(storageManager *storage.StorageManager) gin.HandlerFunc
 	return func(c *gin.Context) {
 		hash := c.Param("hash")
 		if hash == "" {
-			c.JSON(http.StatusBadRequest, APIResponse{
-				Success: false,
-				Error:   "Hash parameter is required",
-			})
+			reject(c, apierror.InvalidHash, "Hash parameter is required")
 			return
 		}
 
@@ -277,10 +276,10 @@ func VerifyFileIntegrity(storageManager *storage.StorageManager) gin.HandlerFunc
 
 		c.JSON(http.StatusOK, APIResponse{
 			Success: true,
-			Data: map[string]interface{}{
-				"hash":      hash,
-				"is_valid":  isValid,
-				"verified":  true,
+			Data: integrityResponse{
+				Hash:     hash,
+				IsValid:  isValid,
+				Verified: true,
 			},
 			Message: "File integrity verified successfully",
 		})
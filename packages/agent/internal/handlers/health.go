@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"nebularvault-agent/internal/apierror"
+	"nebularvault-agent/internal/health"
+	"nebularvault-agent/internal/middleware"
+	"nebularvault-agent/internal/version"
+)
+
+// started is when the agent process started serving
+var started = time.Now()
+
+// healthResponse describes the running agent and its build
+type healthResponse struct {
+	Status    string       `json:"status"`
+	Service   string       `json:"service"`
+	Version   version.Info `json:"version"`
+	Uptime    string       `json:"uptime"`
+	Timestamp string       `json:"timestamp"`
+}
+
+// livezResponse reports that the process is up
+type livezResponse struct {
+	Status  string `json:"status"`
+	Version string `json:"version"`
+	Uptime  string `json:"uptime"`
+}
+
+// HealthCheck reports that the agent is running, with its build
+func HealthCheck(c *gin.Context) {
+	c.JSON(http.StatusOK, APIResponse{
+		Success: true,
+		Data: healthResponse{
+			Status:    "healthy",
+			Service:   "nebularvault-agent",
+			Version:   version.Get(),
+			Uptime:    time.Since(started).Round(time.Second).String(),
+			Timestamp: time.Now().UTC().Format(time.RFC3339),
+		},
+		Message: "NebularVault Agent is running 🚀",
+	})
+}
+
+// Livez reports that the process is up and serving; it deliberately checks
+// no dependencies, so an outage elsewhere does not get the agent restarted
+func Livez(c *gin.Context) {
+	c.JSON(http.StatusOK, APIResponse{
+		Success: true,
+		Data: livezResponse{
+			Status:  health.StatusOK,
+			Version: version.Get().Version,
+			Uptime:  time.Since(started).Round(time.Second).String(),
+		},
+	})
+}
+
+// Readyz reports whether every dependency the agent needs to serve requests
+// is available, answering 503 with the failing checks otherwise
+func Readyz(prober *health.Prober) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		report := prober.Ready(c.Request.Context())
+		if report.Status != health.StatusOK {
+			requestLog(c).WithField("checks", report.Checks).Warn("Readiness check failed")
+			middleware.ProblemWithData(c, apierror.New(apierror.Unavailable, "Agent is not ready"), report)
+			return
+		}
+
+		c.JSON(http.StatusOK, APIResponse{
+			Success: true,
+			Data:    report,
+		})
+	}
+}
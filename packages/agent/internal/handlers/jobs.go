@@ -0,0 +1,295 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"nebularvault-agent/internal/apierror"
+	"nebularvault-agent/internal/jobs"
+	"nebularvault-agent/internal/metadata"
+	"nebularvault-agent/internal/middleware"
+	"nebularvault-agent/internal/pipeline"
+	"nebularvault-agent/internal/policy"
+	"nebularvault-agent/internal/quota"
+)
+
+// maxJobWait caps how long a request may wait for a job, staying inside
+// the server's default 30s write timeout
+const maxJobWait = 25 * time.Second
+
+// jobResponse is a job as clients see it; payloads stay internal. EventsURL
+// streams its progress as Server-Sent Events.
+type jobResponse struct {
+	ID          string             `json:"id"`
+	Type        string             `json:"type"`
+	Subject     string             `json:"subject,omitempty"`
+	Status      metadata.JobStatus `json:"status"`
+	Attempts    int                `json:"attempts"`
+	MaxAttempts int                `json:"max_attempts"`
+	Error       string             `json:"error,omitempty"`
+	Result      json.RawMessage    `json:"result,omitempty"`
+	RetryAt     *time.Time         `json:"retry_at,omitempty"`
+	EventsURL   string             `json:"events_url"`
+	CreatedAt   time.Time          `json:"created_at"`
+	UpdatedAt   time.Time          `json:"updated_at"`
+}
+
+func newJobResponse(job *metadata.Job) *jobResponse {
+	resp := &jobResponse{
+		ID:          job.ID,
+		Type:        job.Type,
+		Subject:     job.Key,
+		Status:      job.Status,
+		Attempts:    job.Attempts,
+		MaxAttempts: job.MaxAttempts,
+		Error:       job.Error,
+		Result:      job.Result,
+		EventsURL:   "/api/v1/jobs/" + job.ID + "/events",
+		CreatedAt:   job.CreatedAt,
+		UpdatedAt:   job.UpdatedAt,
+	}
+	if job.Status == metadata.JobQueued && job.Attempts > 0 {
+		retryAt := job.RunAt
+		resp.RetryAt = &retryAt
+	}
+	return resp
+}
+
+// UploadFile spools an uploaded file and queues it for chunking, upload to
+// 0G Storage and anchoring, answering 202 with the job. With ?wait=<duration>
+// it waits up to that long for the job to finish first.
+func UploadFile(p *pipeline.Pipeline, queue *jobs.Queue, tracker *quota.Tracker) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// Hold quota for the upload before its body is read
+		reservation, ok := reserveQuota(c, tracker)
+		if !ok {
+			return
+		}
+		queued := false
+		defer func() {
+			if !queued {
+				reservation.Release()
+			}
+		}()
+
+		wait, ok := waitParam(c)
+		if !ok {
+			return
+		}
+
+		file, err := c.FormFile("file")
+		if err != nil {
+			requestLog(c).Errorf("Failed to get uploaded file: %v", err)
+			reject(c, apierror.InvalidRequest, "No file uploaded")
+			return
+		}
+
+		path, err := p.SpoolPath(file.Filename)
+		if err == nil {
+			err = c.SaveUploadedFile(file, path)
+		}
+		if err != nil {
+			fail(c, err, "Failed to save uploaded file")
+			return
+		}
+
+		// Uploads belong to the signed-in wallet
+		owner, _ := middleware.UserAddress(c)
+		job, err := p.EnqueueUpload(pipeline.UploadRequest{
+			Path:     path,
+			Filename: file.Filename,
+			UserID:   owner,
+		}, owner)
+		if err != nil {
+			fail(c, err, "Failed to queue upload")
+			return
+		}
+		queued = true
+		go holdQuota(queue, job.ID, reservation)
+
+		respondJob(c, queue, job, wait, "File upload queued")
+	}
+}
+
+// holdQuota keeps an upload's quota reserved until its job finishes
+func holdQuota(queue *jobs.Queue, id string, reservation *quota.Reservation) {
+	if reservation == nil {
+		return
+	}
+	job, err := queue.WaitJob(context.Background(), id)
+	if err == nil && job.Status == metadata.JobSucceeded {
+		reservation.Commit()
+		return
+	}
+	reservation.Release()
+}
+
+// GetJob returns a job the caller may see. With ?wait=<duration> it waits
+// up to that long for the job to finish, so clients can long-poll.
+func GetJob(queue *jobs.Queue) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		wait, ok := waitParam(c)
+		if !ok {
+			return
+		}
+
+		job, err := queue.Get(c.Param("id"))
+		if err == metadata.ErrNotFound || (err == nil && !ownsJob(c, job)) {
+			reject(c, apierror.NotFound, "Job not found")
+			return
+		}
+		if err != nil {
+			fail(c, err, "Failed to get job")
+			return
+		}
+
+		if wait > 0 && !job.Done() {
+			ctx, cancel := context.WithTimeout(c.Request.Context(), wait)
+			job, err = queue.WaitJob(ctx, job.ID)
+			cancel()
+			if err != nil {
+				fail(c, err, "Failed to get job")
+				return
+			}
+		}
+
+		c.JSON(http.StatusOK, APIResponse{
+			Success: true,
+			Data:    newJobResponse(job),
+			Message: "Job retrieved successfully",
+		})
+	}
+}
+
+// ListJobs returns the caller's jobs, newest first, optionally filtered by
+// ?type= and ?status=
+func ListJobs(queue *jobs.Queue) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		jobType := c.Query("type")
+		status := metadata.JobStatus(c.Query("status"))
+
+		found, err := queue.List(func(job *metadata.Job) bool {
+			return (jobType == "" || job.Type == jobType) &&
+				(status == "" || job.Status == status) &&
+				ownsJob(c, job)
+		})
+		if err != nil {
+			fail(c, err, "Failed to list jobs")
+			return
+		}
+
+		sort.Slice(found, func(i, j int) bool {
+			return found[i].CreatedAt.After(found[j].CreatedAt)
+		})
+		list := make([]*jobResponse, 0, len(found))
+		for _, job := range found {
+			list = append(list, newJobResponse(job))
+		}
+
+		c.JSON(http.StatusOK, APIResponse{
+			Success: true,
+			Data:    list,
+			Message: "Jobs retrieved successfully",
+		})
+	}
+}
+
+// createJobRequest names a job to run on a stored file
+type createJobRequest struct {
+	Type string `json:"type" binding:"required"`
+	Hash string `json:"hash" binding:"required"`
+}
+
+// CreateJob queues a verify job for a file the caller may read, or a repair
+// job for one it owns
+func CreateJob(p *pipeline.Pipeline, queue *jobs.Queue, engine *policy.Engine) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var request createJobRequest
+		if err := c.ShouldBindJSON(&request); err != nil {
+			reject(c, apierror.InvalidRequest, "Invalid request body")
+			return
+		}
+
+		enqueue := map[string]func(hash, owner string) (*metadata.Job, error){
+			pipeline.JobVerify: p.EnqueueVerify,
+			pipeline.JobRepair: p.EnqueueRepair,
+		}[request.Type]
+		if enqueue == nil {
+			reject(c, apierror.InvalidRequest, "Job type must be verify or repair")
+			return
+		}
+		if !authorizeFile(c, engine, request.Hash) {
+			return
+		}
+		// Repairs re-upload chunks and may queue an anchor the agent pays
+		// for, so only the owner may ask for one
+		if request.Type == pipeline.JobRepair && !requireOwner(c, engine, request.Hash) {
+			return
+		}
+
+		owner, _ := middleware.UserAddress(c)
+		job, err := enqueue(request.Hash, owner)
+		if err != nil {
+			fail(c, err, "Failed to queue job")
+			return
+		}
+
+		respondJob(c, queue, job, 0, "Job queued")
+	}
+}
+
+// respondJob answers 202 with a queued job, or 200 once it finishes when
+// the caller asked to wait
+func respondJob(c *gin.Context, queue *jobs.Queue, job *metadata.Job, wait time.Duration, message string) {
+	if wait > 0 {
+		ctx, cancel := context.WithTimeout(c.Request.Context(), wait)
+		waited, err := queue.WaitJob(ctx, job.ID)
+		cancel()
+		if err == nil {
+			job = waited
+		}
+	}
+
+	c.Header("Location", "/api/v1/jobs/"+job.ID)
+	status := http.StatusAccepted
+	if job.Done() {
+		status = http.StatusOK
+	}
+	c.JSON(status, APIResponse{
+		Success: job.Status != metadata.JobFailed,
+		Data:    newJobResponse(job),
+		Error:   job.Error,
+		Message: message,
+	})
+}
+
+// waitParam parses ?wait=<duration>, capped at maxJobWait, and responds if
+// it is malformed
+func waitParam(c *gin.Context) (time.Duration, bool) {
+	value := c.Query("wait")
+	if value == "" {
+		return 0, true
+	}
+	wait, err := time.ParseDuration(value)
+	if err != nil || wait < 0 {
+		reject(c, apierror.InvalidRequest, "wait must be a duration such as 10s")
+		return 0, false
+	}
+	if wait > maxJobWait {
+		wait = maxJobWait
+	}
+	return wait, true
+}
+
+// ownsJob reports whether the caller may see a job: signed-in wallets see
+// their own, and API keys and unauthenticated callers see all
+func ownsJob(c *gin.Context, job *metadata.Job) bool {
+	address, ok := middleware.UserAddress(c)
+	return !ok || strings.EqualFold(job.Owner, address)
+}
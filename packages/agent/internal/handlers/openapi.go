@@ -0,0 +1,414 @@
+package handlers
+
+import (
+	"net/http"
+	"sort"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+
+	"nebularvault-agent/internal/apierror"
+	"nebularvault-agent/internal/apikey"
+	"nebularvault-agent/internal/auth"
+	"nebularvault-agent/internal/contracts"
+	"nebularvault-agent/internal/health"
+	"nebularvault-agent/internal/indexer"
+	"nebularvault-agent/internal/metadata"
+	"nebularvault-agent/internal/openapi"
+	"nebularvault-agent/internal/quota"
+	"nebularvault-agent/internal/storage"
+	"nebularvault-agent/internal/version"
+)
+
+// Routes describes every route the agent can serve. The server registers
+// those its configuration enables, and a test keeps the two in step.
+var Routes = []openapi.Route{
+	// Health checks
+	{
+		ID: "healthCheck", Method: http.MethodGet, Path: "/health", Tag: "health", Public: true,
+		Summary:   "Report that the agent is running, with its build",
+		Responses: []openapi.Response{reply(http.StatusOK, healthResponse{})},
+	},
+	{
+		ID: "livez", Method: http.MethodGet, Path: "/livez", Tag: "health", Public: true,
+		Summary:   "Report that the process is up",
+		Responses: []openapi.Response{reply(http.StatusOK, livezResponse{})},
+	},
+	{
+		ID: "readyz", Method: http.MethodGet, Path: "/readyz", Tag: "health", Public: true,
+		Summary:   "Report whether every dependency is available; 503 lists the failing checks",
+		Responses: []openapi.Response{reply(http.StatusOK, health.Report{})},
+	},
+	{
+		ID: "metrics", Method: http.MethodGet, Path: "/metrics", Tag: "health", Scope: apikey.ScopeAdmin,
+		Summary:   "Prometheus metrics, at the configured metrics path",
+		Responses: []openapi.Response{{Status: http.StatusOK, Raw: "text/plain"}},
+	},
+	{
+		ID: "getOpenAPI", Method: http.MethodGet, Path: "/api/v1/openapi.json", Tag: "health", Public: true,
+		Summary:   "This document",
+		Responses: []openapi.Response{{Status: http.StatusOK, Raw: "application/json"}},
+	},
+
+	// Sign-in
+	{
+		ID: "getNonce", Method: http.MethodGet, Path: "/api/v1/auth/nonce", Tag: "auth", Public: true,
+		Summary:   "Issue a nonce to embed in a Sign-In with Ethereum message",
+		Responses: []openapi.Response{reply(http.StatusOK, nonceResponse{})},
+	},
+	{
+		ID: "signIn", Method: http.MethodPost, Path: "/api/v1/auth/verify", Tag: "auth", Public: true,
+		Summary:   "Exchange a signed Sign-In with Ethereum message for a session token",
+		Body:      signInRequest{},
+		Responses: []openapi.Response{reply(http.StatusOK, auth.Session{})},
+	},
+	{
+		ID: "getSession", Method: http.MethodGet, Path: "/api/v1/auth/session", Tag: "auth",
+		Summary:   "Describe the caller",
+		Responses: []openapi.Response{reply(http.StatusOK, sessionResponse{})},
+	},
+
+	// Files
+	{
+		ID: "listFiles", Method: http.MethodGet, Path: "/api/v1/files", Tag: "files", Scope: apikey.ScopeFilesRead,
+		Summary:   "List the caller's files",
+		Responses: []openapi.Response{reply(http.StatusOK, []metadata.FileRecord{})},
+	},
+	{
+		ID: "uploadFile", Method: http.MethodPost, Path: "/api/v1/files/upload", Tag: "files", Scope: apikey.ScopeFilesWrite,
+		Summary: "Upload a file in one request and queue it for storage",
+		Params:  []openapi.Param{waitParamDoc},
+		Upload:  "file",
+		Responses: []openapi.Response{
+			replyJob(http.StatusAccepted, "The upload job, queued"),
+			replyJob(http.StatusOK, "The upload job, finished within ?wait"),
+		},
+	},
+	{
+		ID: "createUpload", Method: http.MethodPost, Path: "/api/v1/files/uploads", Tag: "uploads", Scope: apikey.ScopeFilesWrite,
+		Summary: "Start a resumable upload",
+		Body:    createUploadRequest{},
+		Responses: []openapi.Response{{
+			Status: http.StatusCreated, Data: uploadSessionResponse{}, Headers: []string{"Location", "Upload-Offset"},
+		}},
+	},
+	{
+		ID: "getUpload", Method: http.MethodGet, Path: "/api/v1/files/uploads/:id", Tag: "uploads", Scope: apikey.ScopeFilesRead,
+		Summary: "Report how much of a resumable upload the agent holds",
+		Responses: []openapi.Response{{
+			Status: http.StatusOK, Data: uploadSessionResponse{}, Headers: []string{"Upload-Offset"},
+		}},
+	},
+	{
+		ID: "appendUpload", Method: http.MethodPatch, Path: "/api/v1/files/uploads/:id", Tag: "uploads", Scope: apikey.ScopeFilesWrite,
+		Summary: "Append content to a resumable upload; the last piece queues the upload job",
+		Params: []openapi.Param{
+			{In: "header", Name: "Upload-Offset", Type: "integer", Required: true, Description: "Offset of the content, which must match the upload's"},
+			waitParamDoc,
+		},
+		RawBody: "application/offset+octet-stream",
+		Responses: []openapi.Response{
+			{
+				Status: http.StatusOK, Description: "The upload, or its job once complete and finished within ?wait",
+				Data: openapi.OneOf(uploadSessionResponse{}, jobResponse{}), Headers: []string{"Upload-Offset"},
+			},
+			{
+				Status: http.StatusAccepted, Description: "The upload job, queued",
+				Data: jobResponse{}, Headers: []string{"Upload-Offset", "Location"},
+			},
+		},
+	},
+	{
+		ID: "cancelUpload", Method: http.MethodDelete, Path: "/api/v1/files/uploads/:id", Tag: "uploads", Scope: apikey.ScopeFilesWrite,
+		Summary:   "Cancel a resumable upload and discard its content",
+		Responses: []openapi.Response{reply(http.StatusOK, nil)},
+	},
+	{
+		ID: "downloadFile", Method: http.MethodGet, Path: "/api/v1/files/download/:hash", Tag: "files", Scope: apikey.ScopeFilesRead,
+		Summary:   "Fetch a hash's content from 0G Storage as base64",
+		Responses: []openapi.Response{reply(http.StatusOK, downloadResponse{})},
+	},
+	{
+		ID: "downloadContent", Method: http.MethodGet, Path: "/api/v1/files/:hash/content", Tag: "files", Scope: apikey.ScopeFilesRead,
+		Summary: "Stream a stored file's content, or a single byte range of it",
+		Params:  []openapi.Param{{In: "header", Name: "Range", Description: "A single bytes= range"}},
+		Responses: []openapi.Response{
+			{Status: http.StatusOK, Raw: "application/octet-stream", Headers: []string{"ETag", "Accept-Ranges"}},
+			{Status: http.StatusPartialContent, Raw: "application/octet-stream", Headers: []string{"ETag", "Content-Range"}},
+		},
+	},
+	{
+		ID: "getFileMetadata", Method: http.MethodGet, Path: "/api/v1/files/metadata/:hash", Tag: "files", Scope: apikey.ScopeFilesRead,
+		Summary:   "Get a stored file's metadata",
+		Responses: []openapi.Response{reply(http.StatusOK, metadata.FileRecord{})},
+	},
+	{
+		ID: "getProof", Method: http.MethodGet, Path: "/api/v1/files/proof/:hash", Tag: "files", Scope: apikey.ScopeFilesRead,
+		Summary:   "Get the 0G Storage proof for a hash",
+		Responses: []openapi.Response{reply(http.StatusOK, proofResponse{})},
+	},
+	{
+		ID: "retryAnchor", Method: http.MethodPost, Path: "/api/v1/files/anchor/:hash", Tag: "files", Scope: apikey.ScopeFilesWrite,
+		Summary:   "Queue a file whose anchoring failed for another attempt",
+		Responses: []openapi.Response{reply(http.StatusAccepted, anchorRetryResponse{})},
+	},
+	{
+		ID: "estimateAnchor", Method: http.MethodPost, Path: "/api/v1/files/:hash/anchor/estimate", Tag: "files", Scope: apikey.ScopeFilesWrite,
+		Summary: "Price anchoring a file on chain without sending a transaction",
+		Params: []openapi.Param{
+			{In: "query", Name: "trusted", Type: "boolean", Description: "Price a plain uploadFile instead of uploadFileWithVerification"},
+		},
+		Responses: []openapi.Response{reply(http.StatusOK, contracts.CostEstimate{})},
+	},
+	{
+		ID: "deleteFile", Method: http.MethodDelete, Path: "/api/v1/files/:hash", Tag: "files", Scope: apikey.ScopeFilesWrite,
+		Summary:   "Mark a file inactive on chain",
+		Responses: []openapi.Response{reply(http.StatusOK, contracts.TransactionResponse{})},
+	},
+	{
+		ID: "verifyFileProof", Method: http.MethodPost, Path: "/api/v1/files/:hash/verify", Tag: "files", Scope: apikey.ScopeFilesWrite,
+		Summary:   "Submit a Merkle proof for a file to the vault",
+		Body:      verifyProofRequest{},
+		Responses: []openapi.Response{reply(http.StatusOK, contracts.ProofVerificationResponse{})},
+	},
+	{
+		ID: "getFileAccess", Method: http.MethodGet, Path: "/api/v1/files/:hash/access", Tag: "files", Scope: apikey.ScopeFilesRead,
+		Summary:   "List the accounts authorized to download a file",
+		Responses: []openapi.Response{reply(http.StatusOK, contracts.FileAccess{})},
+	},
+	{
+		ID: "grantFileAccess", Method: http.MethodPost, Path: "/api/v1/files/:hash/access", Tag: "files", Scope: apikey.ScopeFilesWrite,
+		Summary:   "Authorize an account to download a file",
+		Body:      grantAccessRequest{},
+		Responses: []openapi.Response{reply(http.StatusOK, contracts.TransactionResponse{})},
+	},
+	{
+		ID: "revokeFileAccess", Method: http.MethodDelete, Path: "/api/v1/files/:hash/access/:address", Tag: "files", Scope: apikey.ScopeFilesWrite,
+		Summary:   "Withdraw an account's access to a file",
+		Responses: []openapi.Response{reply(http.StatusOK, contracts.TransactionResponse{})},
+	},
+
+	// Jobs
+	{
+		ID: "listJobs", Method: http.MethodGet, Path: "/api/v1/jobs", Tag: "jobs", Scope: apikey.ScopeFilesRead,
+		Summary: "List the caller's jobs, newest first",
+		Params: []openapi.Param{
+			{In: "query", Name: "type", Description: "upload, verify, repair or anchor"},
+			{In: "query", Name: "status", Description: "queued, running, succeeded or failed"},
+		},
+		Responses: []openapi.Response{reply(http.StatusOK, []jobResponse{})},
+	},
+	{
+		ID: "createJob", Method: http.MethodPost, Path: "/api/v1/jobs", Tag: "jobs", Scope: apikey.ScopeFilesWrite,
+		Summary:   "Queue a verify or repair job for a stored file",
+		Body:      createJobRequest{},
+		Responses: []openapi.Response{replyJob(http.StatusAccepted, "The job, queued")},
+	},
+	{
+		ID: "getJob", Method: http.MethodGet, Path: "/api/v1/jobs/:id", Tag: "jobs", Scope: apikey.ScopeFilesRead,
+		Summary:   "Get a job, waiting up to ?wait for it to finish",
+		Params:    []openapi.Param{waitParamDoc},
+		Responses: []openapi.Response{reply(http.StatusOK, jobResponse{})},
+	},
+	{
+		ID: "jobEvents", Method: http.MethodGet, Path: "/api/v1/jobs/:id/events", Tag: "jobs", Scope: apikey.ScopeFilesRead,
+		Summary:   "Stream a job's progress as Server-Sent Events",
+		Responses: []openapi.Response{{Status: http.StatusOK, Raw: "text/event-stream"}},
+	},
+
+	// Webhooks
+	{
+		ID: "listWebhooks", Method: http.MethodGet, Path: "/api/v1/webhooks", Tag: "webhooks", Scope: apikey.ScopeAdmin,
+		Summary:   "List every webhook, oldest first",
+		Responses: []openapi.Response{reply(http.StatusOK, []webhookResponse{})},
+	},
+	{
+		ID: "createWebhook", Method: http.MethodPost, Path: "/api/v1/webhooks", Tag: "webhooks", Scope: apikey.ScopeAdmin,
+		Summary:   "Subscribe a URL to file lifecycle events; the response holds the signing secret",
+		Body:      createWebhookRequest{},
+		Responses: []openapi.Response{reply(http.StatusCreated, webhookResponse{})},
+	},
+	{
+		ID: "listDeadLetters", Method: http.MethodGet, Path: "/api/v1/webhooks/dead-letters", Tag: "webhooks", Scope: apikey.ScopeAdmin,
+		Summary:   "List deliveries that used up their attempts, newest first",
+		Params:    deliveryParamDocs,
+		Responses: []openapi.Response{reply(http.StatusOK, []metadata.Delivery{})},
+	},
+	{
+		ID: "getWebhook", Method: http.MethodGet, Path: "/api/v1/webhooks/:id", Tag: "webhooks", Scope: apikey.ScopeAdmin,
+		Summary:   "Get a webhook",
+		Responses: []openapi.Response{reply(http.StatusOK, webhookResponse{})},
+	},
+	{
+		ID: "deleteWebhook", Method: http.MethodDelete, Path: "/api/v1/webhooks/:id", Tag: "webhooks", Scope: apikey.ScopeAdmin,
+		Summary:   "Delete a webhook",
+		Responses: []openapi.Response{reply(http.StatusOK, nil)},
+	},
+	{
+		ID: "listWebhookDeliveries", Method: http.MethodGet, Path: "/api/v1/webhooks/:id/deliveries", Tag: "webhooks", Scope: apikey.ScopeAdmin,
+		Summary:   "List a webhook's deliveries, newest first",
+		Params:    deliveryParamDocs,
+		Responses: []openapi.Response{reply(http.StatusOK, []metadata.Delivery{})},
+	},
+	{
+		ID: "redeliverWebhook", Method: http.MethodPost, Path: "/api/v1/webhooks/:id/deliveries/:delivery/redeliver", Tag: "webhooks", Scope: apikey.ScopeAdmin,
+		Summary:   "Retry a dead-lettered delivery",
+		Responses: []openapi.Response{reply(http.StatusAccepted, metadata.Delivery{})},
+	},
+
+	// Users and statistics
+	{
+		ID: "registerUser", Method: http.MethodPost, Path: "/api/v1/users", Tag: "users", Scope: apikey.ScopeAdmin,
+		Summary:   "Register the agent's account under a username",
+		Body:      registerUserRequest{},
+		Responses: []openapi.Response{reply(http.StatusCreated, contracts.FileUploadResponse{})},
+	},
+	{
+		ID: "getUserProfile", Method: http.MethodGet, Path: "/api/v1/users/:address", Tag: "users", Scope: apikey.ScopeFilesRead,
+		Summary:   "Get an account's on-chain profile",
+		Responses: []openapi.Response{reply(http.StatusOK, contracts.UserProfile{})},
+	},
+	{
+		ID: "getStorageQuota", Method: http.MethodGet, Path: "/api/v1/users/:address/quota", Tag: "users", Scope: apikey.ScopeFilesRead,
+		Summary:   "Get an account's storage quota and usage",
+		Responses: []openapi.Response{reply(http.StatusOK, quota.Usage{})},
+	},
+	{
+		ID: "getSystemStats", Method: http.MethodGet, Path: "/api/v1/stats", Tag: "users", Scope: apikey.ScopeFilesRead,
+		Summary:   "Get vault-wide statistics",
+		Responses: []openapi.Response{reply(http.StatusOK, contracts.SystemStats{})},
+	},
+
+	// Server-side storage
+	{
+		ID: "chunkFile", Method: http.MethodPost, Path: "/api/v1/storage/chunk", Tag: "storage", Scope: apikey.ScopeAdmin,
+		Summary:   "Split a server-side file into chunks",
+		Body:      chunkFileRequest{},
+		Responses: []openapi.Response{reply(http.StatusOK, storage.FileMetadata{})},
+	},
+	{
+		ID: "reconstructFile", Method: http.MethodPost, Path: "/api/v1/storage/reconstruct", Tag: "storage", Scope: apikey.ScopeAdmin,
+		Summary:   "Reassemble a chunked file at a server-side path",
+		Body:      reconstructFileRequest{},
+		Responses: []openapi.Response{reply(http.StatusOK, reconstructFileResponse{})},
+	},
+	{
+		ID: "verifyFileIntegrity", Method: http.MethodGet, Path: "/api/v1/storage/verify/:hash", Tag: "storage", Scope: apikey.ScopeFilesRead,
+		Summary:   "Check a stored file's integrity",
+		Responses: []openapi.Response{reply(http.StatusOK, integrityResponse{})},
+	},
+
+	// Indexed chain state
+	{
+		ID: "listChainFiles", Method: http.MethodGet, Path: "/api/v1/chain/files", Tag: "chain", Scope: apikey.ScopeFilesRead,
+		Summary: "List indexed on-chain files",
+		Params: []openapi.Param{
+			{In: "query", Name: "uploader", Description: "Only files uploaded by this address"},
+			{In: "query", Name: "q", Description: "Only files whose name contains this"},
+			{In: "query", Name: "include_deleted", Type: "boolean"},
+		},
+		Responses: []openapi.Response{reply(http.StatusOK, []metadata.ChainFile{})},
+	},
+	{
+		ID: "getChainFile", Method: http.MethodGet, Path: "/api/v1/chain/files/:hash", Tag: "chain", Scope: apikey.ScopeFilesRead,
+		Summary:   "Get a file's indexed on-chain state",
+		Responses: []openapi.Response{reply(http.StatusOK, metadata.ChainFile{})},
+	},
+	{
+		ID: "getFileEvents", Method: http.MethodGet, Path: "/api/v1/chain/files/:hash/events", Tag: "chain", Scope: apikey.ScopeFilesRead,
+		Summary:   "List a file's indexed events",
+		Responses: []openapi.Response{reply(http.StatusOK, []metadata.Event{})},
+	},
+	{
+		ID: "getUserEvents", Method: http.MethodGet, Path: "/api/v1/chain/users/:address/events", Tag: "chain", Scope: apikey.ScopeFilesRead,
+		Summary:   "List an account's indexed events",
+		Responses: []openapi.Response{reply(http.StatusOK, []metadata.Event{})},
+	},
+	{
+		ID: "getIndexerStatus", Method: http.MethodGet, Path: "/api/v1/chain/status", Tag: "chain", Scope: apikey.ScopeFilesRead,
+		Summary:   "Report how far the event indexer has synced",
+		Responses: []openapi.Response{reply(http.StatusOK, indexer.Status{})},
+	},
+}
+
+var (
+	waitParamDoc = openapi.Param{
+		In: "query", Name: "wait", Description: "How long to wait for the job to finish, such as 10s; at most 25s",
+	}
+	deliveryParamDocs = []openapi.Param{
+		{In: "query", Name: "status", Description: "pending, delivered or dead"},
+		{In: "query", Name: "limit", Type: "integer", Description: "At most this many deliveries; default 100"},
+	}
+)
+
+func reply(status int, data interface{}) openapi.Response {
+	return openapi.Response{Status: status, Data: data}
+}
+
+func replyJob(status int, description string) openapi.Response {
+	return openapi.Response{Status: status, Description: description, Data: jobResponse{}, Headers: []string{"Location"}}
+}
+
+// Document builds the OpenAPI document for routes. Routes are marked as
+// needing credentials only when secured.
+func Document(routes []openapi.Route, secured bool) (*openapi.Document, error) {
+	spec := openapi.Spec{
+		Info: openapi.Info{
+			Title:       "NebularVault Agent API",
+			Description: "Stores files on 0G Storage and anchors them on chain. Successful JSON responses are wrapped in the APIResponse envelope; errors are RFC 7807 problem documents with a machine-readable code.",
+			Version:     version.Get().Version,
+		},
+		Envelope:     APIResponse{},
+		Problem:      apierror.Problem{},
+		ProblemCodes: problemCodes(),
+		Routes:       routes,
+	}
+	if secured {
+		spec.Security = map[string]*openapi.SecurityScheme{
+			"bearer": {Type: "http", Scheme: "bearer"},
+			"apiKey": {Type: "apiKey", In: "header", Name: "X-API-Key"},
+		}
+	}
+	return openapi.Build(spec)
+}
+
+// problemCodes lists every error code in a stable order
+func problemCodes() []string {
+	var codes []string
+	for _, code := range apierror.Codes() {
+		codes = append(codes, string(code))
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// OpenAPI serves the OpenAPI document for the routes registered reports,
+// leaving out those the configuration disables. The document is built on
+// the first request, once every route is registered.
+func OpenAPI(registered func() gin.RoutesInfo, secured bool) gin.HandlerFunc {
+	var once sync.Once
+	var doc *openapi.Document
+	var err error
+
+	return func(c *gin.Context) {
+		once.Do(func() {
+			served := map[string]bool{}
+			for _, route := range registered() {
+				served[route.Method+" "+route.Path] = true
+			}
+			var routes []openapi.Route
+			for _, route := range Routes {
+				if served[route.Method+" "+route.Path] {
+					routes = append(routes, route)
+				}
+			}
+			doc, err = Document(routes, secured)
+		})
+		if err != nil {
+			fail(c, err, "Failed to build OpenAPI document")
+			return
+		}
+
+		c.JSON(http.StatusOK, doc)
+	}
+}
@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"nebularvault-agent/internal/apierror"
+	"nebularvault-agent/internal/middleware"
+	"nebularvault-agent/internal/quota"
+)
+
+// GetStorageQuota reports an account's quota, its usage on chain and in the
+// agent, and the space held by uploads in flight
+func GetStorageQuota(tracker *quota.Tracker) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		address, ok := addressParam(c)
+		if !ok {
+			return
+		}
+
+		usage, err := tracker.Usage(address)
+		if err == quota.ErrNotRegistered {
+			reject(c, apierror.NotFound, "User not registered")
+			return
+		}
+		if err != nil {
+			fail(c, err, "Failed to get storage quota")
+			return
+		}
+
+		c.JSON(http.StatusOK, APIResponse{
+			Success: true,
+			Data:    usage,
+			Message: "Storage quota retrieved successfully",
+		})
+	}
+}
+
+// reserveQuota holds the signed-in wallet's quota for an upload before its
+// body is read, sized by Content-Length, and responds if it does not fit.
+// API keys and unauthenticated requests, as when auth is disabled, are not
+// subject to quotas and get a nil reservation.
+func reserveQuota(c *gin.Context, tracker *quota.Tracker) (*quota.Reservation, bool) {
+	if _, ok := middleware.UserAddress(c); !ok || tracker == nil {
+		return nil, true
+	}
+
+	size := c.Request.ContentLength
+	if size < 0 {
+		reject(c, apierror.LengthRequired, "Content-Length is required to check the storage quota")
+		return nil, false
+	}
+	return reserveQuotaSize(c, tracker, size)
+}
+
+// reserveQuotaSize holds size bytes of the signed-in wallet's quota and
+// responds if they do not fit
+func reserveQuotaSize(c *gin.Context, tracker *quota.Tracker, size int64) (*quota.Reservation, bool) {
+	address, ok := middleware.UserAddress(c)
+	if !ok || tracker == nil {
+		return nil, true
+	}
+
+	reservation, usage, err := tracker.Reserve(address, uint64(size))
+	switch err {
+	case nil:
+		return reservation, true
+	case quota.ErrExceeded:
+		err := apierror.Newf(apierror.QuotaExceeded, "Storage quota exceeded: upload needs %d bytes, %d available", size, usage.Available)
+		middleware.ProblemWithData(c, err, usage)
+	case quota.ErrNotRegistered:
+		reject(c, apierror.Forbidden, "Register on chain to get a storage quota")
+	case quota.ErrSuspended:
+		reject(c, apierror.Forbidden, "Account is suspended")
+	default:
+		fail(c, err, "Failed to check storage quota")
+	}
+	return nil, false
+}
@@ -0,0 +1,234 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"nebularvault-agent/internal/apierror"
+	"nebularvault-agent/internal/jobs"
+	"nebularvault-agent/internal/metadata"
+	"nebularvault-agent/internal/middleware"
+	"nebularvault-agent/internal/pipeline"
+	"nebularvault-agent/internal/quota"
+)
+
+// createUploadRequest describes a file to upload in pieces
+type createUploadRequest struct {
+	Filename string `json:"filename" binding:"required"`
+	Size     *int64 `json:"size" binding:"required"`
+}
+
+// uploadSessionResponse is a resumable upload as clients see it; PATCH
+// content to URL starting at Offset
+type uploadSessionResponse struct {
+	ID        string    `json:"id"`
+	Filename  string    `json:"filename"`
+	Size      int64     `json:"size"`
+	Offset    int64     `json:"offset"`
+	URL       string    `json:"url"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func newUploadSessionResponse(session *metadata.UploadSession) *uploadSessionResponse {
+	return &uploadSessionResponse{
+		ID:        session.ID,
+		Filename:  session.Filename,
+		Size:      session.Size,
+		Offset:    session.Offset,
+		URL:       "/api/v1/files/uploads/" + session.ID,
+		CreatedAt: session.CreatedAt,
+		ExpiresAt: session.ExpiresAt,
+	}
+}
+
+// CreateUpload starts a resumable upload. Its content is sent in one or more
+// PATCH requests; the last one queues the upload job.
+func CreateUpload(p *pipeline.Pipeline, tracker *quota.Tracker, maxFileSize int64) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var request createUploadRequest
+		if err := c.ShouldBindJSON(&request); err != nil || *request.Size < 0 {
+			reject(c, apierror.InvalidRequest, "Invalid request body")
+			return
+		}
+		size := *request.Size
+		if maxFileSize > 0 && size > maxFileSize {
+			reject(c, apierror.PayloadTooLarge, fmt.Sprintf("File exceeds the %d byte limit", maxFileSize))
+			return
+		}
+
+		// Quota is held while the content arrives, so open sessions cannot
+		// together spool more than the account may store
+		reservation, ok := reserveQuotaSize(c, tracker, size)
+		if !ok {
+			return
+		}
+
+		owner, _ := middleware.UserAddress(c)
+		session, err := p.CreateSession(request.Filename, size, owner, reservation)
+		if err != nil {
+			fail(c, err, "Failed to create upload session")
+			return
+		}
+
+		resp := newUploadSessionResponse(session)
+		c.Header("Location", resp.URL)
+		c.Header("Upload-Offset", "0")
+		c.JSON(http.StatusCreated, APIResponse{
+			Success: true,
+			Data:    resp,
+			Message: "Upload session created",
+		})
+	}
+}
+
+// GetUpload reports how much of a resumable upload the agent has, so an
+// interrupted client knows where to resume
+func GetUpload(p *pipeline.Pipeline) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		session, ok := uploadSessionParam(c, p)
+		if !ok {
+			return
+		}
+
+		c.Header("Upload-Offset", strconv.FormatInt(session.Offset, 10))
+		c.JSON(http.StatusOK, APIResponse{
+			Success: true,
+			Data:    newUploadSessionResponse(session),
+			Message: "Upload session retrieved successfully",
+		})
+	}
+}
+
+// AppendUpload writes the request body to a resumable upload at the offset
+// in the Upload-Offset header. Once the upload is complete it is queued like
+// UploadFile, answering with the job; until then it answers with the
+// session. ?wait=<duration> applies to the last request.
+func AppendUpload(p *pipeline.Pipeline, queue *jobs.Queue, tracker *quota.Tracker) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		session, ok := uploadSessionParam(c, p)
+		if !ok {
+			return
+		}
+		wait, ok := waitParam(c)
+		if !ok {
+			return
+		}
+		offset, err := strconv.ParseInt(c.GetHeader("Upload-Offset"), 10, 64)
+		if err != nil {
+			reject(c, apierror.InvalidRequest, "Upload-Offset header is required")
+			return
+		}
+
+		appended, err := p.AppendSession(session.ID, offset, c.Request.Body)
+		if appended != nil {
+			session = appended
+			c.Header("Upload-Offset", strconv.FormatInt(session.Offset, 10))
+		}
+		switch {
+		case err == nil:
+		case err == pipeline.ErrOffsetMismatch:
+			err := apierror.New(apierror.Conflict, "Upload-Offset does not match the session")
+			middleware.ProblemWithData(c, err, newUploadSessionResponse(session))
+			return
+		case err == pipeline.ErrSessionBusy:
+			reject(c, apierror.Conflict, "Upload session is busy")
+			return
+		case err == pipeline.ErrSessionOverflow:
+			err := apierror.New(apierror.PayloadTooLarge, "Content exceeds the upload's declared size")
+			middleware.ProblemWithData(c, err, newUploadSessionResponse(session))
+			return
+		case err == metadata.ErrNotFound:
+			reject(c, apierror.NotFound, "Upload session not found")
+			return
+		default:
+			fail(c, err, "Failed to save upload content")
+			return
+		}
+
+		if session.Offset < session.Size {
+			c.JSON(http.StatusOK, APIResponse{
+				Success: true,
+				Data:    newUploadSessionResponse(session),
+				Message: "Upload content received",
+			})
+			return
+		}
+
+		// Complete; the session's quota is held for the upload as UploadFile
+		// holds it. A session left from before a restart holds none, so it
+		// is reserved now.
+		var reservation *quota.Reservation
+		if !p.HoldsQuota(session.ID) {
+			if reservation, ok = reserveQuotaSize(c, tracker, session.Size); !ok {
+				return
+			}
+		}
+		job, held, err := p.CompleteSession(session.ID)
+		if err != nil {
+			reservation.Release()
+			if err == pipeline.ErrSessionBusy {
+				reject(c, apierror.Conflict, "Upload session is busy")
+				return
+			}
+			fail(c, err, "Failed to queue upload")
+			return
+		}
+		if held != nil {
+			reservation = held
+		}
+		go holdQuota(queue, job.ID, reservation)
+
+		respondJob(c, queue, job, wait, "File upload queued")
+	}
+}
+
+// CancelUpload ends a resumable upload and discards its content
+func CancelUpload(p *pipeline.Pipeline) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		session, ok := uploadSessionParam(c, p)
+		if !ok {
+			return
+		}
+
+		err := p.CancelSession(session.ID)
+		if err == pipeline.ErrSessionBusy {
+			reject(c, apierror.Conflict, "Upload session is busy")
+			return
+		}
+		if err != nil && err != metadata.ErrNotFound {
+			fail(c, err, "Failed to cancel upload session")
+			return
+		}
+
+		c.JSON(http.StatusOK, APIResponse{
+			Success: true,
+			Message: "Upload session cancelled",
+		})
+	}
+}
+
+// uploadSessionParam loads the session named by :id and responds if it is
+// missing or another wallet's
+func uploadSessionParam(c *gin.Context, p *pipeline.Pipeline) (*metadata.UploadSession, bool) {
+	session, err := p.Session(c.Param("id"))
+	if err == nil {
+		if address, ok := middleware.UserAddress(c); ok && !strings.EqualFold(session.Owner, address) {
+			err = metadata.ErrNotFound
+		}
+	}
+	if err == metadata.ErrNotFound {
+		reject(c, apierror.NotFound, "Upload session not found")
+		return nil, false
+	}
+	if err != nil {
+		fail(c, err, "Failed to get upload session")
+		return nil, false
+	}
+	return session, true
+}
@@ -0,0 +1,342 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gin-gonic/gin"
+
+	"nebularvault-agent/internal/anchor"
+	"nebularvault-agent/internal/apierror"
+	"nebularvault-agent/internal/contracts"
+	"nebularvault-agent/internal/metadata"
+	"nebularvault-agent/internal/middleware"
+	"nebularvault-agent/internal/policy"
+	"nebularvault-agent/internal/webhook"
+)
+
+// fileHashParam reads a bytes32 file hash from the path, with or without a
+// 0x prefix
+func fileHashParam(c *gin.Context) (string, bool) {
+	hash := strings.TrimPrefix(c.Param("hash"), "0x")
+	if len(hash) != 64 || !isHex(hash) {
+		reject(c, apierror.InvalidHash, "Invalid file hash")
+		return "", false
+	}
+	return "0x" + hash, true
+}
+
+// addressParam reads an account address from the path
+func addressParam(c *gin.Context) (string, bool) {
+	address := c.Param("address")
+	if !common.IsHexAddress(address) {
+		reject(c, apierror.InvalidRequest, "Invalid address")
+		return "", false
+	}
+	return common.HexToAddress(address).Hex(), true
+}
+
+func isHex(s string) bool {
+	for _, r := range s {
+		if !strings.ContainsRune("0123456789abcdefABCDEF", r) {
+			return false
+		}
+	}
+	return true
+}
+
+// registerUserRequest names the agent's account
+type registerUserRequest struct {
+	Username string `json:"username" binding:"required"`
+}
+
+// verifyProofRequest is a Merkle proof for a file
+type verifyProofRequest struct {
+	MerkleRoot string   `json:"merkle_root" binding:"required"`
+	Proof      []string `json:"proof" binding:"required"`
+	Indices    []uint64 `json:"indices" binding:"required"`
+	LeafHash   string   `json:"leaf_hash" binding:"required"`
+}
+
+// grantAccessRequest names an account to authorize
+type grantAccessRequest struct {
+	Address string `json:"address" binding:"required"`
+}
+
+// RegisterUser registers the agent's account under a username
+func RegisterUser(client *contracts.ContractClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var request registerUserRequest
+		if err := c.ShouldBindJSON(&request); err != nil {
+			reject(c, apierror.InvalidRequest, "Invalid request body")
+			return
+		}
+
+		resp, err := client.RegisterUser(request.Username)
+		if err != nil {
+			fail(c, err, "Failed to register user")
+			return
+		}
+
+		c.JSON(http.StatusCreated, APIResponse{
+			Success: true,
+			Data:    resp,
+			Message: "User registered successfully",
+		})
+	}
+}
+
+// GetUserProfile returns an account's on-chain profile
+func GetUserProfile(client *contracts.ContractClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		address, ok := addressParam(c)
+		if !ok {
+			return
+		}
+
+		profile, err := client.GetUserProfile(address)
+		if err != nil {
+			fail(c, err, "Failed to get user profile")
+			return
+		}
+
+		c.JSON(http.StatusOK, APIResponse{
+			Success: true,
+			Data:    profile,
+			Message: "User profile retrieved successfully",
+		})
+	}
+}
+
+// GetSystemStats returns the vault-wide counters
+func GetSystemStats(client *contracts.ContractClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		stats, err := client.GetSystemStats()
+		if err != nil {
+			fail(c, err, "Failed to get system stats")
+			return
+		}
+
+		c.JSON(http.StatusOK, APIResponse{
+			Success: true,
+			Data:    stats,
+			Message: "System stats retrieved successfully",
+		})
+	}
+}
+
+// VerifyFileProof submits a Merkle proof for a file to the vault
+func VerifyFileProof(client *contracts.ContractClient, engine *policy.Engine) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		hash, ok := fileHashParam(c)
+		if !ok || !requireOwner(c, engine, hash) {
+			return
+		}
+
+		var request verifyProofRequest
+		if err := c.ShouldBindJSON(&request); err != nil {
+			reject(c, apierror.InvalidRequest, "Invalid request body")
+			return
+		}
+
+		resp, err := client.VerifyProof(&contracts.FileUploadRequest{
+			FileHash:   hash,
+			MerkleRoot: request.MerkleRoot,
+			Proof:      request.Proof,
+			Indices:    request.Indices,
+			LeafHash:   request.LeafHash,
+		})
+		if err != nil {
+			fail(c, err, "Failed to verify proof")
+			return
+		}
+
+		c.JSON(http.StatusOK, APIResponse{
+			Success: true,
+			Data:    resp,
+			Message: "Proof submitted successfully",
+		})
+	}
+}
+
+// EstimateAnchor prices anchoring a stored file without sending anything:
+// the uploadFileWithVerification call the anchorer makes, or with
+// ?trusted=true a plain uploadFile
+func EstimateAnchor(client *contracts.ContractClient, store *metadata.Store, engine *policy.Engine) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		hash, ok := fileHashParam(c)
+		if !ok || !requireOwner(c, engine, hash) {
+			return
+		}
+
+		record, err := store.GetFile(hash)
+		if err == metadata.ErrNotFound {
+			reject(c, apierror.FileNotFound, "File not found")
+			return
+		}
+		if err != nil {
+			fail(c, err, "Failed to get file")
+			return
+		}
+		req, err := anchor.Request(record)
+		if err != nil {
+			fail(c, err, "Failed to build anchor request")
+			return
+		}
+
+		estimate := client.EstimateUploadFileWithVerification
+		if trusted, _ := strconv.ParseBool(c.Query("trusted")); trusted {
+			estimate = client.EstimateUploadFile
+		}
+		cost, err := estimate(req)
+		if err != nil {
+			fail(c, err, "Failed to estimate anchor cost")
+			return
+		}
+
+		c.JSON(http.StatusOK, APIResponse{
+			Success: true,
+			Data:    cost,
+			Message: "Anchor cost estimated successfully",
+		})
+	}
+}
+
+// DeleteFile marks a file inactive on chain. webhooks is nil when no
+// webhooks are configured.
+func DeleteFile(client *contracts.ContractClient, engine *policy.Engine, webhooks *webhook.Dispatcher) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		hash, ok := fileHashParam(c)
+		if !ok || !requireOwner(c, engine, hash) {
+			return
+		}
+
+		resp, err := client.DeleteFile(hash)
+		if err != nil {
+			fail(c, err, "Failed to delete file")
+			return
+		}
+		if webhooks != nil {
+			webhooks.FileDeleted(hash, resp.TxHash)
+		}
+
+		c.JSON(http.StatusOK, APIResponse{
+			Success: true,
+			Data:    resp,
+			Message: "File deleted successfully",
+		})
+	}
+}
+
+// GetFileAccess lists the accounts granted access to a file
+func GetFileAccess(client *contracts.ContractClient, engine *policy.Engine) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		hash, ok := fileHashParam(c)
+		if !ok || !authorizeFile(c, engine, hash) {
+			return
+		}
+
+		access, err := client.GetFileAccess(hash)
+		if err != nil {
+			fail(c, err, "Failed to get file access")
+			return
+		}
+
+		c.JSON(http.StatusOK, APIResponse{
+			Success: true,
+			Data:    access,
+			Message: "File access retrieved successfully",
+		})
+	}
+}
+
+// GrantFileAccess authorizes an account to download a file
+func GrantFileAccess(client *contracts.ContractClient, engine *policy.Engine, webhooks *webhook.Dispatcher) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		hash, ok := fileHashParam(c)
+		if !ok || !requireOwner(c, engine, hash) {
+			return
+		}
+
+		var request grantAccessRequest
+		if err := c.ShouldBindJSON(&request); err != nil || !common.IsHexAddress(request.Address) {
+			reject(c, apierror.InvalidRequest, "Invalid request body")
+			return
+		}
+
+		resp, err := client.AuthorizeUser(hash, request.Address)
+		if err != nil {
+			fail(c, err, "Failed to authorize user")
+			return
+		}
+		if engine != nil {
+			engine.Forget(hash, request.Address)
+		}
+		if webhooks != nil {
+			webhooks.AccessGranted(hash, request.Address, resp.TxHash)
+		}
+
+		c.JSON(http.StatusOK, APIResponse{
+			Success: true,
+			Data:    resp,
+			Message: "User authorized successfully",
+		})
+	}
+}
+
+// RevokeFileAccess withdraws an account's access to a file
+func RevokeFileAccess(client *contracts.ContractClient, engine *policy.Engine) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		hash, ok := fileHashParam(c)
+		if !ok || !requireOwner(c, engine, hash) {
+			return
+		}
+		address, ok := addressParam(c)
+		if !ok {
+			return
+		}
+
+		resp, err := client.RevokeUser(hash, address)
+		if err != nil {
+			fail(c, err, "Failed to revoke user")
+			return
+		}
+		if engine != nil {
+			engine.Forget(hash, address)
+		}
+
+		c.JSON(http.StatusOK, APIResponse{
+			Success: true,
+			Data:    resp,
+			Message: "User revoked successfully",
+		})
+	}
+}
+
+// requireOwner lets only the wallet that uploaded a file change it on chain:
+// anchor, verify or delete it, or change who may read it. Every transaction
+// is signed by the agent's key, so the contract cannot tell callers apart.
+// API keys are only subject to their scopes.
+func requireOwner(c *gin.Context, engine *policy.Engine, hash string) bool {
+	address, ok := middleware.UserAddress(c)
+	if !ok || engine == nil {
+		return true
+	}
+
+	owner, err := engine.IsOwner(address, hash)
+	if err == metadata.ErrNotFound {
+		reject(c, apierror.FileNotFound, "File not found")
+		return false
+	}
+	if err != nil {
+		fail(c, err, "Failed to check file owner")
+		return false
+	}
+	if !owner {
+		reject(c, apierror.Forbidden, "Only the file owner can manage the file")
+		return false
+	}
+	return true
+}
@@ -0,0 +1,543 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	"nebularvault-agent/internal/apierror"
+	"nebularvault-agent/internal/auth"
+	"nebularvault-agent/internal/contracts"
+	"nebularvault-agent/internal/jobs"
+	"nebularvault-agent/internal/metadata"
+	"nebularvault-agent/internal/middleware"
+	"nebularvault-agent/internal/pipeline"
+	"nebularvault-agent/internal/policy"
+	"nebularvault-agent/internal/storage"
+	"nebularvault-agent/internal/zerog"
+)
+
+// newVaultRouter deploys the vault on a simulated chain that mines
+// continuously and serves the contract-backed routes for the deployer
+func newVaultRouter(t *testing.T) (*gin.Engine, *contracts.ContractClient, string) {
+	t.Helper()
+
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	owner := crypto.PubkeyToAddress(key.PublicKey)
+
+	backend := simulated.NewBackend(types.GenesisAlloc{
+		owner: {Balance: new(big.Int).Mul(big.NewInt(1000), big.NewInt(1e18))},
+	}, simulated.WithBlockGasLimit(30_000_000))
+	t.Cleanup(func() { backend.Close() })
+
+	deployer, err := bind.NewKeyedTransactorWithChainID(key, big.NewInt(1337))
+	if err != nil {
+		t.Fatalf("Failed to create deployer: %v", err)
+	}
+	vault, _, _, err := contracts.DeployNebulaVaultContract(deployer, backend.Client())
+	if err != nil {
+		t.Fatalf("Failed to deploy NebulaVault: %v", err)
+	}
+	backend.Commit()
+
+	stop, done := make(chan struct{}), make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(10 * time.Millisecond)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				backend.Commit()
+			}
+		}
+	}()
+	// Cleanups run last-registered first, so mining stops before the
+	// backend closes
+	t.Cleanup(func() {
+		close(stop)
+		<-done
+	})
+
+	client, err := contracts.NewContractClientWithBackend(&contracts.ContractConfig{
+		ChainID:         1337,
+		ContractAddress: vault.Hex(),
+		PrivateKey:      hex.EncodeToString(crypto.FromECDSA(key)),
+		Confirmations:   1,
+		PollInterval:    10 * time.Millisecond,
+		Timeout:         10 * time.Second,
+	}, backend.Client(), logrus.New())
+	if err != nil {
+		t.Fatalf("Failed to create contract client: %v", err)
+	}
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.POST("/users", RegisterUser(client))
+	router.GET("/users/:address", GetUserProfile(client))
+	router.GET("/stats", GetSystemStats(client))
+	router.DELETE("/files/:hash", DeleteFile(client, nil, nil))
+	router.GET("/files/:hash/access", GetFileAccess(client, nil))
+	router.POST("/files/:hash/access", GrantFileAccess(client, nil, nil))
+	router.DELETE("/files/:hash/access/:address", RevokeFileAccess(client, nil))
+
+	return router, client, owner.Hex()
+}
+
+func serve(t *testing.T, router *gin.Engine, method, path string, body interface{}, data interface{}) (int, apierror.Problem) {
+	t.Helper()
+
+	var reader *bytes.Reader
+	if body != nil {
+		encoded, _ := json.Marshal(body)
+		reader = bytes.NewReader(encoded)
+	} else {
+		reader = bytes.NewReader(nil)
+	}
+	req := httptest.NewRequest(method, path, reader)
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	var problem apierror.Problem
+	var target interface{} = &APIResponse{Data: data}
+	if w.Code >= http.StatusBadRequest {
+		if contentType := w.Header().Get("Content-Type"); contentType != apierror.ContentType {
+			t.Errorf("Expected %s for %s %s, got %q", apierror.ContentType, method, path, contentType)
+		}
+		target = &problem
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), target); err != nil {
+		t.Fatalf("Failed to decode %s %s response: %v", method, path, err)
+	}
+	return w.Code, problem
+}
+
+func TestUserRoutes(t *testing.T) {
+	router, _, owner := newVaultRouter(t)
+
+	status, problem := serve(t, router, http.MethodGet, "/users/"+owner, nil, nil)
+	if status != http.StatusNotFound || problem.Code != apierror.NotFound || problem.Detail != "User not registered" {
+		t.Errorf("Expected 404 for an unregistered user, got %d %+v", status, problem)
+	}
+
+	status, problem = serve(t, router, http.MethodGet, "/users/not-an-address", nil, nil)
+	if status != http.StatusBadRequest || problem.Code != apierror.InvalidRequest {
+		t.Errorf("Expected 400 for an invalid address, got %d %+v", status, problem)
+	}
+
+	status, problem = serve(t, router, http.MethodPost, "/users", map[string]string{"username": "alice"}, nil)
+	if status != http.StatusCreated {
+		t.Fatalf("Expected 201 registering a user, got %d %+v", status, problem)
+	}
+
+	status, problem = serve(t, router, http.MethodPost, "/users", map[string]string{"username": "alice2"}, nil)
+	if status != http.StatusConflict || problem.Code != apierror.Conflict || problem.Detail != "User already registered" {
+		t.Errorf("Expected 409 registering twice, got %d %+v", status, problem)
+	}
+
+	var profile contracts.UserProfile
+	status, _ = serve(t, router, http.MethodGet, "/users/"+strings.ToLower(owner), nil, &profile)
+	if status != http.StatusOK || profile.Username != "alice" || profile.Address != owner {
+		t.Errorf("Expected alice's profile, got %d %+v", status, profile)
+	}
+
+	var stats contracts.SystemStats
+	status, _ = serve(t, router, http.MethodGet, "/stats", nil, &stats)
+	if status != http.StatusOK || stats.TotalUsers != 1 {
+		t.Errorf("Expected one registered user in stats, got %d %+v", status, stats)
+	}
+}
+
+func TestFileAccessRoutes(t *testing.T) {
+	router, client, _ := newVaultRouter(t)
+	friend := common.HexToAddress("0xf1").Hex()
+	fileHash := "0x" + strings.Repeat("51", 32)
+
+	if _, err := client.RegisterUser("alice"); err != nil {
+		t.Fatalf("Failed to register user: %v", err)
+	}
+
+	status, problem := serve(t, router, http.MethodGet, "/files/"+fileHash+"/access", nil, nil)
+	if status != http.StatusNotFound || problem.Code != apierror.FileNotFound || problem.Detail != "File does not exist" {
+		t.Errorf("Expected 404 for an unknown file, got %d %+v", status, problem)
+	}
+	if problem.Instance != "/files/"+fileHash+"/access" || problem.Debug != "" {
+		t.Errorf("Expected the request path and no debug detail, got %+v", problem)
+	}
+
+	status, problem = serve(t, router, http.MethodGet, "/files/abc/access", nil, nil)
+	if status != http.StatusBadRequest || problem.Code != apierror.InvalidHash {
+		t.Errorf("Expected 400 for an invalid hash, got %d %+v", status, problem)
+	}
+
+	if _, err := client.UploadFile(&contracts.FileUploadRequest{
+		FileHash:   fileHash,
+		Filename:   "shared.txt",
+		Size:       10,
+		MerkleRoot: strings.Repeat("ab", 32),
+	}); err != nil {
+		t.Fatalf("Failed to upload file: %v", err)
+	}
+
+	status, problem = serve(t, router, http.MethodPost, "/files/"+fileHash+"/access", map[string]string{"address": friend}, nil)
+	if status != http.StatusOK {
+		t.Fatalf("Expected 200 granting access, got %d %+v", status, problem)
+	}
+
+	var access contracts.FileAccess
+	status, _ = serve(t, router, http.MethodGet, "/files/"+strings.TrimPrefix(fileHash, "0x")+"/access", nil, &access)
+	if status != http.StatusOK || !hasGrant(access, friend, true) {
+		t.Errorf("Expected %s to be authorized, got %d %+v", friend, status, access)
+	}
+
+	status, problem = serve(t, router, http.MethodDelete, "/files/"+fileHash+"/access/"+friend, nil, nil)
+	if status != http.StatusOK {
+		t.Fatalf("Expected 200 revoking access, got %d %+v", status, problem)
+	}
+
+	access = contracts.FileAccess{}
+	serve(t, router, http.MethodGet, "/files/"+fileHash+"/access", nil, &access)
+	if !hasGrant(access, friend, false) {
+		t.Errorf("Expected %s to be revoked, got %+v", friend, access)
+	}
+
+	status, problem = serve(t, router, http.MethodDelete, "/files/"+fileHash, nil, nil)
+	if status != http.StatusOK {
+		t.Fatalf("Expected 200 deleting the file, got %d %+v", status, problem)
+	}
+
+	status, problem = serve(t, router, http.MethodDelete, "/files/"+fileHash, nil, nil)
+	if status != http.StatusGone || problem.Code != apierror.Gone || problem.Detail != "File is not active" {
+		t.Errorf("Expected 410 deleting twice, got %d %+v", status, problem)
+	}
+}
+
+func TestEstimateAnchor(t *testing.T) {
+	router, client, _ := newVaultRouter(t)
+	store, err := metadata.Open(filepath.Join(t.TempDir(), "metadata"))
+	if err != nil {
+		t.Fatalf("Failed to open metadata store: %v", err)
+	}
+	defer store.Close()
+	router.POST("/files/:hash/anchor/estimate", EstimateAnchor(client, store, nil))
+
+	hash := strings.Repeat("53", 32)
+	if err := store.PutFile(&metadata.FileRecord{
+		ID:          "file-1",
+		Hash:        hash,
+		Filename:    "estimate.txt",
+		Size:        32,
+		MerkleRoot:  "0x" + strings.Repeat("ab", 32),
+		ChunkHashes: []string{strings.Repeat("01", 32), strings.Repeat("02", 32)},
+	}); err != nil {
+		t.Fatalf("Failed to store file: %v", err)
+	}
+
+	status, problem := serve(t, router, http.MethodPost, "/files/0x"+strings.Repeat("54", 32)+"/anchor/estimate", nil, nil)
+	if status != http.StatusNotFound || problem.Code != apierror.FileNotFound {
+		t.Errorf("Expected 404 for an unknown file, got %d %+v", status, problem)
+	}
+
+	// The call is simulated, so a revert is reported before anything is sent
+	status, problem = serve(t, router, http.MethodPost, "/files/0x"+hash+"/anchor/estimate", nil, nil)
+	if status != http.StatusForbidden || problem.Detail != "User not registered or inactive" {
+		t.Errorf("Expected the simulated call to revert for an unregistered account, got %d %+v", status, problem)
+	}
+
+	if _, err := client.RegisterUser("alice"); err != nil {
+		t.Fatalf("Failed to register user: %v", err)
+	}
+	fee, err := client.StorageFee()
+	if err != nil {
+		t.Fatalf("Failed to read storage fee: %v", err)
+	}
+
+	for _, tc := range []struct{ query, method string }{
+		{"", "uploadFileWithVerification"},
+		{"?trusted=true", "uploadFile"},
+	} {
+		var estimate contracts.CostEstimate
+		status, problem = serve(t, router, http.MethodPost, "/files/0x"+hash+"/anchor/estimate"+tc.query, nil, &estimate)
+		if status != http.StatusOK {
+			t.Fatalf("Expected 200 estimating %s, got %d %+v", tc.method, status, problem)
+		}
+		if estimate.Method != tc.method || estimate.GasLimit == 0 || estimate.Value.Cmp(fee) != 0 {
+			t.Errorf("Expected a %s estimate carrying the %s fee, got %+v", tc.method, fee, estimate)
+		}
+		if estimate.ExpectedCost.Cmp(fee) <= 0 || estimate.MaxCost.Cmp(estimate.ExpectedCost) < 0 {
+			t.Errorf("Expected the %s cost to add gas to the fee, got %+v", tc.method, estimate)
+		}
+	}
+
+	stats, err := client.GetSystemStats()
+	if err != nil {
+		t.Fatalf("Failed to get system stats: %v", err)
+	}
+	if stats.TotalFiles != 0 {
+		t.Errorf("Expected estimating to upload nothing, got %d files", stats.TotalFiles)
+	}
+}
+
+// TestFileRoutes_RequireOwner checks that a signed-in wallet can only make
+// the agent sign transactions for files it uploaded
+func TestFileRoutes_RequireOwner(t *testing.T) {
+	_, client, _ := newVaultRouter(t)
+	authenticator, err := auth.NewAuthenticator(auth.Config{Domain: "vault.example", ChainID: 1})
+	if err != nil {
+		t.Fatalf("Failed to create authenticator: %v", err)
+	}
+	owner, ownerToken := signIn(t, authenticator)
+	_, otherToken := signIn(t, authenticator)
+
+	store, err := metadata.Open(filepath.Join(t.TempDir(), "metadata"))
+	if err != nil {
+		t.Fatalf("Failed to open metadata store: %v", err)
+	}
+	defer store.Close()
+	hash := strings.Repeat("52", 32)
+	if err := store.PutFile(&metadata.FileRecord{ID: "file-1", Hash: hash, UserID: owner}); err != nil {
+		t.Fatalf("Failed to store file: %v", err)
+	}
+	engine := policy.NewEngine(store, nil, policy.Config{}, logrus.New())
+
+	router := gin.New()
+	files := router.Group("/files", middleware.Authenticate(authenticator, nil))
+	files.POST("/anchor/:hash", RetryAnchor(nil, engine))
+	files.POST("/:hash/anchor/estimate", EstimateAnchor(client, store, engine))
+	files.DELETE("/:hash", DeleteFile(client, engine, nil))
+	files.POST("/:hash/verify", VerifyFileProof(client, engine))
+	files.GET("/:hash/access", GetFileAccess(client, engine))
+
+	request := func(method, path, token string) (int, apierror.Problem) {
+		req := httptest.NewRequest(method, path, strings.NewReader("{}"))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		var problem apierror.Problem
+		json.Unmarshal(w.Body.Bytes(), &problem)
+		return w.Code, problem
+	}
+
+	for _, route := range []struct{ method, path string }{
+		{http.MethodPost, "/files/anchor/" + hash},
+		{http.MethodPost, "/files/0x" + hash + "/anchor/estimate"},
+		{http.MethodDelete, "/files/0x" + hash},
+		{http.MethodPost, "/files/0x" + hash + "/verify"},
+	} {
+		status, problem := request(route.method, route.path, otherToken)
+		if status != http.StatusForbidden || problem.Code != apierror.Forbidden {
+			t.Errorf("Expected 403 for another wallet on %s %s, got %d %+v", route.method, route.path, status, problem)
+		}
+	}
+
+	// A file the caller cannot read is reported as missing
+	status, problem := request(http.MethodGet, "/files/0x"+hash+"/access", otherToken)
+	if status != http.StatusNotFound || problem.Detail != "File not found" {
+		t.Errorf("Expected 404 listing access to an unreadable file, got %d %+v", status, problem)
+	}
+
+	// The owner gets through to the chain, where the agent's account is not
+	// registered and the file was never uploaded
+	status, problem = request(http.MethodDelete, "/files/0x"+hash, ownerToken)
+	if problem.Detail != "User not registered or inactive" {
+		t.Errorf("Expected the owner's delete to reach the chain, got %d %+v", status, problem)
+	}
+	status, problem = request(http.MethodGet, "/files/0x"+hash+"/access", ownerToken)
+	if status != http.StatusNotFound || problem.Detail != "File does not exist" {
+		t.Errorf("Expected the owner's access listing to reach the chain, got %d %+v", status, problem)
+	}
+}
+
+// grantingChain grants every registered, active account access to every file
+type grantingChain struct{}
+
+func (grantingChain) IsUserAuthorized(fileHash, userAddress string) (bool, error) {
+	return true, nil
+}
+
+func (grantingChain) UserStatus(userAddress string) (bool, bool, error) {
+	return true, true, nil
+}
+
+func (grantingChain) HasPermission(userAddress, permission string) (bool, error) {
+	return false, nil
+}
+
+// TestCreateJob_RepairRequiresOwner checks that a wallet granted read access
+// can verify a file but not make the agent pay to repair it
+func TestCreateJob_RepairRequiresOwner(t *testing.T) {
+	authenticator, err := auth.NewAuthenticator(auth.Config{Domain: "vault.example", ChainID: 1})
+	if err != nil {
+		t.Fatalf("Failed to create authenticator: %v", err)
+	}
+	owner, ownerToken := signIn(t, authenticator)
+	_, readerToken := signIn(t, authenticator)
+
+	dir := t.TempDir()
+	store, err := metadata.Open(filepath.Join(dir, "metadata"))
+	if err != nil {
+		t.Fatalf("Failed to open metadata store: %v", err)
+	}
+	defer store.Close()
+	hash := strings.Repeat("56", 32)
+	if err := store.PutFile(&metadata.FileRecord{ID: "file-1", Hash: hash, UserID: owner}); err != nil {
+		t.Fatalf("Failed to store file: %v", err)
+	}
+	queue := jobs.NewQueue(store, jobs.Config{}, logrus.New())
+	p := pipeline.New(storage.NewStorageManager(dir, dir, 16), nil, store, nil, queue, pipeline.Config{}, logrus.New())
+	engine := policy.NewEngine(store, grantingChain{}, policy.Config{}, logrus.New())
+
+	router := gin.New()
+	router.POST("/jobs", middleware.Authenticate(authenticator, nil), CreateJob(p, queue, engine))
+	request := func(jobType, token string) (int, apierror.Problem) {
+		req := httptest.NewRequest(http.MethodPost, "/jobs", strings.NewReader(`{"type":"`+jobType+`","hash":"`+hash+`"}`))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		var problem apierror.Problem
+		json.Unmarshal(w.Body.Bytes(), &problem)
+		return w.Code, problem
+	}
+
+	if status, problem := request(pipeline.JobVerify, readerToken); status != http.StatusAccepted {
+		t.Errorf("Expected a granted wallet to queue a verify, got %d %+v", status, problem)
+	}
+	if status, problem := request(pipeline.JobRepair, readerToken); status != http.StatusForbidden || problem.Code != apierror.Forbidden {
+		t.Errorf("Expected 403 for a granted wallet's repair, got %d %+v", status, problem)
+	}
+	if status, problem := request(pipeline.JobRepair, ownerToken); status != http.StatusAccepted {
+		t.Errorf("Expected the owner to queue a repair, got %d %+v", status, problem)
+	}
+}
+
+// TestStorageRefRoutes_ResolveOwner checks that a wallet can download and
+// prove its own file's chunks by the 0G roots they are stored under
+func TestStorageRefRoutes_ResolveOwner(t *testing.T) {
+	authenticator, err := auth.NewAuthenticator(auth.Config{Domain: "vault.example", ChainID: 1})
+	if err != nil {
+		t.Fatalf("Failed to create authenticator: %v", err)
+	}
+	owner, ownerToken := signIn(t, authenticator)
+	_, otherToken := signIn(t, authenticator)
+
+	store, err := metadata.Open(filepath.Join(t.TempDir(), "metadata"))
+	if err != nil {
+		t.Fatalf("Failed to open metadata store: %v", err)
+	}
+	defer store.Close()
+	root := "0x" + strings.Repeat("5a", 32)
+	if err := store.PutFile(&metadata.FileRecord{
+		ID:          "file-1",
+		Hash:        strings.Repeat("55", 32),
+		UserID:      owner,
+		ChunkHashes: []string{strings.Repeat("01", 32)},
+		StorageRefs: []string{root},
+	}); err != nil {
+		t.Fatalf("Failed to store file: %v", err)
+	}
+	engine := policy.NewEngine(store, nil, policy.Config{}, logrus.New())
+	zeroGClient, err := zerog.NewZeroGClient(&zerog.ZeroGConfig{}, logrus.New())
+	if err != nil {
+		t.Fatalf("Failed to create 0G client: %v", err)
+	}
+
+	router := gin.New()
+	files := router.Group("/files", middleware.Authenticate(authenticator, nil))
+	files.GET("/download/:hash", DownloadFile(nil, zeroGClient, engine))
+	files.GET("/proof/:hash", GetProof(zeroGClient, engine))
+
+	request := func(path, token string) (int, apierror.Problem) {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		var problem apierror.Problem
+		json.Unmarshal(w.Body.Bytes(), &problem)
+		return w.Code, problem
+	}
+
+	for _, path := range []string{"/files/download/" + root, "/files/proof/" + strings.ToUpper(root[2:])} {
+		if status, problem := request(path, ownerToken); status != http.StatusOK {
+			t.Errorf("Expected the owner to get %s, got %d %+v", path, status, problem)
+		}
+		if status, problem := request(path, otherToken); status != http.StatusNotFound || problem.Code != apierror.FileNotFound {
+			t.Errorf("Expected 404 for another wallet on %s, got %d %+v", path, status, problem)
+		}
+	}
+
+	status, problem := request("/files/download/0x"+strings.Repeat("5b", 32), ownerToken)
+	if status != http.StatusNotFound || problem.Code != apierror.FileNotFound {
+		t.Errorf("Expected 404 for a root no file has, got %d %+v", status, problem)
+	}
+}
+
+// signIn signs in a new wallet and returns its address and session token
+func signIn(t *testing.T, authenticator *auth.Authenticator) (string, string) {
+	t.Helper()
+
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	nonce, err := authenticator.Nonce()
+	if err != nil {
+		t.Fatalf("Failed to issue nonce: %v", err)
+	}
+
+	text := (&auth.Message{
+		Domain:   "vault.example",
+		Address:  crypto.PubkeyToAddress(key.PublicKey),
+		URI:      "https://vault.example",
+		Version:  "1",
+		ChainID:  1,
+		Nonce:    nonce,
+		IssuedAt: time.Now(),
+	}).String()
+	sig, err := crypto.Sign(accounts.TextHash([]byte(text)), key)
+	if err != nil {
+		t.Fatalf("Failed to sign message: %v", err)
+	}
+
+	session, err := authenticator.SignIn(text, hexutil.Encode(sig))
+	if err != nil {
+		t.Fatalf("Failed to sign in: %v", err)
+	}
+	return session.Address, session.Token
+}
+
+func hasGrant(access contracts.FileAccess, address string, authorized bool) bool {
+	for _, grant := range access.Grants {
+		if grant.Address == address {
+			return grant.Authorized == authorized
+		}
+	}
+	return false
+}
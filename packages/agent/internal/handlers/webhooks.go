@@ -0,0 +1,231 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"nebularvault-agent/internal/apierror"
+	"nebularvault-agent/internal/metadata"
+	"nebularvault-agent/internal/webhook"
+)
+
+// webhookResponse is a webhook as clients see it. The secret is only shown
+// when the webhook is created.
+type webhookResponse struct {
+	ID          string    `json:"id"`
+	URL         string    `json:"url"`
+	Events      []string  `json:"events"`
+	Description string    `json:"description,omitempty"`
+	Secret      string    `json:"secret,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+func newWebhookResponse(w *metadata.Webhook) *webhookResponse {
+	return &webhookResponse{
+		ID:          w.ID,
+		URL:         w.URL,
+		Events:      w.Events,
+		Description: w.Description,
+		CreatedAt:   w.CreatedAt,
+	}
+}
+
+// createWebhookRequest subscribes a URL to events
+type createWebhookRequest struct {
+	URL         string   `json:"url" binding:"required"`
+	Events      []string `json:"events" binding:"required"`
+	Description string   `json:"description,omitempty"`
+}
+
+// CreateWebhook subscribes a URL to file lifecycle events
+func CreateWebhook(dispatcher *webhook.Dispatcher) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var request createWebhookRequest
+		if err := c.ShouldBindJSON(&request); err != nil {
+			reject(c, apierror.InvalidRequest, "Invalid request body")
+			return
+		}
+
+		created, err := dispatcher.Create(request.URL, request.Events, request.Description)
+		if errors.Is(err, webhook.ErrInvalidWebhook) {
+			reject(c, apierror.InvalidRequest, err.Error())
+			return
+		}
+		if err != nil {
+			fail(c, err, "Failed to create webhook")
+			return
+		}
+
+		resp := newWebhookResponse(created)
+		resp.Secret = created.Secret
+		c.JSON(http.StatusCreated, APIResponse{
+			Success: true,
+			Data:    resp,
+			Message: "Webhook created; store the secret, it is not shown again",
+		})
+	}
+}
+
+// ListWebhooks returns every webhook, oldest first
+func ListWebhooks(store *metadata.Store) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		webhooks, err := store.ListWebhooks()
+		if err != nil {
+			fail(c, err, "Failed to list webhooks")
+			return
+		}
+
+		sort.Slice(webhooks, func(i, j int) bool {
+			return webhooks[i].CreatedAt.Before(webhooks[j].CreatedAt)
+		})
+		list := make([]*webhookResponse, 0, len(webhooks))
+		for _, w := range webhooks {
+			list = append(list, newWebhookResponse(w))
+		}
+
+		c.JSON(http.StatusOK, APIResponse{
+			Success: true,
+			Data:    list,
+			Message: "Webhooks retrieved successfully",
+		})
+	}
+}
+
+// GetWebhook returns a webhook
+func GetWebhook(store *metadata.Store) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		w, ok := webhookParam(c, store)
+		if !ok {
+			return
+		}
+
+		c.JSON(http.StatusOK, APIResponse{
+			Success: true,
+			Data:    newWebhookResponse(w),
+			Message: "Webhook retrieved successfully",
+		})
+	}
+}
+
+// DeleteWebhook unsubscribes a webhook. Its pending deliveries are
+// dead-lettered when they next run; its delivery log is kept.
+func DeleteWebhook(store *metadata.Store) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		err := store.DeleteWebhook(c.Param("id"))
+		if err == metadata.ErrNotFound {
+			reject(c, apierror.NotFound, "Webhook not found")
+			return
+		}
+		if err != nil {
+			fail(c, err, "Failed to delete webhook")
+			return
+		}
+
+		c.JSON(http.StatusOK, APIResponse{
+			Success: true,
+			Message: "Webhook deleted successfully",
+		})
+	}
+}
+
+// ListWebhookDeliveries returns a webhook's delivery log, newest first,
+// optionally filtered by ?status= and capped by ?limit= (default 100)
+func ListWebhookDeliveries(store *metadata.Store) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		w, ok := webhookParam(c, store)
+		if !ok {
+			return
+		}
+		respondDeliveries(c, store, func(delivery *metadata.Delivery) bool {
+			return delivery.WebhookID == w.ID
+		})
+	}
+}
+
+// ListDeadLetters returns the deliveries to every webhook that used up
+// their attempts, newest first
+func ListDeadLetters(store *metadata.Store) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		respondDeliveries(c, store, func(delivery *metadata.Delivery) bool {
+			return delivery.Status == metadata.DeliveryDead
+		})
+	}
+}
+
+// RedeliverWebhook retries a dead-lettered delivery
+func RedeliverWebhook(dispatcher *webhook.Dispatcher, store *metadata.Store) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		delivery, err := store.GetDelivery(c.Param("delivery"))
+		if err == metadata.ErrNotFound || (err == nil && delivery.WebhookID != c.Param("id")) {
+			reject(c, apierror.NotFound, "Delivery not found")
+			return
+		}
+		if err == nil {
+			delivery, err = dispatcher.Redeliver(delivery.ID)
+		}
+		if errors.Is(err, webhook.ErrNotDead) {
+			reject(c, apierror.Conflict, "Only dead deliveries can be redelivered")
+			return
+		}
+		if err != nil {
+			fail(c, err, "Failed to redeliver webhook")
+			return
+		}
+
+		c.JSON(http.StatusAccepted, APIResponse{
+			Success: true,
+			Data:    delivery,
+			Message: "Delivery queued",
+		})
+	}
+}
+
+// webhookParam loads the webhook named by the :id parameter, responding if
+// it does not exist
+func webhookParam(c *gin.Context, store *metadata.Store) (*metadata.Webhook, bool) {
+	w, err := store.GetWebhook(c.Param("id"))
+	if err == metadata.ErrNotFound {
+		reject(c, apierror.NotFound, "Webhook not found")
+		return nil, false
+	}
+	if err != nil {
+		fail(c, err, "Failed to get webhook")
+		return nil, false
+	}
+	return w, true
+}
+
+func respondDeliveries(c *gin.Context, store *metadata.Store, match func(*metadata.Delivery) bool) {
+	status := metadata.DeliveryStatus(c.Query("status"))
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "100"))
+	if err != nil || limit <= 0 {
+		reject(c, apierror.InvalidRequest, "limit must be a positive integer")
+		return
+	}
+
+	deliveries, err := store.ListDeliveries(func(delivery *metadata.Delivery) bool {
+		return match(delivery) && (status == "" || delivery.Status == status)
+	})
+	if err != nil {
+		fail(c, err, "Failed to list deliveries")
+		return
+	}
+
+	sort.Slice(deliveries, func(i, j int) bool {
+		return deliveries[i].CreatedAt.After(deliveries[j].CreatedAt)
+	})
+	if len(deliveries) > limit {
+		deliveries = deliveries[:limit]
+	}
+
+	c.JSON(http.StatusOK, APIResponse{
+		Success: true,
+		Data:    deliveries,
+		Message: "Deliveries retrieved successfully",
+	})
+}
@@ -0,0 +1,9 @@
+//go:build !unix
+
+package health
+
+import "errors"
+
+func freeBytes(string) (uint64, error) {
+	return 0, errors.New("free disk space is not supported on this platform")
+}
@@ -0,0 +1,129 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Check statuses
+const (
+	StatusOK   = "ok"
+	StatusFail = "fail"
+)
+
+// CheckFunc checks one dependency, returning a short description of what
+// it found or why it is unavailable
+type CheckFunc func(ctx context.Context) (string, error)
+
+// Result is the outcome of one check
+type Result struct {
+	Name       string  `json:"name"`
+	Status     string  `json:"status"`
+	Detail     string  `json:"detail,omitempty"`
+	Error      string  `json:"error,omitempty"`
+	DurationMs float64 `json:"duration_ms"`
+}
+
+// Report is the agent's readiness with the result of every check
+type Report struct {
+	Status string   `json:"status"`
+	Checks []Result `json:"checks"`
+}
+
+type check struct {
+	name string
+	fn   CheckFunc
+}
+
+// Prober runs readiness checks. Liveness needs no checks: a process that
+// can answer is alive, and restarting it does not fix its dependencies.
+type Prober struct {
+	timeout  time.Duration
+	checks   []check
+	draining atomic.Bool
+}
+
+// NewProber creates a prober that gives each check up to timeout
+func NewProber(timeout time.Duration) *Prober {
+	if timeout <= 0 {
+		timeout = 5 * time.Second
+	}
+	return &Prober{timeout: timeout}
+}
+
+// Register adds a check; all checks must pass for the agent to be ready
+func (p *Prober) Register(name string, fn CheckFunc) {
+	p.checks = append(p.checks, check{name: name, fn: fn})
+}
+
+// Drain marks the agent as shutting down, so it reports not ready and load
+// balancers stop sending it requests before the server stops
+func (p *Prober) Drain() {
+	p.draining.Store(true)
+}
+
+// Ready runs every check concurrently and reports whether all passed
+func (p *Prober) Ready(ctx context.Context) Report {
+	results := make([]Result, len(p.checks))
+	var wg sync.WaitGroup
+	for i, c := range p.checks {
+		wg.Add(1)
+		go func(i int, c check) {
+			defer wg.Done()
+			results[i] = p.run(ctx, c)
+		}(i, c)
+	}
+	wg.Wait()
+
+	report := Report{Status: StatusOK, Checks: results}
+	if p.draining.Load() {
+		report.Status = StatusFail
+		report.Checks = append(report.Checks, Result{Name: "shutdown", Status: StatusFail, Error: "agent is shutting down"})
+	}
+	for _, result := range results {
+		if result.Status != StatusOK {
+			report.Status = StatusFail
+		}
+	}
+	return report
+}
+
+func (p *Prober) run(ctx context.Context, c check) (result Result) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	start := time.Now()
+	result = Result{Name: c.name, Status: StatusOK}
+	defer func() {
+		if r := recover(); r != nil {
+			result.Status, result.Error = StatusFail, fmt.Sprintf("check panicked: %v", r)
+		}
+		result.DurationMs = float64(time.Since(start).Microseconds()) / 1000
+	}()
+
+	detail, err := c.fn(ctx)
+	result.Detail = detail
+	if err != nil {
+		result.Status, result.Error = StatusFail, err.Error()
+	}
+	return result
+}
+
+// DiskSpace checks that dir's filesystem has at least minFree bytes
+// available to the agent
+func DiskSpace(dir string, minFree uint64) CheckFunc {
+	return func(context.Context) (string, error) {
+		free, err := freeBytes(dir)
+		if err != nil {
+			return "", fmt.Errorf("failed to read free space of %s: %w", dir, err)
+		}
+		detail := fmt.Sprintf("%d MiB free in %s", free>>20, dir)
+		if free < minFree {
+			return detail, fmt.Errorf("%d MiB free in %s, below the %d MiB minimum", free>>20, dir, minFree>>20)
+		}
+		return detail, nil
+	}
+}
@@ -0,0 +1,73 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestProber_Ready(t *testing.T) {
+	prober := NewProber(50 * time.Millisecond)
+	prober.Register("ok", func(context.Context) (string, error) { return "fine", nil })
+
+	report := prober.Ready(context.Background())
+	if report.Status != StatusOK || len(report.Checks) != 1 || report.Checks[0].Detail != "fine" {
+		t.Fatalf("Expected a passing report, got %+v", report)
+	}
+
+	prober.Register("down", func(context.Context) (string, error) { return "", errors.New("connection refused") })
+	prober.Register("slow", func(ctx context.Context) (string, error) {
+		<-ctx.Done()
+		return "", ctx.Err()
+	})
+	prober.Register("broken", func(context.Context) (string, error) { panic("nil client") })
+
+	start := time.Now()
+	report = prober.Ready(context.Background())
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Expected checks to run concurrently within their timeout, took %v", elapsed)
+	}
+	if report.Status != StatusFail {
+		t.Errorf("Expected a failing report, got %+v", report)
+	}
+
+	want := map[string]string{
+		"ok":     "",
+		"down":   "connection refused",
+		"slow":   "deadline exceeded",
+		"broken": "check panicked",
+	}
+	for _, result := range report.Checks {
+		if (result.Status == StatusOK) != (want[result.Name] == "") || !strings.Contains(result.Error, want[result.Name]) {
+			t.Errorf("Unexpected result for %s: %+v", result.Name, result)
+		}
+	}
+}
+
+func TestProber_Drain(t *testing.T) {
+	prober := NewProber(time.Second)
+	prober.Register("ok", func(context.Context) (string, error) { return "", nil })
+	prober.Drain()
+
+	report := prober.Ready(context.Background())
+	if report.Status != StatusFail || report.Checks[len(report.Checks)-1].Name != "shutdown" {
+		t.Errorf("Expected a draining agent to report not ready, got %+v", report)
+	}
+}
+
+func TestDiskSpace(t *testing.T) {
+	dir := t.TempDir()
+
+	if detail, err := DiskSpace(dir, 0)(context.Background()); err != nil || !strings.Contains(detail, "MiB free") {
+		t.Errorf("Expected free space to be reported, got %q, %v", detail, err)
+	}
+	if _, err := DiskSpace(dir, math.MaxUint64)(context.Background()); err == nil {
+		t.Error("Expected the check to fail below the minimum")
+	}
+	if _, err := DiskSpace(dir+"/missing", 0)(context.Background()); err == nil {
+		t.Error("Expected the check to fail for a missing directory")
+	}
+}
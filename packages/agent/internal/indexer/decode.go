@@ -0,0 +1,249 @@
+package indexer
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"nebularvault-agent/internal/contracts"
+	"nebularvault-agent/internal/metadata"
+)
+
+// decodeFunc turns a raw log into a store event
+type decodeFunc func(types.Log) (*metadata.Event, error)
+
+// roleNames maps AccessControl role hashes back to their names
+var roleNames = map[common.Hash]string{}
+
+func init() {
+	for _, role := range []string{"ADMIN_ROLE", "UPLOADER_ROLE", "DOWNLOADER_ROLE", "VERIFIER_ROLE", "MODERATOR_ROLE"} {
+		roleNames[crypto.Keccak256Hash([]byte(role))] = role
+	}
+}
+
+// newDecoders builds a decoder for every indexed event, keyed by topic
+func newDecoders() (map[common.Hash]decodeFunc, error) {
+	// The filterers only parse logs, so they never touch a backend
+	vault, err := contracts.NewNebulaVaultContractFilterer(common.Address{}, nil)
+	if err != nil {
+		return nil, err
+	}
+	fileStorage, err := contracts.NewFileStorageContractFilterer(common.Address{}, nil)
+	if err != nil {
+		return nil, err
+	}
+	accessControl, err := contracts.NewAccessControlContractFilterer(common.Address{}, nil)
+	if err != nil {
+		return nil, err
+	}
+	proofVerification, err := contracts.NewProofVerificationContractFilterer(common.Address{}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	decoders := make(map[common.Hash]decodeFunc)
+	add := func(meta *bind.MetaData, name string, decode decodeFunc) error {
+		parsed, err := meta.GetAbi()
+		if err != nil {
+			return err
+		}
+		event, ok := parsed.Events[name]
+		if !ok {
+			return fmt.Errorf("event %s not found in ABI", name)
+		}
+		decoders[event.ID] = decode
+		return nil
+	}
+
+	type entry struct {
+		meta   *bind.MetaData
+		name   string
+		decode decodeFunc
+	}
+	entries := []entry{
+		{contracts.NebulaVaultContractMetaData, "FileUploadedWithVerification", func(l types.Log) (*metadata.Event, error) {
+			e, err := vault.ParseFileUploadedWithVerification(l)
+			if err != nil {
+				return nil, err
+			}
+			return newEvent("NebulaVault", metadata.EventFileUploadedWithVerification, l, e.FileHash, e.Uploader, nil, map[string]interface{}{
+				"filename":        e.Filename,
+				"size":            e.Size.Uint64(),
+				"proof_submitted": e.ProofSubmitted,
+			}), nil
+		}},
+		{contracts.NebulaVaultContractMetaData, "SystemUpgraded", func(l types.Log) (*metadata.Event, error) {
+			e, err := vault.ParseSystemUpgraded(l)
+			if err != nil {
+				return nil, err
+			}
+			return newEvent("NebulaVault", metadata.EventSystemUpgraded, l, [32]byte{}, common.Address{}, nil, map[string]interface{}{
+				"old_contract":  normalizeAddress(e.OldContract),
+				"new_contract":  normalizeAddress(e.NewContract),
+				"contract_type": e.ContractType,
+			}), nil
+		}},
+		{contracts.FileStorageContractMetaData, "FileUploaded", func(l types.Log) (*metadata.Event, error) {
+			e, err := fileStorage.ParseFileUploaded(l)
+			if err != nil {
+				return nil, err
+			}
+			return newEvent("FileStorage", metadata.EventFileUploaded, l, e.FileHash, e.Uploader, e.Timestamp, map[string]interface{}{
+				"filename":    e.Filename,
+				"size":        e.Size.Uint64(),
+				"merkle_root": e.MerkleRoot,
+			}), nil
+		}},
+		{contracts.FileStorageContractMetaData, "FileDownloaded", func(l types.Log) (*metadata.Event, error) {
+			e, err := fileStorage.ParseFileDownloaded(l)
+			if err != nil {
+				return nil, err
+			}
+			return newEvent("FileStorage", metadata.EventFileDownloaded, l, e.FileHash, e.Downloader, e.Timestamp, nil), nil
+		}},
+		{contracts.FileStorageContractMetaData, "FileDeleted", func(l types.Log) (*metadata.Event, error) {
+			e, err := fileStorage.ParseFileDeleted(l)
+			if err != nil {
+				return nil, err
+			}
+			return newEvent("FileStorage", metadata.EventFileDeleted, l, e.FileHash, e.Deleter, e.Timestamp, nil), nil
+		}},
+		{contracts.FileStorageContractMetaData, "ProofVerified", func(l types.Log) (*metadata.Event, error) {
+			e, err := fileStorage.ParseProofVerified(l)
+			if err != nil {
+				return nil, err
+			}
+			return newEvent("FileStorage", metadata.EventProofVerified, l, e.FileHash, e.Verifier, e.Timestamp, map[string]interface{}{
+				"valid": e.IsValid,
+			}), nil
+		}},
+		{contracts.ProofVerificationContractMetaData, "ProofSubmitted", func(l types.Log) (*metadata.Event, error) {
+			e, err := proofVerification.ParseProofSubmitted(l)
+			if err != nil {
+				return nil, err
+			}
+			return newEvent("ProofVerification", metadata.EventProofSubmitted, l, e.FileHash, e.Submitter, e.Timestamp, map[string]interface{}{
+				"merkle_root": common.Hash(e.MerkleRoot).Hex(),
+			}), nil
+		}},
+		{contracts.ProofVerificationContractMetaData, "ProofVerified", func(l types.Log) (*metadata.Event, error) {
+			e, err := proofVerification.ParseProofVerified(l)
+			if err != nil {
+				return nil, err
+			}
+			return newEvent("ProofVerification", metadata.EventProofVerified, l, e.FileHash, e.Verifier, e.Timestamp, map[string]interface{}{
+				"merkle_root": common.Hash(e.MerkleRoot).Hex(),
+				"valid":       e.IsValid,
+			}), nil
+		}},
+		{contracts.AccessControlContractMetaData, "UserRegistered", func(l types.Log) (*metadata.Event, error) {
+			e, err := accessControl.ParseUserRegistered(l)
+			if err != nil {
+				return nil, err
+			}
+			return newEvent("AccessControl", metadata.EventUserRegistered, l, [32]byte{}, e.User, e.Timestamp, map[string]interface{}{
+				"username": e.Username,
+			}), nil
+		}},
+		{contracts.AccessControlContractMetaData, "UserSuspended", func(l types.Log) (*metadata.Event, error) {
+			e, err := accessControl.ParseUserSuspended(l)
+			if err != nil {
+				return nil, err
+			}
+			return newEvent("AccessControl", metadata.EventUserSuspended, l, [32]byte{}, e.User, e.Timestamp, map[string]interface{}{
+				"moderator": normalizeAddress(e.Moderator),
+			}), nil
+		}},
+		{contracts.AccessControlContractMetaData, "UserUnsuspended", func(l types.Log) (*metadata.Event, error) {
+			e, err := accessControl.ParseUserUnsuspended(l)
+			if err != nil {
+				return nil, err
+			}
+			return newEvent("AccessControl", metadata.EventUserUnsuspended, l, [32]byte{}, e.User, e.Timestamp, map[string]interface{}{
+				"moderator": normalizeAddress(e.Moderator),
+			}), nil
+		}},
+		{contracts.AccessControlContractMetaData, "UserRoleGranted", func(l types.Log) (*metadata.Event, error) {
+			e, err := accessControl.ParseUserRoleGranted(l)
+			if err != nil {
+				return nil, err
+			}
+			return newEvent("AccessControl", metadata.EventUserRoleGranted, l, [32]byte{}, e.User, nil, map[string]interface{}{
+				"role":    roleName(e.Role),
+				"granter": normalizeAddress(e.Granter),
+			}), nil
+		}},
+		{contracts.AccessControlContractMetaData, "UserRoleRevoked", func(l types.Log) (*metadata.Event, error) {
+			e, err := accessControl.ParseUserRoleRevoked(l)
+			if err != nil {
+				return nil, err
+			}
+			return newEvent("AccessControl", metadata.EventUserRoleRevoked, l, [32]byte{}, e.User, nil, map[string]interface{}{
+				"role":    roleName(e.Role),
+				"revoker": normalizeAddress(e.Revoker),
+			}), nil
+		}},
+		{contracts.AccessControlContractMetaData, "StorageQuotaUpdated", func(l types.Log) (*metadata.Event, error) {
+			e, err := accessControl.ParseStorageQuotaUpdated(l)
+			if err != nil {
+				return nil, err
+			}
+			return newEvent("AccessControl", metadata.EventStorageQuotaUpdated, l, [32]byte{}, e.User, nil, map[string]interface{}{
+				"quota":   e.NewQuota.Uint64(),
+				"updater": normalizeAddress(e.Updater),
+			}), nil
+		}},
+	}
+	for _, e := range entries {
+		if err := add(e.meta, e.name, e.decode); err != nil {
+			return nil, err
+		}
+	}
+	return decoders, nil
+}
+
+// topics returns the event signatures the decoders understand
+func topics(decoders map[common.Hash]decodeFunc) []common.Hash {
+	ids := make([]common.Hash, 0, len(decoders))
+	for id := range decoders {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func newEvent(contract, name string, l types.Log, fileHash [32]byte, account common.Address, timestamp interface{ Uint64() uint64 }, data map[string]interface{}) *metadata.Event {
+	event := &metadata.Event{
+		Contract:    contract,
+		Name:        name,
+		BlockNumber: l.BlockNumber,
+		BlockHash:   l.BlockHash.Hex(),
+		TxHash:      l.TxHash.Hex(),
+		LogIndex:    l.Index,
+		Data:        data,
+	}
+	if fileHash != ([32]byte{}) {
+		event.FileHash = common.Hash(fileHash).Hex()
+	}
+	if account != (common.Address{}) {
+		event.Account = normalizeAddress(account)
+	}
+	if timestamp != nil {
+		event.Timestamp = timestamp.Uint64()
+	}
+	return event
+}
+
+func roleName(role [32]byte) string {
+	if name, ok := roleNames[role]; ok {
+		return name
+	}
+	return common.Hash(role).Hex()
+}
+
+// normalizeAddress renders an address the way the store indexes it
+func normalizeAddress(address common.Address) string {
+	return "0x" + common.Bytes2Hex(address.Bytes())
+}
@@ -0,0 +1,386 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/sirupsen/logrus"
+
+	"nebularvault-agent/internal/contracts"
+	"nebularvault-agent/internal/metadata"
+)
+
+// Backend is the chain access the indexer needs
+type Backend interface {
+	bind.ContractCaller
+	ethereum.LogFilterer
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// Config controls where indexing starts and how it follows the chain
+type Config struct {
+	VaultAddress  common.Address
+	StartBlock    uint64
+	BatchSize     uint64
+	PollInterval  time.Duration
+	Confirmations uint64
+	ReorgDepth    uint64
+}
+
+// Status reports indexing progress
+type Status struct {
+	Cursor    uint64    `json:"cursor"`
+	Head      uint64    `json:"head"`
+	Contracts []string  `json:"contracts"`
+	SyncedAt  time.Time `json:"synced_at"`
+	LastError string    `json:"last_error,omitempty"`
+}
+
+// Indexer backfills NebulaVault events from a start block, then follows new
+// heads and writes decoded events into the metadata store
+type Indexer struct {
+	backend  Backend
+	store    *metadata.Store
+	config   Config
+	vault    *contracts.NebulaVaultContractCaller
+	decoders map[common.Hash]decodeFunc
+	logger   *logrus.Logger
+
+	// upgradeTopic is the signature of the vault's SystemUpgraded event
+	upgradeTopic common.Hash
+
+	mu        sync.RWMutex
+	addresses []common.Address
+	status    Status
+	listeners []func([]*metadata.Event)
+}
+
+// NewIndexer creates an indexer for the vault at config.VaultAddress
+func NewIndexer(backend Backend, store *metadata.Store, config Config, logger *logrus.Logger) (*Indexer, error) {
+	if config.BatchSize == 0 {
+		config.BatchSize = 2000
+	}
+	if config.PollInterval == 0 {
+		config.PollInterval = 5 * time.Second
+	}
+	if config.ReorgDepth == 0 {
+		config.ReorgDepth = 128
+	}
+
+	vault, err := contracts.NewNebulaVaultContractCaller(config.VaultAddress, backend)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind vault: %w", err)
+	}
+	decoders, err := newDecoders()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build event decoders: %w", err)
+	}
+	parsed, err := contracts.NebulaVaultContractMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse vault ABI: %w", err)
+	}
+
+	return &Indexer{
+		backend:  backend,
+		store:    store,
+		config:   config,
+		vault:    vault,
+		decoders: decoders,
+		logger:   logger,
+
+		upgradeTopic: parsed.Events["SystemUpgraded"].ID,
+	}, nil
+}
+
+// Run syncs until ctx is cancelled, polling for new heads
+func (ix *Indexer) Run(ctx context.Context) {
+	ticker := time.NewTicker(ix.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := ix.Sync(ctx); err != nil && ctx.Err() == nil {
+			ix.logger.Warnf("Event indexer sync failed: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// OnEvents registers fn to be called with every batch of events once it has
+// been stored, so caches built from chain state can be dropped
+func (ix *Indexer) OnEvents(fn func([]*metadata.Event)) {
+	ix.mu.Lock()
+	ix.listeners = append(ix.listeners, fn)
+	ix.mu.Unlock()
+}
+
+// Status returns the indexer's progress
+func (ix *Indexer) Status() Status {
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+
+	status := ix.status
+	status.Contracts = append([]string(nil), ix.status.Contracts...)
+	return status
+}
+
+// Sync indexes every block up to the confirmed head, rewinding first if the
+// last indexed block has been reorganised away
+func (ix *Indexer) Sync(ctx context.Context) (err error) {
+	defer func() {
+		ix.mu.Lock()
+		if err != nil {
+			ix.status.LastError = err.Error()
+		} else {
+			ix.status.LastError = ""
+			ix.status.SyncedAt = time.Now()
+		}
+		ix.mu.Unlock()
+	}()
+
+	head, err := ix.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get head: %w", err)
+	}
+	ix.setStatus(func(s *Status) { s.Head = head.Number.Uint64() })
+
+	if head.Number.Uint64() < ix.config.Confirmations {
+		return nil
+	}
+	target := head.Number.Uint64() - ix.config.Confirmations
+
+	next := ix.config.StartBlock
+	cursor, ok, err := ix.store.Cursor()
+	if err != nil {
+		return err
+	}
+	if ok {
+		if cursor, err = ix.checkReorg(ctx, cursor); err != nil {
+			return err
+		}
+		ix.setStatus(func(s *Status) { s.Cursor = cursor })
+		next = cursor + 1
+	}
+	if err := ix.resolveAddresses(ctx, next, target); err != nil {
+		return err
+	}
+
+	for next <= target {
+		to := next + ix.config.BatchSize - 1
+		if to > target {
+			to = target
+		}
+		if err := ix.indexRange(ctx, next, to); err != nil {
+			return err
+		}
+		ix.setStatus(func(s *Status) { s.Cursor = to })
+		next = to + 1
+	}
+	return nil
+}
+
+// indexRange decodes and stores the events in [from, to]
+func (ix *Indexer) indexRange(ctx context.Context, from, to uint64) error {
+	var events []*metadata.Event
+	for {
+		logs, err := ix.backend.FilterLogs(ctx, ethereum.FilterQuery{
+			FromBlock: new(big.Int).SetUint64(from),
+			ToBlock:   new(big.Int).SetUint64(to),
+			Addresses: ix.watched(),
+			Topics:    [][]common.Hash{topics(ix.decoders)},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to filter logs %d-%d: %w", from, to, err)
+		}
+
+		events = events[:0]
+		upgraded := false
+		for _, l := range logs {
+			if l.Removed || len(l.Topics) == 0 {
+				continue
+			}
+			decode, ok := ix.decoders[l.Topics[0]]
+			if !ok {
+				continue
+			}
+			event, err := decode(l)
+			if err != nil {
+				return fmt.Errorf("failed to decode log %d in block %d: %w", l.Index, l.BlockNumber, err)
+			}
+			if event.Name == metadata.EventSystemUpgraded && ix.watch(common.HexToAddress(event.Data["new_contract"].(string))) {
+				upgraded = true
+			}
+			events = append(events, event)
+		}
+
+		// A replacement contract may already have emitted events later in
+		// the range, so filter it again with the new address included
+		if !upgraded {
+			break
+		}
+	}
+
+	header, err := ix.backend.HeaderByNumber(ctx, new(big.Int).SetUint64(to))
+	if err != nil {
+		return fmt.Errorf("failed to get block %d: %w", to, err)
+	}
+	blocks := map[uint64]string{to: header.Hash().Hex()}
+	for _, event := range events {
+		blocks[event.BlockNumber] = event.BlockHash
+	}
+
+	if err := ix.store.Apply(&metadata.Batch{Cursor: to, Blocks: blocks, Events: events}, ix.config.ReorgDepth); err != nil {
+		return err
+	}
+	if len(events) > 0 {
+		ix.logger.Debugf("Indexed %d events in blocks %d-%d", len(events), from, to)
+
+		ix.mu.RLock()
+		listeners := ix.listeners
+		ix.mu.RUnlock()
+		for _, fn := range listeners {
+			fn(events)
+		}
+	}
+	return nil
+}
+
+// checkReorg compares recorded block hashes with the chain and rewinds the
+// store to the newest block both agree on
+func (ix *Indexer) checkReorg(ctx context.Context, cursor uint64) (uint64, error) {
+	blocks, err := ix.store.RecentBlocks(cursor, int(ix.config.ReorgDepth))
+	if err != nil {
+		return 0, err
+	}
+
+	for i, number := range blocks {
+		recorded, _, err := ix.store.BlockHash(number)
+		if err != nil {
+			return 0, err
+		}
+		header, err := ix.backend.HeaderByNumber(ctx, new(big.Int).SetUint64(number))
+		if err != nil && err != ethereum.NotFound {
+			return 0, fmt.Errorf("failed to get block %d: %w", number, err)
+		}
+		if err == nil && header.Hash().Hex() == recorded {
+			if i == 0 {
+				return cursor, nil
+			}
+			ix.logger.Warnf("Chain reorganisation detected, rewinding index from block %d to %d", cursor, number)
+			if err := ix.store.Rewind(number); err != nil {
+				return 0, err
+			}
+			return number, nil
+		}
+	}
+
+	if len(blocks) == 0 {
+		return cursor, nil
+	}
+	return 0, fmt.Errorf("no common ancestor within %d recorded blocks of %d", len(blocks), cursor)
+}
+
+// resolveAddresses looks up the vault's component contracts the first time
+// the indexer syncs, along with the ones upgrades replaced in [from, to] so
+// the events they emitted before being replaced are indexed too
+func (ix *Indexer) resolveAddresses(ctx context.Context, from, to uint64) error {
+	ix.mu.RLock()
+	resolved := len(ix.addresses) > 0
+	ix.mu.RUnlock()
+	if resolved {
+		return nil
+	}
+
+	opts := &bind.CallOpts{Context: ctx}
+	fileStorage, err := ix.vault.FileStorage(opts)
+	if err != nil {
+		return fmt.Errorf("failed to resolve FileStorage: %w", err)
+	}
+	accessControl, err := ix.vault.AccessControl(opts)
+	if err != nil {
+		return fmt.Errorf("failed to resolve AccessControl: %w", err)
+	}
+	proofVerification, err := ix.vault.ProofVerification(opts)
+	if err != nil {
+		return fmt.Errorf("failed to resolve ProofVerification: %w", err)
+	}
+
+	replaced, err := ix.upgradedAddresses(ctx, from, to)
+	if err != nil {
+		return err
+	}
+
+	for _, address := range append([]common.Address{ix.config.VaultAddress, fileStorage, accessControl, proofVerification}, replaced...) {
+		ix.watch(address)
+	}
+	return nil
+}
+
+// upgradedAddresses returns the old and new contracts of every component
+// upgrade the vault made in [from, to]
+func (ix *Indexer) upgradedAddresses(ctx context.Context, from, to uint64) ([]common.Address, error) {
+	var addresses []common.Address
+	for next := from; next <= to; next += ix.config.BatchSize {
+		end := min(next+ix.config.BatchSize-1, to)
+		logs, err := ix.backend.FilterLogs(ctx, ethereum.FilterQuery{
+			FromBlock: new(big.Int).SetUint64(next),
+			ToBlock:   new(big.Int).SetUint64(end),
+			Addresses: []common.Address{ix.config.VaultAddress},
+			Topics:    [][]common.Hash{{ix.upgradeTopic}},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to filter upgrades %d-%d: %w", next, end, err)
+		}
+
+		for _, l := range logs {
+			if l.Removed {
+				continue
+			}
+			event, err := ix.decoders[ix.upgradeTopic](l)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode upgrade in block %d: %w", l.BlockNumber, err)
+			}
+			addresses = append(addresses,
+				common.HexToAddress(event.Data["old_contract"].(string)),
+				common.HexToAddress(event.Data["new_contract"].(string)))
+		}
+	}
+	return addresses, nil
+}
+
+// watch adds address to the filtered contracts, reporting whether it was new
+func (ix *Indexer) watch(address common.Address) bool {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	for _, known := range ix.addresses {
+		if known == address {
+			return false
+		}
+	}
+	ix.addresses = append(ix.addresses, address)
+	ix.status.Contracts = append(ix.status.Contracts, address.Hex())
+	return true
+}
+
+func (ix *Indexer) watched() []common.Address {
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+	return append([]common.Address(nil), ix.addresses...)
+}
+
+func (ix *Indexer) setStatus(update func(*Status)) {
+	ix.mu.Lock()
+	update(&ix.status)
+	ix.mu.Unlock()
+}
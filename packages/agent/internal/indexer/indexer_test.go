@@ -0,0 +1,308 @@
+package indexer
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/sirupsen/logrus"
+
+	"nebularvault-agent/internal/contracts"
+	"nebularvault-agent/internal/metadata"
+)
+
+type testChain struct {
+	backend  *simulated.Backend
+	user     common.Address
+	deployer *bind.TransactOpts
+	vault    common.Address
+	client   *contracts.ContractClient
+	store    *metadata.Store
+	indexer  *Indexer
+}
+
+func newTestChain(t *testing.T) *testChain {
+	t.Helper()
+
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	user := crypto.PubkeyToAddress(key.PublicKey)
+
+	backend := simulated.NewBackend(types.GenesisAlloc{
+		user: {Balance: new(big.Int).Mul(big.NewInt(1000), big.NewInt(1e18))},
+	}, simulated.WithBlockGasLimit(30_000_000))
+	t.Cleanup(func() { backend.Close() })
+
+	deployer, err := bind.NewKeyedTransactorWithChainID(key, big.NewInt(1337))
+	if err != nil {
+		t.Fatalf("Failed to create deployer: %v", err)
+	}
+	vault, _, _, err := contracts.DeployNebulaVaultContract(deployer, backend.Client())
+	if err != nil {
+		t.Fatalf("Failed to deploy NebulaVault: %v", err)
+	}
+	backend.Commit()
+
+	client, err := contracts.NewContractClientWithBackend(&contracts.ContractConfig{
+		ChainID:         1337,
+		ContractAddress: vault.Hex(),
+		PrivateKey:      hex.EncodeToString(crypto.FromECDSA(key)),
+	}, backend.Client(), logrus.New())
+	if err != nil {
+		t.Fatalf("Failed to create contract client: %v", err)
+	}
+
+	store, err := metadata.Open(filepath.Join(t.TempDir(), "metadata"))
+	if err != nil {
+		t.Fatalf("Failed to open metadata store: %v", err)
+	}
+	t.Cleanup(func() { store.Close() })
+
+	ix, err := NewIndexer(backend.Client(), store, Config{VaultAddress: vault, BatchSize: 3}, logrus.New())
+	if err != nil {
+		t.Fatalf("Failed to create indexer: %v", err)
+	}
+
+	return &testChain{
+		backend:  backend,
+		user:     user,
+		deployer: deployer,
+		vault:    vault,
+		client:   client,
+		store:    store,
+		indexer:  ix,
+	}
+}
+
+// upload stores a file through the vault, trusted unless withProof is set
+func (c *testChain) upload(t *testing.T, fileHash, filename string, withProof bool) {
+	t.Helper()
+
+	req := &contracts.FileUploadRequest{
+		FileHash:   fileHash,
+		Filename:   filename,
+		Size:       256,
+		MerkleRoot: "0x" + strings.Repeat("ab", 32),
+		Proof:      []string{"0x" + strings.Repeat("01", 32)},
+		Indices:    []uint64{1},
+		LeafHash:   "0x" + strings.Repeat("02", 32),
+	}
+	upload := c.client.UploadFile
+	if withProof {
+		upload = c.client.UploadFileWithVerification
+	}
+	if _, err := upload(req); err != nil {
+		t.Fatalf("Failed to upload %s: %v", filename, err)
+	}
+	c.backend.Commit()
+}
+
+func (c *testChain) sync(t *testing.T) {
+	t.Helper()
+	if err := c.indexer.Sync(context.Background()); err != nil {
+		t.Fatalf("Failed to sync indexer: %v", err)
+	}
+}
+
+func TestIndexer_BackfillsAndFollows(t *testing.T) {
+	c := newTestChain(t)
+
+	var notified []string
+	c.indexer.OnEvents(func(events []*metadata.Event) {
+		for _, event := range events {
+			notified = append(notified, event.Name)
+		}
+	})
+
+	if _, err := c.client.RegisterUser("alice"); err != nil {
+		t.Fatalf("Failed to register user: %v", err)
+	}
+	c.backend.Commit()
+
+	report := "0x" + strings.Repeat("11", 32)
+	c.upload(t, report, "Quarterly-Report.pdf", false)
+	c.sync(t)
+
+	file, err := c.store.ChainFile(report)
+	if err != nil {
+		t.Fatalf("Failed to get indexed file: %v", err)
+	}
+	if file.Filename != "Quarterly-Report.pdf" || file.Size != 256 || !file.Active {
+		t.Errorf("Unexpected indexed file: %+v", file)
+	}
+	if !strings.EqualFold(file.Uploader, c.user.Hex()) {
+		t.Errorf("Expected uploader %s, got %s", c.user.Hex(), file.Uploader)
+	}
+	if !file.Verified || file.ProofSubmitted {
+		t.Errorf("Expected trusted upload to be verified without a proof, got %+v", file)
+	}
+
+	user, err := c.store.ChainUser(c.user.Hex())
+	if err != nil {
+		t.Fatalf("Failed to get indexed user: %v", err)
+	}
+	if user.Username != "alice" || user.Suspended {
+		t.Errorf("Unexpected indexed user: %+v", user)
+	}
+
+	// Follow new heads
+	if _, err := c.client.DownloadFile(report); err != nil {
+		t.Fatalf("Failed to download file: %v", err)
+	}
+	c.backend.Commit()
+	holiday := "0x" + strings.Repeat("22", 32)
+	c.upload(t, holiday, "holiday.jpg", true)
+	c.sync(t)
+
+	if file, err := c.store.ChainFile(holiday); err != nil || !file.ProofSubmitted || file.Verified {
+		t.Errorf("Expected unverified upload with a submitted proof, got %+v (%v)", file, err)
+	}
+
+	files, err := c.store.ListChainFiles(metadata.FileQuery{Search: "report"})
+	if err != nil {
+		t.Fatalf("Failed to list files: %v", err)
+	}
+	if len(files) != 1 || files[0].Downloads != 1 {
+		t.Errorf("Expected one downloaded report, got %+v", files)
+	}
+
+	files, err = c.store.ListChainFiles(metadata.FileQuery{Uploader: c.user.Hex()})
+	if err != nil {
+		t.Fatalf("Failed to list files: %v", err)
+	}
+	if len(files) != 2 {
+		t.Errorf("Expected 2 files for uploader, got %d", len(files))
+	}
+
+	audit, err := c.store.FileEvents(report)
+	if err != nil {
+		t.Fatalf("Failed to get audit trail: %v", err)
+	}
+	var names []string
+	for _, event := range audit {
+		names = append(names, event.Name)
+	}
+	if len(names) == 0 || names[0] != metadata.EventFileUploaded || names[len(names)-1] != metadata.EventFileDownloaded {
+		t.Errorf("Unexpected audit trail: %v", names)
+	}
+
+	head, err := c.backend.Client().BlockNumber(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to get block number: %v", err)
+	}
+	if status := c.indexer.Status(); status.Cursor != head || len(status.Contracts) != 4 {
+		t.Errorf("Expected cursor at head %d watching 4 contracts, got %+v", head, status)
+	}
+	if len(notified) == 0 || notified[0] != metadata.EventUserRegistered {
+		t.Errorf("Expected listeners to see every stored event, got %v", notified)
+	}
+}
+
+func TestIndexer_BackfillsReplacedContracts(t *testing.T) {
+	c := newTestChain(t)
+
+	if _, err := c.client.RegisterUser("alice"); err != nil {
+		t.Fatalf("Failed to register user: %v", err)
+	}
+	c.backend.Commit()
+	report := "0x" + strings.Repeat("44", 32)
+	c.upload(t, report, "before-upgrade.txt", false)
+
+	// Replace FileStorage before the indexer first syncs, so the upload was
+	// emitted by a contract the vault no longer points at
+	vault, err := contracts.NewNebulaVaultContract(c.vault, c.backend.Client())
+	if err != nil {
+		t.Fatalf("Failed to bind vault: %v", err)
+	}
+	replacement, _, _, err := contracts.DeployFileStorageContract(c.deployer, c.backend.Client())
+	if err != nil {
+		t.Fatalf("Failed to deploy FileStorage: %v", err)
+	}
+	c.backend.Commit()
+	if _, err := vault.UpgradeFileStorage(c.deployer, replacement); err != nil {
+		t.Fatalf("Failed to upgrade FileStorage: %v", err)
+	}
+	c.backend.Commit()
+	c.sync(t)
+
+	file, err := c.store.ChainFile(report)
+	if err != nil {
+		t.Fatalf("Expected the upload to the replaced contract to be indexed: %v", err)
+	}
+	if file.Filename != "before-upgrade.txt" {
+		t.Errorf("Unexpected indexed file: %+v", file)
+	}
+	if status := c.indexer.Status(); len(status.Contracts) != 5 {
+		t.Errorf("Expected the replacement to be watched alongside the replaced contract, got %v", status.Contracts)
+	}
+}
+
+func TestIndexer_HandlesReorg(t *testing.T) {
+	c := newTestChain(t)
+
+	if _, err := c.client.RegisterUser("alice"); err != nil {
+		t.Fatalf("Failed to register user: %v", err)
+	}
+	c.backend.Commit()
+
+	fork, err := c.backend.Client().HeaderByNumber(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("Failed to get head: %v", err)
+	}
+
+	orphan := "0x" + strings.Repeat("33", 32)
+	c.upload(t, orphan, "orphan.txt", false)
+	c.sync(t)
+	if _, err := c.store.ChainFile(orphan); err != nil {
+		t.Fatalf("Expected upload to be indexed before the reorg: %v", err)
+	}
+
+	// Replace the upload's block with a longer chain of empty blocks
+	if err := c.backend.Fork(fork.Hash()); err != nil {
+		t.Fatalf("Failed to fork chain: %v", err)
+	}
+	for i := 0; i < 3; i++ {
+		c.backend.Commit()
+	}
+	c.sync(t)
+
+	// The orphaned transaction may be re-mined on the new branch, but any
+	// indexed event must belong to a canonical block
+	events, err := c.store.Events(0, 0)
+	if err != nil {
+		t.Fatalf("Failed to list events: %v", err)
+	}
+	for _, event := range events {
+		header, err := c.backend.Client().HeaderByNumber(context.Background(), new(big.Int).SetUint64(event.BlockNumber))
+		if err != nil {
+			t.Fatalf("Failed to get block %d: %v", event.BlockNumber, err)
+		}
+		if header.Hash().Hex() != event.BlockHash {
+			t.Errorf("Event %s in block %d is from a reorganised block", event.Name, event.BlockNumber)
+		}
+	}
+
+	uploads := 0
+	audit, err := c.store.FileEvents(orphan)
+	if err != nil {
+		t.Fatalf("Failed to get audit trail: %v", err)
+	}
+	for _, event := range audit {
+		if event.Name == metadata.EventFileUploaded {
+			uploads++
+		}
+	}
+	if uploads > 1 {
+		t.Errorf("Expected the orphaned upload to be indexed at most once, got %d", uploads)
+	}
+}
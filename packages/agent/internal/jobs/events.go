@@ -0,0 +1,146 @@
+package jobs
+
+import (
+	"time"
+
+	"nebularvault-agent/internal/metadata"
+	"nebularvault-agent/internal/progress"
+)
+
+// StageStatus is the stage of the events the queue reports each time a job
+// changes state
+const StageStatus = "status"
+
+const (
+	// maxEventHistory bounds the events kept for a job's late subscribers
+	maxEventHistory = 256
+	// eventRetention is how long a finished job's events stay readable
+	eventRetention = 10 * time.Minute
+	// eventBuffer is how many events a slow subscriber may fall behind
+	eventBuffer = 64
+)
+
+// Event is progress reported by a job's runner, or a change of its status
+type Event struct {
+	// Seq orders the events of a job, starting at 1
+	Seq   int    `json:"seq"`
+	JobID string `json:"job_id"`
+	Type  string `json:"type"`
+	progress.Event
+}
+
+// eventLog holds a job's recent events and the channels following them.
+// Events are kept in memory only; after a restart a job's progress starts
+// again from its stored state.
+type eventLog struct {
+	seq         int
+	history     []Event
+	subscribers []chan Event
+}
+
+// Events returns the events a job has reported so far, a channel of the
+// events that follow and a function that ends the subscription. The history
+// keeps only the latest event of consecutive runs of a stage, so it shows
+// where each step got to. Subscribers that fall behind lose the oldest
+// events they have not read, never the latest.
+func (q *Queue) Events(id string) ([]Event, <-chan Event, func()) {
+	updates := make(chan Event, eventBuffer)
+
+	q.eventsMu.Lock()
+	log := q.eventLog(id)
+	history := append([]Event(nil), log.history...)
+	log.subscribers = append(log.subscribers, updates)
+	q.eventsMu.Unlock()
+
+	return history, updates, func() {
+		q.eventsMu.Lock()
+		defer q.eventsMu.Unlock()
+		log, ok := q.events[id]
+		if !ok {
+			return
+		}
+		for i, ch := range log.subscribers {
+			if ch == updates {
+				log.subscribers = append(log.subscribers[:i], log.subscribers[i+1:]...)
+				break
+			}
+		}
+		if len(log.subscribers) == 0 && len(log.history) == 0 {
+			delete(q.events, id)
+		}
+	}
+}
+
+// eventLog returns a job's log, creating it; eventsMu must be held
+func (q *Queue) eventLog(id string) *eventLog {
+	log, ok := q.events[id]
+	if !ok {
+		log = &eventLog{}
+		q.events[id] = log
+	}
+	return log
+}
+
+// record adds an event to a job's log and sends it to its subscribers
+func (q *Queue) record(id, jobType string, event progress.Event) {
+	q.eventsMu.Lock()
+	defer q.eventsMu.Unlock()
+
+	log := q.eventLog(id)
+	log.seq++
+	e := Event{Seq: log.seq, JobID: id, Type: jobType, Event: event}
+
+	if n := len(log.history); n > 0 && e.Stage != StageStatus && log.history[n-1].Stage == e.Stage {
+		log.history[n-1] = e
+	} else {
+		log.history = append(log.history, e)
+		if len(log.history) > maxEventHistory {
+			log.history = log.history[len(log.history)-maxEventHistory:]
+		}
+	}
+
+	for _, ch := range log.subscribers {
+		select {
+		case ch <- e:
+		default:
+			// Make room by dropping the oldest unread event
+			select {
+			case <-ch:
+			default:
+			}
+			select {
+			case ch <- e:
+			default:
+			}
+		}
+	}
+}
+
+// recordStatus reports a job's state as a status event. A finished job's
+// events are dropped once they have been readable for eventRetention.
+func (q *Queue) recordStatus(job *metadata.Job) {
+	data := map[string]interface{}{
+		"status":       job.Status,
+		"attempt":      job.Attempts,
+		"max_attempts": job.MaxAttempts,
+	}
+	if job.Error != "" {
+		data["error"] = job.Error
+	}
+	if job.Status == metadata.JobQueued && job.Attempts > 0 {
+		data["retry_at"] = job.RunAt
+	}
+	if len(job.Result) > 0 {
+		data["result"] = job.Result
+	}
+	q.record(job.ID, job.Type, progress.Event{Stage: StageStatus, Data: data, Time: job.UpdatedAt})
+
+	if job.Done() {
+		id := job.ID
+		time.AfterFunc(eventRetention, func() {
+			q.eventsMu.Lock()
+			defer q.eventsMu.Unlock()
+			delete(q.events, id)
+		})
+	}
+}
@@ -0,0 +1,546 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+
+	"nebularvault-agent/internal/metadata"
+	"nebularvault-agent/internal/metrics"
+	"nebularvault-agent/internal/progress"
+	"nebularvault-agent/internal/tracing"
+)
+
+// ErrUnknownType is returned when enqueueing a type no runner handles
+var ErrUnknownType = errors.New("unknown job type")
+
+// Runner performs one attempt of a job. Its result is stored on the job
+// when it succeeds; an error is retried unless it is Permanent or the job
+// is on its last attempt.
+type Runner func(ctx context.Context, job *metadata.Job) (interface{}, error)
+
+// Policy controls how a job type runs and retries
+type Policy struct {
+	// Workers is how many jobs of the type run at once
+	Workers int
+	// MaxAttempts includes the first attempt
+	MaxAttempts int
+	// Backoff is the delay before the first retry; it doubles with each
+	// retry up to MaxBackoff, which defaults to 32 times Backoff
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+	// Timeout bounds each attempt; zero leaves it to the runner
+	Timeout time.Duration
+}
+
+// Config controls the queue as a whole
+type Config struct {
+	// Retention is how long finished jobs are kept for clients to read
+	Retention time.Duration
+}
+
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent marks err as one retrying cannot fix, failing the job at once
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+// IsPermanent reports whether err was marked with Permanent
+func IsPermanent(err error) bool {
+	var permanent *permanentError
+	return errors.As(err, &permanent)
+}
+
+type jobType struct {
+	name   string
+	policy Policy
+	run    Runner
+	ready  chan string
+}
+
+// Queue runs background jobs on per-type worker pools. Jobs are persisted
+// in the metadata store before they are accepted, so queued jobs and jobs
+// interrupted by a restart resume when the queue starts again.
+type Queue struct {
+	store  *metadata.Store
+	config Config
+	logger *logrus.Logger
+	stop   chan struct{}
+	wg     sync.WaitGroup
+
+	mu          sync.Mutex
+	types       map[string]*jobType
+	subscribers map[string][]chan *metadata.Job
+	listeners   []func(*metadata.Job)
+
+	eventsMu sync.Mutex
+	events   map[string]*eventLog
+}
+
+// NewQueue creates a queue that keeps its jobs in store
+func NewQueue(store *metadata.Store, config Config, logger *logrus.Logger) *Queue {
+	if config.Retention <= 0 {
+		config.Retention = 7 * 24 * time.Hour
+	}
+
+	return &Queue{
+		store:       store,
+		config:      config,
+		logger:      logger,
+		stop:        make(chan struct{}),
+		types:       make(map[string]*jobType),
+		subscribers: make(map[string][]chan *metadata.Job),
+		events:      make(map[string]*eventLog),
+	}
+}
+
+// Register sets the runner and policy of a job type. Types must be
+// registered before Start.
+func (q *Queue) Register(name string, policy Policy, run Runner) {
+	if policy.Workers <= 0 {
+		policy.Workers = 1
+	}
+	if policy.MaxAttempts <= 0 {
+		policy.MaxAttempts = 1
+	}
+	if policy.Backoff <= 0 {
+		policy.Backoff = time.Second
+	}
+	if policy.MaxBackoff <= 0 {
+		policy.MaxBackoff = 32 * policy.Backoff
+	}
+	if policy.MaxBackoff < policy.Backoff {
+		policy.MaxBackoff = policy.Backoff
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.types[name] = &jobType{name: name, policy: policy, run: run, ready: make(chan string)}
+}
+
+// OnDone registers fn to be called with every job once it succeeds or
+// finally fails. Listeners run on the job's worker, so they should be quick.
+func (q *Queue) OnDone(fn func(*metadata.Job)) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.listeners = append(q.listeners, fn)
+}
+
+// Start resumes unfinished jobs and starts the workers, which stop when ctx
+// is cancelled. Jobs interrupted by shutdown go back to the queue.
+func (q *Queue) Start(ctx context.Context) error {
+	if _, err := q.prune(); err != nil {
+		return err
+	}
+
+	unfinished, err := q.store.ListJobs(func(job *metadata.Job) bool { return !job.Done() })
+	if err != nil {
+		return fmt.Errorf("failed to list unfinished jobs: %w", err)
+	}
+
+	q.mu.Lock()
+	for _, t := range q.types {
+		for i := 0; i < t.policy.Workers; i++ {
+			q.wg.Add(1)
+			go q.work(ctx, t)
+		}
+	}
+	q.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		close(q.stop)
+	}()
+	go q.pruneEvery(ctx, time.Hour)
+
+	resumed := 0
+	for _, job := range unfinished {
+		if job.Status == metadata.JobRunning {
+			// The agent stopped mid-attempt, so the attempt does not count
+			job, err = q.store.UpdateJob(job.ID, func(job *metadata.Job) error {
+				job.Status = metadata.JobQueued
+				if job.Attempts > 0 {
+					job.Attempts--
+				}
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("failed to resume job: %w", err)
+			}
+		}
+		if q.dispatch(job) {
+			resumed++
+		}
+	}
+	if resumed > 0 {
+		q.logger.Infof("Resuming %d unfinished jobs", resumed)
+	}
+	return nil
+}
+
+// Wait blocks until the workers have stopped after ctx was cancelled, so
+// no job is written after the store closes
+func (q *Queue) Wait() {
+	q.wg.Wait()
+}
+
+// Enqueue persists a job and schedules it. A job with a key is unique among
+// unfinished jobs of its type: enqueueing it again returns the existing job.
+func (q *Queue) Enqueue(name, key, owner string, payload interface{}) (*metadata.Job, error) {
+	q.mu.Lock()
+	t, ok := q.types[name]
+	q.mu.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownType, name)
+	}
+
+	encoded, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode job payload: %w", err)
+	}
+
+	// Serialize keyed enqueues so two callers cannot both miss the other
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if key != "" {
+		existing, err := q.store.ListJobs(func(job *metadata.Job) bool {
+			return job.Type == name && job.Key == key && !job.Done()
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(existing) > 0 {
+			return existing[0], nil
+		}
+	}
+
+	now := time.Now()
+	job := &metadata.Job{
+		ID:          uuid.New().String(),
+		Type:        name,
+		Key:         key,
+		Owner:       owner,
+		Status:      metadata.JobQueued,
+		Payload:     encoded,
+		MaxAttempts: t.policy.MaxAttempts,
+		RunAt:       now,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err := q.store.PutJob(job); err != nil {
+		return nil, err
+	}
+	q.logger.WithFields(logrus.Fields{"job_id": job.ID, "type": name, "key": key}).Debug("Job queued")
+	q.recordStatus(job)
+
+	go q.deliver(t, job.ID, 0)
+	return job, nil
+}
+
+// Get returns a job by ID
+func (q *Queue) Get(id string) (*metadata.Job, error) {
+	return q.store.GetJob(id)
+}
+
+// List returns the jobs accepted by match
+func (q *Queue) List(match func(*metadata.Job) bool) ([]*metadata.Job, error) {
+	return q.store.ListJobs(match)
+}
+
+// Subscribe returns a channel that receives a job each time it changes
+// and a function that ends the subscription. Only the latest state is
+// kept for slow readers.
+func (q *Queue) Subscribe(id string) (<-chan *metadata.Job, func()) {
+	updates := make(chan *metadata.Job, 1)
+
+	q.mu.Lock()
+	q.subscribers[id] = append(q.subscribers[id], updates)
+	q.mu.Unlock()
+
+	return updates, func() {
+		q.mu.Lock()
+		defer q.mu.Unlock()
+		subscribers := q.subscribers[id]
+		for i, ch := range subscribers {
+			if ch == updates {
+				q.subscribers[id] = append(subscribers[:i], subscribers[i+1:]...)
+				break
+			}
+		}
+		if len(q.subscribers[id]) == 0 {
+			delete(q.subscribers, id)
+		}
+	}
+}
+
+// WaitJob blocks until a job finishes or ctx is done, returning its latest
+// state
+func (q *Queue) WaitJob(ctx context.Context, id string) (*metadata.Job, error) {
+	updates, unsubscribe := q.Subscribe(id)
+	defer unsubscribe()
+
+	job, err := q.store.GetJob(id)
+	if err != nil {
+		return nil, err
+	}
+	for !job.Done() {
+		select {
+		case <-ctx.Done():
+			return job, nil
+		case job = <-updates:
+		}
+	}
+	return job, nil
+}
+
+// publish sends a job's new state to its subscribers and event log
+func (q *Queue) publish(job *metadata.Job) {
+	q.recordStatus(job)
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for _, ch := range q.subscribers[job.ID] {
+		select {
+		case ch <- job:
+		default:
+			// Replace the state the subscriber has not read yet
+			select {
+			case <-ch:
+			default:
+			}
+			select {
+			case ch <- job:
+			default:
+			}
+		}
+	}
+}
+
+// dispatch schedules a queued job for its run time, reporting whether its
+// type is registered
+func (q *Queue) dispatch(job *metadata.Job) bool {
+	q.mu.Lock()
+	t, ok := q.types[job.Type]
+	q.mu.Unlock()
+	if !ok {
+		q.logger.WithFields(logrus.Fields{"job_id": job.ID, "type": job.Type}).
+			Warn("No runner for job type; leaving it queued")
+		return false
+	}
+
+	go q.deliver(t, job.ID, time.Until(job.RunAt))
+	return true
+}
+
+// deliver hands a job to a worker of its type after delay
+func (q *Queue) deliver(t *jobType, id string, delay time.Duration) {
+	if delay > 0 {
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+		select {
+		case <-q.stop:
+			return
+		case <-timer.C:
+		}
+	}
+
+	select {
+	case <-q.stop:
+	case t.ready <- id:
+	}
+}
+
+func (q *Queue) work(ctx context.Context, t *jobType) {
+	defer q.wg.Done()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case id := <-t.ready:
+			q.run(ctx, t, id)
+		}
+	}
+}
+
+// errNotQueued skips a job another worker claimed or that already finished
+var errNotQueued = errors.New("job is not queued")
+
+// run claims a queued job and performs one attempt of it
+func (q *Queue) run(ctx context.Context, t *jobType, id string) {
+	log := q.logger.WithFields(logrus.Fields{"job_id": id, "type": t.name})
+
+	job, err := q.store.UpdateJob(id, func(job *metadata.Job) error {
+		if job.Status != metadata.JobQueued {
+			return errNotQueued
+		}
+		job.Status = metadata.JobRunning
+		job.Attempts++
+		job.UpdatedAt = time.Now()
+		return nil
+	})
+	if err == errNotQueued {
+		return
+	}
+	if err != nil {
+		log.WithError(err).Error("Failed to claim job")
+		return
+	}
+	q.publish(job)
+
+	runCtx, cancel := ctx, context.CancelFunc(func() {})
+	if t.policy.Timeout > 0 {
+		runCtx, cancel = context.WithTimeout(ctx, t.policy.Timeout)
+	}
+	runCtx, span := tracing.Start(runCtx, "job."+t.name,
+		attribute.String("job.id", job.ID), attribute.Int("job.attempt", job.Attempts))
+	runCtx = progress.WithReporter(runCtx, func(event progress.Event) {
+		q.record(id, t.name, event)
+	})
+
+	start := time.Now()
+	result, runErr := q.attempt(runCtx, t, job)
+	cancel()
+	tracing.End(span, runErr)
+
+	if runErr != nil && ctx.Err() != nil {
+		// Shutting down; the attempt resumes on restart
+		if _, err := q.store.UpdateJob(id, func(job *metadata.Job) error {
+			job.Status = metadata.JobQueued
+			job.Attempts--
+			job.UpdatedAt = time.Now()
+			return nil
+		}); err != nil {
+			log.WithError(err).Error("Failed to requeue interrupted job")
+		}
+		return
+	}
+
+	var outcome string
+	job, err = q.store.UpdateJob(id, func(job *metadata.Job) error {
+		job.UpdatedAt = time.Now()
+		switch {
+		case runErr == nil:
+			outcome = string(metadata.JobSucceeded)
+			job.Status = metadata.JobSucceeded
+			job.Error = ""
+			if result != nil {
+				encoded, err := json.Marshal(result)
+				if err != nil {
+					return fmt.Errorf("failed to encode job result: %w", err)
+				}
+				job.Result = encoded
+			}
+		case IsPermanent(runErr) || job.LastAttempt():
+			outcome = string(metadata.JobFailed)
+			job.Status = metadata.JobFailed
+			job.Error = runErr.Error()
+		default:
+			outcome = "retrying"
+			job.Status = metadata.JobQueued
+			job.Error = runErr.Error()
+			job.RunAt = job.UpdatedAt.Add(backoff(t.policy, job.Attempts))
+		}
+		return nil
+	})
+	if err != nil {
+		log.WithError(err).Error("Failed to record job outcome")
+		return
+	}
+	metrics.ObserveJob(t.name, outcome, start)
+	q.publish(job)
+	if job.Done() {
+		q.mu.Lock()
+		listeners := q.listeners
+		q.mu.Unlock()
+		for _, fn := range listeners {
+			fn(job)
+		}
+	}
+
+	switch job.Status {
+	case metadata.JobSucceeded:
+		log.WithField("attempts", job.Attempts).Info("Job succeeded")
+	case metadata.JobFailed:
+		log.WithError(runErr).WithField("attempts", job.Attempts).Warn("Job failed")
+	default:
+		log.WithError(runErr).WithFields(logrus.Fields{
+			"attempts": job.Attempts,
+			"retry_at": job.RunAt,
+		}).Info("Job attempt failed; retrying")
+		q.dispatch(job)
+	}
+}
+
+// attempt runs a job, turning a runner panic into a permanent failure
+func (q *Queue) attempt(ctx context.Context, t *jobType, job *metadata.Job) (result interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = Permanent(fmt.Errorf("job panicked: %v", r))
+		}
+	}()
+	return t.run(ctx, job)
+}
+
+// backoff is the delay before the retry that follows attempt
+func backoff(policy Policy, attempt int) time.Duration {
+	delay := policy.Backoff
+	for i := 1; i < attempt && delay < policy.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > policy.MaxBackoff {
+		delay = policy.MaxBackoff
+	}
+	return delay
+}
+
+// prune deletes jobs that finished longer than the retention period ago
+func (q *Queue) prune() (int, error) {
+	cutoff := time.Now().Add(-q.config.Retention)
+	expired, err := q.store.ListJobs(func(job *metadata.Job) bool {
+		return job.Done() && job.UpdatedAt.Before(cutoff)
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to list expired jobs: %w", err)
+	}
+	for _, job := range expired {
+		if err := q.store.DeleteJob(job.ID); err != nil {
+			return 0, err
+		}
+	}
+	return len(expired), nil
+}
+
+func (q *Queue) pruneEvery(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if pruned, err := q.prune(); err != nil {
+				q.logger.WithError(err).Warn("Failed to prune finished jobs")
+			} else if pruned > 0 {
+				q.logger.Debugf("Pruned %d finished jobs", pruned)
+			}
+		}
+	}
+}
@@ -0,0 +1,251 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"nebularvault-agent/internal/metadata"
+	"nebularvault-agent/internal/progress"
+)
+
+func openStore(t *testing.T, path string) *metadata.Store {
+	t.Helper()
+
+	store, err := metadata.Open(path)
+	if err != nil {
+		t.Fatalf("Failed to open metadata store: %v", err)
+	}
+	return store
+}
+
+// startQueue starts q and stops it when the test ends
+func startQueue(t *testing.T, q *Queue) {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	if err := q.Start(ctx); err != nil {
+		t.Fatalf("Failed to start queue: %v", err)
+	}
+	t.Cleanup(func() {
+		cancel()
+		q.Wait()
+	})
+}
+
+func waitJob(t *testing.T, q *Queue, id string) *metadata.Job {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	job, err := q.WaitJob(ctx, id)
+	if err != nil {
+		t.Fatalf("Failed to wait for job: %v", err)
+	}
+	if !job.Done() {
+		t.Fatalf("Timed out waiting for job %s, last %+v", id, job)
+	}
+	return job
+}
+
+func TestQueue_RetriesWithBackoff(t *testing.T) {
+	store := openStore(t, filepath.Join(t.TempDir(), "metadata"))
+	defer store.Close()
+
+	q := NewQueue(store, Config{}, logrus.New())
+	var calls atomic.Int32
+	q.Register("flaky", Policy{MaxAttempts: 3, Backoff: 10 * time.Millisecond}, func(ctx context.Context, job *metadata.Job) (interface{}, error) {
+		if calls.Add(1) < 3 {
+			return nil, errors.New("node unavailable")
+		}
+		var name string
+		if err := job.DecodePayload(&name); err != nil {
+			return nil, err
+		}
+		return map[string]string{"greeting": "hello " + name}, nil
+	})
+	q.Register("doomed", Policy{MaxAttempts: 3, Backoff: 10 * time.Millisecond}, func(context.Context, *metadata.Job) (interface{}, error) {
+		return nil, Permanent(errors.New("file is gone"))
+	})
+	startQueue(t, q)
+
+	job, err := q.Enqueue("flaky", "", "0xowner", "agent")
+	if err != nil {
+		t.Fatalf("Failed to enqueue job: %v", err)
+	}
+	job = waitJob(t, q, job.ID)
+	if job.Status != metadata.JobSucceeded || job.Attempts != 3 || string(job.Result) != `{"greeting":"hello agent"}` {
+		t.Errorf("Expected success on the third attempt, got %+v with result %s", job, job.Result)
+	}
+
+	job, err = q.Enqueue("doomed", "", "", nil)
+	if err != nil {
+		t.Fatalf("Failed to enqueue job: %v", err)
+	}
+	job = waitJob(t, q, job.ID)
+	if job.Status != metadata.JobFailed || job.Attempts != 1 || job.Error != "file is gone" {
+		t.Errorf("Expected a permanent failure without retries, got %+v", job)
+	}
+
+	if _, err := q.Enqueue("unknown", "", "", nil); !errors.Is(err, ErrUnknownType) {
+		t.Errorf("Expected ErrUnknownType, got %v", err)
+	}
+}
+
+func TestQueue_DeduplicatesKeyedJobs(t *testing.T) {
+	store := openStore(t, filepath.Join(t.TempDir(), "metadata"))
+	defer store.Close()
+
+	q := NewQueue(store, Config{}, logrus.New())
+	release := make(chan struct{})
+	q.Register("verify", Policy{}, func(ctx context.Context, job *metadata.Job) (interface{}, error) {
+		<-release
+		return nil, nil
+	})
+	startQueue(t, q)
+
+	first, err := q.Enqueue("verify", "abc", "", "abc")
+	if err != nil {
+		t.Fatalf("Failed to enqueue job: %v", err)
+	}
+	second, err := q.Enqueue("verify", "abc", "", "abc")
+	if err != nil {
+		t.Fatalf("Failed to enqueue job: %v", err)
+	}
+	if first.ID != second.ID {
+		t.Errorf("Expected an unfinished keyed job to be reused, got %s and %s", first.ID, second.ID)
+	}
+
+	close(release)
+	waitJob(t, q, first.ID)
+	third, err := q.Enqueue("verify", "abc", "", "abc")
+	if err != nil {
+		t.Fatalf("Failed to enqueue job: %v", err)
+	}
+	if third.ID == first.ID {
+		t.Error("Expected a new job once the previous one finished")
+	}
+}
+
+func TestQueue_ResumesAfterRestart(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "metadata")
+	store := openStore(t, path)
+
+	// The first run stops while the job is running
+	started := make(chan struct{})
+	ctx, cancel := context.WithCancel(context.Background())
+	q := NewQueue(store, Config{}, logrus.New())
+	q.Register("upload", Policy{MaxAttempts: 1}, func(ctx context.Context, job *metadata.Job) (interface{}, error) {
+		close(started)
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+	if err := q.Start(ctx); err != nil {
+		t.Fatalf("Failed to start queue: %v", err)
+	}
+	job, err := q.Enqueue("upload", "", "", "report.pdf")
+	if err != nil {
+		t.Fatalf("Failed to enqueue job: %v", err)
+	}
+	<-started
+	cancel()
+	q.Wait()
+	store.Close()
+
+	store = openStore(t, path)
+	defer store.Close()
+	interrupted, err := store.GetJob(job.ID)
+	if err != nil {
+		t.Fatalf("Failed to read job: %v", err)
+	}
+	if interrupted.Status != metadata.JobQueued || interrupted.Attempts != 0 {
+		t.Fatalf("Expected the interrupted job to be requeued without using its attempt, got %+v", interrupted)
+	}
+
+	// The next run picks it up
+	q = NewQueue(store, Config{}, logrus.New())
+	q.Register("upload", Policy{MaxAttempts: 1}, func(ctx context.Context, job *metadata.Job) (interface{}, error) {
+		return "stored", nil
+	})
+	startQueue(t, q)
+	if job = waitJob(t, q, job.ID); job.Status != metadata.JobSucceeded {
+		t.Errorf("Expected the resumed job to succeed, got %+v", job)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	policy := Policy{Backoff: time.Second, MaxBackoff: 5 * time.Second}
+	for attempt, want := range map[int]time.Duration{1: time.Second, 2: 2 * time.Second, 3: 4 * time.Second, 4: 5 * time.Second, 10: 5 * time.Second} {
+		if got := backoff(policy, attempt); got != want {
+			t.Errorf("backoff after attempt %d = %v, want %v", attempt, got, want)
+		}
+	}
+}
+
+func TestQueue_Events(t *testing.T) {
+	store := openStore(t, filepath.Join(t.TempDir(), "metadata"))
+	defer store.Close()
+
+	q := NewQueue(store, Config{}, logrus.New())
+	release := make(chan struct{})
+	q.Register("upload", Policy{}, func(ctx context.Context, job *metadata.Job) (interface{}, error) {
+		<-release
+		for i := 1; i <= 3; i++ {
+			progress.Report(ctx, progress.ChunkUploaded, map[string]interface{}{"uploaded": i})
+		}
+		progress.Report(ctx, progress.Recorded, nil)
+		return "stored", nil
+	})
+	startQueue(t, q)
+
+	job, err := q.Enqueue("upload", "", "", nil)
+	if err != nil {
+		t.Fatalf("Failed to enqueue job: %v", err)
+	}
+	_, updates, unsubscribe := q.Events(job.ID)
+	defer unsubscribe()
+	close(release)
+	waitJob(t, q, job.ID)
+
+	// A subscriber sees every event after it subscribed, in order. The job
+	// may have started running before the subscription.
+	var stages []string
+	for len(stages) == 0 || stages[len(stages)-1] != StageStatus+":"+string(metadata.JobSucceeded) {
+		select {
+		case event := <-updates:
+			stage := event.Stage
+			if stage == StageStatus {
+				stage += ":" + string(event.Data["status"].(metadata.JobStatus))
+			}
+			if stage != "status:running" {
+				stages = append(stages, stage)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Timed out waiting for events, got %v", stages)
+		}
+	}
+	want := []string{"chunk_uploaded", "chunk_uploaded", "chunk_uploaded", "recorded", "status:succeeded"}
+	if strings.Join(stages, ",") != strings.Join(want, ",") {
+		t.Errorf("Expected events %v, got %v", want, stages)
+	}
+
+	// A late subscriber gets the latest event of each step
+	history, _, unsubscribeLate := q.Events(job.ID)
+	defer unsubscribeLate()
+	if len(history) != 5 {
+		t.Fatalf("Expected 5 events in the history, got %+v", history)
+	}
+	if uploaded := history[2]; uploaded.Stage != progress.ChunkUploaded || uploaded.Data["uploaded"] != 3 || uploaded.Seq != 5 {
+		t.Errorf("Expected the history to keep the last chunk upload, got %+v", uploaded)
+	}
+	if last := history[4]; last.JobID != job.ID || last.Type != "upload" || string(last.Data["result"].(json.RawMessage)) != `"stored"` {
+		t.Errorf("Expected the final status to carry the result, got %+v", last)
+	}
+}
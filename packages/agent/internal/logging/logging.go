@@ -0,0 +1,179 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// Config describes where and how the agent logs
+type Config struct {
+	Level  string
+	Format string
+	// Output is stdout, stderr or a file path; files are rotated
+	Output     string
+	MaxSizeMB  int
+	MaxBackups int
+	MaxAgeDays int
+	Compress   bool
+	// Secrets are literal values, such as the private key, that never
+	// appear in logs
+	Secrets []string
+}
+
+// Setup configures the standard logger, which every component is given,
+// and returns it with a closer for its output
+func Setup(config Config) (*logrus.Logger, io.Closer, error) {
+	logger := logrus.StandardLogger()
+
+	level, err := logrus.ParseLevel(config.Level)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid log level %q", config.Level)
+	}
+	logger.SetLevel(level)
+
+	var formatter logrus.Formatter
+	switch config.Format {
+	case "", "json":
+		formatter = &logrus.JSONFormatter{TimestampFormat: time.RFC3339}
+	case "text":
+		formatter = &logrus.TextFormatter{TimestampFormat: time.RFC3339, FullTimestamp: true}
+	default:
+		return nil, nil, fmt.Errorf("invalid log format %q", config.Format)
+	}
+	logger.SetFormatter(NewRedactor(formatter, config.Secrets...))
+
+	var closer io.Closer = nopCloser{}
+	switch config.Output {
+	case "", "stdout":
+		logger.SetOutput(os.Stdout)
+	case "stderr":
+		logger.SetOutput(os.Stderr)
+	default:
+		file := &lumberjack.Logger{
+			Filename:   config.Output,
+			MaxSize:    config.MaxSizeMB,
+			MaxBackups: config.MaxBackups,
+			MaxAge:     config.MaxAgeDays,
+			Compress:   config.Compress,
+		}
+		logger.SetOutput(file)
+		closer = file
+	}
+
+	return logger, closer, nil
+}
+
+// nopCloser is the closer of outputs the agent does not own
+type nopCloser struct{}
+
+func (nopCloser) Close() error { return nil }
+
+type requestIDKey struct{}
+
+// WithRequestID returns a context carrying the ID of the request it serves
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestID returns the request ID in ctx, if any
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// Entry returns logger annotated with the request and trace ctx belongs to,
+// so lines from different components can be tied to one request
+func Entry(ctx context.Context, logger *logrus.Logger) *logrus.Entry {
+	entry := logrus.NewEntry(logger)
+	if id := RequestID(ctx); id != "" {
+		entry = entry.WithField("request_id", id)
+	}
+	if span := trace.SpanContextFromContext(ctx); span.HasTraceID() {
+		entry = entry.WithField("trace_id", span.TraceID().String())
+	}
+	return entry
+}
+
+// Redacted replaces secrets in log output
+const Redacted = "[REDACTED]"
+
+// sensitiveFields are field names whose values are never logged
+var sensitiveFields = []string{"private_key", "privatekey", "secret", "password", "token", "authorization", "api_key", "apikey", "signature"}
+
+// sensitivePatterns match credentials inside messages and field values:
+// bearer tokens, agent API keys and hex private keys labelled as such
+var sensitivePatterns = []*regexp.Regexp{
+	regexp.MustCompile(`(?i)(bearer\s+)[^\s"',]+`),
+	regexp.MustCompile(`nvk_[A-Za-z0-9_-]+`),
+	regexp.MustCompile(`(?i)(private[ _-]?key["'=:\s]+)(0x)?[0-9a-f]{64}`),
+}
+
+// Redactor is a formatter that scrubs secrets from entries before handing
+// them to the formatter it wraps
+type Redactor struct {
+	inner   logrus.Formatter
+	secrets []string
+}
+
+// NewRedactor wraps inner, also scrubbing the literal secrets given
+func NewRedactor(inner logrus.Formatter, secrets ...string) *Redactor {
+	r := &Redactor{inner: inner}
+	for _, secret := range secrets {
+		// Short values would redact innocent text
+		if len(secret) >= 8 {
+			r.secrets = append(r.secrets, secret, strings.TrimPrefix(secret, "0x"))
+		}
+	}
+	return r
+}
+
+// Format implements logrus.Formatter
+func (r *Redactor) Format(entry *logrus.Entry) ([]byte, error) {
+	scrubbed := *entry
+	scrubbed.Message = r.scrub(entry.Message)
+	scrubbed.Data = make(logrus.Fields, len(entry.Data))
+	for key, value := range entry.Data {
+		if sensitiveField(key) {
+			scrubbed.Data[key] = Redacted
+			continue
+		}
+		switch v := value.(type) {
+		case string:
+			scrubbed.Data[key] = r.scrub(v)
+		case error:
+			scrubbed.Data[key] = r.scrub(v.Error())
+		default:
+			scrubbed.Data[key] = value
+		}
+	}
+	return r.inner.Format(&scrubbed)
+}
+
+func (r *Redactor) scrub(text string) string {
+	for _, secret := range r.secrets {
+		text = strings.ReplaceAll(text, secret, Redacted)
+	}
+	for _, pattern := range sensitivePatterns {
+		text = pattern.ReplaceAllString(text, "${1}"+Redacted)
+	}
+	return text
+}
+
+func sensitiveField(key string) bool {
+	key = strings.ToLower(key)
+	for _, name := range sensitiveFields {
+		if strings.Contains(key, name) {
+			return true
+		}
+	}
+	return false
+}
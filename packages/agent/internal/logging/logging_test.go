@@ -0,0 +1,61 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+)
+
+func TestRedactor(t *testing.T) {
+	privateKey := "4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318"
+	fileHash := "0x9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+
+	var out bytes.Buffer
+	logger := logrus.New()
+	logger.SetOutput(&out)
+	logger.SetFormatter(NewRedactor(&logrus.JSONFormatter{}, "0x"+privateKey, "short"))
+
+	logger.WithFields(logrus.Fields{
+		"authorization": "Bearer eyJhbGciOi",
+		"session_token": "abc.def",
+		"hash":          fileHash,
+		"error":         errors.New("dial failed with key " + privateKey),
+		"size":          42,
+	}).Infof("Request with header Authorization: Bearer abc.def and key nvk_k1_s3cr3t")
+
+	logged := out.String()
+	for _, secret := range []string{privateKey, "eyJhbGciOi", "abc.def", "nvk_k1_s3cr3t"} {
+		if strings.Contains(logged, secret) {
+			t.Errorf("Expected %q to be redacted from %s", secret, logged)
+		}
+	}
+	for _, kept := range []string{fileHash, `"size":42`, "Bearer [REDACTED]", "dial failed with key [REDACTED]"} {
+		if !strings.Contains(logged, kept) {
+			t.Errorf("Expected %q to be kept in %s", kept, logged)
+		}
+	}
+
+	// Secrets too short to redact safely are ignored rather than blanking words
+	out.Reset()
+	logger.Info("a short message")
+	if !strings.Contains(out.String(), "a short message") {
+		t.Errorf("Expected short secrets to be ignored, got %s", out.String())
+	}
+}
+
+func TestEntry_CarriesRequestID(t *testing.T) {
+	logger := logrus.New()
+
+	if _, ok := Entry(context.Background(), logger).Data["request_id"]; ok {
+		t.Error("Expected no request ID outside a request")
+	}
+
+	ctx := WithRequestID(context.Background(), "req-1")
+	if id := Entry(ctx, logger).Data["request_id"]; id != "req-1" {
+		t.Errorf("Expected request ID req-1, got %v", id)
+	}
+}
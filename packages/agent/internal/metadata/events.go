@@ -0,0 +1,235 @@
+package metadata
+
+import (
+	"strings"
+)
+
+// Event names written by the indexer
+const (
+	EventFileUploaded                 = "FileUploaded"
+	EventFileUploadedWithVerification = "FileUploadedWithVerification"
+	EventFileDownloaded               = "FileDownloaded"
+	EventFileDeleted                  = "FileDeleted"
+	EventProofSubmitted               = "ProofSubmitted"
+	EventProofVerified                = "ProofVerified"
+	EventUserRegistered               = "UserRegistered"
+	EventUserSuspended                = "UserSuspended"
+	EventUserUnsuspended              = "UserUnsuspended"
+	EventUserRoleGranted              = "UserRoleGranted"
+	EventUserRoleRevoked              = "UserRoleRevoked"
+	EventStorageQuotaUpdated          = "StorageQuotaUpdated"
+	EventSystemUpgraded               = "SystemUpgraded"
+)
+
+// Event is a decoded contract log. FileHash and Account are indexed so a
+// file's or user's history can be read back without scanning every event.
+type Event struct {
+	Contract    string                 `json:"contract"`
+	Name        string                 `json:"name"`
+	BlockNumber uint64                 `json:"block_number"`
+	BlockHash   string                 `json:"block_hash"`
+	TxHash      string                 `json:"tx_hash"`
+	LogIndex    uint                   `json:"log_index"`
+	FileHash    string                 `json:"file_hash,omitempty"`
+	Account     string                 `json:"account,omitempty"`
+	Timestamp   uint64                 `json:"timestamp,omitempty"`
+	Data        map[string]interface{} `json:"data,omitempty"`
+}
+
+// ChainFile is a file's on-chain state, folded from its events
+type ChainFile struct {
+	FileHash       string `json:"file_hash"`
+	Uploader       string `json:"uploader"`
+	Filename       string `json:"filename"`
+	Size           uint64 `json:"size"`
+	MerkleRoot     string `json:"merkle_root"`
+	UploadedAt     uint64 `json:"uploaded_at"`
+	UploadBlock    uint64 `json:"upload_block"`
+	UploadTx       string `json:"upload_tx"`
+	Active         bool   `json:"active"`
+	Verified       bool   `json:"verified"`
+	ProofSubmitted bool   `json:"proof_submitted"`
+	Downloads      uint64 `json:"downloads"`
+}
+
+// ChainUser is an account's on-chain state, folded from its events
+type ChainUser struct {
+	Address      string   `json:"address"`
+	Username     string   `json:"username"`
+	Registered   bool     `json:"registered"`
+	Suspended    bool     `json:"suspended"`
+	StorageQuota uint64   `json:"storage_quota,omitempty"`
+	Roles        []string `json:"roles,omitempty"`
+}
+
+// FileQuery filters ListChainFiles
+type FileQuery struct {
+	// Uploader matches the uploading account exactly
+	Uploader string
+	// Search matches a case-insensitive substring of the filename
+	Search string
+	// IncludeDeleted also returns files that have been deleted
+	IncludeDeleted bool
+}
+
+// ChainFile folds a file's indexed events into its current state
+func (s *Store) ChainFile(fileHash string) (*ChainFile, error) {
+	events, err := s.FileEvents(fileHash)
+	if err != nil {
+		return nil, err
+	}
+	file := foldFile(events)
+	if file == nil {
+		return nil, ErrNotFound
+	}
+	return file, nil
+}
+
+// ListChainFiles returns the indexed files matching query
+func (s *Store) ListChainFiles(query FileQuery) ([]*ChainFile, error) {
+	hashes, err := s.fileHashes()
+	if err != nil {
+		return nil, err
+	}
+
+	uploader := ""
+	if query.Uploader != "" {
+		uploader = normalizeHex(query.Uploader)
+	}
+	search := strings.ToLower(query.Search)
+
+	files := make([]*ChainFile, 0, len(hashes))
+	for _, hash := range hashes {
+		file, err := s.ChainFile(hash)
+		if err == ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !file.Active && !query.IncludeDeleted {
+			continue
+		}
+		if uploader != "" && file.Uploader != uploader {
+			continue
+		}
+		if search != "" && !strings.Contains(strings.ToLower(file.Filename), search) {
+			continue
+		}
+		files = append(files, file)
+	}
+	return files, nil
+}
+
+// ChainUser folds an account's indexed events into its current state
+func (s *Store) ChainUser(account string) (*ChainUser, error) {
+	events, err := s.UserEvents(account)
+	if err != nil {
+		return nil, err
+	}
+
+	user := &ChainUser{Address: normalizeHex(account)}
+	for _, event := range events {
+		switch event.Name {
+		case EventUserRegistered:
+			user.Registered = true
+			user.Username, _ = event.Data["username"].(string)
+		case EventUserSuspended:
+			user.Suspended = true
+		case EventUserUnsuspended:
+			user.Suspended = false
+		case EventStorageQuotaUpdated:
+			user.StorageQuota = dataUint(event.Data, "quota")
+		case EventUserRoleGranted:
+			if role, ok := event.Data["role"].(string); ok && !containsString(user.Roles, role) {
+				user.Roles = append(user.Roles, role)
+			}
+		case EventUserRoleRevoked:
+			if role, ok := event.Data["role"].(string); ok {
+				user.Roles = removeString(user.Roles, role)
+			}
+		}
+	}
+	if !user.Registered {
+		return nil, ErrNotFound
+	}
+	return user, nil
+}
+
+// foldFile replays a file's events in chain order, returning nil if the
+// file was never uploaded
+func foldFile(events []*Event) *ChainFile {
+	var file *ChainFile
+	for _, event := range events {
+		if event.Name != EventFileUploaded && file == nil {
+			continue
+		}
+		switch event.Name {
+		case EventFileUploaded:
+			file = &ChainFile{
+				FileHash:    event.FileHash,
+				Uploader:    event.Account,
+				Filename:    stringData(event.Data, "filename"),
+				Size:        dataUint(event.Data, "size"),
+				MerkleRoot:  stringData(event.Data, "merkle_root"),
+				UploadedAt:  event.Timestamp,
+				UploadBlock: event.BlockNumber,
+				UploadTx:    event.TxHash,
+				Active:      true,
+			}
+		case EventFileUploadedWithVerification:
+			// FileStorage sees the vault as the uploader; the vault's own
+			// event names the real account
+			file.Uploader = event.Account
+			if submitted, _ := event.Data["proof_submitted"].(bool); submitted {
+				file.ProofSubmitted = true
+			} else {
+				file.Verified = true
+			}
+		case EventProofVerified:
+			if valid, _ := event.Data["valid"].(bool); valid {
+				file.Verified = true
+			}
+		case EventFileDownloaded:
+			file.Downloads++
+		case EventFileDeleted:
+			file.Active = false
+		}
+	}
+	return file
+}
+
+func stringData(data map[string]interface{}, key string) string {
+	s, _ := data[key].(string)
+	return s
+}
+
+// dataUint reads a number from event data, which JSON decodes as float64
+func dataUint(data map[string]interface{}, key string) uint64 {
+	switch v := data[key].(type) {
+	case float64:
+		return uint64(v)
+	case uint64:
+		return v
+	}
+	return 0
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+func removeString(list []string, s string) []string {
+	out := list[:0]
+	for _, item := range list {
+		if item != s {
+			out = append(out, item)
+		}
+	}
+	return out
+}
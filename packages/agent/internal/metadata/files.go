@@ -0,0 +1,231 @@
+package metadata
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// filePrefix keys agent-side file records by content hash, and
+// fileRefPrefix indexes them by the 0G roots of their chunks.
+// fileRefIndexedKey marks that records from before the index are in it.
+const (
+	filePrefix        = "file/"
+	fileRefPrefix     = "file-ref/"
+	fileRefIndexedKey = "file-ref-indexed"
+)
+
+// AnchorStatus tracks a file's registration on chain
+type AnchorStatus string
+
+const (
+	AnchorPending   AnchorStatus = "pending"
+	AnchorConfirmed AnchorStatus = "confirmed"
+	AnchorFailed    AnchorStatus = "failed"
+)
+
+// Anchor is the state of a file's on-chain registration
+type Anchor struct {
+	Status      AnchorStatus `json:"status"`
+	TxHash      string       `json:"tx_hash,omitempty"`
+	BlockNumber uint64       `json:"block_number,omitempty"`
+	Error       string       `json:"error,omitempty"`
+	UpdatedAt   time.Time    `json:"updated_at"`
+}
+
+// Verification is the outcome of the last check that a file's chunks are
+// still retrievable from 0G and match its Merkle root
+type Verification struct {
+	Valid           bool      `json:"valid"`
+	MerkleRootValid bool      `json:"merkle_root_valid"`
+	MissingChunks   []int     `json:"missing_chunks,omitempty"`
+	CheckedAt       time.Time `json:"checked_at"`
+}
+
+// FileRecord is the agent's metadata for an uploaded file. Chunk data lives
+// in 0G; only the hashes needed to rebuild proofs are kept. ChunkSize is
+// the size of every chunk but the last, unset on records from before it was
+// kept.
+type FileRecord struct {
+	ID          string   `json:"id"`
+	Filename    string   `json:"filename"`
+	Size        int64    `json:"size"`
+	MimeType    string   `json:"mime_type"`
+	Hash        string   `json:"hash"`
+	MerkleRoot  string   `json:"merkle_root"`
+	ChunkSize   int64    `json:"chunk_size,omitempty"`
+	ChunkHashes []string `json:"chunk_hashes"`
+	StorageRefs []string `json:"storage_refs"`
+	UploadedAt  string   `json:"uploaded_at"`
+	UserID      string   `json:"user_id"`
+	IsPublic    bool     `json:"is_public"`
+	Anchor      *Anchor  `json:"anchor,omitempty"`
+
+	Verification *Verification `json:"verification,omitempty"`
+}
+
+// PutFile creates or replaces a file record
+func (s *Store) PutFile(record *FileRecord) error {
+	s.fileMu.Lock()
+	defer s.fileMu.Unlock()
+	return s.putFile(record)
+}
+
+// putFile writes a record along with its storage ref index, dropping the
+// refs the record it replaces no longer has. It is called with fileMu held.
+func (s *Store) putFile(record *FileRecord) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode file record")
+	}
+
+	batch := new(leveldb.Batch)
+	existing, err := s.GetFile(record.Hash)
+	if err != nil && err != ErrNotFound {
+		return err
+	}
+	if existing != nil {
+		for _, ref := range existing.StorageRefs {
+			batch.Delete(fileRefKey(ref, existing.Hash))
+		}
+	}
+	for _, ref := range record.StorageRefs {
+		if ref != "" {
+			batch.Put(fileRefKey(ref, record.Hash), nil)
+		}
+	}
+	batch.Put(fileKey(record.Hash), data)
+	return errors.Wrap(s.db.Write(batch, nil), "failed to write file record")
+}
+
+// GetFile returns the record for a content hash
+func (s *Store) GetFile(hash string) (*FileRecord, error) {
+	data, err := s.db.Get(fileKey(hash), nil)
+	if err == leveldb.ErrNotFound {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read file record")
+	}
+
+	var record FileRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		return nil, errors.Wrap(err, "failed to decode file record")
+	}
+	return &record, nil
+}
+
+// UpdateFile applies update to a stored record and saves it
+func (s *Store) UpdateFile(hash string, update func(*FileRecord)) (*FileRecord, error) {
+	s.fileMu.Lock()
+	defer s.fileMu.Unlock()
+
+	record, err := s.GetFile(hash)
+	if err != nil {
+		return nil, err
+	}
+	update(record)
+	if err := s.putFile(record); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// MergeFile stores the record merge builds from the stored record for hash,
+// or from nil when there is none. It serializes with UpdateFile, so neither
+// overwrites the other's changes.
+func (s *Store) MergeFile(hash string, merge func(existing *FileRecord) *FileRecord) (*FileRecord, error) {
+	s.fileMu.Lock()
+	defer s.fileMu.Unlock()
+
+	existing, err := s.GetFile(hash)
+	if err != nil && err != ErrNotFound {
+		return nil, err
+	}
+	record := merge(existing)
+	if err := s.putFile(record); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// ListFiles returns every record accepted by match, or all records when
+// match is nil
+func (s *Store) ListFiles(match func(*FileRecord) bool) ([]*FileRecord, error) {
+	iter := s.db.NewIterator(util.BytesPrefix([]byte(filePrefix)), nil)
+	defer iter.Release()
+
+	records := []*FileRecord{}
+	for iter.Next() {
+		var record FileRecord
+		if err := json.Unmarshal(iter.Value(), &record); err != nil {
+			return nil, errors.Wrap(err, "failed to decode file record")
+		}
+		if match == nil || match(&record) {
+			records = append(records, &record)
+		}
+	}
+	return records, errors.Wrap(iter.Error(), "failed to iterate file records")
+}
+
+// FilesByStorageRef returns the records with a chunk stored under a 0G
+// root. Identical chunks share a root, so more than one file may.
+func (s *Store) FilesByStorageRef(ref string) ([]*FileRecord, error) {
+	prefix := fileRefKey(ref, "")
+	iter := s.db.NewIterator(util.BytesPrefix(prefix), nil)
+	defer iter.Release()
+
+	records := []*FileRecord{}
+	for iter.Next() {
+		record, err := s.GetFile(string(iter.Key()[len(prefix):]))
+		if err == ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, errors.Wrap(iter.Error(), "failed to iterate storage refs")
+}
+
+// indexStorageRefs indexes the storage refs of records written before the
+// index existed. It runs once, when the store is opened.
+func (s *Store) indexStorageRefs() error {
+	indexed, err := s.db.Has([]byte(fileRefIndexedKey), nil)
+	if err != nil || indexed {
+		return errors.Wrap(err, "failed to read storage ref index state")
+	}
+
+	records, err := s.ListFiles(nil)
+	if err != nil {
+		return err
+	}
+	batch := new(leveldb.Batch)
+	for _, record := range records {
+		for _, ref := range record.StorageRefs {
+			if ref != "" {
+				batch.Put(fileRefKey(ref, record.Hash), nil)
+			}
+		}
+	}
+	batch.Put([]byte(fileRefIndexedKey), nil)
+	return errors.Wrap(s.db.Write(batch, nil), "failed to index storage refs")
+}
+
+// fileRefKey indexes a content hash under one of its file's 0G roots
+func fileRefKey(ref, hash string) []byte {
+	ref = strings.TrimPrefix(strings.ToLower(ref), "0x")
+	hash = strings.TrimPrefix(strings.ToLower(hash), "0x")
+	return []byte(fileRefPrefix + ref + "/" + hash)
+}
+
+// fileKey normalises a content hash, which may carry a 0x prefix when it
+// comes from the chain
+func fileKey(hash string) []byte {
+	return []byte(filePrefix + strings.TrimPrefix(strings.ToLower(hash), "0x"))
+}
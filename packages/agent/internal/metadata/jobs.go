@@ -0,0 +1,130 @@
+package metadata
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// jobPrefix keys background jobs by ID
+const jobPrefix = "job/"
+
+// JobStatus is where a job is in its lifecycle
+type JobStatus string
+
+const (
+	JobQueued    JobStatus = "queued"
+	JobRunning   JobStatus = "running"
+	JobSucceeded JobStatus = "succeeded"
+	JobFailed    JobStatus = "failed"
+)
+
+// Job is a unit of background work, persisted so it survives restarts.
+// Payload is the job type's input and Result its output once it succeeds.
+type Job struct {
+	ID          string          `json:"id"`
+	Type        string          `json:"type"`
+	Key         string          `json:"key,omitempty"`
+	Owner       string          `json:"owner,omitempty"`
+	Status      JobStatus       `json:"status"`
+	Payload     json.RawMessage `json:"payload,omitempty"`
+	Result      json.RawMessage `json:"result,omitempty"`
+	Error       string          `json:"error,omitempty"`
+	Attempts    int             `json:"attempts"`
+	MaxAttempts int             `json:"max_attempts"`
+	RunAt       time.Time       `json:"run_at"`
+	CreatedAt   time.Time       `json:"created_at"`
+	UpdatedAt   time.Time       `json:"updated_at"`
+}
+
+// Done reports whether the job has finished, successfully or not
+func (j *Job) Done() bool {
+	return j.Status == JobSucceeded || j.Status == JobFailed
+}
+
+// LastAttempt reports whether a failure of the running attempt is final
+func (j *Job) LastAttempt() bool {
+	return j.Attempts >= j.MaxAttempts
+}
+
+// DecodePayload unmarshals the job's payload into v
+func (j *Job) DecodePayload(v interface{}) error {
+	return errors.Wrap(json.Unmarshal(j.Payload, v), "failed to decode job payload")
+}
+
+// DecodeResult unmarshals the result of a succeeded job into v
+func (j *Job) DecodeResult(v interface{}) error {
+	return errors.Wrap(json.Unmarshal(j.Result, v), "failed to decode job result")
+}
+
+// PutJob creates or replaces a job
+func (s *Store) PutJob(job *Job) error {
+	data, err := json.Marshal(job)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode job")
+	}
+	return errors.Wrap(s.db.Put([]byte(jobPrefix+job.ID), data, nil), "failed to write job")
+}
+
+// GetJob returns a job by ID
+func (s *Store) GetJob(id string) (*Job, error) {
+	data, err := s.db.Get([]byte(jobPrefix+id), nil)
+	if err == leveldb.ErrNotFound {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read job")
+	}
+
+	var job Job
+	if err := json.Unmarshal(data, &job); err != nil {
+		return nil, errors.Wrap(err, "failed to decode job")
+	}
+	return &job, nil
+}
+
+// UpdateJob applies update to a stored job and saves it. An error from
+// update leaves the job unchanged and is returned as is.
+func (s *Store) UpdateJob(id string, update func(*Job) error) (*Job, error) {
+	s.jobMu.Lock()
+	defer s.jobMu.Unlock()
+
+	job, err := s.GetJob(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := update(job); err != nil {
+		return job, err
+	}
+	if err := s.PutJob(job); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// ListJobs returns every job accepted by match, or all jobs when match is
+// nil
+func (s *Store) ListJobs(match func(*Job) bool) ([]*Job, error) {
+	iter := s.db.NewIterator(util.BytesPrefix([]byte(jobPrefix)), nil)
+	defer iter.Release()
+
+	jobs := []*Job{}
+	for iter.Next() {
+		var job Job
+		if err := json.Unmarshal(iter.Value(), &job); err != nil {
+			return nil, errors.Wrap(err, "failed to decode job")
+		}
+		if match == nil || match(&job) {
+			jobs = append(jobs, &job)
+		}
+	}
+	return jobs, errors.Wrap(iter.Error(), "failed to iterate jobs")
+}
+
+// DeleteJob removes a job
+func (s *Store) DeleteJob(id string) error {
+	return errors.Wrap(s.db.Delete([]byte(jobPrefix+id), nil), "failed to delete job")
+}
@@ -0,0 +1,311 @@
+package metadata
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// Key layout. Block numbers and log indices are zero padded so that
+// iteration order matches chain order.
+//
+//	event/<block>/<log>            -> Event
+//	file-event/<hash>/<block>/<log> -> (index)
+//	user-event/<addr>/<block>/<log> -> (index)
+//	block/<block>                  -> block hash
+//	cursor                         -> last indexed block
+//	file/<hash>                    -> FileRecord
+//	file-ref/<root>/<hash>         -> (index)
+//	file-ref-indexed               -> (marker, refs of older records indexed)
+//	job/<id>                       -> Job
+//	webhook/<id>                   -> Webhook
+//	webhook-delivery/<id>          -> Delivery
+//	webhook-event/<event id>       -> unix time dispatched
+//	health                         -> readiness probe scratch value
+const (
+	eventPrefix     = "event/"
+	fileEventPrefix = "file-event/"
+	userEventPrefix = "user-event/"
+	blockPrefix     = "block/"
+	cursorKey       = "cursor"
+	healthKey       = "health"
+)
+
+// ErrNotFound is returned when a record does not exist
+var ErrNotFound = errors.New("not found")
+
+// Store is the agent's local metadata database
+type Store struct {
+	db *leveldb.DB
+
+	// fileMu, jobMu and deliveryMu serialize read-modify-write updates of
+	// file records, jobs and webhook deliveries
+	fileMu     sync.Mutex
+	jobMu      sync.Mutex
+	deliveryMu sync.Mutex
+}
+
+// Open opens or creates a metadata store at path
+func Open(path string) (*Store, error) {
+	db, err := leveldb.OpenFile(path, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open metadata store")
+	}
+	store := &Store{db: db}
+	if err := store.indexStorageRefs(); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return store, nil
+}
+
+// Close closes the underlying database
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// Check verifies the store is writable by syncing a probe value to disk,
+// reading it back and deleting it
+func (s *Store) Check() error {
+	value := []byte(strconv.FormatInt(time.Now().UnixNano(), 10))
+	if err := s.db.Put([]byte(healthKey), value, &opt.WriteOptions{Sync: true}); err != nil {
+		return errors.Wrap(err, "metadata store is not writable")
+	}
+	read, err := s.db.Get([]byte(healthKey), nil)
+	if err != nil {
+		return errors.Wrap(err, "metadata store is not readable")
+	}
+	if string(read) != string(value) {
+		return errors.New("metadata store returned a stale probe value")
+	}
+	return errors.Wrap(s.db.Delete([]byte(healthKey), nil), "failed to delete probe value")
+}
+
+// Cursor returns the last indexed block, or false if nothing has been
+// indexed yet
+func (s *Store) Cursor() (uint64, bool, error) {
+	value, err := s.db.Get([]byte(cursorKey), nil)
+	if err == leveldb.ErrNotFound {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, errors.Wrap(err, "failed to read cursor")
+	}
+	block, err := strconv.ParseUint(string(value), 10, 64)
+	if err != nil {
+		return 0, false, errors.Wrap(err, "failed to parse cursor")
+	}
+	return block, true, nil
+}
+
+// BlockHash returns the recorded hash of an indexed block
+func (s *Store) BlockHash(number uint64) (string, bool, error) {
+	value, err := s.db.Get([]byte(blockPrefix+blockKey(number)), nil)
+	if err == leveldb.ErrNotFound {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, errors.Wrap(err, "failed to read block hash")
+	}
+	return string(value), true, nil
+}
+
+// RecentBlocks returns up to limit recorded block numbers at or below
+// from, newest first
+func (s *Store) RecentBlocks(from uint64, limit int) ([]uint64, error) {
+	iter := s.db.NewIterator(&util.Range{
+		Start: []byte(blockPrefix),
+		Limit: []byte(blockPrefix + blockKey(from+1)),
+	}, nil)
+	defer iter.Release()
+
+	var blocks []uint64
+	for ok := iter.Last(); ok && len(blocks) < limit; ok = iter.Prev() {
+		number, err := strconv.ParseUint(strings.TrimPrefix(string(iter.Key()), blockPrefix), 10, 64)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse block key")
+		}
+		blocks = append(blocks, number)
+	}
+	return blocks, errors.Wrap(iter.Error(), "failed to iterate blocks")
+}
+
+// Batch is a set of indexed blocks applied atomically
+type Batch struct {
+	// Cursor is the last block the batch covers
+	Cursor uint64
+	// Blocks maps block numbers to hashes for reorg detection
+	Blocks map[uint64]string
+	// Events are the decoded logs in the batch's blocks
+	Events []*Event
+}
+
+// Apply writes an indexed batch and advances the cursor. Block hashes
+// older than keepBlocks below the cursor are pruned.
+func (s *Store) Apply(b *Batch, keepBlocks uint64) error {
+	batch := new(leveldb.Batch)
+
+	for number, hash := range b.Blocks {
+		batch.Put([]byte(blockPrefix+blockKey(number)), []byte(hash))
+	}
+	for _, event := range b.Events {
+		data, err := json.Marshal(event)
+		if err != nil {
+			return errors.Wrap(err, "failed to encode event")
+		}
+		key := eventKey(event.BlockNumber, event.LogIndex)
+		batch.Put([]byte(eventPrefix+key), data)
+		if event.FileHash != "" {
+			batch.Put([]byte(fileEventPrefix+event.FileHash+"/"+key), nil)
+		}
+		if event.Account != "" {
+			batch.Put([]byte(userEventPrefix+event.Account+"/"+key), nil)
+		}
+	}
+	batch.Put([]byte(cursorKey), []byte(strconv.FormatUint(b.Cursor, 10)))
+
+	if keepBlocks > 0 && b.Cursor > keepBlocks {
+		iter := s.db.NewIterator(&util.Range{
+			Start: []byte(blockPrefix),
+			Limit: []byte(blockPrefix + blockKey(b.Cursor-keepBlocks)),
+		}, nil)
+		for iter.Next() {
+			batch.Delete(append([]byte(nil), iter.Key()...))
+		}
+		iter.Release()
+		if err := iter.Error(); err != nil {
+			return errors.Wrap(err, "failed to prune block hashes")
+		}
+	}
+
+	return errors.Wrap(s.db.Write(batch, nil), "failed to write batch")
+}
+
+// Rewind drops every event and block hash above block and moves the cursor
+// back to it, undoing blocks that were reorganised away
+func (s *Store) Rewind(block uint64) error {
+	batch := new(leveldb.Batch)
+
+	events := s.db.NewIterator(util.BytesPrefix([]byte(eventPrefix)), nil)
+	for ok := events.Seek([]byte(eventPrefix + blockKey(block+1))); ok; ok = events.Next() {
+		var event Event
+		if err := json.Unmarshal(events.Value(), &event); err != nil {
+			events.Release()
+			return errors.Wrap(err, "failed to decode event")
+		}
+		key := eventKey(event.BlockNumber, event.LogIndex)
+		batch.Delete([]byte(eventPrefix + key))
+		if event.FileHash != "" {
+			batch.Delete([]byte(fileEventPrefix + event.FileHash + "/" + key))
+		}
+		if event.Account != "" {
+			batch.Delete([]byte(userEventPrefix + event.Account + "/" + key))
+		}
+	}
+	events.Release()
+	if err := events.Error(); err != nil {
+		return errors.Wrap(err, "failed to iterate events")
+	}
+
+	blocks := s.db.NewIterator(util.BytesPrefix([]byte(blockPrefix)), nil)
+	for ok := blocks.Seek([]byte(blockPrefix + blockKey(block+1))); ok; ok = blocks.Next() {
+		batch.Delete(append([]byte(nil), blocks.Key()...))
+	}
+	blocks.Release()
+	if err := blocks.Error(); err != nil {
+		return errors.Wrap(err, "failed to iterate blocks")
+	}
+
+	batch.Put([]byte(cursorKey), []byte(strconv.FormatUint(block, 10)))
+	return errors.Wrap(s.db.Write(batch, nil), "failed to rewind")
+}
+
+// Events returns indexed events in chain order, starting at fromBlock
+func (s *Store) Events(fromBlock uint64, limit int) ([]*Event, error) {
+	iter := s.db.NewIterator(util.BytesPrefix([]byte(eventPrefix)), nil)
+	defer iter.Release()
+
+	var events []*Event
+	for ok := iter.Seek([]byte(eventPrefix + blockKey(fromBlock))); ok; ok = iter.Next() {
+		if limit > 0 && len(events) >= limit {
+			break
+		}
+		var event Event
+		if err := json.Unmarshal(iter.Value(), &event); err != nil {
+			return nil, errors.Wrap(err, "failed to decode event")
+		}
+		events = append(events, &event)
+	}
+	return events, errors.Wrap(iter.Error(), "failed to iterate events")
+}
+
+// FileEvents returns the audit trail of a file in chain order
+func (s *Store) FileEvents(fileHash string) ([]*Event, error) {
+	return s.indexedEvents(fileEventPrefix + normalizeHex(fileHash) + "/")
+}
+
+// UserEvents returns the events involving an account in chain order
+func (s *Store) UserEvents(account string) ([]*Event, error) {
+	return s.indexedEvents(userEventPrefix + normalizeHex(account) + "/")
+}
+
+func (s *Store) indexedEvents(prefix string) ([]*Event, error) {
+	iter := s.db.NewIterator(util.BytesPrefix([]byte(prefix)), nil)
+	defer iter.Release()
+
+	var events []*Event
+	for iter.Next() {
+		key := strings.TrimPrefix(string(iter.Key()), prefix)
+		data, err := s.db.Get([]byte(eventPrefix+key), nil)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read event %s", key)
+		}
+		var event Event
+		if err := json.Unmarshal(data, &event); err != nil {
+			return nil, errors.Wrap(err, "failed to decode event")
+		}
+		events = append(events, &event)
+	}
+	return events, errors.Wrap(iter.Error(), "failed to iterate events")
+}
+
+// fileHashes returns every file hash with at least one indexed event
+func (s *Store) fileHashes() ([]string, error) {
+	iter := s.db.NewIterator(util.BytesPrefix([]byte(fileEventPrefix)), nil)
+	defer iter.Release()
+
+	var hashes []string
+	for iter.Next() {
+		hash := strings.TrimPrefix(string(iter.Key()), fileEventPrefix)
+		hash = hash[:strings.Index(hash, "/")]
+		if len(hashes) == 0 || hashes[len(hashes)-1] != hash {
+			hashes = append(hashes, hash)
+		}
+	}
+	return hashes, errors.Wrap(iter.Error(), "failed to iterate files")
+}
+
+func blockKey(number uint64) string {
+	return fmt.Sprintf("%020d", number)
+}
+
+func eventKey(block uint64, logIndex uint) string {
+	return fmt.Sprintf("%020d/%010d", block, logIndex)
+}
+
+// normalizeHex lowercases a hex string and ensures it has a 0x prefix
+func normalizeHex(s string) string {
+	s = strings.ToLower(s)
+	if !strings.HasPrefix(s, "0x") {
+		s = "0x" + s
+	}
+	return s
+}
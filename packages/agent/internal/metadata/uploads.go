@@ -0,0 +1,77 @@
+package metadata
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// uploadPrefix keys resumable upload sessions by ID
+const uploadPrefix = "upload/"
+
+// UploadSession is a resumable upload in progress. Its content is spooled
+// at Path; Offset bytes of Size have been received.
+type UploadSession struct {
+	ID        string    `json:"id"`
+	Filename  string    `json:"filename"`
+	Size      int64     `json:"size"`
+	Offset    int64     `json:"offset"`
+	Path      string    `json:"path"`
+	Owner     string    `json:"owner,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// PutUploadSession creates or replaces an upload session
+func (s *Store) PutUploadSession(session *UploadSession) error {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode upload session")
+	}
+	return errors.Wrap(s.db.Put([]byte(uploadPrefix+session.ID), data, nil), "failed to write upload session")
+}
+
+// GetUploadSession returns an upload session by ID
+func (s *Store) GetUploadSession(id string) (*UploadSession, error) {
+	data, err := s.db.Get([]byte(uploadPrefix+id), nil)
+	if err == leveldb.ErrNotFound {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read upload session")
+	}
+
+	var session UploadSession
+	if err := json.Unmarshal(data, &session); err != nil {
+		return nil, errors.Wrap(err, "failed to decode upload session")
+	}
+	return &session, nil
+}
+
+// ListUploadSessions returns every upload session accepted by match, or all
+// sessions when match is nil
+func (s *Store) ListUploadSessions(match func(*UploadSession) bool) ([]*UploadSession, error) {
+	iter := s.db.NewIterator(util.BytesPrefix([]byte(uploadPrefix)), nil)
+	defer iter.Release()
+
+	sessions := []*UploadSession{}
+	for iter.Next() {
+		var session UploadSession
+		if err := json.Unmarshal(iter.Value(), &session); err != nil {
+			return nil, errors.Wrap(err, "failed to decode upload session")
+		}
+		if match == nil || match(&session) {
+			sessions = append(sessions, &session)
+		}
+	}
+	return sessions, errors.Wrap(iter.Error(), "failed to iterate upload sessions")
+}
+
+// DeleteUploadSession removes an upload session
+func (s *Store) DeleteUploadSession(id string) error {
+	return errors.Wrap(s.db.Delete([]byte(uploadPrefix+id), nil), "failed to delete upload session")
+}
@@ -0,0 +1,242 @@
+package metadata
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// Webhook subscriptions, their deliveries, and the IDs of events already
+// dispatched so an event reported by both the agent and the chain feed is
+// delivered once
+const (
+	webhookPrefix      = "webhook/"
+	deliveryPrefix     = "webhook-delivery/"
+	webhookEventPrefix = "webhook-event/"
+)
+
+// Webhook is a URL subscribed to file lifecycle events. Secret signs every
+// payload sent to it.
+type Webhook struct {
+	ID          string    `json:"id"`
+	URL         string    `json:"url"`
+	Events      []string  `json:"events"`
+	Description string    `json:"description,omitempty"`
+	Secret      string    `json:"secret"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// Subscribed reports whether the webhook receives events of eventType
+func (w *Webhook) Subscribed(eventType string) bool {
+	for _, event := range w.Events {
+		if event == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// DeliveryStatus is where a webhook delivery is in its lifecycle
+type DeliveryStatus string
+
+const (
+	DeliveryPending   DeliveryStatus = "pending"
+	DeliveryDelivered DeliveryStatus = "delivered"
+	// DeliveryDead marks a delivery that used up its attempts; dead
+	// deliveries form the dead-letter list until retried
+	DeliveryDead DeliveryStatus = "dead"
+)
+
+// DeliveryAttempt is one try at sending a delivery
+type DeliveryAttempt struct {
+	At         time.Time `json:"at"`
+	StatusCode int       `json:"status_code,omitempty"`
+	Error      string    `json:"error,omitempty"`
+	DurationMs int64     `json:"duration_ms"`
+}
+
+// Delivery is an event sent, or to be sent, to a webhook. Payload is the
+// exact body that is signed and posted.
+type Delivery struct {
+	ID          string            `json:"id"`
+	WebhookID   string            `json:"webhook_id"`
+	EventID     string            `json:"event_id"`
+	EventType   string            `json:"event_type"`
+	Payload     json.RawMessage   `json:"payload"`
+	Status      DeliveryStatus    `json:"status"`
+	Attempts    []DeliveryAttempt `json:"attempts"`
+	JobID       string            `json:"job_id,omitempty"`
+	CreatedAt   time.Time         `json:"created_at"`
+	UpdatedAt   time.Time         `json:"updated_at"`
+	DeliveredAt *time.Time        `json:"delivered_at,omitempty"`
+}
+
+// PutWebhook creates or replaces a webhook
+func (s *Store) PutWebhook(webhook *Webhook) error {
+	data, err := json.Marshal(webhook)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode webhook")
+	}
+	return errors.Wrap(s.db.Put([]byte(webhookPrefix+webhook.ID), data, nil), "failed to write webhook")
+}
+
+// GetWebhook returns a webhook by ID
+func (s *Store) GetWebhook(id string) (*Webhook, error) {
+	data, err := s.db.Get([]byte(webhookPrefix+id), nil)
+	if err == leveldb.ErrNotFound {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read webhook")
+	}
+
+	var webhook Webhook
+	if err := json.Unmarshal(data, &webhook); err != nil {
+		return nil, errors.Wrap(err, "failed to decode webhook")
+	}
+	return &webhook, nil
+}
+
+// ListWebhooks returns every webhook
+func (s *Store) ListWebhooks() ([]*Webhook, error) {
+	iter := s.db.NewIterator(util.BytesPrefix([]byte(webhookPrefix)), nil)
+	defer iter.Release()
+
+	webhooks := []*Webhook{}
+	for iter.Next() {
+		var webhook Webhook
+		if err := json.Unmarshal(iter.Value(), &webhook); err != nil {
+			return nil, errors.Wrap(err, "failed to decode webhook")
+		}
+		webhooks = append(webhooks, &webhook)
+	}
+	return webhooks, errors.Wrap(iter.Error(), "failed to iterate webhooks")
+}
+
+// DeleteWebhook removes a webhook. Its deliveries stay in the log.
+func (s *Store) DeleteWebhook(id string) error {
+	if _, err := s.GetWebhook(id); err != nil {
+		return err
+	}
+	return errors.Wrap(s.db.Delete([]byte(webhookPrefix+id), nil), "failed to delete webhook")
+}
+
+// PutDelivery creates or replaces a delivery
+func (s *Store) PutDelivery(delivery *Delivery) error {
+	data, err := json.Marshal(delivery)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode delivery")
+	}
+	return errors.Wrap(s.db.Put([]byte(deliveryPrefix+delivery.ID), data, nil), "failed to write delivery")
+}
+
+// GetDelivery returns a delivery by ID
+func (s *Store) GetDelivery(id string) (*Delivery, error) {
+	data, err := s.db.Get([]byte(deliveryPrefix+id), nil)
+	if err == leveldb.ErrNotFound {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read delivery")
+	}
+
+	var delivery Delivery
+	if err := json.Unmarshal(data, &delivery); err != nil {
+		return nil, errors.Wrap(err, "failed to decode delivery")
+	}
+	return &delivery, nil
+}
+
+// UpdateDelivery applies update to a stored delivery and saves it. An error
+// from update leaves the delivery unchanged and is returned as is.
+func (s *Store) UpdateDelivery(id string, update func(*Delivery) error) (*Delivery, error) {
+	s.deliveryMu.Lock()
+	defer s.deliveryMu.Unlock()
+
+	delivery, err := s.GetDelivery(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := update(delivery); err != nil {
+		return delivery, err
+	}
+	if err := s.PutDelivery(delivery); err != nil {
+		return nil, err
+	}
+	return delivery, nil
+}
+
+// ListDeliveries returns every delivery accepted by match, or all
+// deliveries when match is nil
+func (s *Store) ListDeliveries(match func(*Delivery) bool) ([]*Delivery, error) {
+	iter := s.db.NewIterator(util.BytesPrefix([]byte(deliveryPrefix)), nil)
+	defer iter.Release()
+
+	deliveries := []*Delivery{}
+	for iter.Next() {
+		var delivery Delivery
+		if err := json.Unmarshal(iter.Value(), &delivery); err != nil {
+			return nil, errors.Wrap(err, "failed to decode delivery")
+		}
+		if match == nil || match(&delivery) {
+			deliveries = append(deliveries, &delivery)
+		}
+	}
+	return deliveries, errors.Wrap(iter.Error(), "failed to iterate deliveries")
+}
+
+// DeleteDelivery removes a delivery
+func (s *Store) DeleteDelivery(id string) error {
+	return errors.Wrap(s.db.Delete([]byte(deliveryPrefix+id), nil), "failed to delete delivery")
+}
+
+// PublishWebhookEvent records that an event was dispatched together with
+// its deliveries, in one write so a failure leaves neither. It reports
+// false, writing nothing, if the event already had been.
+func (s *Store) PublishWebhookEvent(id string, deliveries []*Delivery) (bool, error) {
+	s.deliveryMu.Lock()
+	defer s.deliveryMu.Unlock()
+
+	key := []byte(webhookEventPrefix + id)
+	seen, err := s.db.Has(key, nil)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to read webhook event")
+	}
+	if seen {
+		return false, nil
+	}
+
+	batch := new(leveldb.Batch)
+	for _, delivery := range deliveries {
+		data, err := json.Marshal(delivery)
+		if err != nil {
+			return false, errors.Wrap(err, "failed to encode delivery")
+		}
+		batch.Put([]byte(deliveryPrefix+delivery.ID), data)
+	}
+	batch.Put(key, []byte(strconv.FormatInt(time.Now().Unix(), 10)))
+	return true, errors.Wrap(s.db.Write(batch, nil), "failed to write webhook event")
+}
+
+// PruneWebhookEvents forgets events dispatched before cutoff, returning how
+// many were removed
+func (s *Store) PruneWebhookEvents(cutoff time.Time) (int, error) {
+	iter := s.db.NewIterator(util.BytesPrefix([]byte(webhookEventPrefix)), nil)
+	defer iter.Release()
+
+	batch := new(leveldb.Batch)
+	for iter.Next() {
+		at, err := strconv.ParseInt(string(iter.Value()), 10, 64)
+		if err != nil || time.Unix(at, 0).Before(cutoff) {
+			batch.Delete(append([]byte(nil), iter.Key()...))
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return 0, errors.Wrap(err, "failed to iterate webhook events")
+	}
+	return batch.Len(), errors.Wrap(s.db.Write(batch, nil), "failed to prune webhook events")
+}
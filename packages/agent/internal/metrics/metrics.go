@@ -0,0 +1,240 @@
+package metrics
+
+import (
+	"math/big"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "nebularvault"
+
+// Registry holds the agent's metrics alongside the Go runtime and process
+// collectors
+var Registry = prometheus.NewRegistry()
+
+var (
+	requestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of API requests by route, method and status.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"route", "method", "status"})
+
+	uploadedBytes = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "uploaded_bytes_total",
+		Help:      "Bytes of files uploaded through the agent.",
+	})
+	downloadedBytes = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "downloaded_bytes_total",
+		Help:      "Bytes of files downloaded through the agent.",
+	})
+
+	chunkedBytes = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "chunking",
+		Name:      "bytes_total",
+		Help:      "Bytes split into chunks. Divide its rate by that of chunking_duration_seconds_sum for throughput.",
+	})
+	chunks = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "chunking",
+		Name:      "chunks_total",
+		Help:      "Chunks produced.",
+	})
+	chunkingDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "chunking",
+		Name:      "duration_seconds",
+		Help:      "Time spent chunking and hashing a file.",
+		Buckets:   prometheus.ExponentialBuckets(0.001, 4, 8),
+	})
+
+	zeroGDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "zerog",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of 0G Storage calls by operation and endpoint.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"operation", "endpoint"})
+	zeroGErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "zerog",
+		Name:      "errors_total",
+		Help:      "Failed 0G Storage calls by operation and endpoint.",
+	}, []string{"operation", "endpoint"})
+
+	transactions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "chain",
+		Name:      "transactions_total",
+		Help:      "Contract transactions by method and outcome: sent, replaced, confirmed, reverted or dropped.",
+	}, []string{"method", "status"})
+	gasUsed = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "chain",
+		Name:      "gas_used_total",
+		Help:      "Gas used by mined contract transactions, including reverted ones.",
+	}, []string{"method"})
+	gasSpent = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "chain",
+		Name:      "gas_spent_wei_total",
+		Help:      "Fees paid for mined contract transactions, in wei.",
+	}, []string{"method"})
+	pendingTransactions = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "chain",
+		Name:      "pending_transactions",
+		Help:      "Contract transactions awaiting confirmation.",
+	})
+
+	jobAttempts = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "jobs",
+		Name:      "attempt_duration_seconds",
+		Help:      "Duration of background job attempts by type and outcome: succeeded, retrying or failed.",
+		Buckets:   prometheus.ExponentialBuckets(0.01, 4, 9),
+	}, []string{"type", "outcome"})
+
+	duplicateBytes = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "dedup",
+		Name:      "duplicate_bytes_total",
+		Help:      "Bytes of uploads whose content the agent already held.",
+	})
+)
+
+// dedup keeps the totals behind the dedup ratio gauge
+var dedup struct {
+	sync.Mutex
+	uploaded  float64
+	duplicate float64
+}
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		requestDuration,
+		uploadedBytes,
+		downloadedBytes,
+		chunkedBytes,
+		chunks,
+		chunkingDuration,
+		zeroGDuration,
+		zeroGErrors,
+		transactions,
+		gasUsed,
+		gasSpent,
+		pendingTransactions,
+		jobAttempts,
+		duplicateBytes,
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "dedup",
+			Name:      "ratio",
+			Help:      "Fraction of uploaded bytes whose content the agent already held, since start.",
+		}, dedupRatio),
+	)
+}
+
+// Handler serves the registry in the Prometheus exposition format
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
+
+// Middleware times each request under its route pattern, so paths with
+// hashes or addresses in them do not each become a series. Requests that
+// match no route are counted together.
+func Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+		requestDuration.WithLabelValues(route, c.Request.Method, strconv.Itoa(c.Writer.Status())).
+			Observe(time.Since(start).Seconds())
+	}
+}
+
+// ObserveUpload counts a stored upload; duplicate says the agent already
+// held its content
+func ObserveUpload(size int64, duplicate bool) {
+	uploadedBytes.Add(float64(size))
+
+	dedup.Lock()
+	defer dedup.Unlock()
+	dedup.uploaded += float64(size)
+	if duplicate {
+		duplicateBytes.Add(float64(size))
+		dedup.duplicate += float64(size)
+	}
+}
+
+// ObserveDownload counts bytes served from 0G Storage
+func ObserveDownload(size int) {
+	downloadedBytes.Add(float64(size))
+}
+
+// ObserveChunking records a file split into count chunks
+func ObserveChunking(size int64, count int, elapsed time.Duration) {
+	chunkedBytes.Add(float64(size))
+	chunks.Add(float64(count))
+	chunkingDuration.Observe(elapsed.Seconds())
+}
+
+// ObserveZeroG records a 0G Storage call that started at start
+func ObserveZeroG(operation, endpoint string, start time.Time, err error) {
+	zeroGDuration.WithLabelValues(operation, endpoint).Observe(time.Since(start).Seconds())
+	if err != nil {
+		zeroGErrors.WithLabelValues(operation, endpoint).Inc()
+	}
+}
+
+// ObserveTransaction counts a transaction reaching status
+func ObserveTransaction(method, status string) {
+	transactions.WithLabelValues(method, status).Inc()
+}
+
+// ObserveGas records the gas a mined transaction used and, when the price
+// is known, the fee it paid
+func ObserveGas(method string, used uint64, price *big.Int) {
+	gasUsed.WithLabelValues(method).Add(float64(used))
+	if price != nil {
+		fee, _ := new(big.Float).SetInt(new(big.Int).Mul(price, new(big.Int).SetUint64(used))).Float64()
+		gasSpent.WithLabelValues(method).Add(fee)
+	}
+}
+
+// SetPendingTransactions reports how many transactions await confirmation
+func SetPendingTransactions(count int) {
+	pendingTransactions.Set(float64(count))
+}
+
+// ObserveJob records a job attempt that started at start
+func ObserveJob(jobType, outcome string, start time.Time) {
+	jobAttempts.WithLabelValues(jobType, outcome).Observe(time.Since(start).Seconds())
+}
+
+func dedupRatio() float64 {
+	dedup.Lock()
+	defer dedup.Unlock()
+
+	if dedup.uploaded == 0 {
+		return 0
+	}
+	return dedup.duplicate / dedup.uploaded
+}
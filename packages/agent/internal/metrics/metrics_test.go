@@ -0,0 +1,73 @@
+package metrics
+
+import (
+	"errors"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func scrape(t *testing.T) string {
+	t.Helper()
+
+	w := httptest.NewRecorder()
+	Handler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected 200 from the metrics handler, got %d", w.Code)
+	}
+	body, _ := io.ReadAll(w.Body)
+	return string(body)
+}
+
+func expectLines(t *testing.T, body string, lines ...string) {
+	t.Helper()
+
+	for _, line := range lines {
+		if !strings.Contains(body, line+"\n") {
+			t.Errorf("Expected metrics to contain %q", line)
+		}
+	}
+}
+
+func TestMetrics_Exposition(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(Middleware())
+	router.GET("/files/:hash", func(c *gin.Context) { c.Status(http.StatusNotFound) })
+	for _, path := range []string{"/files/aa", "/files/bb", "/nowhere"} {
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	ObserveUpload(300, false)
+	ObserveUpload(100, true)
+	ObserveDownload(50)
+	ObserveChunking(400, 4, time.Millisecond)
+	ObserveZeroG("upload", "https://transfer.0g.ai", time.Now(), nil)
+	ObserveZeroG("upload", "https://transfer.0g.ai", time.Now(), errors.New("unreachable"))
+	ObserveTransaction("anchorFile", "confirmed")
+	ObserveGas("anchorFile", 21000, big.NewInt(2))
+	SetPendingTransactions(3)
+
+	expectLines(t, scrape(t),
+		`nebularvault_http_request_duration_seconds_count{method="GET",route="/files/:hash",status="404"} 2`,
+		`nebularvault_http_request_duration_seconds_count{method="GET",route="unmatched",status="404"} 1`,
+		`nebularvault_uploaded_bytes_total 400`,
+		`nebularvault_downloaded_bytes_total 50`,
+		`nebularvault_chunking_bytes_total 400`,
+		`nebularvault_chunking_chunks_total 4`,
+		`nebularvault_zerog_request_duration_seconds_count{endpoint="https://transfer.0g.ai",operation="upload"} 2`,
+		`nebularvault_zerog_errors_total{endpoint="https://transfer.0g.ai",operation="upload"} 1`,
+		`nebularvault_chain_transactions_total{method="anchorFile",status="confirmed"} 1`,
+		`nebularvault_chain_gas_used_total{method="anchorFile"} 21000`,
+		`nebularvault_chain_gas_spent_wei_total{method="anchorFile"} 42000`,
+		`nebularvault_chain_pending_transactions 3`,
+		`nebularvault_dedup_duplicate_bytes_total 100`,
+		`nebularvault_dedup_ratio 0.25`,
+	)
+}
@@ -0,0 +1,159 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"nebularvault-agent/internal/apierror"
+	"nebularvault-agent/internal/apikey"
+	"nebularvault-agent/internal/auth"
+)
+
+// Context keys set by Authenticate
+const (
+	userAddressKey = "user_address"
+	apiKeyKey      = "api_key"
+	scopesKey      = "scopes"
+)
+
+// sessionScopes are granted to wallet sessions; admin routes need an API key
+var sessionScopes = []string{apikey.ScopeFilesRead, apikey.ScopeFilesWrite}
+
+// Errors from Identify
+var (
+	ErrNoCredentials  = errors.New("authentication required")
+	ErrInvalidAPIKey  = errors.New("invalid or expired API key")
+	ErrInvalidSession = errors.New("invalid or expired session")
+)
+
+// unauthorizedMessages are the REST responses to the errors from Identify
+var unauthorizedMessages = map[error]string{
+	ErrNoCredentials:  "Authentication required",
+	ErrInvalidAPIKey:  "Invalid or expired API key",
+	ErrInvalidSession: "Invalid or expired session",
+}
+
+// Caller is an authenticated API caller: a signed-in wallet or an API key
+type Caller struct {
+	// Address is the signed-in wallet, empty for API keys
+	Address string
+	Key     *apikey.Key
+	Scopes  []string
+}
+
+// HasScope reports whether the caller was granted scope, admin granting
+// every scope
+func (c *Caller) HasScope(scope string) bool {
+	return hasScope(c.Scopes, scope)
+}
+
+// Identify resolves a presented credential, either an API key or a wallet
+// session token, to its caller. It backs both the REST and gRPC APIs.
+func Identify(authenticator *auth.Authenticator, keys *apikey.Store, presented string) (*Caller, error) {
+	if presented == "" {
+		return nil, ErrNoCredentials
+	}
+
+	if keys != nil && strings.HasPrefix(presented, apikey.Prefix) {
+		key, err := keys.Authenticate(presented)
+		if err != nil {
+			return nil, ErrInvalidAPIKey
+		}
+		return &Caller{Key: key, Scopes: key.Scopes}, nil
+	}
+
+	session, err := authenticator.VerifySession(presented)
+	if err != nil {
+		return nil, ErrInvalidSession
+	}
+	return &Caller{Address: session.Address, Scopes: sessionScopes}, nil
+}
+
+// Authenticate accepts either a wallet session token as
+// "Authorization: Bearer <token>" or an API key as "X-API-Key: <key>" (or
+// as a bearer token), and records the caller and its scopes in the context
+func Authenticate(authenticator *auth.Authenticator, keys *apikey.Store) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		presented := c.GetHeader("X-API-Key")
+		if presented == "" {
+			presented, _ = bearerToken(c.GetHeader("Authorization"))
+		}
+
+		caller, err := Identify(authenticator, keys, presented)
+		if err != nil {
+			unauthorized(c, unauthorizedMessages[err])
+			return
+		}
+		if caller.Key != nil {
+			c.Set(apiKeyKey, caller.Key)
+		} else {
+			c.Set(userAddressKey, caller.Address)
+		}
+		c.Set(scopesKey, caller.Scopes)
+		c.Next()
+	}
+}
+
+// RequireScope rejects authenticated callers without the scope a route
+// group needs: read for GET and HEAD requests, write for everything else.
+// Callers that were not authenticated pass, as when auth is disabled.
+func RequireScope(read, write string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		value, authenticated := c.Get(scopesKey)
+		if !authenticated {
+			c.Next()
+			return
+		}
+
+		scope := write
+		if c.Request.Method == http.MethodGet || c.Request.Method == http.MethodHead {
+			scope = read
+		}
+		if hasScope(value.([]string), scope) {
+			c.Next()
+			return
+		}
+
+		Problem(c, apierror.New(apierror.Forbidden, "Missing scope "+scope))
+	}
+}
+
+// UserAddress returns the checksummed address of the signed-in wallet
+func UserAddress(c *gin.Context) (string, bool) {
+	address := c.GetString(userAddressKey)
+	return address, address != ""
+}
+
+// APIKey returns the API key the caller authenticated with
+func APIKey(c *gin.Context) (*apikey.Key, bool) {
+	value, ok := c.Get(apiKeyKey)
+	if !ok {
+		return nil, false
+	}
+	return value.(*apikey.Key), true
+}
+
+func unauthorized(c *gin.Context, message string) {
+	Problem(c, apierror.New(apierror.Unauthorized, message))
+}
+
+func hasScope(granted []string, scope string) bool {
+	for _, g := range granted {
+		if g == scope || g == apikey.ScopeAdmin {
+			return true
+		}
+	}
+	return false
+}
+
+// bearerToken reads the token from an "Authorization: Bearer <token>" value
+func bearerToken(header string) (string, bool) {
+	if len(header) < 7 || !strings.EqualFold(header[:7], "Bearer ") {
+		return "", false
+	}
+	token := strings.TrimSpace(header[7:])
+	return token, token != ""
+}
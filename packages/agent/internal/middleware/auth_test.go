@@ -0,0 +1,113 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/gin-gonic/gin"
+
+	"nebularvault-agent/internal/apikey"
+	"nebularvault-agent/internal/auth"
+)
+
+func TestAuthenticate_Scopes(t *testing.T) {
+	authenticator, err := auth.NewAuthenticator(auth.Config{Domain: "vault.example", ChainID: 1})
+	if err != nil {
+		t.Fatalf("Failed to create authenticator: %v", err)
+	}
+	keys, err := apikey.Open(filepath.Join(t.TempDir(), "api-keys.json"))
+	if err != nil {
+		t.Fatalf("Failed to open key store: %v", err)
+	}
+
+	_, reader, err := keys.Create("reader", []string{apikey.ScopeFilesRead}, 0)
+	if err != nil {
+		t.Fatalf("Failed to create key: %v", err)
+	}
+	_, admin, err := keys.Create("admin", []string{apikey.ScopeAdmin}, 0)
+	if err != nil {
+		t.Fatalf("Failed to create key: %v", err)
+	}
+
+	session := signIn(t, authenticator)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	api := router.Group("", Authenticate(authenticator, keys))
+	files := api.Group("/files", RequireScope(apikey.ScopeFilesRead, apikey.ScopeFilesWrite))
+	files.GET("", func(c *gin.Context) { c.Status(http.StatusOK) })
+	files.POST("", func(c *gin.Context) { c.Status(http.StatusOK) })
+	users := api.Group("/users", RequireScope(apikey.ScopeFilesRead, apikey.ScopeAdmin))
+	users.POST("", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	cases := []struct {
+		name   string
+		method string
+		path   string
+		header string
+		value  string
+		status int
+	}{
+		{"no credentials", http.MethodGet, "/files", "", "", http.StatusUnauthorized},
+		{"bad key", http.MethodGet, "/files", "X-API-Key", reader + "x", http.StatusUnauthorized},
+		{"bad session", http.MethodGet, "/files", "Authorization", "Bearer nope", http.StatusUnauthorized},
+		{"reader reads", http.MethodGet, "/files", "X-API-Key", reader, http.StatusOK},
+		{"reader writes", http.MethodPost, "/files", "X-API-Key", reader, http.StatusForbidden},
+		{"key as bearer", http.MethodGet, "/files", "Authorization", "Bearer " + reader, http.StatusOK},
+		{"admin writes", http.MethodPost, "/files", "X-API-Key", admin, http.StatusOK},
+		{"admin registers", http.MethodPost, "/users", "X-API-Key", admin, http.StatusOK},
+		{"session writes", http.MethodPost, "/files", "Authorization", "Bearer " + session, http.StatusOK},
+		{"session registers", http.MethodPost, "/users", "Authorization", "Bearer " + session, http.StatusForbidden},
+	}
+	for _, tc := range cases {
+		req := httptest.NewRequest(tc.method, tc.path, nil)
+		if tc.header != "" {
+			req.Header.Set(tc.header, tc.value)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		if w.Code != tc.status {
+			t.Errorf("%s: expected %d, got %d", tc.name, tc.status, w.Code)
+		}
+	}
+}
+
+// signIn obtains a session token through a real SIWE sign-in
+func signIn(t *testing.T, authenticator *auth.Authenticator) string {
+	t.Helper()
+
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	nonce, err := authenticator.Nonce()
+	if err != nil {
+		t.Fatalf("Failed to issue nonce: %v", err)
+	}
+
+	text := (&auth.Message{
+		Domain:   "vault.example",
+		Address:  crypto.PubkeyToAddress(key.PublicKey),
+		URI:      "https://vault.example",
+		Version:  "1",
+		ChainID:  1,
+		Nonce:    nonce,
+		IssuedAt: time.Now(),
+	}).String()
+	sig, err := crypto.Sign(accounts.TextHash([]byte(text)), key)
+	if err != nil {
+		t.Fatalf("Failed to sign message: %v", err)
+	}
+
+	session, err := authenticator.SignIn(text, hexutil.Encode(sig))
+	if err != nil {
+		t.Fatalf("Failed to sign in: %v", err)
+	}
+	return session.Token
+}
@@ -0,0 +1,155 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"nebularvault-agent/internal/apierror"
+)
+
+// CORSPolicy says which browser origins may call the API and how.
+// AllowedOrigins entries are exact origins such as https://vault.example.com,
+// patterns such as https://*.example.com matching any subdomain, or "*" for
+// every origin. "*" in AllowedHeaders allows whatever headers are requested.
+type CORSPolicy struct {
+	AllowedOrigins   []string
+	AllowedMethods   []string
+	AllowedHeaders   []string
+	ExposedHeaders   []string
+	MaxAge           time.Duration
+	AllowCredentials bool
+}
+
+// CORS applies policy to cross-origin requests. Requests from origins it
+// does not allow get no CORS headers, so browsers withhold the response,
+// and their preflights are refused. Responses vary by Origin unless every
+// origin is allowed without credentials.
+func CORS(policy CORSPolicy) gin.HandlerFunc {
+	anyOrigin := contains(policy.AllowedOrigins, "*")
+	anyHeader := contains(policy.AllowedHeaders, "*")
+	methods := strings.Join(policy.AllowedMethods, ", ")
+	headers := strings.Join(policy.AllowedHeaders, ", ")
+	exposed := strings.Join(policy.ExposedHeaders, ", ")
+	maxAge := strconv.Itoa(int(policy.MaxAge / time.Second))
+	// Browsers reject a wildcard origin on credentialed requests, so the
+	// origin is echoed back instead
+	echoOrigin := !anyOrigin || policy.AllowCredentials
+
+	return func(c *gin.Context) {
+		origin := c.GetHeader("Origin")
+		preflight := c.Request.Method == http.MethodOptions && c.GetHeader("Access-Control-Request-Method") != ""
+		header := c.Writer.Header()
+		if echoOrigin {
+			header.Add("Vary", "Origin")
+		}
+		if preflight {
+			header.Add("Vary", "Access-Control-Request-Method")
+			header.Add("Vary", "Access-Control-Request-Headers")
+		}
+
+		if origin == "" {
+			c.Next()
+			return
+		}
+		if !anyOrigin && !originAllowed(origin, policy.AllowedOrigins) {
+			if preflight {
+				corsRejected(c, "Origin not allowed")
+				return
+			}
+			c.Next()
+			return
+		}
+
+		if echoOrigin {
+			header.Set("Access-Control-Allow-Origin", origin)
+		} else {
+			header.Set("Access-Control-Allow-Origin", "*")
+		}
+		if policy.AllowCredentials {
+			header.Set("Access-Control-Allow-Credentials", "true")
+		}
+
+		if !preflight {
+			if exposed != "" {
+				header.Set("Access-Control-Expose-Headers", exposed)
+			}
+			c.Next()
+			return
+		}
+
+		if !containsFold(policy.AllowedMethods, c.GetHeader("Access-Control-Request-Method")) {
+			corsRejected(c, "Method not allowed")
+			return
+		}
+		requested := c.GetHeader("Access-Control-Request-Headers")
+		if anyHeader {
+			if requested != "" {
+				header.Set("Access-Control-Allow-Headers", requested)
+			}
+		} else {
+			for _, name := range strings.Split(requested, ",") {
+				if name = strings.TrimSpace(name); name != "" && !containsFold(policy.AllowedHeaders, name) {
+					corsRejected(c, "Header not allowed: "+name)
+					return
+				}
+			}
+			if headers != "" {
+				header.Set("Access-Control-Allow-Headers", headers)
+			}
+		}
+		header.Set("Access-Control-Allow-Methods", methods)
+		if policy.MaxAge > 0 {
+			header.Set("Access-Control-Max-Age", maxAge)
+		}
+		c.AbortWithStatus(http.StatusNoContent)
+	}
+}
+
+func corsRejected(c *gin.Context, message string) {
+	Problem(c, apierror.New(apierror.Forbidden, "CORS preflight rejected: "+message))
+}
+
+// originAllowed matches an origin against exact origins and patterns with
+// a single "*" standing for one or more subdomain labels
+func originAllowed(origin string, allowed []string) bool {
+	origin = strings.ToLower(origin)
+	for _, pattern := range allowed {
+		pattern = strings.ToLower(pattern)
+		prefix, suffix, wildcard := strings.Cut(pattern, "*")
+		if !wildcard {
+			if pattern == origin {
+				return true
+			}
+			continue
+		}
+		if len(origin) <= len(prefix)+len(suffix) || !strings.HasPrefix(origin, prefix) || !strings.HasSuffix(origin, suffix) {
+			continue
+		}
+		if label := origin[len(prefix) : len(origin)-len(suffix)]; !strings.ContainsAny(label, "/:@") {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,149 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func corsRouter(policy CORSPolicy) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(CORS(policy))
+	router.GET("/files", func(c *gin.Context) { c.Status(http.StatusOK) })
+	return router
+}
+
+func corsRequest(router *gin.Engine, method, origin string, headers map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, "/files", nil)
+	if origin != "" {
+		req.Header.Set("Origin", origin)
+	}
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestCORS_Origins(t *testing.T) {
+	router := corsRouter(CORSPolicy{
+		AllowedOrigins:   []string{"https://vault.example.com", "https://*.nebular.dev"},
+		AllowedMethods:   []string{"GET", "POST"},
+		AllowedHeaders:   []string{"Authorization", "Content-Type"},
+		ExposedHeaders:   []string{"RateLimit-Remaining"},
+		MaxAge:           10 * time.Minute,
+		AllowCredentials: true,
+	})
+
+	cases := []struct {
+		origin  string
+		allowed bool
+	}{
+		{"https://vault.example.com", true},
+		{"https://app.nebular.dev", true},
+		{"https://eu.app.nebular.dev", true},
+		{"https://nebular.dev", false},
+		{"http://app.nebular.dev", false},
+		{"https://evil.com/.nebular.dev", false},
+		{"https://vault.example.com.evil.com", false},
+	}
+	for _, tc := range cases {
+		w := corsRequest(router, http.MethodGet, tc.origin, nil)
+		if w.Code != http.StatusOK {
+			t.Errorf("%s: expected the request to be served, got %d", tc.origin, w.Code)
+		}
+		got := w.Header().Get("Access-Control-Allow-Origin")
+		if tc.allowed && (got != tc.origin || w.Header().Get("Access-Control-Allow-Credentials") != "true") {
+			t.Errorf("%s: expected the origin to be echoed with credentials, got %v", tc.origin, w.Header())
+		}
+		if !tc.allowed && got != "" {
+			t.Errorf("%s: expected no CORS headers, got %s", tc.origin, got)
+		}
+		if w.Header().Get("Vary") != "Origin" {
+			t.Errorf("%s: expected Vary: Origin, got %v", tc.origin, w.Header().Values("Vary"))
+		}
+	}
+
+	if exposed := corsRequest(router, http.MethodGet, "https://vault.example.com", nil).Header().Get("Access-Control-Expose-Headers"); exposed != "RateLimit-Remaining" {
+		t.Errorf("Expected exposed headers, got %q", exposed)
+	}
+	if w := corsRequest(router, http.MethodGet, "", nil); w.Header().Get("Access-Control-Allow-Origin") != "" {
+		t.Errorf("Expected same-origin requests to get no CORS headers, got %v", w.Header())
+	}
+}
+
+func TestCORS_Preflight(t *testing.T) {
+	router := corsRouter(CORSPolicy{
+		AllowedOrigins: []string{"https://vault.example.com"},
+		AllowedMethods: []string{"GET", "POST"},
+		AllowedHeaders: []string{"Authorization", "Content-Type"},
+		MaxAge:         10 * time.Minute,
+	})
+	preflight := func(origin, method, headers string) *httptest.ResponseRecorder {
+		return corsRequest(router, http.MethodOptions, origin, map[string]string{
+			"Access-Control-Request-Method":  method,
+			"Access-Control-Request-Headers": headers,
+		})
+	}
+
+	w := preflight("https://vault.example.com", "POST", "authorization, content-type")
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("Expected 204, got %d", w.Code)
+	}
+	expected := map[string]string{
+		"Access-Control-Allow-Origin":  "https://vault.example.com",
+		"Access-Control-Allow-Methods": "GET, POST",
+		"Access-Control-Allow-Headers": "Authorization, Content-Type",
+		"Access-Control-Max-Age":       "600",
+	}
+	for name, value := range expected {
+		if got := w.Header().Get(name); got != value {
+			t.Errorf("Expected %s %q, got %q", name, value, got)
+		}
+	}
+	if got := w.Header().Get("Access-Control-Allow-Credentials"); got != "" {
+		t.Errorf("Expected no credentials header, got %q", got)
+	}
+	if vary := w.Header().Values("Vary"); len(vary) != 3 {
+		t.Errorf("Expected preflights to vary by origin and requested method and headers, got %v", vary)
+	}
+
+	for _, w := range []*httptest.ResponseRecorder{
+		preflight("https://other.example.com", "POST", ""),
+		preflight("https://vault.example.com", "DELETE", ""),
+		preflight("https://vault.example.com", "POST", "X-Custom"),
+	} {
+		if w.Code != http.StatusForbidden || w.Header().Get("Access-Control-Allow-Methods") != "" {
+			t.Errorf("Expected the preflight to be refused, got %d %v", w.Code, w.Header())
+		}
+	}
+}
+
+func TestCORS_AnyOrigin(t *testing.T) {
+	router := corsRouter(CORSPolicy{
+		AllowedOrigins: []string{"*"},
+		AllowedMethods: []string{"GET"},
+		AllowedHeaders: []string{"*"},
+	})
+
+	w := corsRequest(router, http.MethodGet, "https://anywhere.example", nil)
+	if got := w.Header().Get("Access-Control-Allow-Origin"); got != "*" {
+		t.Errorf("Expected a wildcard origin, got %q", got)
+	}
+	if vary := w.Header().Get("Vary"); vary != "" {
+		t.Errorf("Expected no Vary for a wildcard origin, got %q", vary)
+	}
+
+	w = corsRequest(router, http.MethodOptions, "https://anywhere.example", map[string]string{
+		"Access-Control-Request-Method":  "GET",
+		"Access-Control-Request-Headers": "x-trace-id",
+	})
+	if w.Code != http.StatusNoContent || w.Header().Get("Access-Control-Allow-Headers") != "x-trace-id" {
+		t.Errorf("Expected requested headers to be allowed, got %d %v", w.Code, w.Header())
+	}
+}
@@ -0,0 +1,133 @@
+package middleware
+
+import (
+	"context"
+	"runtime/debug"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"nebularvault-agent/internal/apikey"
+	"nebularvault-agent/internal/auth"
+	"nebularvault-agent/internal/logging"
+)
+
+type callerKey struct{}
+
+// CallerFromContext returns the caller a gRPC request was authenticated as.
+// It reports false when auth is disabled.
+func CallerFromContext(ctx context.Context) (*Caller, bool) {
+	caller, ok := ctx.Value(callerKey{}).(*Caller)
+	return caller, ok
+}
+
+// GRPCAuthenticate returns interceptors accepting the same credentials as
+// Authenticate, from "authorization: Bearer <token>" or "x-api-key"
+// metadata. scopes names the scope each method needs; methods missing from
+// it are refused.
+func GRPCAuthenticate(authenticator *auth.Authenticator, keys *apikey.Store, scopes map[string]string) (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+	authenticate := func(ctx context.Context, method string) (context.Context, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		presented := firstValue(md, "x-api-key")
+		if presented == "" {
+			presented, _ = bearerToken(firstValue(md, "authorization"))
+		}
+
+		caller, err := Identify(authenticator, keys, presented)
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, unauthorizedMessages[err])
+		}
+		scope, ok := scopes[method]
+		if !ok || !caller.HasScope(scope) {
+			return nil, status.Error(codes.PermissionDenied, "Missing scope "+scope)
+		}
+		return context.WithValue(ctx, callerKey{}, caller), nil
+	}
+
+	unary := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := authenticate(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+	stream := func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticate(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+	}
+	return unary, stream
+}
+
+// GRPCLogger returns interceptors that do for gRPC what RequestID, Logger
+// and Recovery do for REST: tag each call with an ID from "x-request-id"
+// metadata or a new one, log one line per call, and turn panics into
+// Internal errors
+func GRPCLogger(logger *logrus.Logger) (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+	begin := func(ctx context.Context) context.Context {
+		md, _ := metadata.FromIncomingContext(ctx)
+		id := firstValue(md, "x-request-id")
+		if !requestIDPattern.MatchString(id) {
+			id = uuid.New().String()
+		}
+		grpc.SetHeader(ctx, metadata.Pairs("x-request-id", id))
+		return logging.WithRequestID(ctx, id)
+	}
+	finish := func(ctx context.Context, method string, start time.Time, err *error) {
+		if recovered := recover(); recovered != nil {
+			logging.Entry(ctx, logger).Errorf("Panic recovered: %v\n%s", recovered, debug.Stack())
+			*err = status.Error(codes.Internal, "Internal server error")
+		}
+
+		code := status.Code(*err)
+		entry := logging.Entry(ctx, logger).WithFields(logrus.Fields{
+			"method":     method,
+			"code":       code.String(),
+			"latency_ms": float64(time.Since(start).Microseconds()) / 1000,
+		})
+		switch code {
+		case codes.OK:
+			entry.Info("Call served")
+		case codes.Internal, codes.Unknown, codes.DataLoss, codes.Unavailable:
+			entry.WithError(*err).Error("Call failed")
+		default:
+			entry.WithError(*err).Warn("Call rejected")
+		}
+	}
+
+	unary := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		ctx = begin(ctx)
+		defer finish(ctx, info.FullMethod, time.Now(), &err)
+		return handler(ctx, req)
+	}
+	stream := func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		ctx := begin(ss.Context())
+		defer finish(ctx, info.FullMethod, time.Now(), &err)
+		return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+	}
+	return unary, stream
+}
+
+// contextStream replaces a server stream's context
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
+
+func firstValue(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
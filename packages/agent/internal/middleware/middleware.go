@@ -1,86 +1,94 @@
 package middleware
 
 import (
-	"fmt"
+	"net"
 	"net/http"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
-	"golang.org/x/time/rate"
+
+	"nebularvault-agent/internal/apierror"
+	"nebularvault-agent/internal/logging"
 )
 
-func Logger() gin.HandlerFunc {
-	return gin.LoggerWithFormatter(func(param gin.LogFormatterParams) string {
-		return fmt.Sprintf("%s - [%s] \"%s %s %s %d %s \"%s\" %s\"\n",
-			param.ClientIP,
-			param.TimeStamp.Format(time.RFC1123),
-			param.Method,
-			param.Path,
-			param.Request.Proto,
-			param.StatusCode,
-			param.Latency,
-			param.Request.UserAgent(),
-			param.ErrorMessage,
-		)
-	})
-}
+// RequestIDHeader carries the ID correlating a request's log lines
+const RequestIDHeader = "X-Request-ID"
 
-func Recovery() gin.HandlerFunc {
-	return gin.CustomRecovery(func(c *gin.Context, recovered interface{}) {
-		if err, ok := recovered.(string); ok {
-			logrus.Errorf("Panic recovered: %s", err)
-			c.JSON(http.StatusInternalServerError, gin.H{
-				"success": false,
-				"error":   "Internal server error",
-			})
-		}
-		c.Abort()
-	})
-}
+var requestIDPattern = regexp.MustCompile(`^[A-Za-z0-9._-]{1,64}$`)
 
-func CORS() gin.HandlerFunc {
+// RequestID tags each request with an ID, kept from the caller when it is
+// well-formed, which is echoed in the response and carried in the request
+// context for logging.Entry
+func RequestID() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		c.Header("Access-Control-Allow-Origin", "*")
-		c.Header("Access-Control-Allow-Credentials", "true")
-		c.Header("Access-Control-Allow-Headers", "Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, accept, origin, Cache-Control, X-Requested-With")
-		c.Header("Access-Control-Allow-Methods", "POST, OPTIONS, GET, PUT, DELETE")
-
-		if c.Request.Method == "OPTIONS" {
-			c.AbortWithStatus(204)
-			return
+		id := c.GetHeader(RequestIDHeader)
+		if !requestIDPattern.MatchString(id) {
+			id = uuid.New().String()
 		}
 
+		c.Header(RequestIDHeader, id)
+		c.Request = c.Request.WithContext(logging.WithRequestID(c.Request.Context(), id))
 		c.Next()
 	}
 }
 
-func RateLimit(requestsPerMinute int, window time.Duration) gin.HandlerFunc {
-	limiter := rate.NewLimiter(rate.Every(window/time.Duration(requestsPerMinute)), requestsPerMinute)
-
+// Logger logs one structured line per request through logger. The query
+// string is left out since it may carry credentials.
+func Logger(logger *logrus.Logger) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		if !limiter.Allow() {
-			c.JSON(http.StatusTooManyRequests, gin.H{
-				"success": false,
-				"error":   "Rate limit exceeded",
-			})
-			c.Abort()
-			return
-		}
+		start := time.Now()
 		c.Next()
+
+		status := c.Writer.Status()
+		entry := logging.Entry(c.Request.Context(), logger).WithFields(logrus.Fields{
+			"method":     c.Request.Method,
+			"path":       c.Request.URL.Path,
+			"route":      c.FullPath(),
+			"status":     status,
+			"latency_ms": float64(time.Since(start).Microseconds()) / 1000,
+			"client_ip":  c.ClientIP(),
+			"user_agent": c.Request.UserAgent(),
+			"bytes_in":   c.Request.ContentLength,
+			"bytes_out":  c.Writer.Size(),
+		})
+		if len(c.Errors) > 0 {
+			entry = entry.WithField("errors", c.Errors.String())
+		}
+
+		switch {
+		case status >= http.StatusInternalServerError:
+			entry.Error("Request failed")
+		case status >= http.StatusBadRequest:
+			entry.Warn("Request rejected")
+		default:
+			entry.Info("Request served")
+		}
 	}
 }
 
+func Recovery() gin.HandlerFunc {
+	return gin.CustomRecovery(func(c *gin.Context, recovered interface{}) {
+		if err, ok := recovered.(string); ok {
+			logrus.Errorf("Panic recovered: %s", err)
+			Problem(c, apierror.New(apierror.Internal, "Internal server error"))
+		}
+		c.Abort()
+	})
+}
+
+// RequestSizeLimit rejects bodies over maxSize up front when they declare
+// their length, and cuts off reads past it when they do not
 func RequestSizeLimit(maxSize int64) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		if c.Request.ContentLength > maxSize {
-			c.JSON(http.StatusRequestEntityTooLarge, gin.H{
-				"success": false,
-				"error":   "Request entity too large",
-			})
-			c.Abort()
+			Problem(c, apierror.New(apierror.PayloadTooLarge, "Request entity too large"))
 			return
 		}
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxSize)
 		c.Next()
 	}
 }
@@ -94,3 +102,31 @@ func SecurityHeaders() gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+// AllowedHosts rejects requests whose Host header is not listed. Entries
+// starting with "*." match any subdomain; an empty list allows every host.
+func AllowedHosts(hosts []string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if len(hosts) == 0 || hostAllowed(c.Request.Host, hosts) {
+			c.Next()
+			return
+		}
+
+		Problem(c, apierror.New(apierror.MisdirectedRequest, "Host not allowed"))
+	}
+}
+
+func hostAllowed(host string, allowed []string) bool {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	host = strings.ToLower(strings.TrimSuffix(strings.Trim(host, "[]"), "."))
+
+	for _, pattern := range allowed {
+		pattern = strings.ToLower(pattern)
+		if pattern == host || (strings.HasPrefix(pattern, "*.") && strings.HasSuffix(host, pattern[1:])) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,103 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	"nebularvault-agent/internal/apierror"
+	"nebularvault-agent/internal/logging"
+)
+
+func TestLogger_CorrelatesRequests(t *testing.T) {
+	var out bytes.Buffer
+	logger := logrus.New()
+	logger.SetOutput(&out)
+	logger.SetFormatter(&logrus.JSONFormatter{})
+
+	var handlerID string
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(RequestID(), Logger(logger))
+	router.GET("/files/metadata/:hash", func(c *gin.Context) {
+		handlerID = logging.RequestID(c.Request.Context())
+		c.Status(http.StatusNotFound)
+	})
+
+	serve := func(id string) (*httptest.ResponseRecorder, map[string]interface{}) {
+		out.Reset()
+		req := httptest.NewRequest(http.MethodGet, "/files/metadata/abc?token=secret", nil)
+		if id != "" {
+			req.Header.Set(RequestIDHeader, id)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		var line map[string]interface{}
+		if err := json.Unmarshal(out.Bytes(), &line); err != nil {
+			t.Fatalf("Expected one JSON log line, got %q", out.String())
+		}
+		return w, line
+	}
+
+	w, line := serve("trace-me-42")
+	if w.Header().Get(RequestIDHeader) != "trace-me-42" || handlerID != "trace-me-42" || line["request_id"] != "trace-me-42" {
+		t.Errorf("Expected the caller's request ID throughout, got header %q, handler %q, log %v",
+			w.Header().Get(RequestIDHeader), handlerID, line["request_id"])
+	}
+	if line["route"] != "/files/metadata/:hash" || line["path"] != "/files/metadata/abc" || line["status"] != float64(404) || line["level"] != "warning" {
+		t.Errorf("Unexpected log line: %v", line)
+	}
+
+	// Malformed IDs are replaced rather than echoed into logs
+	w, line = serve("bad id\nforged=1")
+	if id := w.Header().Get(RequestIDHeader); len(id) != 36 || line["request_id"] != id {
+		t.Errorf("Expected a generated request ID, got header %q, log %v", id, line["request_id"])
+	}
+}
+
+func TestProblem(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	serve := func(debug bool) (*httptest.ResponseRecorder, apierror.Problem) {
+		router := gin.New()
+		router.Use(RequestID(), DebugErrors(debug))
+		router.GET("/files/:hash", func(c *gin.Context) {
+			err := apierror.Wrap(errors.New("open /data/abc: no such file or directory"), apierror.FileNotFound, "File does not exist")
+			ProblemWithData(c, fmt.Errorf("loading: %w", err), map[string]string{"hash": c.Param("hash")})
+		})
+
+		req := httptest.NewRequest(http.MethodGet, "/files/abc", nil)
+		req.Header.Set(RequestIDHeader, "trace-me-42")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		var problem apierror.Problem
+		if err := json.Unmarshal(w.Body.Bytes(), &problem); err != nil {
+			t.Fatalf("Failed to decode problem: %v", err)
+		}
+		return w, problem
+	}
+
+	w, problem := serve(false)
+	if w.Code != http.StatusNotFound || w.Header().Get("Content-Type") != apierror.ContentType {
+		t.Errorf("Expected a 404 problem document, got %d %q", w.Code, w.Header().Get("Content-Type"))
+	}
+	if problem.Code != apierror.FileNotFound || problem.Status != http.StatusNotFound || problem.Detail != "File does not exist" ||
+		problem.Instance != "/files/abc" || problem.RequestID != "trace-me-42" || problem.Data == nil {
+		t.Errorf("Unexpected problem: %+v", problem)
+	}
+	if problem.Debug != "" {
+		t.Errorf("Expected no debug detail by default, got %q", problem.Debug)
+	}
+
+	if _, problem := serve(true); problem.Debug != "open /data/abc: no such file or directory" {
+		t.Errorf("Expected the cause as debug detail, got %q", problem.Debug)
+	}
+}
@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"nebularvault-agent/internal/apierror"
+	"nebularvault-agent/internal/logging"
+)
+
+const debugErrorsKey = "debug_errors"
+
+// DebugErrors sets whether error responses carry the underlying cause of
+// the error, which can reveal paths, endpoints and node messages
+func DebugErrors(enabled bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(debugErrorsKey, enabled)
+		c.Next()
+	}
+}
+
+// Problem aborts the request with err as an RFC 7807 problem document, with
+// the status err's code maps to
+func Problem(c *gin.Context, err error) {
+	ProblemWithData(c, err, nil)
+}
+
+// ProblemWithData is Problem with state the client needs to recover, sent
+// as the document's data member
+func ProblemWithData(c *gin.Context, err error, data interface{}) {
+	problem := apierror.NewProblem(err, c.GetBool(debugErrorsKey))
+	problem.Instance = c.Request.URL.Path
+	problem.RequestID = logging.RequestID(c.Request.Context())
+	problem.Data = data
+
+	// gin keeps a content type that is already set
+	c.Header("Content-Type", apierror.ContentType)
+	c.AbortWithStatusJSON(problem.Status, problem)
+}
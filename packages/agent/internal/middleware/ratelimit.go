@@ -0,0 +1,140 @@
+package middleware
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/time/rate"
+
+	"nebularvault-agent/internal/apierror"
+)
+
+// RateLimiter keeps a token bucket per client for one budget of limit
+// requests per window. A client is its API key, its wallet or, failing both,
+// its IP address. A bucket left idle for a whole window has refilled, so it
+// is dropped rather than kept.
+type RateLimiter struct {
+	limit  int
+	window time.Duration
+	now    func() time.Time
+
+	mu      sync.Mutex
+	clients map[string]*bucket
+	swept   time.Time
+}
+
+type bucket struct {
+	limiter *rate.Limiter
+	seen    time.Time
+}
+
+// rateResult is the state of a client's bucket after a request
+type rateResult struct {
+	allowed    bool
+	remaining  int
+	reset      time.Duration
+	retryAfter time.Duration
+}
+
+// NewRateLimiter allows each client limit requests per window, refilled
+// evenly over the window. A limit of zero or less disables limiting.
+func NewRateLimiter(limit int, window time.Duration) *RateLimiter {
+	return &RateLimiter{
+		limit:   limit,
+		window:  window,
+		now:     time.Now,
+		clients: make(map[string]*bucket),
+	}
+}
+
+// RateLimit enforces limiter's budget on each client separately. Responses
+// carry RateLimit-* headers, and Retry-After once the budget is spent. It
+// must run after Authenticate to tell API keys and wallets apart.
+func RateLimit(limiter *RateLimiter) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if limiter.limit <= 0 || limiter.window <= 0 {
+			c.Next()
+			return
+		}
+
+		result := limiter.take(clientKey(c))
+		c.Header("RateLimit-Policy", fmt.Sprintf("%d;w=%d", limiter.limit, seconds(limiter.window)))
+		c.Header("RateLimit-Limit", strconv.Itoa(limiter.limit))
+		c.Header("RateLimit-Remaining", strconv.Itoa(result.remaining))
+		c.Header("RateLimit-Reset", strconv.Itoa(seconds(result.reset)))
+
+		if !result.allowed {
+			c.Header("Retry-After", strconv.Itoa(seconds(result.retryAfter)))
+			Problem(c, apierror.New(apierror.RateLimited, "Rate limit exceeded"))
+			return
+		}
+		c.Next()
+	}
+}
+
+// take spends a token from key's bucket if one is available
+func (l *RateLimiter) take(key string) rateResult {
+	now := l.now()
+	interval := l.window / time.Duration(l.limit)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.sweep(now)
+	b, ok := l.clients[key]
+	if !ok {
+		b = &bucket{limiter: rate.NewLimiter(rate.Every(interval), l.limit)}
+		l.clients[key] = b
+	}
+	b.seen = now
+
+	reservation := b.limiter.ReserveN(now, 1)
+	delay := reservation.DelayFrom(now)
+	if delay > 0 {
+		reservation.CancelAt(now)
+	}
+
+	tokens := b.limiter.TokensAt(now)
+	return rateResult{
+		allowed:    delay == 0,
+		remaining:  int(math.Max(0, math.Floor(tokens))),
+		reset:      time.Duration((float64(l.limit) - tokens) * float64(interval)),
+		retryAfter: delay,
+	}
+}
+
+// sweep drops buckets idle for a whole window, at most once per window. It
+// is called with mu held.
+func (l *RateLimiter) sweep(now time.Time) {
+	if now.Sub(l.swept) < l.window {
+		return
+	}
+	l.swept = now
+
+	for key, b := range l.clients {
+		if now.Sub(b.seen) >= l.window {
+			delete(l.clients, key)
+		}
+	}
+}
+
+// clientKey identifies the caller a request is counted against
+func clientKey(c *gin.Context) string {
+	if key, ok := APIKey(c); ok {
+		return "key:" + key.ID
+	}
+	if address, ok := UserAddress(c); ok {
+		return "wallet:" + strings.ToLower(address)
+	}
+	return "ip:" + c.ClientIP()
+}
+
+// seconds rounds d up to whole seconds, as the rate limit headers use
+func seconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
@@ -0,0 +1,93 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"nebularvault-agent/internal/apikey"
+)
+
+func TestRateLimit_PerClient(t *testing.T) {
+	now := time.Now()
+	limiter := NewRateLimiter(2, time.Minute)
+	limiter.now = func() time.Time { return now }
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/files", func(c *gin.Context) {
+		if id := c.GetHeader("X-Test-Key"); id != "" {
+			c.Set(apiKeyKey, &apikey.Key{ID: id})
+		}
+		c.Next()
+	}, RateLimit(limiter), func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	serve := func(ip, key string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/files", nil)
+		req.RemoteAddr = ip + ":1234"
+		if key != "" {
+			req.Header.Set("X-Test-Key", key)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	first := serve("10.0.0.1", "")
+	if first.Code != http.StatusOK || first.Header().Get("RateLimit-Remaining") != "1" || first.Header().Get("RateLimit-Limit") != "2" {
+		t.Errorf("Unexpected first response: %d %v", first.Code, first.Header())
+	}
+	if policy := first.Header().Get("RateLimit-Policy"); policy != "2;w=60" {
+		t.Errorf("Expected policy 2;w=60, got %s", policy)
+	}
+	serve("10.0.0.1", "")
+
+	limited := serve("10.0.0.1", "")
+	if limited.Code != http.StatusTooManyRequests {
+		t.Fatalf("Expected 429 once the budget is spent, got %d", limited.Code)
+	}
+	if retry := limited.Header().Get("Retry-After"); retry != "30" {
+		t.Errorf("Expected Retry-After 30, got %s", retry)
+	}
+	if reset := limited.Header().Get("RateLimit-Reset"); reset != "60" {
+		t.Errorf("Expected RateLimit-Reset 60, got %s", reset)
+	}
+
+	// Other clients, and API keys behind the same address, have budgets of their own
+	if w := serve("10.0.0.2", ""); w.Code != http.StatusOK {
+		t.Errorf("Expected another IP to pass, got %d", w.Code)
+	}
+	if w := serve("10.0.0.1", "k1"); w.Code != http.StatusOK {
+		t.Errorf("Expected an API key to pass, got %d", w.Code)
+	}
+
+	// The budget refills over the window
+	now = now.Add(30 * time.Second)
+	if w := serve("10.0.0.1", ""); w.Code != http.StatusOK {
+		t.Errorf("Expected a refilled token after 30s, got %d", w.Code)
+	}
+
+	// Idle buckets are dropped once a window has passed
+	now = now.Add(2 * time.Minute)
+	serve("10.0.0.3", "")
+	if len(limiter.clients) != 1 {
+		t.Errorf("Expected idle buckets to be evicted, %d remain", len(limiter.clients))
+	}
+}
+
+func TestRateLimit_Disabled(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/", RateLimit(NewRateLimiter(0, time.Minute)), func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	for i := 0; i < 3; i++ {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+		if w.Code != http.StatusOK || w.Header().Get("RateLimit-Limit") != "" {
+			t.Fatalf("Expected a disabled limit to pass without headers, got %d %v", w.Code, w.Header())
+		}
+	}
+}
@@ -0,0 +1,362 @@
+// Package openapi builds an OpenAPI 3 document from a description of each
+// route, deriving schemas from the Go types the routes exchange
+package openapi
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Version is the OpenAPI version of built documents
+const Version = "3.0.3"
+
+// ProblemContentType is the media type of RFC 7807 problem documents
+const ProblemContentType = "application/problem+json"
+
+// Spec describes an API to document
+type Spec struct {
+	Info Info
+	// Envelope is the type every JSON response is wrapped in, as a zero
+	// value; a response's data is documented as its "data" property. Schemas
+	// for types from other packages are named with their package.
+	Envelope interface{}
+	// Problem is the struct errors are reported in as RFC 7807 problem
+	// documents, as a zero value, documented as the Problem schema with
+	// ProblemCodes as the values of its code property. Without it errors
+	// are documented as the envelope.
+	Problem      interface{}
+	ProblemCodes []string
+	// Security names the schemes, any one of which authenticates routes
+	// that are not public
+	Security map[string]*SecurityScheme
+	Routes   []Route
+}
+
+// Route describes one endpoint. Path is in gin syntax, such as
+// /api/v1/files/:hash, and its parameters are documented as strings.
+type Route struct {
+	ID      string
+	Method  string
+	Path    string
+	Summary string
+	Tag     string
+	// Public routes need no credentials; others need a session or an API
+	// key with Scope, if set
+	Public bool
+	Scope  string
+	Params []Param
+	// Body is the JSON request body, as a zero value of its type. RawBody
+	// instead names the content type of a body read as is, and Upload the
+	// file field of a multipart form.
+	Body    interface{}
+	RawBody string
+	Upload  string
+	// Responses are the successful responses; errors share one response
+	Responses []Response
+}
+
+// Param is a query or header parameter
+type Param struct {
+	In          string
+	Name        string
+	Type        string
+	Description string
+	Required    bool
+}
+
+// Response is a successful response. Data is the envelope's data, as a zero
+// value of its type or a OneOf, or nil for none; Raw instead names the
+// content type of a response sent without the envelope.
+type Response struct {
+	Status      int
+	Description string
+	Data        interface{}
+	Raw         string
+	Headers     []string
+}
+
+// Document is an OpenAPI document
+type Document struct {
+	OpenAPI    string              `json:"openapi"`
+	Info       Info                `json:"info"`
+	Paths      map[string]PathItem `json:"paths"`
+	Components Components          `json:"components"`
+}
+
+// Info describes the API
+type Info struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+// PathItem maps lower-case HTTP methods to the operations on a path
+type PathItem map[string]*Operation
+
+// Operation is one documented endpoint
+type Operation struct {
+	OperationID string                `json:"operationId"`
+	Summary     string                `json:"summary,omitempty"`
+	Tags        []string              `json:"tags,omitempty"`
+	Parameters  []*Parameter          `json:"parameters,omitempty"`
+	RequestBody *RequestBody          `json:"requestBody,omitempty"`
+	Responses   map[string]*Reply     `json:"responses"`
+	Security    []map[string][]string `json:"security,omitempty"`
+	Scope       string                `json:"x-required-scope,omitempty"`
+}
+
+// Parameter is a documented path, query or header parameter
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required,omitempty"`
+	Schema      *Schema `json:"schema"`
+}
+
+// RequestBody is a documented request body
+type RequestBody struct {
+	Required bool                  `json:"required"`
+	Content  map[string]*MediaType `json:"content"`
+}
+
+// Reply is a documented response, or a reference to a shared one
+type Reply struct {
+	Ref         string                `json:"$ref,omitempty"`
+	Description string                `json:"description,omitempty"`
+	Headers     map[string]*Header    `json:"headers,omitempty"`
+	Content     map[string]*MediaType `json:"content,omitempty"`
+}
+
+// Header is a documented response header
+type Header struct {
+	Schema *Schema `json:"schema"`
+}
+
+// MediaType is the schema of a body in one content type
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// SecurityScheme is a way of authenticating requests
+type SecurityScheme struct {
+	Type   string `json:"type"`
+	Scheme string `json:"scheme,omitempty"`
+	In     string `json:"in,omitempty"`
+	Name   string `json:"name,omitempty"`
+}
+
+// Components holds the schemas, responses and security schemes operations
+// refer to
+type Components struct {
+	Schemas         map[string]*Schema         `json:"schemas"`
+	Responses       map[string]*Reply          `json:"responses"`
+	SecuritySchemes map[string]*SecurityScheme `json:"securitySchemes,omitempty"`
+}
+
+// Build documents spec. It fails if two routes share a method and path or
+// an ID, or if a route exchanges a type JSON cannot encode.
+func Build(spec Spec) (*Document, error) {
+	g := newGenerator(reflect.TypeOf(spec.Envelope).PkgPath())
+	envelope, err := g.schema(spec.Envelope)
+	if err != nil {
+		return nil, fmt.Errorf("envelope: %w", err)
+	}
+
+	errorContent := map[string]*MediaType{"application/json": {Schema: envelope}}
+	if spec.Problem != nil {
+		problem, err := g.define(reflect.TypeOf(spec.Problem), "Problem")
+		if err != nil {
+			return nil, fmt.Errorf("problem: %w", err)
+		}
+		if code := g.schemas["Problem"].Properties["code"]; code != nil {
+			code.Enum = spec.ProblemCodes
+		}
+		errorContent = map[string]*MediaType{ProblemContentType: {Schema: problem}}
+	}
+
+	doc := &Document{
+		OpenAPI: Version,
+		Info:    spec.Info,
+		Paths:   map[string]PathItem{},
+		Components: Components{
+			Schemas: g.schemas,
+			Responses: map[string]*Reply{
+				"Error": {
+					Description: "The request failed",
+					Content:     errorContent,
+				},
+			},
+			SecuritySchemes: spec.Security,
+		},
+	}
+
+	var security []map[string][]string
+	for _, name := range sortedKeys(spec.Security) {
+		security = append(security, map[string][]string{name: {}})
+	}
+
+	ids := map[string]bool{}
+	for _, route := range spec.Routes {
+		if ids[route.ID] {
+			return nil, fmt.Errorf("duplicate operation ID %q", route.ID)
+		}
+		ids[route.ID] = true
+
+		op, err := g.operation(route, envelope)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s: %w", route.Method, route.Path, err)
+		}
+		if !route.Public {
+			op.Security = security
+		}
+
+		path := Path(route.Path)
+		item := doc.Paths[path]
+		if item == nil {
+			item = PathItem{}
+			doc.Paths[path] = item
+		}
+		method := strings.ToLower(route.Method)
+		if item[method] != nil {
+			return nil, fmt.Errorf("%s %s is documented twice", route.Method, route.Path)
+		}
+		item[method] = op
+	}
+	return doc, nil
+}
+
+// Path converts a gin path to an OpenAPI path, so /files/:hash becomes
+// /files/{hash}
+func Path(ginPath string) string {
+	segments := strings.Split(ginPath, "/")
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, ":") || strings.HasPrefix(segment, "*") {
+			segments[i] = "{" + segment[1:] + "}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// operation documents one route
+func (g *generator) operation(route Route, envelope *Schema) (*Operation, error) {
+	op := &Operation{
+		OperationID: route.ID,
+		Summary:     route.Summary,
+		Responses:   map[string]*Reply{"default": {Ref: "#/components/responses/Error"}},
+		Scope:       route.Scope,
+	}
+	if route.Tag != "" {
+		op.Tags = []string{route.Tag}
+	}
+
+	for _, segment := range strings.Split(route.Path, "/") {
+		if strings.HasPrefix(segment, ":") || strings.HasPrefix(segment, "*") {
+			op.Parameters = append(op.Parameters, &Parameter{
+				Name:     segment[1:],
+				In:       "path",
+				Required: true,
+				Schema:   &Schema{Type: "string"},
+			})
+		}
+	}
+	for _, param := range route.Params {
+		kind := param.Type
+		if kind == "" {
+			kind = "string"
+		}
+		op.Parameters = append(op.Parameters, &Parameter{
+			Name:        param.Name,
+			In:          param.In,
+			Description: param.Description,
+			Required:    param.Required,
+			Schema:      &Schema{Type: kind},
+		})
+	}
+
+	switch {
+	case route.Body != nil:
+		body, err := g.schema(route.Body)
+		if err != nil {
+			return nil, fmt.Errorf("request body: %w", err)
+		}
+		op.RequestBody = &RequestBody{
+			Required: true,
+			Content:  map[string]*MediaType{"application/json": {Schema: body}},
+		}
+	case route.RawBody != "":
+		op.RequestBody = &RequestBody{
+			Required: true,
+			Content:  map[string]*MediaType{route.RawBody: {Schema: rawSchema(route.RawBody)}},
+		}
+	case route.Upload != "":
+		op.RequestBody = &RequestBody{
+			Required: true,
+			Content: map[string]*MediaType{"multipart/form-data": {Schema: &Schema{
+				Type:       "object",
+				Properties: map[string]*Schema{route.Upload: {Type: "string", Format: "binary"}},
+				Required:   []string{route.Upload},
+			}}},
+		}
+	}
+
+	if len(route.Responses) == 0 {
+		return nil, fmt.Errorf("no successful response")
+	}
+	for _, resp := range route.Responses {
+		reply := &Reply{Description: resp.Description}
+		if reply.Description == "" {
+			reply.Description = http.StatusText(resp.Status)
+		}
+		for _, name := range resp.Headers {
+			if reply.Headers == nil {
+				reply.Headers = map[string]*Header{}
+			}
+			reply.Headers[name] = &Header{Schema: &Schema{Type: "string"}}
+		}
+
+		switch {
+		case resp.Raw != "":
+			reply.Content = map[string]*MediaType{resp.Raw: {Schema: rawSchema(resp.Raw)}}
+		case resp.Data != nil:
+			data, err := g.schema(resp.Data)
+			if err != nil {
+				return nil, fmt.Errorf("%d response: %w", resp.Status, err)
+			}
+			reply.Content = map[string]*MediaType{"application/json": {Schema: &Schema{AllOf: []*Schema{
+				envelope,
+				{Type: "object", Properties: map[string]*Schema{"data": data}},
+			}}}}
+		default:
+			reply.Content = map[string]*MediaType{"application/json": {Schema: envelope}}
+		}
+		op.Responses[strconv.Itoa(resp.Status)] = reply
+	}
+	return op, nil
+}
+
+// rawSchema describes a body sent as is: JSON as an object, text as a
+// string and anything else as binary
+func rawSchema(contentType string) *Schema {
+	switch {
+	case contentType == "application/json":
+		return &Schema{Type: "object"}
+	case strings.HasPrefix(contentType, "text/"):
+		return &Schema{Type: "string"}
+	default:
+		return &Schema{Type: "string", Format: "binary"}
+	}
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,135 @@
+package openapi
+
+import (
+	"net/http"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+type envelope struct {
+	Success bool        `json:"success"`
+	Data    interface{} `json:"data,omitempty"`
+}
+
+type problem struct {
+	Title  string `json:"title"`
+	Status int    `json:"status"`
+	Code   string `json:"code"`
+}
+
+type widget struct {
+	ID       string            `json:"id"`
+	Size     uint64            `json:"size"`
+	Tags     []string          `json:"tags,omitempty"`
+	Parent   *widget           `json:"parent,omitempty"`
+	Labels   map[string]string `json:"labels,omitempty"`
+	Created  time.Time         `json:"created_at"`
+	Content  []byte            `json:"content,omitempty"`
+	Ignored  string            `json:"-"`
+	internal string
+}
+
+type createWidgetRequest struct {
+	Name string `json:"name,omitempty" binding:"required"`
+	Note string `json:"note,omitempty"`
+}
+
+func TestBuild(t *testing.T) {
+	doc, err := Build(Spec{
+		Info:         Info{Title: "Widgets", Version: "1"},
+		Envelope:     envelope{},
+		Problem:      problem{},
+		ProblemCodes: []string{"not_found", "conflict"},
+		Security:     map[string]*SecurityScheme{"apiKey": {Type: "apiKey", In: "header", Name: "X-API-Key"}},
+		Routes: []Route{
+			{
+				ID: "createWidget", Method: http.MethodPost, Path: "/widgets", Scope: "admin",
+				Body:      createWidgetRequest{},
+				Responses: []Response{{Status: http.StatusCreated, Data: widget{}}},
+			},
+			{
+				ID: "getWidget", Method: http.MethodGet, Path: "/widgets/:id", Public: true,
+				Responses: []Response{{Status: http.StatusOK, Data: OneOf(widget{}, []widget{})}},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to build document: %v", err)
+	}
+
+	schema := doc.Components.Schemas["Widget"]
+	if schema == nil {
+		t.Fatalf("Expected a Widget schema, got %v", doc.Components.Schemas)
+	}
+	var properties []string
+	for name := range schema.Properties {
+		properties = append(properties, name)
+	}
+	sort.Strings(properties)
+	if want := []string{"content", "created_at", "id", "labels", "parent", "size", "tags"}; !reflect.DeepEqual(properties, want) {
+		t.Errorf("Expected properties %v, got %v", want, properties)
+	}
+	if want := []string{"id", "size", "created_at"}; !reflect.DeepEqual(schema.Required, want) {
+		t.Errorf("Expected required %v, got %v", want, schema.Required)
+	}
+	if ref := schema.Properties["parent"].Ref; ref != "#/components/schemas/Widget" {
+		t.Errorf("Expected parent to refer to Widget, got %q", ref)
+	}
+	if got := schema.Properties["created_at"]; got.Type != "string" || got.Format != "date-time" {
+		t.Errorf("Expected created_at as a date-time, got %+v", got)
+	}
+	if got := schema.Properties["content"]; got.Format != "byte" {
+		t.Errorf("Expected content as base64, got %+v", got)
+	}
+	if got := schema.Properties["size"]; got.Minimum == nil || *got.Minimum != 0 {
+		t.Errorf("Expected size to be unsigned, got %+v", got)
+	}
+
+	request := doc.Components.Schemas["CreateWidgetRequest"]
+	if request == nil || !reflect.DeepEqual(request.Required, []string{"name"}) {
+		t.Errorf("Expected only the bound field to be required, got %+v", request)
+	}
+
+	create := doc.Paths["/widgets"]["post"]
+	if create == nil || create.Scope != "admin" || len(create.Security) != 1 {
+		t.Fatalf("Expected a secured createWidget, got %+v", create)
+	}
+	if create.Responses["201"] == nil || create.Responses["default"] == nil {
+		t.Errorf("Expected 201 and default responses, got %v", create.Responses)
+	}
+
+	errorReply := doc.Components.Responses["Error"].Content[ProblemContentType]
+	if errorReply == nil || errorReply.Schema.Ref != "#/components/schemas/Problem" {
+		t.Fatalf("Expected errors as problem documents, got %+v", doc.Components.Responses["Error"])
+	}
+	if code := doc.Components.Schemas["Problem"].Properties["code"]; !reflect.DeepEqual(code.Enum, []string{"not_found", "conflict"}) {
+		t.Errorf("Expected the problem codes as an enum, got %+v", code)
+	}
+
+	get := doc.Paths["/widgets/{id}"]["get"]
+	if get == nil || get.Security != nil {
+		t.Fatalf("Expected a public getWidget, got %+v", get)
+	}
+	if len(get.Parameters) != 1 || get.Parameters[0].Name != "id" || get.Parameters[0].In != "path" {
+		t.Errorf("Expected the id path parameter, got %+v", get.Parameters)
+	}
+	data := get.Responses["200"].Content["application/json"].Schema.AllOf[1].Properties["data"]
+	if len(data.OneOf) != 2 || data.OneOf[1].Type != "array" {
+		t.Errorf("Expected data to be a widget or a list, got %+v", data)
+	}
+}
+
+func TestBuild_RejectsDuplicates(t *testing.T) {
+	route := Route{ID: "listWidgets", Method: http.MethodGet, Path: "/widgets", Responses: []Response{{Status: http.StatusOK}}}
+	other := route
+	other.ID = "listWidgetsAgain"
+
+	if _, err := Build(Spec{Envelope: envelope{}, Routes: []Route{route, route}}); err == nil {
+		t.Error("Expected an error for a duplicate operation ID")
+	}
+	if _, err := Build(Spec{Envelope: envelope{}, Routes: []Route{route, other}}); err == nil {
+		t.Error("Expected an error for a route documented twice")
+	}
+}
@@ -0,0 +1,230 @@
+package openapi
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"path"
+	"reflect"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Schema is a JSON schema as OpenAPI 3.0 uses it. The empty schema matches
+// any value.
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Enum                 []string           `json:"enum,omitempty"`
+	Minimum              *int               `json:"minimum,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	AllOf                []*Schema          `json:"allOf,omitempty"`
+	OneOf                []*Schema          `json:"oneOf,omitempty"`
+}
+
+// oneOf is a value documented as any of several types
+type oneOf []interface{}
+
+// OneOf documents a request or response that is one of values' types
+func OneOf(values ...interface{}) interface{} {
+	return oneOf(values)
+}
+
+var (
+	timeType          = reflect.TypeOf(time.Time{})
+	rawMessageType    = reflect.TypeOf(json.RawMessage{})
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// generator derives schemas from Go types, collecting named structs as
+// components. Structs from packages other than home are named with their
+// package, as in MetadataFileRecord.
+type generator struct {
+	home    string
+	schemas map[string]*Schema
+	names   map[reflect.Type]string
+}
+
+func newGenerator(home string) *generator {
+	return &generator{
+		home:    home,
+		schemas: map[string]*Schema{},
+		names:   map[reflect.Type]string{},
+	}
+}
+
+// schema describes how encoding/json encodes values like v
+func (g *generator) schema(v interface{}) (*Schema, error) {
+	if values, ok := v.(oneOf); ok {
+		schema := &Schema{}
+		for _, value := range values {
+			option, err := g.schema(value)
+			if err != nil {
+				return nil, err
+			}
+			schema.OneOf = append(schema.OneOf, option)
+		}
+		return schema, nil
+	}
+	return g.typeSchema(reflect.TypeOf(v))
+}
+
+func (g *generator) typeSchema(t reflect.Type) (*Schema, error) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch {
+	case t == timeType:
+		return &Schema{Type: "string", Format: "date-time"}, nil
+	case t == rawMessageType:
+		return &Schema{}, nil
+	case t.Implements(jsonMarshalerType) || reflect.PointerTo(t).Implements(jsonMarshalerType):
+		// Custom encodings cannot be inspected
+		return &Schema{}, nil
+	case t.Implements(textMarshalerType) || reflect.PointerTo(t).Implements(textMarshalerType):
+		return &Schema{Type: "string"}, nil
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32:
+		return &Schema{Type: "integer", Format: "int32"}, nil
+	case reflect.Int64:
+		return &Schema{Type: "integer", Format: "int64"}, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		zero := 0
+		return &Schema{Type: "integer", Format: "int64", Minimum: &zero}, nil
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}, nil
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}, nil
+	case reflect.String:
+		return &Schema{Type: "string"}, nil
+	case reflect.Interface:
+		return &Schema{}, nil
+	case reflect.Slice, reflect.Array:
+		if t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}, nil
+		}
+		items, err := g.typeSchema(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: "array", Items: items}, nil
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("map key %s is not a string", t.Key())
+		}
+		values, err := g.typeSchema(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: "object", AdditionalProperties: values}, nil
+	case reflect.Struct:
+		return g.structSchema(t)
+	}
+	return nil, fmt.Errorf("%s has no JSON encoding", t)
+}
+
+// structSchema refers to the component for a named struct, adding it if
+// needed; anonymous structs are described inline
+func (g *generator) structSchema(t reflect.Type) (*Schema, error) {
+	if t.Name() == "" {
+		return g.objectSchema(t)
+	}
+	if name, ok := g.names[t]; ok {
+		return &Schema{Ref: "#/components/schemas/" + name}, nil
+	}
+
+	name := exportedName(t.Name())
+	if t.PkgPath() != g.home {
+		name = exportedName(path.Base(t.PkgPath())) + name
+	}
+	return g.define(t, name)
+}
+
+// define adds the component for struct type t under name
+func (g *generator) define(t reflect.Type, name string) (*Schema, error) {
+	if _, taken := g.schemas[name]; taken {
+		return nil, fmt.Errorf("schema name %s is used by two types", name)
+	}
+
+	// Register the name first so recursive types refer to themselves
+	g.names[t] = name
+	g.schemas[name] = &Schema{}
+	object, err := g.objectSchema(t)
+	if err != nil {
+		return nil, err
+	}
+	*g.schemas[name] = *object
+	return &Schema{Ref: "#/components/schemas/" + name}, nil
+}
+
+// objectSchema describes a struct's fields as encoding/json encodes them.
+// Fields without omitempty are always present, and so required, as are
+// request fields that binding requires.
+func (g *generator) objectSchema(t reflect.Type) (*Schema, error) {
+	object := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, options, _ := strings.Cut(tag, ",")
+
+		// Untagged embedded structs are flattened into their parent
+		embedded := field.Type
+		if embedded.Kind() == reflect.Pointer {
+			embedded = embedded.Elem()
+		}
+		if field.Anonymous && name == "" && embedded.Kind() == reflect.Struct {
+			inner, err := g.objectSchema(embedded)
+			if err != nil {
+				return nil, err
+			}
+			for key, schema := range inner.Properties {
+				object.Properties[key] = schema
+			}
+			object.Required = append(object.Required, inner.Required...)
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		schema, err := g.typeSchema(field.Type)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %w", t, field.Name, err)
+		}
+		if strings.Contains(","+options+",", ",string,") {
+			schema = &Schema{Type: "string"}
+		}
+		object.Properties[name] = schema
+
+		omitEmpty := strings.Contains(","+options+",", ",omitempty,")
+		if !omitEmpty || strings.Contains(field.Tag.Get("binding"), "required") {
+			object.Required = append(object.Required, name)
+		}
+	}
+	return object, nil
+}
+
+// exportedName upper-cases a type name's first letter, so unexported types
+// get conventional schema names
+func exportedName(name string) string {
+	r, size := utf8.DecodeRuneInString(name)
+	return string(unicode.ToUpper(r)) + name[size:]
+}
@@ -0,0 +1,483 @@
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"nebularvault-agent/internal/anchor"
+	"nebularvault-agent/internal/apierror"
+	"nebularvault-agent/internal/jobs"
+	"nebularvault-agent/internal/logging"
+	"nebularvault-agent/internal/metadata"
+	"nebularvault-agent/internal/metrics"
+	"nebularvault-agent/internal/progress"
+	"nebularvault-agent/internal/quota"
+	"nebularvault-agent/internal/storage"
+	"nebularvault-agent/internal/zerog"
+)
+
+// Job types the pipeline runs. Verify and repair jobs are keyed by content
+// hash, so a file has at most one of each unfinished.
+const (
+	JobUpload = "upload"
+	JobVerify = "verify"
+	JobRepair = "repair"
+)
+
+// Config controls where uploads are spooled and how pipeline jobs retry
+type Config struct {
+	// SpoolDir holds uploaded files until their upload job finishes
+	SpoolDir string
+	// KeepChunks keeps a local copy of uploaded chunks for repair jobs
+	KeepChunks bool
+	// SessionTTL is how long an idle resumable upload is kept
+	SessionTTL  time.Duration
+	Workers     int
+	MaxAttempts int
+	Backoff     time.Duration
+}
+
+// Pipeline moves files between the agent, 0G Storage and the chain: it
+// chunks and uploads files, records them and queues them for anchoring,
+// and verifies and repairs what is stored. Each step runs as a job.
+type Pipeline struct {
+	storage  *storage.StorageManager
+	zeroG    *zerog.ZeroGClient
+	store    *metadata.Store
+	anchorer *anchor.Anchorer
+	queue    *jobs.Queue
+	config   Config
+	logger   *logrus.Logger
+
+	// busy holds the upload sessions a request is writing to; held holds
+	// the quota reserved for each session until it ends
+	sessionMu sync.Mutex
+	busy      map[string]bool
+	held      map[string]*quota.Reservation
+}
+
+// New creates a pipeline and registers its jobs with queue. anchorer is nil
+// when chain integration is disabled.
+func New(storageManager *storage.StorageManager, zeroGClient *zerog.ZeroGClient, store *metadata.Store, anchorer *anchor.Anchorer, queue *jobs.Queue, config Config, logger *logrus.Logger) *Pipeline {
+	p := &Pipeline{
+		storage:  storageManager,
+		zeroG:    zeroGClient,
+		store:    store,
+		anchorer: anchorer,
+		queue:    queue,
+		config:   config,
+		logger:   logger,
+		busy:     make(map[string]bool),
+		held:     make(map[string]*quota.Reservation),
+	}
+
+	policy := jobs.Policy{
+		Workers:     config.Workers,
+		MaxAttempts: config.MaxAttempts,
+		Backoff:     config.Backoff,
+	}
+	queue.Register(JobUpload, policy, p.runUpload)
+	queue.Register(JobVerify, policy, p.runVerify)
+	queue.Register(JobRepair, policy, p.runRepair)
+	return p
+}
+
+// UploadRequest is the payload of an upload job
+type UploadRequest struct {
+	// Path is the spooled file, removed once the job finishes
+	Path     string `json:"path"`
+	Filename string `json:"filename"`
+	UserID   string `json:"user_id,omitempty"`
+}
+
+// UploadResult describes a stored upload
+type UploadResult struct {
+	FileID      string           `json:"file_id"`
+	Hash        string           `json:"hash"`
+	Filename    string           `json:"filename"`
+	Size        int64            `json:"size"`
+	MerkleRoot  string           `json:"merkle_root"`
+	Chunks      []string         `json:"chunks"`
+	UploadedAt  string           `json:"uploaded_at"`
+	Anchor      *metadata.Anchor `json:"anchor,omitempty"`
+	AnchorJobID string           `json:"anchor_job_id,omitempty"`
+}
+
+// RepairResult describes what a repair job fixed
+type RepairResult struct {
+	RepairedChunks []int                  `json:"repaired_chunks"`
+	AnchorJobID    string                 `json:"anchor_job_id,omitempty"`
+	Verification   *metadata.Verification `json:"verification"`
+}
+
+// SpoolPath returns a new path in the spool directory for an upload named
+// filename. The name is kept so chunking detects its type.
+func (p *Pipeline) SpoolPath(filename string) (string, error) {
+	dir := filepath.Join(p.config.SpoolDir, uuid.New().String())
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", fmt.Errorf("failed to create spool directory: %w", err)
+	}
+
+	name := filepath.Base(filepath.Clean("/" + filename))
+	if name == "/" || name == "." {
+		name = "upload"
+	}
+	return filepath.Join(dir, name), nil
+}
+
+// EnqueueUpload queues a spooled file for upload on behalf of owner
+func (p *Pipeline) EnqueueUpload(req UploadRequest, owner string) (*metadata.Job, error) {
+	return p.queue.Enqueue(JobUpload, "", owner, req)
+}
+
+// EnqueueVerify queues a check of a stored file
+func (p *Pipeline) EnqueueVerify(hash, owner string) (*metadata.Job, error) {
+	return p.queue.Enqueue(JobVerify, normalizeHash(hash), owner, normalizeHash(hash))
+}
+
+// EnqueueRepair queues a repair of a stored file
+func (p *Pipeline) EnqueueRepair(hash, owner string) (*metadata.Job, error) {
+	return p.queue.Enqueue(JobRepair, normalizeHash(hash), owner, normalizeHash(hash))
+}
+
+func (p *Pipeline) runUpload(ctx context.Context, job *metadata.Job) (interface{}, error) {
+	var req UploadRequest
+	if err := job.DecodePayload(&req); err != nil {
+		return nil, jobs.Permanent(err)
+	}
+
+	result, err := p.Upload(ctx, req)
+	if err == nil || (ctx.Err() == nil && (jobs.IsPermanent(err) || job.LastAttempt())) {
+		p.removeSpooled(req.Path)
+	}
+	return result, err
+}
+
+// Upload chunks a file, uploads its chunks to 0G Storage and records it,
+// queueing new content for anchoring
+func (p *Pipeline) Upload(ctx context.Context, req UploadRequest) (*UploadResult, error) {
+	log := logging.Entry(ctx, p.logger)
+
+	if _, err := os.Stat(req.Path); err != nil {
+		return nil, jobs.Permanent(fmt.Errorf("uploaded file is gone: %w", err))
+	}
+	file, err := p.storage.ChunkFile(ctx, req.Path)
+	if err != nil {
+		return nil, jobs.Permanent(fmt.Errorf("failed to chunk file: %w", err))
+	}
+	if req.Filename != "" {
+		file.Filename = req.Filename
+	}
+	if req.UserID != "" {
+		file.UserID = req.UserID
+	}
+	// Content already held keeps its ID, so local chunk copies are kept
+	// where reads of its record look for them
+	if existing, err := p.store.GetFile(file.Hash); err == nil {
+		if !owns(existing, file.UserID) {
+			return nil, errStoredByOther
+		}
+		file.ID = existing.ID
+		for i := range file.Chunks {
+			file.Chunks[i].ID = storage.ChunkID(existing.ID, i)
+			file.Chunks[i].ParentID = existing.ID
+		}
+	}
+
+	var uploadedChunks []string
+	var uploadedBytes int64
+	for _, chunk := range file.Chunks {
+		uploadResp, err := p.zeroG.Upload(ctx, chunk.Data, map[string]interface{}{
+			"chunk_id":  chunk.ID,
+			"parent_id": chunk.ParentID,
+			"index":     chunk.Index,
+			"size":      chunk.Size,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to upload chunk %d to 0G Storage: %w", chunk.Index, err)
+		}
+		uploadedChunks = append(uploadedChunks, uploadResp.Hash)
+		uploadedBytes += chunk.Size
+		progress.Report(ctx, progress.ChunkUploaded, map[string]interface{}{
+			"index":       chunk.Index,
+			"root":        uploadResp.Hash,
+			"uploaded":    len(uploadedChunks),
+			"chunks":      len(file.Chunks),
+			"bytes":       uploadedBytes,
+			"total_bytes": file.Size,
+			"percent":     progress.Percent(uploadedBytes, file.Size),
+		})
+
+		if p.config.KeepChunks {
+			if err := p.storage.SaveChunk(&chunk); err != nil {
+				log.WithError(err).WithField("chunk_id", chunk.ID).Warn("Failed to keep local copy of chunk")
+			}
+		}
+	}
+
+	record, anchorJob, err := p.save(file, uploadedChunks)
+	if err == errStoredByOther {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to save file metadata: %w", err)
+	}
+
+	result := &UploadResult{
+		FileID:     record.ID,
+		Hash:       file.Hash,
+		Filename:   file.Filename,
+		Size:       file.Size,
+		MerkleRoot: file.MerkleRoot,
+		Chunks:     uploadedChunks,
+		UploadedAt: file.UploadedAt,
+		Anchor:     record.Anchor,
+	}
+	if anchorJob != nil {
+		result.AnchorJobID = anchorJob.ID
+	}
+	progress.Report(ctx, progress.Recorded, map[string]interface{}{
+		"file_id":       result.FileID,
+		"hash":          result.Hash,
+		"anchor_job_id": result.AnchorJobID,
+	})
+	return result, nil
+}
+
+// errStoredByOther refuses a re-upload of content another account owns,
+// which must not rename it or change who may read it
+var errStoredByOther = jobs.Permanent(apierror.New(apierror.Conflict, "File is already stored by another account"))
+
+// owns reports whether userID may replace a stored record's details: it
+// uploaded the content, or nobody in particular did
+func owns(record *metadata.FileRecord, userID string) bool {
+	return record.UserID == "" || record.UserID == "anonymous" || strings.EqualFold(record.UserID, userID)
+}
+
+// save stores the metadata of an uploaded file. New content is queued for
+// anchoring; content the agent already holds keeps its ID, verification,
+// owner and anchor unless the anchor failed, and counts as deduplicated.
+// Content another account owns is left as it is.
+func (p *Pipeline) save(file *storage.FileMetadata, storageRefs []string) (*metadata.FileRecord, *metadata.Job, error) {
+	deduplicated, queue, storedByOther := false, false, false
+	record, err := p.store.MergeFile(file.Hash, func(existing *metadata.FileRecord) *metadata.FileRecord {
+		if existing != nil && !owns(existing, file.UserID) {
+			storedByOther = true
+			return existing
+		}
+
+		record := &metadata.FileRecord{
+			ID:          file.ID,
+			Filename:    file.Filename,
+			Size:        file.Size,
+			MimeType:    file.MimeType,
+			Hash:        file.Hash,
+			MerkleRoot:  file.MerkleRoot,
+			StorageRefs: storageRefs,
+			UploadedAt:  file.UploadedAt,
+			UserID:      file.UserID,
+			IsPublic:    file.IsPublic,
+		}
+		if len(file.Chunks) > 0 {
+			record.ChunkSize = file.Chunks[0].Size
+		}
+		for _, chunk := range file.Chunks {
+			record.ChunkHashes = append(record.ChunkHashes, chunk.Hash)
+		}
+
+		deduplicated = existing != nil
+		if existing != nil {
+			record.ID = existing.ID
+			record.Verification = existing.Verification
+			if existing.UserID != "" && existing.UserID != "anonymous" {
+				record.UserID = existing.UserID
+			}
+		}
+		queue = false
+		if existing != nil && existing.Anchor != nil && existing.Anchor.Status != metadata.AnchorFailed {
+			record.Anchor = existing.Anchor
+		} else if p.anchorer != nil {
+			record.Anchor = &metadata.Anchor{Status: metadata.AnchorPending, UpdatedAt: time.Now()}
+			queue = true
+		}
+		return record
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if storedByOther {
+		return nil, nil, errStoredByOther
+	}
+	metrics.ObserveUpload(record.Size, deduplicated)
+	if !queue {
+		return record, nil, nil
+	}
+
+	// The record stays pending if this fails, and is queued on restart
+	job, err := p.anchorer.Enqueue(record.Hash)
+	if err != nil {
+		p.logger.WithError(err).WithField("hash", record.Hash).Error("Failed to queue file for anchoring")
+	}
+	return record, job, nil
+}
+
+// removeSpooled deletes an upload's spool directory
+func (p *Pipeline) removeSpooled(path string) {
+	dir := filepath.Dir(path)
+	if filepath.Dir(dir) != filepath.Clean(p.config.SpoolDir) {
+		// Not spooled by SpoolPath; leave it alone
+		return
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		p.logger.WithError(err).WithField("path", path).Warn("Failed to remove spooled upload")
+	}
+}
+
+func (p *Pipeline) runVerify(ctx context.Context, job *metadata.Job) (interface{}, error) {
+	var hash string
+	if err := job.DecodePayload(&hash); err != nil {
+		return nil, jobs.Permanent(err)
+	}
+	return p.Verify(ctx, hash)
+}
+
+// Verify checks that a file's chunk hashes still produce its Merkle root
+// and that every chunk is retrievable from 0G Storage, recording the
+// outcome on the file's record. A file that fails the check is not an
+// error; its verification says what is wrong.
+func (p *Pipeline) Verify(ctx context.Context, hash string) (*metadata.Verification, error) {
+	record, err := p.store.GetFile(hash)
+	if err == metadata.ErrNotFound {
+		return nil, jobs.Permanent(fmt.Errorf("file %s not found", hash))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	file := &storage.FileMetadata{MerkleRoot: record.MerkleRoot}
+	for _, chunkHash := range record.ChunkHashes {
+		file.Chunks = append(file.Chunks, storage.FileChunk{Hash: chunkHash})
+	}
+	verification := &metadata.Verification{
+		MerkleRootValid: p.storage.VerifyFileIntegrity(ctx, file),
+		MissingChunks:   []int{},
+	}
+
+	// 0G addresses data by its root, so a chunk that downloads is intact
+	for i := range record.ChunkHashes {
+		stored := i < len(record.StorageRefs) && record.StorageRefs[i] != ""
+		if stored {
+			if _, err := p.zeroG.Download(ctx, record.StorageRefs[i]); err != nil {
+				if ctx.Err() != nil {
+					return nil, ctx.Err()
+				}
+				stored = false
+			}
+		}
+		if !stored {
+			verification.MissingChunks = append(verification.MissingChunks, i)
+		}
+		progress.Report(ctx, progress.ChunkVerified, map[string]interface{}{
+			"index":   i,
+			"ok":      stored,
+			"checked": i + 1,
+			"chunks":  len(record.ChunkHashes),
+			"missing": len(verification.MissingChunks),
+		})
+	}
+	verification.Valid = verification.MerkleRootValid && len(verification.MissingChunks) == 0
+	verification.CheckedAt = time.Now()
+
+	if _, err := p.store.UpdateFile(hash, func(record *metadata.FileRecord) {
+		record.Verification = verification
+	}); err != nil {
+		return nil, fmt.Errorf("failed to record verification: %w", err)
+	}
+	return verification, nil
+}
+
+func (p *Pipeline) runRepair(ctx context.Context, job *metadata.Job) (interface{}, error) {
+	var hash string
+	if err := job.DecodePayload(&hash); err != nil {
+		return nil, jobs.Permanent(err)
+	}
+	return p.Repair(ctx, hash)
+}
+
+// Repair re-uploads chunks missing from 0G Storage from the agent's local
+// copies and requeues a failed anchor
+func (p *Pipeline) Repair(ctx context.Context, hash string) (*RepairResult, error) {
+	verification, err := p.Verify(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	record, err := p.store.GetFile(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &RepairResult{RepairedChunks: []int{}, Verification: verification}
+	if len(verification.MissingChunks) > 0 {
+		refs := make([]string, len(record.ChunkHashes))
+		copy(refs, record.StorageRefs)
+
+		for _, index := range verification.MissingChunks {
+			chunk, err := p.storage.LoadChunk(storage.ChunkID(record.ID, index))
+			if err != nil {
+				return nil, jobs.Permanent(fmt.Errorf("no local copy of chunk %d: %w", index, err))
+			}
+			if chunk.Hash != record.ChunkHashes[index] {
+				return nil, jobs.Permanent(fmt.Errorf("local copy of chunk %d does not match its hash", index))
+			}
+
+			uploadResp, err := p.zeroG.Upload(ctx, chunk.Data, map[string]interface{}{
+				"chunk_id":  chunk.ID,
+				"parent_id": record.ID,
+				"index":     index,
+				"size":      chunk.Size,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to upload chunk %d to 0G Storage: %w", index, err)
+			}
+			refs[index] = uploadResp.Hash
+			result.RepairedChunks = append(result.RepairedChunks, index)
+			progress.Report(ctx, progress.ChunkRepaired, map[string]interface{}{
+				"index":    index,
+				"root":     uploadResp.Hash,
+				"repaired": len(result.RepairedChunks),
+				"missing":  len(verification.MissingChunks),
+			})
+		}
+
+		if _, err := p.store.UpdateFile(hash, func(record *metadata.FileRecord) {
+			record.StorageRefs = refs
+		}); err != nil {
+			return nil, fmt.Errorf("failed to record repaired chunks: %w", err)
+		}
+		if result.Verification, err = p.Verify(ctx, hash); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.anchorer != nil && record.Anchor != nil && record.Anchor.Status == metadata.AnchorFailed {
+		_, job, err := p.anchorer.Retry(hash)
+		if err != nil {
+			return nil, fmt.Errorf("failed to requeue anchor: %w", err)
+		}
+		result.AnchorJobID = job.ID
+	}
+	return result, nil
+}
+
+// normalizeHash matches the form file records are keyed by
+func normalizeHash(hash string) string {
+	return strings.TrimPrefix(strings.ToLower(hash), "0x")
+}
@@ -0,0 +1,311 @@
+package pipeline
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"nebularvault-agent/internal/apierror"
+	"nebularvault-agent/internal/jobs"
+	"nebularvault-agent/internal/metadata"
+	"nebularvault-agent/internal/quota"
+	"nebularvault-agent/internal/storage"
+	"nebularvault-agent/internal/zerog"
+)
+
+func newTestPipeline(t *testing.T) (*Pipeline, *jobs.Queue, *metadata.Store) {
+	t.Helper()
+	dir := t.TempDir()
+
+	store, err := metadata.Open(filepath.Join(dir, "metadata"))
+	if err != nil {
+		t.Fatalf("Failed to open metadata store: %v", err)
+	}
+	zeroGClient, err := zerog.NewZeroGClient(&zerog.ZeroGConfig{}, logrus.New())
+	if err != nil {
+		t.Fatalf("Failed to create 0G client: %v", err)
+	}
+
+	queue := jobs.NewQueue(store, jobs.Config{}, logrus.New())
+	p := New(storage.NewStorageManager(dir, filepath.Join(dir, "temp"), 16), zeroGClient, store, nil, queue, Config{
+		SpoolDir:    filepath.Join(dir, "spool"),
+		KeepChunks:  true,
+		MaxAttempts: 2,
+		Backoff:     10 * time.Millisecond,
+	}, logrus.New())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	if err := queue.Start(ctx); err != nil {
+		t.Fatalf("Failed to start job queue: %v", err)
+	}
+	t.Cleanup(func() {
+		cancel()
+		queue.Wait()
+		store.Close()
+	})
+	return p, queue, store
+}
+
+// finish waits for a job and decodes its result into v
+func finish(t *testing.T, queue *jobs.Queue, job *metadata.Job, v interface{}) *metadata.Job {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	job, err := queue.WaitJob(ctx, job.ID)
+	if err != nil {
+		t.Fatalf("Failed to wait for job: %v", err)
+	}
+	if job.Status != metadata.JobSucceeded {
+		t.Fatalf("Expected %s job to succeed, got %s: %s", job.Type, job.Status, job.Error)
+	}
+	if err := json.Unmarshal(job.Result, v); err != nil {
+		t.Fatalf("Failed to decode job result: %v", err)
+	}
+	return job
+}
+
+func TestPipeline_UploadVerifyRepair(t *testing.T) {
+	p, queue, store := newTestPipeline(t)
+
+	path, err := p.SpoolPath("../../notes.txt")
+	if err != nil {
+		t.Fatalf("Failed to spool upload: %v", err)
+	}
+	if filepath.Dir(filepath.Dir(path)) != p.config.SpoolDir || filepath.Base(path) != "notes.txt" {
+		t.Fatalf("Expected the upload to be spooled inside the spool directory, got %s", path)
+	}
+	if err := os.WriteFile(path, []byte(strings.Repeat("nebular vault ", 4)), 0600); err != nil {
+		t.Fatalf("Failed to write upload: %v", err)
+	}
+
+	job, err := p.EnqueueUpload(UploadRequest{Path: path, Filename: "notes.txt", UserID: "0xAlice"}, "0xAlice")
+	if err != nil {
+		t.Fatalf("Failed to enqueue upload: %v", err)
+	}
+	var uploaded UploadResult
+	finish(t, queue, job, &uploaded)
+	if uploaded.Filename != "notes.txt" || uploaded.Size != 56 || len(uploaded.Chunks) != 4 || uploaded.Anchor != nil {
+		t.Errorf("Unexpected upload result: %+v", uploaded)
+	}
+	if _, err := os.Stat(filepath.Dir(path)); !os.IsNotExist(err) {
+		t.Errorf("Expected the spooled upload to be removed, got %v", err)
+	}
+
+	// The upload reports each step, keeping the latest of each
+	history, _, unsubscribe := queue.Events(job.ID)
+	unsubscribe()
+	var stages []string
+	for _, event := range history {
+		stages = append(stages, event.Stage)
+	}
+	want := "status,status,chunking,merkle_root,chunk_uploaded,recorded,status"
+	if strings.Join(stages, ",") != want {
+		t.Errorf("Expected upload events %s, got %s", want, strings.Join(stages, ","))
+	}
+	if chunk := history[4].Data; chunk["uploaded"] != 4 || chunk["percent"] != 100 {
+		t.Errorf("Expected the last chunk upload to complete the file, got %v", chunk)
+	}
+
+	record, err := store.GetFile(uploaded.Hash)
+	if err != nil {
+		t.Fatalf("Failed to get file record: %v", err)
+	}
+	if record.UserID != "0xAlice" || len(record.StorageRefs) != 4 {
+		t.Errorf("Unexpected file record: %+v", record)
+	}
+
+	var verification metadata.Verification
+	job, err = p.EnqueueVerify("0x"+uploaded.Hash, "")
+	if err != nil {
+		t.Fatalf("Failed to enqueue verify: %v", err)
+	}
+	finish(t, queue, job, &verification)
+	if !verification.Valid || !verification.MerkleRootValid || len(verification.MissingChunks) != 0 {
+		t.Errorf("Expected a valid file, got %+v", verification)
+	}
+
+	// Lose a chunk's 0G reference; verification reports it and repair
+	// re-uploads it from the local copy
+	if _, err := store.UpdateFile(uploaded.Hash, func(record *metadata.FileRecord) {
+		record.StorageRefs[2] = ""
+	}); err != nil {
+		t.Fatalf("Failed to update file record: %v", err)
+	}
+	job, err = p.EnqueueVerify(uploaded.Hash, "")
+	if err != nil {
+		t.Fatalf("Failed to enqueue verify: %v", err)
+	}
+	finish(t, queue, job, &verification)
+	if verification.Valid || len(verification.MissingChunks) != 1 || verification.MissingChunks[0] != 2 {
+		t.Errorf("Expected chunk 2 to be missing, got %+v", verification)
+	}
+
+	var repaired RepairResult
+	job, err = p.EnqueueRepair(uploaded.Hash, "")
+	if err != nil {
+		t.Fatalf("Failed to enqueue repair: %v", err)
+	}
+	finish(t, queue, job, &repaired)
+	if len(repaired.RepairedChunks) != 1 || repaired.RepairedChunks[0] != 2 || !repaired.Verification.Valid {
+		t.Errorf("Expected chunk 2 to be repaired, got %+v", repaired)
+	}
+	if record, _ = store.GetFile(uploaded.Hash); record.StorageRefs[2] == "" || !record.Verification.Valid {
+		t.Errorf("Expected the repaired reference to be recorded, got %+v", record)
+	}
+}
+
+func TestPipeline_UploadFailsWithoutSpooledFile(t *testing.T) {
+	p, queue, _ := newTestPipeline(t)
+
+	job, err := p.EnqueueUpload(UploadRequest{Path: filepath.Join(p.config.SpoolDir, "gone", "file.txt")}, "")
+	if err != nil {
+		t.Fatalf("Failed to enqueue upload: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	job, err = queue.WaitJob(ctx, job.ID)
+	if err != nil {
+		t.Fatalf("Failed to wait for job: %v", err)
+	}
+	if job.Status != metadata.JobFailed || job.Attempts != 1 || !strings.Contains(job.Error, "uploaded file is gone") {
+		t.Errorf("Expected a permanent failure, got %+v", job)
+	}
+}
+
+// TestPipeline_ReuploadKeepsRecord uploads content the agent already holds;
+// its record keeps the ID, verification and anchor it had, and another
+// account cannot rename it
+func TestPipeline_ReuploadKeepsRecord(t *testing.T) {
+	p, _, store := newTestPipeline(t)
+	ctx := context.Background()
+
+	path := filepath.Join(t.TempDir(), "notes.txt")
+	if err := os.WriteFile(path, []byte(strings.Repeat("nebular vault ", 4)), 0600); err != nil {
+		t.Fatalf("Failed to write upload: %v", err)
+	}
+	owner := "0x00000000000000000000000000000000000000a1"
+	first, err := p.Upload(ctx, UploadRequest{Path: path, UserID: owner})
+	if err != nil {
+		t.Fatalf("Upload failed: %v", err)
+	}
+	if _, err := p.Verify(ctx, first.Hash); err != nil {
+		t.Fatalf("Verify failed: %v", err)
+	}
+	anchor := &metadata.Anchor{Status: metadata.AnchorConfirmed, TxHash: "0xabc", UpdatedAt: time.Now()}
+	if _, err := store.UpdateFile(first.Hash, func(record *metadata.FileRecord) {
+		record.Anchor = anchor
+	}); err != nil {
+		t.Fatalf("Failed to update file record: %v", err)
+	}
+
+	_, err = p.Upload(ctx, UploadRequest{Path: path, Filename: "copy.txt", UserID: "0x00000000000000000000000000000000000000b2"})
+	if apierror.CodeOf(err) != apierror.Conflict || !jobs.IsPermanent(err) {
+		t.Errorf("Expected another account's upload to fail with a permanent conflict, got %v", err)
+	}
+
+	second, err := p.Upload(ctx, UploadRequest{Path: path, UserID: strings.ToUpper(owner)})
+	if err != nil {
+		t.Fatalf("Upload failed: %v", err)
+	}
+	if second.FileID != first.FileID {
+		t.Errorf("Expected the file to keep ID %s, got %s", first.FileID, second.FileID)
+	}
+
+	record, err := store.GetFile(first.Hash)
+	if err != nil {
+		t.Fatalf("Failed to get file record: %v", err)
+	}
+	if record.ID != first.FileID || record.Filename != "notes.txt" || record.UserID != owner {
+		t.Errorf("Unexpected file record: %+v", record)
+	}
+	if record.Verification == nil || !record.Verification.Valid {
+		t.Errorf("Expected the verification to be kept, got %+v", record.Verification)
+	}
+	if record.Anchor == nil || record.Anchor.Status != metadata.AnchorConfirmed || record.Anchor.TxHash != "0xabc" {
+		t.Errorf("Expected the confirmed anchor to be kept, got %+v", record.Anchor)
+	}
+
+	// Local chunk copies are found under the kept ID
+	if _, err := p.storage.LoadChunk(storage.ChunkID(record.ID, 0)); err != nil {
+		t.Errorf("Expected a local copy of chunk 0 under the kept ID: %v", err)
+	}
+}
+
+// quotaChain gives every account the same quota and no usage
+type quotaChain uint64
+
+func (quotaChain) UserStatus(string) (bool, bool, error) { return true, true, nil }
+
+func (q quotaChain) StorageInfo(string) (uint64, uint64, error) { return uint64(q), 0, nil }
+
+func TestPipeline_SessionsHoldQuota(t *testing.T) {
+	p, queue, store := newTestPipeline(t)
+	tracker := quota.NewTracker(store, quotaChain(100), 0, logrus.New())
+	owner := "0x00000000000000000000000000000000000000a1"
+
+	reserved := func() uint64 {
+		t.Helper()
+		usage, err := tracker.Usage(owner)
+		if err != nil {
+			t.Fatalf("Failed to get usage: %v", err)
+		}
+		return usage.Reserved
+	}
+	open := func(name string, size int64) *metadata.UploadSession {
+		t.Helper()
+		reservation, _, err := tracker.Reserve(owner, uint64(size))
+		if err != nil {
+			t.Fatalf("Failed to reserve: %v", err)
+		}
+		session, err := p.CreateSession(name, size, owner, reservation)
+		if err != nil {
+			t.Fatalf("Failed to create session: %v", err)
+		}
+		return session
+	}
+
+	// Open sessions together cannot reserve more than the quota
+	cancelled := open("a.txt", 60)
+	if _, _, err := tracker.Reserve(owner, 60); err != quota.ErrExceeded {
+		t.Errorf("Expected a second session to exceed the quota, got %v", err)
+	}
+	if err := p.CancelSession(cancelled.ID); err != nil {
+		t.Fatalf("Failed to cancel session: %v", err)
+	}
+	if got := reserved(); got != 0 {
+		t.Errorf("Expected cancelling to release the reservation, %d bytes still reserved", got)
+	}
+
+	content := "nebular vault"
+	session := open("b.txt", int64(len(content)))
+	if !p.HoldsQuota(session.ID) {
+		t.Error("Expected the session to hold its reservation")
+	}
+	if _, err := p.AppendSession(session.ID, 0, strings.NewReader(content)); err != nil {
+		t.Fatalf("Failed to append: %v", err)
+	}
+	job, held, err := p.CompleteSession(session.ID)
+	if err != nil {
+		t.Fatalf("Failed to complete session: %v", err)
+	}
+	if held == nil || p.HoldsQuota(session.ID) {
+		t.Fatal("Expected completing to hand on the session's reservation")
+	}
+	if got := reserved(); got != uint64(len(content)) {
+		t.Errorf("Expected %d bytes reserved until the upload finishes, got %d", len(content), got)
+	}
+	var result UploadResult
+	finish(t, queue, job, &result)
+	held.Commit()
+	if got := reserved(); got != 0 {
+		t.Errorf("Expected no bytes reserved after the upload, got %d", got)
+	}
+}
@@ -0,0 +1,269 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+
+	"nebularvault-agent/internal/metadata"
+	"nebularvault-agent/internal/quota"
+	"nebularvault-agent/internal/storage"
+)
+
+// defaultSessionTTL is how long an idle upload session is kept when
+// Config.SessionTTL is unset
+const defaultSessionTTL = 24 * time.Hour
+
+// Errors from upload sessions
+var (
+	// ErrOffsetMismatch means content was sent for an offset other than the
+	// session's; the session reports where to resume
+	ErrOffsetMismatch = errors.New("upload offset does not match the session")
+	// ErrSessionBusy means another request is writing to the session
+	ErrSessionBusy = errors.New("upload session is busy")
+	// ErrSessionOverflow means more content was sent than the session's size
+	ErrSessionOverflow = errors.New("content exceeds the upload's size")
+	// ErrSessionIncomplete means the session has not received all its content
+	ErrSessionIncomplete = errors.New("upload session is incomplete")
+)
+
+// CreateSession starts a resumable upload of size bytes named filename on
+// behalf of owner. The session holds reservation, which may be nil, until it
+// is cancelled or expires, or CompleteSession hands it on.
+func (p *Pipeline) CreateSession(filename string, size int64, owner string, reservation *quota.Reservation) (*metadata.UploadSession, error) {
+	path, err := p.SpoolPath(filename)
+	if err != nil {
+		reservation.Release()
+		return nil, err
+	}
+	if err := os.WriteFile(path, nil, 0600); err != nil {
+		reservation.Release()
+		p.removeSpooled(path)
+		return nil, fmt.Errorf("failed to create spool file: %w", err)
+	}
+
+	now := time.Now()
+	session := &metadata.UploadSession{
+		ID:        uuid.New().String(),
+		Filename:  filename,
+		Size:      size,
+		Path:      path,
+		Owner:     owner,
+		CreatedAt: now,
+		UpdatedAt: now,
+		ExpiresAt: now.Add(p.sessionTTL()),
+	}
+	if err := p.store.PutUploadSession(session); err != nil {
+		reservation.Release()
+		p.removeSpooled(path)
+		return nil, err
+	}
+	if reservation != nil {
+		p.sessionMu.Lock()
+		p.held[session.ID] = reservation
+		p.sessionMu.Unlock()
+	}
+	return session, nil
+}
+
+// HoldsQuota reports whether a session holds a quota reservation. Sessions
+// left from before a restart hold none.
+func (p *Pipeline) HoldsQuota(id string) bool {
+	p.sessionMu.Lock()
+	defer p.sessionMu.Unlock()
+	return p.held[id] != nil
+}
+
+// Session returns an upload session by ID
+func (p *Pipeline) Session(id string) (*metadata.UploadSession, error) {
+	return p.store.GetUploadSession(id)
+}
+
+// AppendSession writes content from r at offset, which must be where the
+// session stands. Content received before r fails is kept, so the session
+// reports where to resume.
+func (p *Pipeline) AppendSession(id string, offset int64, r io.Reader) (*metadata.UploadSession, error) {
+	if !p.lockSession(id) {
+		return nil, ErrSessionBusy
+	}
+	defer p.unlockSession(id)
+
+	session, err := p.store.GetUploadSession(id)
+	if err != nil {
+		return nil, err
+	}
+	if offset != session.Offset {
+		return session, ErrOffsetMismatch
+	}
+
+	file, err := os.OpenFile(session.Path, os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open spool file: %w", err)
+	}
+	// Drop anything written past the recorded offset by an interrupted write
+	if err := file.Truncate(session.Offset); err != nil {
+		file.Close()
+		return nil, fmt.Errorf("failed to truncate spool file: %w", err)
+	}
+	if _, err := file.Seek(session.Offset, io.SeekStart); err != nil {
+		file.Close()
+		return nil, fmt.Errorf("failed to seek spool file: %w", err)
+	}
+
+	written, copyErr := io.Copy(file, io.LimitReader(r, session.Size-session.Offset))
+	if copyErr == nil {
+		var extra [1]byte
+		if n, _ := r.Read(extra[:]); n > 0 {
+			copyErr = ErrSessionOverflow
+		}
+	}
+	if err := file.Close(); err != nil && copyErr == nil {
+		copyErr = fmt.Errorf("failed to write spool file: %w", err)
+	}
+
+	now := time.Now()
+	session.Offset += written
+	session.UpdatedAt = now
+	session.ExpiresAt = now.Add(p.sessionTTL())
+	if err := p.store.PutUploadSession(session); err != nil {
+		return nil, err
+	}
+	return session, copyErr
+}
+
+// CompleteSession queues a session's content for upload and ends the
+// session, returning the quota reservation it held for the caller to commit
+// or release with the job
+func (p *Pipeline) CompleteSession(id string) (*metadata.Job, *quota.Reservation, error) {
+	if !p.lockSession(id) {
+		return nil, nil, ErrSessionBusy
+	}
+	defer p.unlockSession(id)
+
+	session, err := p.store.GetUploadSession(id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if session.Offset != session.Size {
+		return nil, nil, ErrSessionIncomplete
+	}
+
+	job, err := p.EnqueueUpload(UploadRequest{
+		Path:     session.Path,
+		Filename: session.Filename,
+		UserID:   session.Owner,
+	}, session.Owner)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := p.store.DeleteUploadSession(id); err != nil {
+		p.logger.WithError(err).WithField("session_id", id).Warn("Failed to remove completed upload session")
+	}
+	return job, p.takeHeld(id), nil
+}
+
+// CancelSession ends a session and discards its content
+func (p *Pipeline) CancelSession(id string) error {
+	if !p.lockSession(id) {
+		return ErrSessionBusy
+	}
+	defer p.unlockSession(id)
+
+	session, err := p.store.GetUploadSession(id)
+	if err != nil {
+		return err
+	}
+	if err := p.store.DeleteUploadSession(id); err != nil {
+		return err
+	}
+	p.takeHeld(id).Release()
+	p.removeSpooled(session.Path)
+	return nil
+}
+
+// ExpireSessions cancels idle upload sessions every interval until ctx is
+// done
+func (p *Pipeline) ExpireSessions(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		now := time.Now()
+		expired, err := p.store.ListUploadSessions(func(session *metadata.UploadSession) bool {
+			return now.After(session.ExpiresAt)
+		})
+		if err != nil {
+			p.logger.WithError(err).Error("Failed to list upload sessions")
+			continue
+		}
+		for _, session := range expired {
+			if err := p.CancelSession(session.ID); err != nil && err != ErrSessionBusy {
+				p.logger.WithError(err).WithField("session_id", session.ID).Warn("Failed to expire upload session")
+			}
+		}
+	}
+}
+
+// ReadChunk returns a stored file's chunk from the agent's local copy when
+// it is intact, and from 0G Storage otherwise
+func (p *Pipeline) ReadChunk(ctx context.Context, record *metadata.FileRecord, index int) ([]byte, error) {
+	if index < 0 || index >= len(record.ChunkHashes) {
+		return nil, fmt.Errorf("file has no chunk %d", index)
+	}
+	if chunk, err := p.storage.LoadChunk(storage.ChunkID(record.ID, index)); err == nil && chunk.Hash == record.ChunkHashes[index] {
+		return chunk.Data, nil
+	}
+
+	if index >= len(record.StorageRefs) || record.StorageRefs[index] == "" {
+		return nil, fmt.Errorf("chunk %d is not stored in 0G Storage", index)
+	}
+	downloadResp, err := p.zeroG.Download(ctx, record.StorageRefs[index])
+	if err != nil {
+		return nil, fmt.Errorf("failed to download chunk %d from 0G Storage: %w", index, err)
+	}
+	return downloadResp.Data, nil
+}
+
+func (p *Pipeline) sessionTTL() time.Duration {
+	if p.config.SessionTTL > 0 {
+		return p.config.SessionTTL
+	}
+	return defaultSessionTTL
+}
+
+// lockSession claims a session for one request, reporting false if another
+// holds it
+func (p *Pipeline) lockSession(id string) bool {
+	p.sessionMu.Lock()
+	defer p.sessionMu.Unlock()
+	if p.busy[id] {
+		return false
+	}
+	p.busy[id] = true
+	return true
+}
+
+func (p *Pipeline) unlockSession(id string) {
+	p.sessionMu.Lock()
+	defer p.sessionMu.Unlock()
+	delete(p.busy, id)
+}
+
+// takeHeld removes and returns the reservation a session holds, if any
+func (p *Pipeline) takeHeld(id string) *quota.Reservation {
+	p.sessionMu.Lock()
+	defer p.sessionMu.Unlock()
+	reservation := p.held[id]
+	delete(p.held, id)
+	return reservation
+}
@@ -0,0 +1,305 @@
+package policy
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"nebularvault-agent/internal/metadata"
+)
+
+// DefaultReadPermission is the AccessControl permission that lets an account
+// read every file, whoever uploaded it
+const DefaultReadPermission = "READ_ANY_FILE"
+
+// Reasons given for a decision
+const (
+	ReasonPublic     = "public"
+	ReasonOwner      = "owner"
+	ReasonPermission = "permission"
+	ReasonGranted    = "granted"
+	ReasonSuspended  = "suspended"
+	ReasonNotGranted = "not_granted"
+)
+
+// Chain is the on-chain state the engine consults
+type Chain interface {
+	IsUserAuthorized(fileHash, userAddress string) (bool, error)
+	UserStatus(userAddress string) (registered, active bool, err error)
+	HasPermission(userAddress, permission string) (bool, error)
+}
+
+// Config controls how long on-chain answers are reused and which permission
+// grants blanket read access
+type Config struct {
+	CacheTTL       time.Duration
+	ReadPermission string
+}
+
+// Decision is the outcome of an authorization check
+type Decision struct {
+	Allowed bool   `json:"allowed"`
+	Reason  string `json:"reason"`
+}
+
+// userState is an account's cached standing in AccessControl
+type userState struct {
+	registered bool
+	active     bool
+	readAll    bool
+	expires    time.Time
+}
+
+// grantState is a cached FileStorage grant
+type grantState struct {
+	authorized bool
+	expires    time.Time
+}
+
+// Engine decides whether a wallet may read a file. Public files and the
+// uploader's own files are decided from the local record; everything else
+// asks the chain, whose answers are cached for CacheTTL and dropped early
+// when the indexer sees an event that changes them.
+type Engine struct {
+	store  *metadata.Store
+	chain  Chain
+	config Config
+	logger *logrus.Logger
+	now    func() time.Time
+
+	mu     sync.Mutex
+	users  map[string]*userState
+	grants map[string]map[string]*grantState
+	swept  time.Time
+}
+
+// NewEngine creates a policy engine. chain may be nil, in which case only
+// public files and the caller's own files are readable.
+func NewEngine(store *metadata.Store, chain Chain, config Config, logger *logrus.Logger) *Engine {
+	if config.CacheTTL == 0 {
+		config.CacheTTL = time.Minute
+	}
+	if config.ReadPermission == "" {
+		config.ReadPermission = DefaultReadPermission
+	}
+
+	return &Engine{
+		store:  store,
+		chain:  chain,
+		config: config,
+		logger: logger,
+		now:    time.Now,
+		users:  make(map[string]*userState),
+		grants: make(map[string]map[string]*grantState),
+	}
+}
+
+// Authorize decides whether userAddress may read the file with the given
+// content hash. It returns metadata.ErrNotFound for files the agent has no
+// record of, and an error if the chain could not be asked.
+func (e *Engine) Authorize(userAddress, fileHash string) (Decision, error) {
+	record, err := e.store.GetFile(hashKey(fileHash))
+	if err != nil {
+		return Decision{}, err
+	}
+
+	owner := strings.EqualFold(record.UserID, userAddress)
+	if e.chain == nil {
+		switch {
+		case record.IsPublic:
+			return Decision{Allowed: true, Reason: ReasonPublic}, nil
+		case owner:
+			return Decision{Allowed: true, Reason: ReasonOwner}, nil
+		}
+		return Decision{Reason: ReasonNotGranted}, nil
+	}
+
+	// A suspended account loses access to everything, its own files included
+	user, err := e.user(userAddress)
+	if err != nil {
+		return Decision{}, err
+	}
+	switch {
+	case user.registered && !user.active:
+		return Decision{Reason: ReasonSuspended}, nil
+	case record.IsPublic:
+		return Decision{Allowed: true, Reason: ReasonPublic}, nil
+	case owner:
+		return Decision{Allowed: true, Reason: ReasonOwner}, nil
+	case user.readAll:
+		return Decision{Allowed: true, Reason: ReasonPermission}, nil
+	}
+
+	granted, err := e.grant(record.Hash, userAddress)
+	if err != nil {
+		return Decision{}, err
+	}
+	if granted {
+		return Decision{Allowed: true, Reason: ReasonGranted}, nil
+	}
+	return Decision{Reason: ReasonNotGranted}, nil
+}
+
+// AuthorizeRef decides whether userAddress may read what ref names: a file
+// by its content hash, or a chunk by the 0G root it is stored under. A
+// chunk shared by several files is readable through any of them.
+func (e *Engine) AuthorizeRef(userAddress, ref string) (Decision, error) {
+	decision, err := e.Authorize(userAddress, ref)
+	if err != metadata.ErrNotFound {
+		return decision, err
+	}
+
+	records, err := e.store.FilesByStorageRef(ref)
+	if err != nil {
+		return Decision{}, err
+	}
+	if len(records) == 0 {
+		return Decision{}, metadata.ErrNotFound
+	}
+	for _, record := range records {
+		if decision, err = e.Authorize(userAddress, record.Hash); err != nil || decision.Allowed {
+			return decision, err
+		}
+	}
+	return decision, nil
+}
+
+// IsOwner reports whether userAddress uploaded the file through the agent
+func (e *Engine) IsOwner(userAddress, fileHash string) (bool, error) {
+	record, err := e.store.GetFile(hashKey(fileHash))
+	if err != nil {
+		return false, err
+	}
+	return strings.EqualFold(record.UserID, userAddress), nil
+}
+
+// Forget drops the cached grant for an account on a file, as after the
+// agent grants or revokes it itself
+func (e *Engine) Forget(fileHash, userAddress string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if grants, ok := e.grants[hashKey(fileHash)]; ok {
+		delete(grants, addressKey(userAddress))
+	}
+}
+
+// Invalidate drops cached answers that indexed events have made stale.
+// Role, suspension and registration changes reset the account; a deleted
+// file loses its grants.
+func (e *Engine) Invalidate(events []*metadata.Event) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for _, event := range events {
+		switch event.Name {
+		case metadata.EventUserSuspended, metadata.EventUserUnsuspended,
+			metadata.EventUserRoleRevoked, metadata.EventUserRoleGranted,
+			metadata.EventUserRegistered:
+			account := addressKey(event.Account)
+			delete(e.users, account)
+			for _, grants := range e.grants {
+				delete(grants, account)
+			}
+			e.logger.Debugf("Dropped cached permissions of %s after %s", event.Account, event.Name)
+		case metadata.EventFileDeleted:
+			delete(e.grants, hashKey(event.FileHash))
+			e.logger.Debugf("Dropped cached grants of %s after %s", event.FileHash, event.Name)
+		}
+	}
+}
+
+// user returns an account's AccessControl standing, asking the chain when
+// it is not cached
+func (e *Engine) user(address string) (*userState, error) {
+	key := addressKey(address)
+	now := e.now()
+
+	e.mu.Lock()
+	cached, ok := e.users[key]
+	e.mu.Unlock()
+	if ok && now.Before(cached.expires) {
+		return cached, nil
+	}
+
+	registered, active, err := e.chain.UserStatus(address)
+	if err != nil {
+		return nil, err
+	}
+	state := &userState{registered: registered, active: active, expires: now.Add(e.config.CacheTTL)}
+	// hasPermission reverts for unregistered accounts
+	if registered {
+		if state.readAll, err = e.chain.HasPermission(address, e.config.ReadPermission); err != nil {
+			return nil, err
+		}
+	}
+
+	e.mu.Lock()
+	e.users[key] = state
+	e.sweep(now)
+	e.mu.Unlock()
+	return state, nil
+}
+
+// grant reports whether an account has been granted access to a file,
+// asking the chain when it is not cached
+func (e *Engine) grant(fileHash, address string) (bool, error) {
+	file, account := hashKey(fileHash), addressKey(address)
+	now := e.now()
+
+	e.mu.Lock()
+	cached, ok := e.grants[file][account]
+	e.mu.Unlock()
+	if ok && now.Before(cached.expires) {
+		return cached.authorized, nil
+	}
+
+	authorized, err := e.chain.IsUserAuthorized("0x"+file, address)
+	if err != nil {
+		return false, err
+	}
+
+	e.mu.Lock()
+	if e.grants[file] == nil {
+		e.grants[file] = make(map[string]*grantState)
+	}
+	e.grants[file][account] = &grantState{authorized: authorized, expires: now.Add(e.config.CacheTTL)}
+	e.sweep(now)
+	e.mu.Unlock()
+	return authorized, nil
+}
+
+// sweep drops expired entries, at most once per TTL, so accounts that stop
+// calling do not stay cached forever. It is called with mu held.
+func (e *Engine) sweep(now time.Time) {
+	if now.Sub(e.swept) < e.config.CacheTTL {
+		return
+	}
+	e.swept = now
+
+	for key, state := range e.users {
+		if !now.Before(state.expires) {
+			delete(e.users, key)
+		}
+	}
+	for file, grants := range e.grants {
+		for key, state := range grants {
+			if !now.Before(state.expires) {
+				delete(grants, key)
+			}
+		}
+		if len(grants) == 0 {
+			delete(e.grants, file)
+		}
+	}
+}
+
+func addressKey(address string) string {
+	return strings.ToLower(address)
+}
+
+func hashKey(fileHash string) string {
+	return strings.ToLower(strings.TrimPrefix(fileHash, "0x"))
+}
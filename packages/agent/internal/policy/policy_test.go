@@ -0,0 +1,137 @@
+package policy
+
+import (
+	"io"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"nebularvault-agent/internal/metadata"
+)
+
+const (
+	alice = "0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B"
+	bob   = "0x4B20993Bc481177ec7E8f571ceCaE8A9e22C02db"
+)
+
+// fakeChain answers from maps and counts how often it is asked
+type fakeChain struct {
+	suspended map[string]bool
+	readAll   map[string]bool
+	grants    map[string]bool
+	calls     int
+}
+
+func (f *fakeChain) IsUserAuthorized(fileHash, userAddress string) (bool, error) {
+	f.calls++
+	return f.grants[fileHash+"/"+strings.ToLower(userAddress)], nil
+}
+
+func (f *fakeChain) UserStatus(userAddress string) (bool, bool, error) {
+	f.calls++
+	return true, !f.suspended[strings.ToLower(userAddress)], nil
+}
+
+func (f *fakeChain) HasPermission(userAddress, permission string) (bool, error) {
+	f.calls++
+	return permission == DefaultReadPermission && f.readAll[strings.ToLower(userAddress)], nil
+}
+
+func newTestEngine(t *testing.T, chain Chain) (*Engine, *metadata.Store) {
+	t.Helper()
+
+	store, err := metadata.Open(filepath.Join(t.TempDir(), "metadata"))
+	if err != nil {
+		t.Fatalf("Failed to open store: %v", err)
+	}
+	t.Cleanup(func() { store.Close() })
+
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	return NewEngine(store, chain, Config{CacheTTL: time.Minute}, logger), store
+}
+
+func putFile(t *testing.T, store *metadata.Store, hash, owner string, public bool) {
+	t.Helper()
+
+	if err := store.PutFile(&metadata.FileRecord{Hash: hash, UserID: owner, IsPublic: public}); err != nil {
+		t.Fatalf("Failed to store file: %v", err)
+	}
+}
+
+func expectDecision(t *testing.T, engine *Engine, user, hash string, allowed bool, reason string) {
+	t.Helper()
+
+	decision, err := engine.Authorize(user, hash)
+	if err != nil {
+		t.Fatalf("Failed to authorize: %v", err)
+	}
+	if decision.Allowed != allowed || decision.Reason != reason {
+		t.Errorf("Expected allowed=%v (%s) for %s on %s, got %+v", allowed, reason, user, hash, decision)
+	}
+}
+
+func TestEngine_WithoutChain(t *testing.T) {
+	engine, store := newTestEngine(t, nil)
+	private := strings.Repeat("aa", 32)
+	public := strings.Repeat("bb", 32)
+	putFile(t, store, private, alice, false)
+	putFile(t, store, public, alice, true)
+
+	expectDecision(t, engine, alice, private, true, ReasonOwner)
+	expectDecision(t, engine, strings.ToLower(alice), "0x"+private, true, ReasonOwner)
+	expectDecision(t, engine, bob, private, false, ReasonNotGranted)
+	expectDecision(t, engine, bob, public, true, ReasonPublic)
+
+	if _, err := engine.Authorize(bob, strings.Repeat("cc", 32)); err != metadata.ErrNotFound {
+		t.Errorf("Expected ErrNotFound for an unknown file, got %v", err)
+	}
+}
+
+func TestEngine_ChainPermissions(t *testing.T) {
+	chain := &fakeChain{
+		suspended: map[string]bool{},
+		readAll:   map[string]bool{},
+		grants:    map[string]bool{},
+	}
+	engine, store := newTestEngine(t, chain)
+	hash := strings.Repeat("aa", 32)
+	putFile(t, store, hash, alice, false)
+
+	expectDecision(t, engine, bob, hash, false, ReasonNotGranted)
+
+	// Negative answers are cached until the agent changes the grant itself
+	chain.grants["0x"+hash+"/"+strings.ToLower(bob)] = true
+	expectDecision(t, engine, bob, hash, false, ReasonNotGranted)
+	engine.Forget("0x"+hash, bob)
+	expectDecision(t, engine, bob, hash, true, ReasonGranted)
+
+	calls := chain.calls
+	expectDecision(t, engine, bob, hash, true, ReasonGranted)
+	if chain.calls != calls {
+		t.Errorf("Expected a cached decision, chain was asked %d more times", chain.calls-calls)
+	}
+
+	// Suspension is picked up from the indexed event and overrides ownership
+	expectDecision(t, engine, alice, hash, true, ReasonOwner)
+	chain.suspended[strings.ToLower(alice)] = true
+	expectDecision(t, engine, alice, hash, true, ReasonOwner)
+	engine.Invalidate([]*metadata.Event{{Name: metadata.EventUserSuspended, Account: strings.ToLower(alice)}})
+	expectDecision(t, engine, alice, hash, false, ReasonSuspended)
+
+	// A revoked grant disappears when its cache entry expires
+	chain.grants["0x"+hash+"/"+strings.ToLower(bob)] = false
+	expectDecision(t, engine, bob, hash, true, ReasonGranted)
+	engine.now = func() time.Time { return time.Now().Add(2 * time.Minute) }
+	expectDecision(t, engine, bob, hash, false, ReasonNotGranted)
+
+	// The read permission opens every file, and role changes reset it
+	carol := "0x0000000000000000000000000000000000000c01"
+	expectDecision(t, engine, carol, hash, false, ReasonNotGranted)
+	chain.readAll[carol] = true
+	engine.Invalidate([]*metadata.Event{{Name: metadata.EventUserRoleRevoked, Account: carol}})
+	expectDecision(t, engine, carol, hash, true, ReasonPermission)
+}
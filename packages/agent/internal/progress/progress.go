@@ -0,0 +1,61 @@
+package progress
+
+import (
+	"context"
+	"time"
+)
+
+// Stages reported while files move through the agent
+const (
+	// Chunking reports bytes read while a file is split into chunks
+	Chunking = "chunking"
+	// MerkleRoot reports the root computed over a file's chunk hashes
+	MerkleRoot = "merkle_root"
+	// ChunkUploaded reports a chunk stored on 0G Storage
+	ChunkUploaded = "chunk_uploaded"
+	// Recorded reports a file's metadata record being saved
+	Recorded = "recorded"
+	// ChunkVerified reports a chunk checked against 0G Storage
+	ChunkVerified = "chunk_verified"
+	// ChunkRepaired reports a missing chunk uploaded again
+	ChunkRepaired = "chunk_repaired"
+	// TxSubmitted reports an anchor transaction sent to the chain
+	TxSubmitted = "tx_submitted"
+	// TxConfirmed reports an anchor transaction mined with its confirmations
+	TxConfirmed = "tx_confirmed"
+)
+
+// Event is one step of progress in a long-running operation
+type Event struct {
+	Stage string                 `json:"stage"`
+	Data  map[string]interface{} `json:"data,omitempty"`
+	Time  time.Time              `json:"time"`
+}
+
+// Reporter receives events; it must not block
+type Reporter func(Event)
+
+type reporterKey struct{}
+
+// WithReporter returns a context whose operations report to reporter
+func WithReporter(ctx context.Context, reporter Reporter) context.Context {
+	return context.WithValue(ctx, reporterKey{}, reporter)
+}
+
+// Report sends an event to the context's reporter, if it has one
+func Report(ctx context.Context, stage string, data map[string]interface{}) {
+	reporter, ok := ctx.Value(reporterKey{}).(Reporter)
+	if !ok || reporter == nil {
+		return
+	}
+	reporter(Event{Stage: stage, Data: data, Time: time.Now()})
+}
+
+// Percent is done as a whole percentage of total, treating an empty total
+// as complete
+func Percent(done, total int64) int {
+	if total <= 0 {
+		return 100
+	}
+	return int(done * 100 / total)
+}
@@ -0,0 +1,275 @@
+package quota
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"nebularvault-agent/internal/metadata"
+)
+
+var (
+	// ErrNotRegistered is returned for accounts AccessControl gives no quota
+	ErrNotRegistered = errors.New("account is not registered on chain")
+	// ErrSuspended is returned for suspended accounts
+	ErrSuspended = errors.New("account is suspended")
+	// ErrExceeded is returned when an upload does not fit the remaining quota
+	ErrExceeded = errors.New("storage quota exceeded")
+)
+
+// Chain is the AccessControl state quotas are read from
+type Chain interface {
+	UserStatus(userAddress string) (registered, active bool, err error)
+	StorageInfo(userAddress string) (quota, used uint64, err error)
+}
+
+// Usage is an account's storage standing. Usage is the larger of what the
+// chain records and what the agent holds for the account, less files the
+// indexer has seen deleted.
+type Usage struct {
+	Quota     uint64 `json:"quota"`
+	ChainUsed uint64 `json:"chain_used"`
+	LocalUsed uint64 `json:"local_used"`
+	Reserved  uint64 `json:"reserved"`
+	Available uint64 `json:"available"`
+}
+
+// account is an account's cached standing and its in-flight reservations,
+// which outlive the cache
+type account struct {
+	registered bool
+	active     bool
+	quota      uint64
+	chainUsed  uint64
+	localUsed  uint64
+	expires    time.Time
+	reserved   uint64
+}
+
+func (a *account) usage() Usage {
+	used := a.chainUsed
+	if a.localUsed > used {
+		used = a.localUsed
+	}
+	usage := Usage{
+		Quota:     a.quota,
+		ChainUsed: a.chainUsed,
+		LocalUsed: a.localUsed,
+		Reserved:  a.reserved,
+	}
+	if a.quota > used+a.reserved {
+		usage.Available = a.quota - used - a.reserved
+	}
+	return usage
+}
+
+// Tracker enforces AccessControl storage quotas on uploads. Quotas are read
+// from the chain and cached for a TTL; space is reserved while an upload is
+// in flight so concurrent uploads cannot overshoot together.
+type Tracker struct {
+	store  *metadata.Store
+	chain  Chain
+	ttl    time.Duration
+	logger *logrus.Logger
+	now    func() time.Time
+
+	mu       sync.Mutex
+	accounts map[string]*account
+}
+
+// NewTracker creates a quota tracker that caches chain answers for ttl
+func NewTracker(store *metadata.Store, chain Chain, ttl time.Duration, logger *logrus.Logger) *Tracker {
+	if ttl == 0 {
+		ttl = 30 * time.Second
+	}
+
+	return &Tracker{
+		store:    store,
+		chain:    chain,
+		ttl:      ttl,
+		logger:   logger,
+		now:      time.Now,
+		accounts: make(map[string]*account),
+	}
+}
+
+// Reservation holds space for an upload in flight. A nil reservation is
+// valid and does nothing, so callers exempt from quotas need no checks.
+type Reservation struct {
+	tracker *Tracker
+	key     string
+	size    uint64
+	done    bool
+}
+
+// Reserve holds size bytes of userAddress's quota. It fails with
+// ErrNotRegistered, ErrSuspended or ErrExceeded, returning the account's
+// usage alongside the latter.
+func (t *Tracker) Reserve(userAddress string, size uint64) (*Reservation, Usage, error) {
+	key := strings.ToLower(userAddress)
+	if err := t.refresh(key, userAddress); err != nil {
+		return nil, Usage{}, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	a := t.accounts[key]
+	switch {
+	case !a.registered:
+		return nil, Usage{}, ErrNotRegistered
+	case !a.active:
+		return nil, Usage{}, ErrSuspended
+	}
+	usage := a.usage()
+	if size > usage.Available {
+		return nil, usage, ErrExceeded
+	}
+
+	a.reserved += size
+	return &Reservation{tracker: t, key: key, size: size}, a.usage(), nil
+}
+
+// Usage returns userAddress's storage standing
+func (t *Tracker) Usage(userAddress string) (Usage, error) {
+	key := strings.ToLower(userAddress)
+	if err := t.refresh(key, userAddress); err != nil {
+		return Usage{}, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	a := t.accounts[key]
+	if !a.registered {
+		return Usage{}, ErrNotRegistered
+	}
+	return a.usage(), nil
+}
+
+// Commit releases the reservation once the upload is stored. The account's
+// usage is recounted on its next check, so content it already held is not
+// counted twice.
+func (r *Reservation) Commit() {
+	r.finish(true)
+}
+
+// Release gives back the reserved space of an upload that failed. It does
+// nothing after Commit, so it can be deferred.
+func (r *Reservation) Release() {
+	r.finish(false)
+}
+
+func (r *Reservation) finish(stored bool) {
+	if r == nil || r.done {
+		return
+	}
+	r.done = true
+
+	t := r.tracker
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if a, ok := t.accounts[r.key]; ok {
+		a.reserved -= r.size
+		if stored {
+			a.expires = time.Time{}
+		}
+	}
+}
+
+// Invalidate drops cached standings that indexed events have made stale: a
+// changed quota or suspension resets the account, and a deleted file may
+// belong to anyone.
+func (t *Tracker) Invalidate(events []*metadata.Event) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, event := range events {
+		switch event.Name {
+		case metadata.EventStorageQuotaUpdated, metadata.EventUserSuspended,
+			metadata.EventUserUnsuspended, metadata.EventUserRegistered:
+			if a, ok := t.accounts[strings.ToLower(event.Account)]; ok {
+				a.expires = time.Time{}
+			}
+		case metadata.EventFileDeleted:
+			for _, a := range t.accounts {
+				a.expires = time.Time{}
+			}
+		}
+	}
+}
+
+// refresh reloads an account's standing if its cache has expired, and drops
+// other idle accounts
+func (t *Tracker) refresh(key, address string) error {
+	now := t.now()
+
+	t.mu.Lock()
+	a, ok := t.accounts[key]
+	fresh := ok && now.Before(a.expires)
+	t.mu.Unlock()
+	if fresh {
+		return nil
+	}
+
+	loaded := &account{expires: now.Add(t.ttl)}
+	registered, active, err := t.chain.UserStatus(address)
+	if err != nil {
+		return errors.Wrap(err, "failed to read account status")
+	}
+	loaded.registered, loaded.active = registered, active
+	// getUserStorageInfo reverts for unregistered accounts
+	if registered {
+		if loaded.quota, loaded.chainUsed, err = t.chain.StorageInfo(address); err != nil {
+			return errors.Wrap(err, "failed to read storage info")
+		}
+		if loaded.localUsed, err = t.localUsage(address); err != nil {
+			return err
+		}
+		if loaded.chainUsed != loaded.localUsed {
+			t.logger.Debugf("Storage usage of %s differs: chain %d, agent %d", address, loaded.chainUsed, loaded.localUsed)
+		}
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if current, ok := t.accounts[key]; ok {
+		loaded.reserved = current.reserved
+	}
+	t.accounts[key] = loaded
+	for other, a := range t.accounts {
+		if a.reserved == 0 && !now.Before(a.expires) {
+			delete(t.accounts, other)
+		}
+	}
+	return nil
+}
+
+// localUsage totals the files the agent holds for an account, skipping
+// those the indexer has seen deleted on chain
+func (t *Tracker) localUsage(address string) (uint64, error) {
+	records, err := t.store.ListFiles(func(record *metadata.FileRecord) bool {
+		return strings.EqualFold(record.UserID, address)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var total uint64
+	for _, record := range records {
+		file, err := t.store.ChainFile("0x" + record.Hash)
+		if err != nil && err != metadata.ErrNotFound {
+			return 0, err
+		}
+		if file != nil && !file.Active {
+			continue
+		}
+		total += uint64(record.Size)
+	}
+	return total, nil
+}
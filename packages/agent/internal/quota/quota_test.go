@@ -0,0 +1,146 @@
+package quota
+
+import (
+	"io"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+
+	"nebularvault-agent/internal/metadata"
+)
+
+const alice = "0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B"
+
+// fakeChain answers from fields and counts how often it is asked
+type fakeChain struct {
+	registered bool
+	suspended  bool
+	quota      uint64
+	used       uint64
+	calls      int
+}
+
+func (f *fakeChain) UserStatus(string) (bool, bool, error) {
+	f.calls++
+	return f.registered, f.registered && !f.suspended, nil
+}
+
+func (f *fakeChain) StorageInfo(string) (uint64, uint64, error) {
+	f.calls++
+	return f.quota, f.used, nil
+}
+
+func newTestTracker(t *testing.T, chain Chain) (*Tracker, *metadata.Store) {
+	t.Helper()
+
+	store, err := metadata.Open(filepath.Join(t.TempDir(), "metadata"))
+	if err != nil {
+		t.Fatalf("Failed to open store: %v", err)
+	}
+	t.Cleanup(func() { store.Close() })
+
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	return NewTracker(store, chain, 0, logger), store
+}
+
+func putFile(t *testing.T, store *metadata.Store, hash string, size int64) {
+	t.Helper()
+
+	if err := store.PutFile(&metadata.FileRecord{Hash: hash, Size: size, UserID: alice}); err != nil {
+		t.Fatalf("Failed to store file: %v", err)
+	}
+}
+
+func TestTracker_Reservations(t *testing.T) {
+	chain := &fakeChain{registered: true, quota: 100}
+	tracker, store := newTestTracker(t, chain)
+	putFile(t, store, strings.Repeat("aa", 32), 30)
+
+	first, usage, err := tracker.Reserve(alice, 50)
+	if err != nil {
+		t.Fatalf("Failed to reserve: %v", err)
+	}
+	if usage.LocalUsed != 30 || usage.Reserved != 50 || usage.Available != 20 {
+		t.Errorf("Unexpected usage after reserving: %+v", usage)
+	}
+
+	// Concurrent uploads cannot overshoot the quota together
+	if _, usage, err := tracker.Reserve(strings.ToLower(alice), 30); err != ErrExceeded || usage.Available != 20 {
+		t.Errorf("Expected ErrExceeded with 20 bytes available, got %+v, %v", usage, err)
+	}
+	first.Release()
+	first.Release()
+	second, _, err := tracker.Reserve(alice, 30)
+	if err != nil {
+		t.Fatalf("Expected released space to be reusable, got %v", err)
+	}
+
+	// A committed upload is counted from the store rather than the reservation
+	putFile(t, store, strings.Repeat("bb", 32), 25)
+	second.Commit()
+	second.Release()
+	usage, err = tracker.Usage(alice)
+	if err != nil {
+		t.Fatalf("Failed to get usage: %v", err)
+	}
+	if usage.LocalUsed != 55 || usage.Reserved != 0 || usage.Available != 45 {
+		t.Errorf("Unexpected usage after commit: %+v", usage)
+	}
+
+	// Answers are cached until the TTL or an indexed event says otherwise
+	calls := chain.calls
+	chain.quota = 200
+	if usage, _ := tracker.Usage(alice); usage.Quota != 100 || chain.calls != calls {
+		t.Errorf("Expected a cached quota, got %+v after %d calls", usage, chain.calls-calls)
+	}
+	tracker.Invalidate([]*metadata.Event{{Name: metadata.EventStorageQuotaUpdated, Account: strings.ToLower(alice)}})
+	if usage, _ := tracker.Usage(alice); usage.Quota != 200 {
+		t.Errorf("Expected the updated quota, got %+v", usage)
+	}
+}
+
+func TestTracker_ReconcilesWithChain(t *testing.T) {
+	chain := &fakeChain{registered: true, quota: 100, used: 60}
+	tracker, store := newTestTracker(t, chain)
+	kept := strings.Repeat("aa", 32)
+	deleted := strings.Repeat("bb", 32)
+	putFile(t, store, kept, 20)
+	putFile(t, store, deleted, 30)
+
+	// The chain records more than the agent holds, so its figure wins
+	usage, err := tracker.Usage(alice)
+	if err != nil {
+		t.Fatalf("Failed to get usage: %v", err)
+	}
+	if usage.ChainUsed != 60 || usage.LocalUsed != 50 || usage.Available != 40 {
+		t.Errorf("Unexpected usage: %+v", usage)
+	}
+
+	// Files the indexer has seen deleted no longer count
+	chain.used = 0
+	events := []*metadata.Event{
+		{Name: metadata.EventFileUploaded, FileHash: "0x" + deleted, BlockNumber: 1, LogIndex: 0},
+		{Name: metadata.EventFileDeleted, FileHash: "0x" + deleted, BlockNumber: 2, LogIndex: 0},
+	}
+	if err := store.Apply(&metadata.Batch{Cursor: 2, Events: events}, 0); err != nil {
+		t.Fatalf("Failed to index events: %v", err)
+	}
+	tracker.Invalidate(events)
+	if usage, err = tracker.Usage(alice); err != nil || usage.LocalUsed != 20 || usage.Available != 80 {
+		t.Errorf("Expected the deleted file to be released, got %+v, %v", usage, err)
+	}
+
+	chain.suspended = true
+	tracker.Invalidate([]*metadata.Event{{Name: metadata.EventUserSuspended, Account: strings.ToLower(alice)}})
+	if _, _, err := tracker.Reserve(alice, 1); err != ErrSuspended {
+		t.Errorf("Expected ErrSuspended, got %v", err)
+	}
+
+	unregistered, _ := newTestTracker(t, &fakeChain{})
+	if _, _, err := unregistered.Reserve(alice, 1); err != ErrNotRegistered {
+		t.Errorf("Expected ErrNotRegistered, got %v", err)
+	}
+}
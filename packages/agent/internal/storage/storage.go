@@ -1,16 +1,25 @@
 package storage
 
 import (
+	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
 	"strings"
+	"syscall"
+	"time"
 
 	"github.com/google/uuid"
-	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+
+	"nebularvault-agent/internal/apierror"
+	"nebularvault-agent/internal/metrics"
+	"nebularvault-agent/internal/progress"
+	"nebularvault-agent/internal/tracing"
 )
 
 type FileChunk struct {
@@ -49,16 +58,22 @@ func NewStorageManager(dataDir, tempDir string, chunkSize int) *StorageManager {
 	}
 }
 
-func (sm *StorageManager) ChunkFile(filePath string) (*FileMetadata, error) {
+func (sm *StorageManager) ChunkFile(ctx context.Context, filePath string) (metadata *FileMetadata, err error) {
+	_, span := tracing.Start(ctx, "storage.ChunkFile", attribute.String("file.name", filepath.Base(filePath)))
+	defer func() { tracing.End(span, err) }()
+	start := time.Now()
 	file, err := os.Open(filePath)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to open file")
+		return nil, pathError(err, apierror.FileNotFound, "File")
 	}
 	defer file.Close()
 
 	fileInfo, err := file.Stat()
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to get file info")
+		return nil, apierror.Wrap(err, apierror.Internal, "Failed to get file info")
+	}
+	if fileInfo.IsDir() {
+		return nil, apierror.New(apierror.InvalidPath, "File is a directory")
 	}
 
 	fileID := uuid.New().String()
@@ -68,11 +83,13 @@ func (sm *StorageManager) ChunkFile(filePath string) (*FileMetadata, error) {
 	// Read file in chunks
 	buffer := make([]byte, sm.chunkSize)
 	chunkIndex := 0
+	var bytesRead int64
+	reported := -1
 
 	for {
 		n, err := file.Read(buffer)
 		if err != nil && err != io.EOF {
-			return nil, errors.Wrap(err, "failed to read file chunk")
+			return nil, apierror.Wrap(err, apierror.Internal, "Failed to read file chunk")
 		}
 
 		if n == 0 {
@@ -87,7 +104,7 @@ func (sm *StorageManager) ChunkFile(filePath string) (*FileMetadata, error) {
 		chunkHashes = append(chunkHashes, chunkHash)
 
 		chunk := FileChunk{
-			ID:       fmt.Sprintf("%s_chunk_%d", fileID, chunkIndex),
+			ID:       ChunkID(fileID, chunkIndex),
 			Index:    chunkIndex,
 			Data:     chunkData,
 			Hash:     chunkHash,
@@ -98,6 +115,18 @@ func (sm *StorageManager) ChunkFile(filePath string) (*FileMetadata, error) {
 		chunks = append(chunks, chunk)
 		chunkIndex++
 
+		// Report each whole percent at most once
+		bytesRead += int64(n)
+		if percent := progress.Percent(bytesRead, fileInfo.Size()); percent > reported {
+			reported = percent
+			progress.Report(ctx, progress.Chunking, map[string]interface{}{
+				"chunks":      chunkIndex,
+				"bytes":       bytesRead,
+				"total_bytes": fileInfo.Size(),
+				"percent":     percent,
+			})
+		}
+
 		if err == io.EOF {
 			break
 		}
@@ -105,11 +134,15 @@ func (sm *StorageManager) ChunkFile(filePath string) (*FileMetadata, error) {
 
 	// Calculate Merkle root
 	merkleRoot := sm.calculateMerkleRoot(chunkHashes)
+	progress.Report(ctx, progress.MerkleRoot, map[string]interface{}{
+		"merkle_root": merkleRoot,
+		"chunks":      len(chunks),
+	})
 
 	// Calculate file hash
 	fileHash := sm.calculateFileHash(filePath)
 
-	metadata := &FileMetadata{
+	metadata = &FileMetadata{
 		ID:         fileID,
 		Filename:   filepath.Base(filePath),
 		Size:       fileInfo.Size(),
@@ -121,10 +154,17 @@ func (sm *StorageManager) ChunkFile(filePath string) (*FileMetadata, error) {
 		UserID:     "anonymous", // This would come from authentication
 		IsPublic:   false,
 	}
+	metrics.ObserveChunking(metadata.Size, len(chunks), time.Since(start))
+	span.SetAttributes(attribute.Int64("file.size", metadata.Size), attribute.Int("storage.chunks", len(chunks)))
 
 	return metadata, nil
 }
 
+// ChunkID names the chunk at index of a chunked file
+func ChunkID(fileID string, index int) string {
+	return fmt.Sprintf("%s_chunk_%d", fileID, index)
+}
+
 func (sm *StorageManager) calculateHash(data []byte) string {
 	hash := sha256.Sum256(data)
 	return hex.EncodeToString(hash[:])
@@ -199,11 +239,11 @@ func (sm *StorageManager) SaveChunk(chunk *FileChunk) error {
 	chunkPath := filepath.Join(sm.dataDir, "chunks", chunk.ID)
 	
 	if err := os.MkdirAll(filepath.Dir(chunkPath), 0755); err != nil {
-		return errors.Wrap(err, "failed to create chunk directory")
+		return apierror.Wrap(err, apierror.Internal, "Failed to create chunk directory")
 	}
 
 	if err := os.WriteFile(chunkPath, chunk.Data, 0644); err != nil {
-		return errors.Wrap(err, "failed to save chunk")
+		return apierror.Wrap(err, apierror.Internal, "Failed to save chunk")
 	}
 
 	return nil
@@ -214,7 +254,7 @@ func (sm *StorageManager) LoadChunk(chunkID string) (*FileChunk, error) {
 	
 	data, err := os.ReadFile(chunkPath)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to read chunk")
+		return nil, pathError(err, apierror.ChunkNotFound, "Chunk "+chunkID)
 	}
 
 	chunk := &FileChunk{
@@ -227,24 +267,44 @@ func (sm *StorageManager) LoadChunk(chunkID string) (*FileChunk, error) {
 	return chunk, nil
 }
 
-func (sm *StorageManager) ReconstructFile(metadata *FileMetadata, outputPath string) error {
+func (sm *StorageManager) ReconstructFile(ctx context.Context, metadata *FileMetadata, outputPath string) (err error) {
+	_, span := tracing.Start(ctx, "storage.ReconstructFile",
+		attribute.Int64("file.size", metadata.Size), attribute.Int("storage.chunks", len(metadata.Chunks)))
+	defer func() { tracing.End(span, err) }()
+
 	file, err := os.Create(outputPath)
 	if err != nil {
-		return errors.Wrap(err, "failed to create output file")
+		return pathError(err, apierror.InvalidPath, "Output path")
 	}
 	defer file.Close()
 
 	// Sort chunks by index
 	for _, chunk := range metadata.Chunks {
 		if _, err := file.Write(chunk.Data); err != nil {
-			return errors.Wrap(err, "failed to write chunk to file")
+			return apierror.Wrap(err, apierror.Internal, "Failed to write chunk to file")
 		}
 	}
 
 	return nil
 }
 
-func (sm *StorageManager) VerifyFileIntegrity(metadata *FileMetadata) bool {
+// pathError types a failure to open what: a missing path gets the code
+// missing, an unusable one invalid_path, and anything else is internal
+func pathError(err error, missing apierror.Code, what string) error {
+	switch {
+	case os.IsNotExist(err):
+		return apierror.Wrap(err, missing, what+" does not exist")
+	case os.IsPermission(err), errors.Is(err, syscall.EISDIR), errors.Is(err, syscall.ENOTDIR):
+		return apierror.Wrap(err, apierror.InvalidPath, what+" is not accessible")
+	default:
+		return apierror.Wrap(err, apierror.Internal, what+" could not be opened")
+	}
+}
+
+func (sm *StorageManager) VerifyFileIntegrity(ctx context.Context, metadata *FileMetadata) bool {
+	_, span := tracing.Start(ctx, "storage.VerifyFileIntegrity", attribute.Int("storage.chunks", len(metadata.Chunks)))
+	defer span.End()
+
 	// Verify Merkle root
 	var chunkHashes []string
 	for _, chunk := range metadata.Chunks {
@@ -252,5 +312,7 @@ func (sm *StorageManager) VerifyFileIntegrity(metadata *FileMetadata) bool {
 	}
 
 	calculatedMerkleRoot := sm.calculateMerkleRoot(chunkHashes)
-	return calculatedMerkleRoot == metadata.MerkleRoot
+	valid := calculatedMerkleRoot == metadata.MerkleRoot
+	span.SetAttributes(attribute.Bool("storage.valid", valid))
+	return valid
 }
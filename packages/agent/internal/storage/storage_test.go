@@ -1,9 +1,12 @@
 package storage
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	"testing"
+
+	"nebularvault-agent/internal/apierror"
 )
 
 func TestStorageManager_ChunkFile(t *testing.T) {
@@ -22,7 +25,7 @@ func TestStorageManager_ChunkFile(t *testing.T) {
 	storageManager := NewStorageManager(tempDir, tempDir, 10) // 10 byte chunks
 
 	// Test chunking
-	metadata, err := storageManager.ChunkFile(testFile)
+	metadata, err := storageManager.ChunkFile(context.Background(), testFile)
 	if err != nil {
 		t.Fatalf("Failed to chunk file: %v", err)
 	}
@@ -73,14 +76,14 @@ func TestStorageManager_ReconstructFile(t *testing.T) {
 	storageManager := NewStorageManager(tempDir, tempDir, 10) // 10 byte chunks
 
 	// Chunk the file
-	metadata, err := storageManager.ChunkFile(testFile)
+	metadata, err := storageManager.ChunkFile(context.Background(), testFile)
 	if err != nil {
 		t.Fatalf("Failed to chunk file: %v", err)
 	}
 
 	// Reconstruct the file
 	outputFile := filepath.Join(tempDir, "reconstructed.txt")
-	err = storageManager.ReconstructFile(metadata, outputFile)
+	err = storageManager.ReconstructFile(context.Background(), metadata, outputFile)
 	if err != nil {
 		t.Fatalf("Failed to reconstruct file: %v", err)
 	}
@@ -96,6 +99,28 @@ func TestStorageManager_ReconstructFile(t *testing.T) {
 	}
 }
 
+func TestStorageManager_ErrorCodes(t *testing.T) {
+	tempDir := t.TempDir()
+	storageManager := NewStorageManager(tempDir, tempDir, 10)
+
+	_, err := storageManager.ChunkFile(context.Background(), filepath.Join(tempDir, "missing.txt"))
+	if code := apierror.CodeOf(err); code != apierror.FileNotFound {
+		t.Errorf("Expected file_not_found chunking a missing file, got %q (%v)", code, err)
+	}
+	_, err = storageManager.ChunkFile(context.Background(), tempDir)
+	if code := apierror.CodeOf(err); code != apierror.InvalidPath {
+		t.Errorf("Expected invalid_path chunking a directory, got %q (%v)", code, err)
+	}
+	_, err = storageManager.LoadChunk("missing_chunk_0")
+	if code := apierror.CodeOf(err); code != apierror.ChunkNotFound {
+		t.Errorf("Expected chunk_not_found loading a missing chunk, got %q (%v)", code, err)
+	}
+	err = storageManager.ReconstructFile(context.Background(), &FileMetadata{}, filepath.Join(tempDir, "missing", "out.txt"))
+	if code := apierror.CodeOf(err); code != apierror.InvalidPath {
+		t.Errorf("Expected invalid_path writing into a missing directory, got %q (%v)", code, err)
+	}
+}
+
 func TestStorageManager_VerifyFileIntegrity(t *testing.T) {
 	// Create a temporary test file
 	tempDir := t.TempDir()
@@ -112,13 +137,13 @@ func TestStorageManager_VerifyFileIntegrity(t *testing.T) {
 	storageManager := NewStorageManager(tempDir, tempDir, 10)
 
 	// Chunk the file
-	metadata, err := storageManager.ChunkFile(testFile)
+	metadata, err := storageManager.ChunkFile(context.Background(), testFile)
 	if err != nil {
 		t.Fatalf("Failed to chunk file: %v", err)
 	}
 
 	// Verify integrity
-	isValid := storageManager.VerifyFileIntegrity(metadata)
+	isValid := storageManager.VerifyFileIntegrity(context.Background(), metadata)
 	if !isValid {
 		t.Error("Expected file integrity verification to pass")
 	}
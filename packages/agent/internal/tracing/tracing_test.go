@@ -0,0 +1,73 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+func TestMiddleware_ContinuesIncomingTrace(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() { provider.Shutdown(context.Background()) })
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(Middleware())
+	router.GET("/files/download/:hash", func(c *gin.Context) {
+		_, span := Start(c.Request.Context(), "zerog.download")
+		End(span, errors.New("node unreachable"))
+		c.Status(http.StatusBadGateway)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/files/download/abc", nil)
+	req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	router.ServeHTTP(httptest.NewRecorder(), req)
+
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("Expected 2 spans, got %d", len(spans))
+	}
+	child, server := spans[0], spans[1]
+
+	if server.Name() != "GET /files/download/:hash" {
+		t.Errorf("Expected the server span to be named after the route, got %q", server.Name())
+	}
+	if got := server.SpanContext().TraceID().String(); got != "4bf92f3577b34da6a3ce929d0e0e4736" {
+		t.Errorf("Expected the incoming trace to continue, got trace %s", got)
+	}
+	if got := server.Parent().SpanID().String(); got != "00f067aa0ba902b7" || !server.Parent().IsRemote() {
+		t.Errorf("Expected the caller's span as remote parent, got %s", got)
+	}
+	if server.Status().Code != codes.Error {
+		t.Errorf("Expected a 502 to mark the server span as failed, got %v", server.Status())
+	}
+	found := false
+	for _, attr := range server.Attributes() {
+		if attr == semconv.HTTPResponseStatusCode(http.StatusBadGateway) {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Expected the response status on the server span, got %v", server.Attributes())
+	}
+
+	if child.Parent().SpanID() != server.SpanContext().SpanID() {
+		t.Errorf("Expected the handler's span to be a child of the server span")
+	}
+	if child.Status().Code != codes.Error || len(child.Events()) != 1 {
+		t.Errorf("Expected the error recorded on the child span, got %v with %d events", child.Status(), len(child.Events()))
+	}
+}
@@ -0,0 +1,193 @@
+package webhook
+
+import (
+	"strings"
+
+	"nebularvault-agent/internal/anchor"
+	"nebularvault-agent/internal/metadata"
+	"nebularvault-agent/internal/pipeline"
+)
+
+// Sources tell receivers whether the agent or the indexed chain feed
+// reported an event
+const (
+	SourceAgent = "agent"
+	SourceChain = "chain"
+)
+
+// OnJob publishes the events a finished pipeline or anchor job stands for:
+// uploads, anchors, and the outcome of verification and repair
+func (d *Dispatcher) OnJob(job *metadata.Job) {
+	if job.Status != metadata.JobSucceeded {
+		return
+	}
+
+	var event *Event
+	switch job.Type {
+	case pipeline.JobUpload:
+		var result pipeline.UploadResult
+		if d.decodeResult(job, &result) {
+			event = &Event{
+				ID:   EventUploaded + ":" + job.ID,
+				Type: EventUploaded,
+				Data: map[string]interface{}{
+					"hash":        result.Hash,
+					"file_id":     result.FileID,
+					"filename":    result.Filename,
+					"size":        result.Size,
+					"merkle_root": result.MerkleRoot,
+					"chunks":      len(result.Chunks),
+					"owner":       job.Owner,
+					"job_id":      job.ID,
+					"source":      SourceAgent,
+				},
+			}
+		}
+	case anchor.JobType:
+		var result metadata.Anchor
+		if d.decodeResult(job, &result) && result.Status == metadata.AnchorConfirmed {
+			event = anchoredEvent(job.Key, result.TxHash, result.BlockNumber, SourceAgent)
+		}
+	case pipeline.JobVerify:
+		var result metadata.Verification
+		if d.decodeResult(job, &result) {
+			event = verificationEvent(job, &result)
+		}
+	case pipeline.JobRepair:
+		var result pipeline.RepairResult
+		if d.decodeResult(job, &result) && result.Verification != nil {
+			event = verificationEvent(job, result.Verification)
+		}
+	}
+
+	if event != nil {
+		d.publish(*event)
+	}
+}
+
+// OnChainEvents publishes the file lifecycle events among a batch of
+// indexed chain events. Uploads the agent anchored itself resolve to the
+// same event as its anchor job, so they are delivered once.
+func (d *Dispatcher) OnChainEvents(events []*metadata.Event) {
+	for _, e := range events {
+		hash := normalizeHash(e.FileHash)
+		if hash == "" {
+			continue
+		}
+
+		var event *Event
+		switch e.Name {
+		case metadata.EventFileUploaded, metadata.EventFileUploadedWithVerification:
+			event = anchoredEvent(hash, e.TxHash, e.BlockNumber, SourceChain)
+			event.Data["uploader"] = e.Account
+		case metadata.EventFileDeleted:
+			event = deletedEvent(hash, e.TxHash, SourceChain)
+			event.Data["block_number"] = e.BlockNumber
+			event.Data["deleted_by"] = e.Account
+		case metadata.EventProofVerified:
+			if valid, _ := e.Data["valid"].(bool); valid {
+				event = &Event{
+					ID:   EventVerified + ":" + hash + ":" + strings.ToLower(e.TxHash),
+					Type: EventVerified,
+					Data: map[string]interface{}{
+						"hash":         hash,
+						"tx_hash":      e.TxHash,
+						"block_number": e.BlockNumber,
+						"verifier":     e.Account,
+						"source":       SourceChain,
+					},
+				}
+			}
+		}
+
+		if event != nil {
+			d.publish(*event)
+		}
+	}
+}
+
+// FileDeleted publishes the deletion of a file by a transaction the agent
+// sent
+func (d *Dispatcher) FileDeleted(hash, txHash string) {
+	d.publish(*deletedEvent(normalizeHash(hash), txHash, SourceAgent))
+}
+
+// AccessGranted publishes a grant of access to a file by a transaction the
+// agent sent
+func (d *Dispatcher) AccessGranted(hash, address, txHash string) {
+	hash = normalizeHash(hash)
+	address = strings.ToLower(address)
+	d.publish(Event{
+		ID:   EventAccessGranted + ":" + hash + ":" + address + ":" + strings.ToLower(txHash),
+		Type: EventAccessGranted,
+		Data: map[string]interface{}{
+			"hash":    hash,
+			"address": address,
+			"tx_hash": txHash,
+			"source":  SourceAgent,
+		},
+	})
+}
+
+func anchoredEvent(hash, txHash string, blockNumber uint64, source string) *Event {
+	return &Event{
+		ID:   EventAnchored + ":" + hash + ":" + strings.ToLower(txHash),
+		Type: EventAnchored,
+		Data: map[string]interface{}{
+			"hash":         hash,
+			"tx_hash":      txHash,
+			"block_number": blockNumber,
+			"source":       source,
+		},
+	}
+}
+
+func deletedEvent(hash, txHash, source string) *Event {
+	return &Event{
+		ID:   EventDeleted + ":" + hash + ":" + strings.ToLower(txHash),
+		Type: EventDeleted,
+		Data: map[string]interface{}{
+			"hash":    hash,
+			"tx_hash": txHash,
+			"source":  source,
+		},
+	}
+}
+
+// verificationEvent reports a verified file, or one whose chunks can no
+// longer all be retrieved from 0G Storage as degraded
+func verificationEvent(job *metadata.Job, verification *metadata.Verification) *Event {
+	data := map[string]interface{}{
+		"hash":              job.Key,
+		"merkle_root_valid": verification.MerkleRootValid,
+		"checked_at":        verification.CheckedAt,
+		"job_id":            job.ID,
+		"source":            SourceAgent,
+	}
+	if verification.Valid {
+		return &Event{ID: EventVerified + ":" + job.ID, Type: EventVerified, Data: data}
+	}
+	data["missing_chunks"] = verification.MissingChunks
+	return &Event{ID: EventReplicationDegraded + ":" + job.ID, Type: EventReplicationDegraded, Data: data}
+}
+
+func (d *Dispatcher) decodeResult(job *metadata.Job, v interface{}) bool {
+	if err := job.DecodeResult(v); err != nil {
+		d.logger.WithError(err).WithField("job_id", job.ID).Warn("Failed to read job result for webhooks")
+		return false
+	}
+	return true
+}
+
+// publish logs rather than returns failures, as event sources cannot act
+// on them
+func (d *Dispatcher) publish(event Event) {
+	if err := d.Publish(event); err != nil {
+		d.logger.WithError(err).WithField("event_id", event.ID).Error("Failed to publish webhook event")
+	}
+}
+
+// normalizeHash matches the form file records are keyed by
+func normalizeHash(hash string) string {
+	return strings.TrimPrefix(strings.ToLower(hash), "0x")
+}
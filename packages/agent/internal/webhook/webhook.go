@@ -0,0 +1,395 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"nebularvault-agent/internal/jobs"
+	"nebularvault-agent/internal/metadata"
+	"nebularvault-agent/internal/version"
+)
+
+// Event types a webhook can subscribe to
+const (
+	EventUploaded            = "file.uploaded"
+	EventAnchored            = "file.anchored"
+	EventVerified            = "file.verified"
+	EventDeleted             = "file.deleted"
+	EventAccessGranted       = "file.access_granted"
+	EventReplicationDegraded = "file.replication_degraded"
+)
+
+// EventTypes lists every event type
+var EventTypes = []string{
+	EventUploaded,
+	EventAnchored,
+	EventVerified,
+	EventDeleted,
+	EventAccessGranted,
+	EventReplicationDegraded,
+}
+
+// ValidEvent reports whether eventType is one webhooks can subscribe to
+func ValidEvent(eventType string) bool {
+	for _, t := range EventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// JobType is the job queue type deliveries run as, keyed by delivery ID
+const JobType = "webhook"
+
+var (
+	// ErrInvalidWebhook is returned when creating a webhook with a bad URL
+	// or event list
+	ErrInvalidWebhook = errors.New("invalid webhook")
+
+	// ErrNotDead is returned when redelivering a delivery that is not in
+	// the dead-letter list
+	ErrNotDead = errors.New("delivery is not dead")
+)
+
+// Event is a file lifecycle event, posted as the body of each delivery.
+// Its ID is stable for what happened, so an event reported by both the
+// agent and the chain feed is delivered once.
+type Event struct {
+	ID        string                 `json:"id"`
+	Type      string                 `json:"type"`
+	CreatedAt time.Time              `json:"created_at"`
+	Data      map[string]interface{} `json:"data"`
+}
+
+// Config controls delivery retries and how long deliveries are kept
+type Config struct {
+	Workers     int
+	MaxAttempts int
+	Backoff     time.Duration
+	MaxBackoff  time.Duration
+	// Timeout bounds each delivery attempt
+	Timeout time.Duration
+	// Retention is how long delivered and dead deliveries stay in the log
+	Retention time.Duration
+}
+
+// Dispatcher fans file lifecycle events out to subscribed webhooks. Each
+// delivery is a job, so deliveries are retried with backoff and survive
+// restarts; deliveries that use up their attempts are dead-lettered.
+type Dispatcher struct {
+	store  *metadata.Store
+	queue  *jobs.Queue
+	client *http.Client
+	config Config
+	logger *logrus.Logger
+}
+
+// NewDispatcher creates a dispatcher and registers its deliveries and its
+// source of pipeline events with queue
+func NewDispatcher(store *metadata.Store, queue *jobs.Queue, config Config, logger *logrus.Logger) *Dispatcher {
+	if config.Timeout <= 0 {
+		config.Timeout = 10 * time.Second
+	}
+	if config.Retention <= 0 {
+		config.Retention = 7 * 24 * time.Hour
+	}
+
+	d := &Dispatcher{
+		store:  store,
+		queue:  queue,
+		client: &http.Client{Timeout: config.Timeout},
+		config: config,
+		logger: logger,
+	}
+	queue.Register(JobType, jobs.Policy{
+		Workers:     config.Workers,
+		MaxAttempts: config.MaxAttempts,
+		Backoff:     config.Backoff,
+		MaxBackoff:  config.MaxBackoff,
+		Timeout:     config.Timeout,
+	}, d.deliver)
+	queue.OnDone(d.OnJob)
+	return d
+}
+
+// Start queues pending deliveries left without a job and prunes old
+// deliveries until ctx is cancelled
+func (d *Dispatcher) Start(ctx context.Context) error {
+	pending, err := d.store.ListDeliveries(func(delivery *metadata.Delivery) bool {
+		return delivery.Status == metadata.DeliveryPending
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list pending deliveries: %w", err)
+	}
+	for _, delivery := range pending {
+		if err := d.enqueue(delivery.ID); err != nil {
+			return err
+		}
+	}
+
+	d.prune()
+	go func() {
+		ticker := time.NewTicker(time.Hour)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				d.prune()
+			}
+		}
+	}()
+	return nil
+}
+
+// Create subscribes url to events, generating the secret its deliveries
+// are signed with
+func (d *Dispatcher) Create(rawURL string, events []string, description string) (*metadata.Webhook, error) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return nil, fmt.Errorf("%w: url must be an absolute http or https URL", ErrInvalidWebhook)
+	}
+	if len(events) == 0 {
+		return nil, fmt.Errorf("%w: at least one event is required", ErrInvalidWebhook)
+	}
+	for _, event := range events {
+		if !ValidEvent(event) {
+			return nil, fmt.Errorf("%w: unknown event %q", ErrInvalidWebhook, event)
+		}
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, fmt.Errorf("failed to generate secret: %w", err)
+	}
+	webhook := &metadata.Webhook{
+		ID:          uuid.New().String(),
+		URL:         parsed.String(),
+		Events:      events,
+		Description: description,
+		Secret:      "whsec_" + hex.EncodeToString(secret),
+		CreatedAt:   time.Now().UTC(),
+	}
+	if err := d.store.PutWebhook(webhook); err != nil {
+		return nil, err
+	}
+	return webhook, nil
+}
+
+// Publish records a delivery of event for every webhook subscribed to its
+// type and queues them. An event already published is ignored.
+func (d *Dispatcher) Publish(event Event) error {
+	webhooks, err := d.store.ListWebhooks()
+	if err != nil {
+		return err
+	}
+	var subscribed []*metadata.Webhook
+	for _, webhook := range webhooks {
+		if webhook.Subscribed(event.Type) {
+			subscribed = append(subscribed, webhook)
+		}
+	}
+	if len(subscribed) == 0 {
+		return nil
+	}
+
+	if event.CreatedAt.IsZero() {
+		event.CreatedAt = time.Now().UTC()
+	}
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+
+	now := time.Now().UTC()
+	deliveries := make([]*metadata.Delivery, 0, len(subscribed))
+	for _, webhook := range subscribed {
+		deliveries = append(deliveries, &metadata.Delivery{
+			ID:        uuid.New().String(),
+			WebhookID: webhook.ID,
+			EventID:   event.ID,
+			EventType: event.Type,
+			Payload:   payload,
+			Status:    metadata.DeliveryPending,
+			Attempts:  []metadata.DeliveryAttempt{},
+			CreatedAt: now,
+			UpdatedAt: now,
+		})
+	}
+	first, err := d.store.PublishWebhookEvent(event.ID, deliveries)
+	if err != nil || !first {
+		return err
+	}
+
+	// A delivery left without a job is queued on restart
+	for _, delivery := range deliveries {
+		if err := d.enqueue(delivery.ID); err != nil {
+			return err
+		}
+	}
+
+	d.logger.WithFields(logrus.Fields{
+		"event_id": event.ID,
+		"type":     event.Type,
+		"webhooks": len(subscribed),
+	}).Debug("Webhook event published")
+	return nil
+}
+
+// Redeliver moves a dead delivery back to pending and queues it with a
+// fresh set of attempts
+func (d *Dispatcher) Redeliver(id string) (*metadata.Delivery, error) {
+	delivery, err := d.store.UpdateDelivery(id, func(delivery *metadata.Delivery) error {
+		if delivery.Status != metadata.DeliveryDead {
+			return ErrNotDead
+		}
+		delivery.Status = metadata.DeliveryPending
+		delivery.UpdatedAt = time.Now().UTC()
+		return nil
+	})
+	if err != nil {
+		return delivery, err
+	}
+	if err := d.enqueue(id); err != nil {
+		return nil, err
+	}
+	return d.store.GetDelivery(id)
+}
+
+// enqueue queues a delivery and records its job
+func (d *Dispatcher) enqueue(id string) error {
+	job, err := d.queue.Enqueue(JobType, id, "", id)
+	if err != nil {
+		return fmt.Errorf("failed to queue delivery: %w", err)
+	}
+	_, err = d.store.UpdateDelivery(id, func(delivery *metadata.Delivery) error {
+		delivery.JobID = job.ID
+		return nil
+	})
+	return err
+}
+
+// deliver is the delivery job runner: one signed POST to the webhook,
+// recorded in the delivery's log
+func (d *Dispatcher) deliver(ctx context.Context, job *metadata.Job) (interface{}, error) {
+	var id string
+	if err := job.DecodePayload(&id); err != nil {
+		return nil, jobs.Permanent(err)
+	}
+	delivery, err := d.store.GetDelivery(id)
+	if err != nil {
+		return nil, jobs.Permanent(fmt.Errorf("failed to load delivery: %w", err))
+	}
+
+	start := time.Now()
+	statusCode, sendErr := 0, error(nil)
+	webhook, err := d.store.GetWebhook(delivery.WebhookID)
+	switch {
+	case err == metadata.ErrNotFound:
+		sendErr = jobs.Permanent(errors.New("webhook was deleted"))
+	case err != nil:
+		return nil, err
+	default:
+		statusCode, sendErr = d.send(ctx, webhook, delivery)
+	}
+	if sendErr != nil && ctx.Err() != nil {
+		// Shutting down; the attempt resumes on restart
+		return nil, sendErr
+	}
+
+	attempt := metadata.DeliveryAttempt{
+		At:         start.UTC(),
+		StatusCode: statusCode,
+		DurationMs: time.Since(start).Milliseconds(),
+	}
+	if sendErr != nil {
+		attempt.Error = sendErr.Error()
+	}
+	if _, err := d.store.UpdateDelivery(id, func(delivery *metadata.Delivery) error {
+		now := time.Now().UTC()
+		delivery.Attempts = append(delivery.Attempts, attempt)
+		delivery.UpdatedAt = now
+		switch {
+		case sendErr == nil:
+			delivery.Status = metadata.DeliveryDelivered
+			delivery.DeliveredAt = &now
+		case jobs.IsPermanent(sendErr) || job.LastAttempt():
+			delivery.Status = metadata.DeliveryDead
+		}
+		return nil
+	}); err != nil {
+		d.logger.WithError(err).WithField("delivery_id", id).Error("Failed to record delivery attempt")
+	}
+
+	if sendErr != nil && (jobs.IsPermanent(sendErr) || job.LastAttempt()) {
+		d.logger.WithError(sendErr).WithFields(logrus.Fields{
+			"delivery_id": id,
+			"webhook_id":  delivery.WebhookID,
+			"event_id":    delivery.EventID,
+		}).Warn("Webhook delivery dead-lettered")
+	}
+	return map[string]int{"status_code": statusCode}, sendErr
+}
+
+// send posts a delivery's payload, signed with the webhook's secret, and
+// treats any 2xx response as delivered
+func (d *Dispatcher) send(ctx context.Context, webhook *metadata.Webhook, delivery *metadata.Delivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, jobs.Permanent(fmt.Errorf("failed to build request: %w", err))
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "NebulaVault-Webhook/"+version.Get().Version)
+	req.Header.Set(SignatureHeader, Sign(webhook.Secret, time.Now(), delivery.Payload))
+	req.Header.Set(EventHeader, delivery.EventType)
+	req.Header.Set(DeliveryHeader, delivery.ID)
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	// Drain a little so the connection can be reused
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("webhook responded with %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// prune deletes finished deliveries and forgets published events older
+// than the retention period
+func (d *Dispatcher) prune() {
+	cutoff := time.Now().Add(-d.config.Retention)
+	expired, err := d.store.ListDeliveries(func(delivery *metadata.Delivery) bool {
+		return delivery.Status != metadata.DeliveryPending && delivery.UpdatedAt.Before(cutoff)
+	})
+	if err != nil {
+		d.logger.WithError(err).Warn("Failed to list expired deliveries")
+		return
+	}
+	for _, delivery := range expired {
+		if err := d.store.DeleteDelivery(delivery.ID); err != nil {
+			d.logger.WithError(err).Warn("Failed to delete expired delivery")
+			return
+		}
+	}
+	if _, err := d.store.PruneWebhookEvents(cutoff); err != nil {
+		d.logger.WithError(err).Warn("Failed to prune published webhook events")
+	}
+}
@@ -0,0 +1,239 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"nebularvault-agent/internal/anchor"
+	"nebularvault-agent/internal/jobs"
+	"nebularvault-agent/internal/metadata"
+)
+
+// newDispatcher starts a dispatcher on a fresh store and queue, stopping it
+// when the test ends
+func newDispatcher(t *testing.T, config Config) (*Dispatcher, *metadata.Store) {
+	t.Helper()
+
+	store, err := metadata.Open(filepath.Join(t.TempDir(), "metadata"))
+	if err != nil {
+		t.Fatalf("Failed to open metadata store: %v", err)
+	}
+	queue := jobs.NewQueue(store, jobs.Config{}, logrus.New())
+	d := NewDispatcher(store, queue, config, logrus.New())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	if err := queue.Start(ctx); err != nil {
+		t.Fatalf("Failed to start queue: %v", err)
+	}
+	if err := d.Start(ctx); err != nil {
+		t.Fatalf("Failed to start dispatcher: %v", err)
+	}
+	t.Cleanup(func() {
+		cancel()
+		queue.Wait()
+		store.Close()
+	})
+	return d, store
+}
+
+// waitDelivery polls until a delivery to webhookID reaches status
+func waitDelivery(t *testing.T, store *metadata.Store, webhookID string, status metadata.DeliveryStatus) *metadata.Delivery {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		deliveries, err := store.ListDeliveries(func(delivery *metadata.Delivery) bool {
+			return delivery.WebhookID == webhookID && delivery.Status == status
+		})
+		if err != nil {
+			t.Fatalf("Failed to list deliveries: %v", err)
+		}
+		if len(deliveries) > 0 {
+			return deliveries[0]
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("Timed out waiting for a %s delivery", status)
+	return nil
+}
+
+func TestSignature(t *testing.T) {
+	body := []byte(`{"type":"file.uploaded"}`)
+	header := Sign("whsec_test", time.Now(), body)
+
+	if err := Verify("whsec_test", header, body, 5*time.Minute); err != nil {
+		t.Fatalf("Expected a valid signature, got %v", err)
+	}
+	if err := Verify("whsec_other", header, body, 5*time.Minute); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("Expected a wrong secret to be rejected, got %v", err)
+	}
+	if err := Verify("whsec_test", header, []byte(`{"type":"file.deleted"}`), 5*time.Minute); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("Expected a tampered body to be rejected, got %v", err)
+	}
+	if err := Verify("whsec_test", "v1=abc", body, 0); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("Expected a header without a timestamp to be rejected, got %v", err)
+	}
+
+	old := Sign("whsec_test", time.Now().Add(-time.Hour), body)
+	if err := Verify("whsec_test", old, body, 5*time.Minute); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("Expected an old signature to be rejected, got %v", err)
+	}
+	if err := Verify("whsec_test", old, body, 0); err != nil {
+		t.Errorf("Expected a zero tolerance to skip the age check, got %v", err)
+	}
+}
+
+func TestDispatcher_DeliversSignedEvents(t *testing.T) {
+	d, store := newDispatcher(t, Config{Workers: 1, MaxAttempts: 3, Backoff: 10 * time.Millisecond})
+
+	var mu sync.Mutex
+	var secret string
+	received := make(chan Event, 4)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		err := Verify(secret, r.Header.Get(SignatureHeader), body, time.Minute)
+		mu.Unlock()
+		if err != nil {
+			t.Errorf("Received a badly signed delivery: %v", err)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var event Event
+		if err := json.Unmarshal(body, &event); err != nil {
+			t.Errorf("Received an undecodable delivery: %v", err)
+		}
+		if r.Header.Get(EventHeader) != event.Type {
+			t.Errorf("Expected event header %s, got %s", event.Type, r.Header.Get(EventHeader))
+		}
+		received <- event
+	}))
+	defer server.Close()
+
+	if _, err := d.Create("ftp://example.com", []string{EventUploaded}, ""); !errors.Is(err, ErrInvalidWebhook) {
+		t.Errorf("Expected a non-http URL to be rejected, got %v", err)
+	}
+	if _, err := d.Create(server.URL, []string{"file.renamed"}, ""); !errors.Is(err, ErrInvalidWebhook) {
+		t.Errorf("Expected an unknown event to be rejected, got %v", err)
+	}
+
+	created, err := d.Create(server.URL, []string{EventAnchored}, "indexer")
+	if err != nil {
+		t.Fatalf("Failed to create webhook: %v", err)
+	}
+	mu.Lock()
+	secret = created.Secret
+	mu.Unlock()
+
+	// The agent's anchor job and the chain feed report the same upload
+	result, _ := json.Marshal(metadata.Anchor{Status: metadata.AnchorConfirmed, TxHash: "0xABC", BlockNumber: 42})
+	d.OnJob(&metadata.Job{ID: "job-1", Type: anchor.JobType, Key: "cafe", Status: metadata.JobSucceeded, Result: result})
+	d.OnChainEvents([]*metadata.Event{{
+		Name:        metadata.EventFileUploaded,
+		TxHash:      "0xabc",
+		BlockNumber: 42,
+		FileHash:    "0xCAFE",
+	}})
+	// Not subscribed
+	d.FileDeleted("cafe", "0xdef")
+
+	select {
+	case event := <-received:
+		if event.Type != EventAnchored || event.Data["hash"] != "cafe" {
+			t.Errorf("Unexpected event %+v", event)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for a delivery")
+	}
+	delivery := waitDelivery(t, store, created.ID, metadata.DeliveryDelivered)
+	if len(delivery.Attempts) != 1 || delivery.Attempts[0].StatusCode != http.StatusOK {
+		t.Errorf("Expected one successful attempt, got %+v", delivery.Attempts)
+	}
+
+	select {
+	case event := <-received:
+		t.Errorf("Expected the anchor to be delivered once, also got %+v", event)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestDispatcher_DeadLettersAndRedelivers(t *testing.T) {
+	d, store := newDispatcher(t, Config{Workers: 1, MaxAttempts: 2, Backoff: 10 * time.Millisecond})
+
+	var healthy atomic.Bool
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		if !healthy.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	created, err := d.Create(server.URL, []string{EventDeleted}, "")
+	if err != nil {
+		t.Fatalf("Failed to create webhook: %v", err)
+	}
+	d.FileDeleted("0xCAFE", "0xdef")
+
+	dead := waitDelivery(t, store, created.ID, metadata.DeliveryDead)
+	if calls.Load() != 2 || len(dead.Attempts) != 2 {
+		t.Fatalf("Expected two attempts before dead-lettering, got %d calls and %+v", calls.Load(), dead.Attempts)
+	}
+	if dead.Attempts[1].StatusCode != http.StatusServiceUnavailable || dead.Attempts[1].Error == "" {
+		t.Errorf("Expected the failed attempt to be logged, got %+v", dead.Attempts[1])
+	}
+
+	healthy.Store(true)
+	if _, err := d.Redeliver(dead.ID); err != nil {
+		t.Fatalf("Failed to redeliver: %v", err)
+	}
+	delivered := waitDelivery(t, store, created.ID, metadata.DeliveryDelivered)
+	if len(delivered.Attempts) != 3 {
+		t.Errorf("Expected the retry to be added to the log, got %+v", delivered.Attempts)
+	}
+	if _, err := d.Redeliver(dead.ID); !errors.Is(err, ErrNotDead) {
+		t.Errorf("Expected a delivered delivery not to be redelivered, got %v", err)
+	}
+}
+
+func TestDispatcher_PublishRecordsDeliveriesOnce(t *testing.T) {
+	d, store := newDispatcher(t, Config{Workers: 1, MaxAttempts: 1, Backoff: 10 * time.Millisecond})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+	for i := 0; i < 2; i++ {
+		if _, err := d.Create(server.URL, []string{EventUploaded}, ""); err != nil {
+			t.Fatalf("Failed to create webhook: %v", err)
+		}
+	}
+
+	// Every delivery is stored with the event, so publishing it again adds
+	// none
+	event := Event{ID: "evt-1", Type: EventUploaded}
+	for i := 0; i < 2; i++ {
+		if err := d.Publish(event); err != nil {
+			t.Fatalf("Failed to publish: %v", err)
+		}
+	}
+	deliveries, err := store.ListDeliveries(func(delivery *metadata.Delivery) bool {
+		return delivery.EventID == event.ID
+	})
+	if err != nil {
+		t.Fatalf("Failed to list deliveries: %v", err)
+	}
+	if len(deliveries) != 2 || deliveries[0].WebhookID == deliveries[1].WebhookID {
+		t.Errorf("Expected one delivery per webhook, got %+v", deliveries)
+	}
+}
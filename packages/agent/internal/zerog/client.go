@@ -1,11 +1,26 @@
 package zerog
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"nebularvault-agent/internal/apierror"
+	"nebularvault-agent/internal/logging"
+	"nebularvault-agent/internal/metrics"
+	"nebularvault-agent/internal/tracing"
 )
 
 // ZeroGConfig holds configuration for 0G Storage client
@@ -22,8 +37,9 @@ type ZeroGConfig struct {
 
 // ZeroGClient implements the 0G Storage client
 type ZeroGClient struct {
-	config *ZeroGConfig
-	logger *logrus.Logger
+	config     *ZeroGConfig
+	logger     *logrus.Logger
+	httpClient *http.Client
 }
 
 // UploadResponse represents the response from an upload operation
@@ -51,13 +67,12 @@ type ProofResponse struct {
 type HealthResponse struct {
 	Success bool   `json:"success"`
 	Message string `json:"message"`
+	// Endpoints maps each endpoint probed to "ok" or why it is unreachable
+	Endpoints map[string]string `json:"endpoints,omitempty"`
 }
 
 // NewZeroGClient creates a new 0G Storage client
-func NewZeroGClient(config *ZeroGConfig) (*ZeroGClient, error) {
-	logger := logrus.New()
-	logger.SetLevel(logrus.InfoLevel)
-
+func NewZeroGClient(config *ZeroGConfig, logger *logrus.Logger) (*ZeroGClient, error) {
 	logger.WithFields(logrus.Fields{
 		"indexer_endpoint":  config.IndexerEndpoint,
 		"transfer_endpoint": config.TransferEndpoint,
@@ -68,23 +83,29 @@ func NewZeroGClient(config *ZeroGConfig) (*ZeroGClient, error) {
 	}).Info("Initializing 0G Storage client")
 
 	return &ZeroGClient{
-		config: config,
-		logger: logger,
+		config:     config,
+		logger:     logger,
+		httpClient: &http.Client{Timeout: config.Timeout},
 	}, nil
 }
 
 // Upload uploads data to 0G Storage
-func (c *ZeroGClient) Upload(data []byte, metadata map[string]interface{}) (*UploadResponse, error) {
-	c.logger.WithField("data_size", len(data)).Info("Starting upload to 0G Storage...")
+func (c *ZeroGClient) Upload(ctx context.Context, data []byte, metadata map[string]interface{}) (resp *UploadResponse, err error) {
+	span := c.start(ctx, "upload", c.config.TransferEndpoint, attribute.Int("zerog.size", len(data)))
+	defer c.observe(span, "upload", c.config.TransferEndpoint, time.Now(), &err)
+	log := logging.Entry(ctx, c.logger)
+	log.WithField("data_size", len(data)).Info("Starting upload to 0G Storage...")
 
 	// Create a mock hash based on the data content
 	// In a real implementation, this would be the actual 0G Storage upload
 	hash := common.BytesToHash(data[:min(32, len(data))])
 	
 	// Simulate upload delay
-	time.Sleep(100 * time.Millisecond)
+	if err := wait(ctx, 100*time.Millisecond); err != nil {
+		return &UploadResponse{Success: false, Message: err.Error()}, callError(err, "Upload to 0G Storage failed")
+	}
 
-	c.logger.WithField("hash", hash.Hex()).Info("Upload successful")
+	log.WithField("hash", hash.Hex()).Info("Upload successful")
 
 	return &UploadResponse{
 		Success: true,
@@ -94,8 +115,11 @@ func (c *ZeroGClient) Upload(data []byte, metadata map[string]interface{}) (*Upl
 }
 
 // Download downloads data from 0G Storage
-func (c *ZeroGClient) Download(hash string) (*DownloadResponse, error) {
-	c.logger.WithField("hash", hash).Info("Starting download from 0G Storage...")
+func (c *ZeroGClient) Download(ctx context.Context, hash string) (resp *DownloadResponse, err error) {
+	span := c.start(ctx, "download", c.config.IndexerEndpoint, attribute.String("zerog.hash", hash))
+	defer c.observe(span, "download", c.config.IndexerEndpoint, time.Now(), &err)
+	log := logging.Entry(ctx, c.logger)
+	log.WithField("hash", hash).Info("Starting download from 0G Storage...")
 
 	// Parse hash
 	rootHash := common.HexToHash(hash)
@@ -103,16 +127,18 @@ func (c *ZeroGClient) Download(hash string) (*DownloadResponse, error) {
 		return &DownloadResponse{
 			Success: false,
 			Message: "Invalid hash format",
-		}, fmt.Errorf("invalid hash format")
+		}, apierror.New(apierror.InvalidHash, "Invalid hash format")
 	}
 
 	// Simulate download delay
-	time.Sleep(100 * time.Millisecond)
+	if err := wait(ctx, 100*time.Millisecond); err != nil {
+		return &DownloadResponse{Success: false, Message: err.Error()}, callError(err, "Download from 0G Storage failed")
+	}
 
 	// Return mock data
 	mockData := []byte(fmt.Sprintf("Mock downloaded data for hash: %s", rootHash.Hex()))
 
-	c.logger.WithField("hash", rootHash.Hex()).Info("Download successful")
+	log.WithField("hash", rootHash.Hex()).Info("Download successful")
 
 	return &DownloadResponse{
 		Success: true,
@@ -122,8 +148,11 @@ func (c *ZeroGClient) Download(hash string) (*DownloadResponse, error) {
 }
 
 // GetProof retrieves a proof for data stored in 0G Storage
-func (c *ZeroGClient) GetProof(hash string) (*ProofResponse, error) {
-	c.logger.WithField("hash", hash).Info("Getting proof from 0G Storage...")
+func (c *ZeroGClient) GetProof(ctx context.Context, hash string) (resp *ProofResponse, err error) {
+	span := c.start(ctx, "proof", c.config.CoreEndpoint, attribute.String("zerog.hash", hash))
+	defer c.observe(span, "proof", c.config.CoreEndpoint, time.Now(), &err)
+	log := logging.Entry(ctx, c.logger)
+	log.WithField("hash", hash).Info("Getting proof from 0G Storage...")
 
 	// Parse hash
 	rootHash := common.HexToHash(hash)
@@ -131,16 +160,18 @@ func (c *ZeroGClient) GetProof(hash string) (*ProofResponse, error) {
 		return &ProofResponse{
 			Success: false,
 			Message: "Invalid hash format",
-		}, fmt.Errorf("invalid hash format")
+		}, apierror.New(apierror.InvalidHash, "Invalid hash format")
 	}
 
 	// Simulate proof generation delay
-	time.Sleep(50 * time.Millisecond)
+	if err := wait(ctx, 50*time.Millisecond); err != nil {
+		return &ProofResponse{Success: false, Message: err.Error()}, callError(err, "Proof retrieval from 0G Storage failed")
+	}
 
 	// Generate mock proof
 	proof := fmt.Sprintf("merkle_proof_for_%s", rootHash.Hex())
 
-	c.logger.WithField("proof", proof).Info("Proof retrieved successfully")
+	log.WithField("proof", proof).Info("Proof retrieved successfully")
 
 	return &ProofResponse{
 		Success: true,
@@ -149,48 +180,133 @@ func (c *ZeroGClient) GetProof(hash string) (*ProofResponse, error) {
 	}, nil
 }
 
-// HealthCheck checks the health of 0G Storage connection
-func (c *ZeroGClient) HealthCheck() (*HealthResponse, error) {
-	c.logger.Info("Performing 0G Storage health check...")
+// HealthCheck checks that the indexer, transfer and core endpoints answer
+// and that the RPC endpoint serves the configured chain
+func (c *ZeroGClient) HealthCheck(ctx context.Context) (resp *HealthResponse, err error) {
+	span := c.start(ctx, "health", c.config.RPCURL)
+	defer c.observe(span, "health", c.config.RPCURL, time.Now(), &err)
+	log := logging.Entry(ctx, c.logger)
+	log.Debug("Performing 0G Storage health check...")
+
+	probes := []struct {
+		name     string
+		endpoint string
+		probe    func(context.Context, string) error
+	}{
+		{"indexer endpoint", c.config.IndexerEndpoint, c.probeHTTP},
+		{"transfer endpoint", c.config.TransferEndpoint, c.probeHTTP},
+		{"core endpoint", c.config.CoreEndpoint, c.probeHTTP},
+		{"rpc url", c.config.RPCURL, c.probeRPC},
+	}
+	for _, p := range probes {
+		if p.endpoint == "" {
+			return &HealthResponse{
+				Success: false,
+				Message: p.name + " not configured",
+			}, apierror.Newf(apierror.ZeroGUnavailable, "0G Storage %s not configured", p.name)
+		}
+	}
 
-	// Simulate health check delay
-	time.Sleep(50 * time.Millisecond)
+	resp = &HealthResponse{Success: true, Endpoints: make(map[string]string, len(probes))}
+	statuses := make([]string, len(probes))
+	var wg sync.WaitGroup
+	for i, p := range probes {
+		wg.Add(1)
+		go func(i int, endpoint string, probe func(context.Context, string) error) {
+			defer wg.Done()
+			statuses[i] = "ok"
+			if err := probe(ctx, endpoint); err != nil {
+				statuses[i] = err.Error()
+			}
+		}(i, p.endpoint, p.probe)
+	}
+	wg.Wait()
+
+	var failed []string
+	for i, p := range probes {
+		resp.Endpoints[p.endpoint] = statuses[i]
+		if statuses[i] != "ok" {
+			failed = append(failed, fmt.Sprintf("%s: %s", p.name, statuses[i]))
+		}
+	}
+	if len(failed) > 0 {
+		resp.Success = false
+		resp.Message = strings.Join(failed, "; ")
+		return resp, callError(errors.New(resp.Message), "0G Storage unreachable")
+	}
 
-	// Check configuration
-	if c.config.IndexerEndpoint == "" {
-		return &HealthResponse{
-			Success: false,
-			Message: "Indexer endpoint not configured",
-		}, fmt.Errorf("indexer endpoint not configured")
+	resp.Message = fmt.Sprintf("0G Storage connection is healthy - Connected to %s", c.config.RPCURL)
+	log.Debug("0G Storage health check passed")
+	return resp, nil
+}
+
+// probeHTTP checks that endpoint answers HTTP; any response counts, since
+// nodes differ in which paths they serve
+func (c *ZeroGClient) probeHTTP(ctx context.Context, endpoint string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("invalid endpoint: %w", err)
+	}
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("unreachable: %w", err)
 	}
+	res.Body.Close()
+	return nil
+}
 
-	if c.config.TransferEndpoint == "" {
-		return &HealthResponse{
-			Success: false,
-			Message: "Transfer endpoint not configured",
-		}, fmt.Errorf("transfer endpoint not configured")
+// probeRPC checks that endpoint serves the configured chain
+func (c *ZeroGClient) probeRPC(ctx context.Context, endpoint string) error {
+	client, err := ethclient.DialContext(ctx, endpoint)
+	if err != nil {
+		return fmt.Errorf("unreachable: %w", err)
 	}
+	defer client.Close()
 
-	if c.config.CoreEndpoint == "" {
-		return &HealthResponse{
-			Success: false,
-			Message: "Core endpoint not configured",
-		}, fmt.Errorf("core endpoint not configured")
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get chain ID: %w", err)
+	}
+	if chainID.Cmp(big.NewInt(c.config.ChainID)) != 0 {
+		return fmt.Errorf("serves chain %s, expected %d", chainID, c.config.ChainID)
 	}
+	return nil
+}
 
-	if c.config.RPCURL == "" {
-		return &HealthResponse{
-			Success: false,
-			Message: "RPC URL not configured",
-		}, fmt.Errorf("rpc url not configured")
+// wait pauses for d, or until ctx is done
+func wait(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
 	}
+}
 
-	c.logger.Info("0G Storage health check passed")
+// callError types a failed call to 0G Storage: a deadline or network
+// timeout is zerog_timeout, and anything else zerog_unavailable
+func callError(err error, message string) error {
+	var netErr net.Error
+	if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout()) {
+		return apierror.Wrap(err, apierror.ZeroGTimeout, message)
+	}
+	return apierror.Wrap(err, apierror.ZeroGUnavailable, message)
+}
 
-	return &HealthResponse{
-		Success: true,
-		Message: fmt.Sprintf("0G Storage connection is healthy - Connected to %s", c.config.RPCURL),
-	}, nil
+// start begins the span of a call to endpoint
+func (c *ZeroGClient) start(ctx context.Context, operation, endpoint string, attrs ...attribute.KeyValue) trace.Span {
+	attrs = append(attrs, attribute.String("zerog.operation", operation), attribute.String("zerog.endpoint", endpoint))
+	_, span := tracing.Start(ctx, "zerog."+operation, attrs...)
+	return span
+}
+
+// observe records the latency and outcome of a call and ends its span; it
+// is deferred with the call's named error
+func (c *ZeroGClient) observe(span trace.Span, operation, endpoint string, start time.Time, err *error) {
+	metrics.ObserveZeroG(operation, endpoint, start, *err)
+	tracing.End(span, *err)
 }
 
 // Close closes the 0G Storage client connections
@@ -1,4 +1,16 @@
 #!/bin/bash
-echo "Uploading file via 0g-storage-client..."
-# Example:
-# ./0g-storage-client upload --file $1 --endpoint $INDEXER_ENDPOINT
+
+# Upload a file to 0G Storage with the agent's own backends, without a
+# running server. Extra arguments are passed to the upload command, e.g.
+# --json or --config <dir>. AGENT overrides the agent binary to run.
+
+set -e
+
+if [ -z "$1" ]; then
+    echo "Usage: $0 <file> [upload flags]" >&2
+    exit 1
+fi
+
+AGENT=${AGENT:-nebularvault-agent}
+echo "Uploading $1 via $AGENT..." >&2
+"$AGENT" upload "$@"
@@ -26,13 +26,13 @@ echo "⬆️  Uploading file to 0G Storage..."
 UPLOAD_RESPONSE=$(curl -s -X POST \
   -F "file=@/tmp/test_file.txt" \
   -F "metadata={\"name\":\"test_file.txt\",\"description\":\"Test file for 0G Storage\"}" \
-  http://localhost:8080/api/v1/files/upload)
+  "http://localhost:8080/api/v1/files/upload?wait=25s")
 
 echo "📤 Upload Response:"
 echo $UPLOAD_RESPONSE | jq .
 
 # Extract hash from response
-HASH=$(echo $UPLOAD_RESPONSE | jq -r '.data.merkle_root')
+HASH=$(echo $UPLOAD_RESPONSE | jq -r '.data.result.merkle_root')
 echo "🔑 File Hash: $HASH"
 
 if [ "$HASH" != "null" ] && [ "$HASH" != "" ]; then